@@ -1143,7 +1143,7 @@ func TestSyncLAPSPassword(t *testing.T) {
 	}, func(harness integration.HarnessDetails, db graph.Database) {
 		if localGroupData, err := adAnalysis.FetchLocalGroupData(testContext.Context(), db); err != nil {
 			t.Fatalf("error expanding groups in integration test; %v", err)
-		} else if _, err := adAnalysis.PostSyncLAPSPassword(testContext.Context(), db, localGroupData); err != nil {
+		} else if _, err := adAnalysis.PostSyncLAPSPassword(testContext.Context(), db, localGroupData, nil); err != nil {
 			t.Fatalf("error creating SyncLAPSPassword edges in integration test; %v", err)
 		} else {
 			db.ReadTransaction(context.Background(), func(tx graph.Transaction) error {
@@ -1174,7 +1174,7 @@ func TestDCSync(t *testing.T) {
 	}, func(harness integration.HarnessDetails, db graph.Database) {
 		if localGroupData, err := adAnalysis.FetchLocalGroupData(testContext.Context(), db); err != nil {
 			t.Fatalf("error expanding groups in integration test; %v", err)
-		} else if _, err := adAnalysis.PostDCSync(testContext.Context(), db, localGroupData); err != nil {
+		} else if _, err := adAnalysis.PostDCSync(testContext.Context(), db, localGroupData, nil); err != nil {
 			t.Fatalf("error creating DCSync edges in integration test; %v", err)
 		} else {
 			db.ReadTransaction(context.Background(), func(tx graph.Transaction) error {
@@ -1206,7 +1206,7 @@ func TestOwnsWriteOwnerPriorCollectorVersions(t *testing.T) {
 	}, func(harness integration.HarnessDetails, db graph.Database) {
 		if localGroupData, err := adAnalysis.FetchLocalGroupData(testContext.Context(), db); err != nil {
 			t.Fatalf("error expanding groups in integration test; %v", err)
-		} else if _, err := adAnalysis.PostOwnsAndWriteOwner(testContext.Context(), db, localGroupData); err != nil {
+		} else if _, err := adAnalysis.PostOwnsAndWriteOwner(testContext.Context(), db, localGroupData, nil); err != nil {
 			t.Fatalf("error creating Owns/WriteOwner edges in integration test; %v", err)
 		} else {
 			db.ReadTransaction(context.Background(), func(tx graph.Transaction) error {
@@ -1409,7 +1409,7 @@ func TestOwnsWriteOwner(t *testing.T) {
 	}, func(harness integration.HarnessDetails, db graph.Database) {
 		if localGroupData, err := adAnalysis.FetchLocalGroupData(testContext.Context(), db); err != nil {
 			t.Fatalf("error expanding groups in integration test; %v", err)
-		} else if _, err := adAnalysis.PostOwnsAndWriteOwner(testContext.Context(), db, localGroupData); err != nil {
+		} else if _, err := adAnalysis.PostOwnsAndWriteOwner(testContext.Context(), db, localGroupData, nil); err != nil {
 			t.Fatalf("error creating Owns/WriteOwner edges in integration test; %v", err)
 		} else {
 			db.ReadTransaction(context.Background(), func(tx graph.Transaction) error {
@@ -1587,7 +1587,7 @@ func TestHasTrustKeys(t *testing.T) {
 	require.NoError(t, err)
 
 	err = graphDB.ReadTransaction(testCtx.Context(), func(tx graph.Transaction) error {
-		if _, err := adAnalysis.PostHasTrustKeys(testCtx.Context(), graphDB); err != nil {
+		if _, err := adAnalysis.PostHasTrustKeys(testCtx.Context(), graphDB, nil); err != nil {
 			t.Fatalf("error creating HasTrustKeys edges in integration test; %v", err)
 		} else {
 			if err = graphDB.ReadTransaction(context.Background(), func(tx graph.Transaction) error {
@@ -1663,7 +1663,7 @@ func TestProtectAdminGroups(t *testing.T) {
 	require.NoError(t, err)
 
 	err = graphDB.ReadTransaction(testCtx.Context(), func(tx graph.Transaction) error {
-		if _, err := adAnalysis.PostProtectAdminGroups(testCtx.Context(), graphDB); err != nil {
+		if _, err := adAnalysis.PostProtectAdminGroups(testCtx.Context(), graphDB, nil); err != nil {
 			t.Fatalf("error creating ProtectAdminGroups edges in integration test; %v", err)
 		} else {
 			if err = graphDB.ReadTransaction(context.Background(), func(tx graph.Transaction) error {
@@ -2719,6 +2719,112 @@ func TestADCSESC6b(t *testing.T) {
 	})
 }
 
+func TestADCSESC7(t *testing.T) {
+	t.Run("ESC7HarnessPrincipalEdges", func(t *testing.T) {
+		testContext := integration.NewGraphTestContext(t, graphschema.DefaultGraphSchema())
+		testContext.DatabaseTestWithSetup(func(harness *integration.HarnessDetails) error {
+			harness.ESC7HarnessPrincipalEdges.Setup(testContext)
+			return nil
+		}, func(harness integration.HarnessDetails, db graph.Database) {
+			operation := analysis.NewPostRelationshipOperation(context.Background(), db, "ADCS Post Process Test - ESC7")
+
+			localGroupData, enterpriseCertAuthorities, _, domains, cache, err := FetchADCSPrereqs(db)
+			require.Nil(t, err)
+
+			for _, enterpriseCA := range enterpriseCertAuthorities {
+				innerEnterpriseCA := enterpriseCA
+				targetDomains := &graph.NodeSet{}
+				for _, domain := range domains {
+					innerDomain := domain
+
+					if cache.DoesCAChainProperlyToDomain(innerEnterpriseCA, innerDomain) {
+						targetDomains.Add(innerDomain)
+					}
+				}
+
+				operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
+					if err := ad2.PostADCSESC7(ctx, tx, outC, localGroupData, innerEnterpriseCA, targetDomains, cache); err != nil {
+						t.Logf("failed post processing for %s: %v", ad.ADCSESC7.String(), err)
+					}
+					return nil
+				})
+			}
+			err = operation.Done()
+			require.Nil(t, err)
+
+			db.ReadTransaction(context.Background(), func(tx graph.Transaction) error {
+				if results, err := ops.FetchStartNodes(tx.Relationships().Filterf(func() graph.Criteria {
+					return query.Kind(query.Relationship(), ad.ADCSESC7)
+				})); err != nil {
+					t.Fatalf("error fetching esc7 edges in integration test; %v", err)
+				} else {
+					names := []string{}
+					for _, node := range results.Slice() {
+						name, _ := node.Properties.Get(common.Name.String()).String()
+						names = append(names, name)
+					}
+
+					// Group0 approves its own request as an officer and Group1 can make itself an officer, both on a template
+					// where the enrollee supplies the subject. Group5 can enable EDITF_ATTRIBUTESUBJECTALTNAME2 as a CA
+					// administrator on a template without the security extension, which Group6 can not do as an officer.
+					// Group2 cannot manage the CA, Group3 only enrolls on a template that is not vulnerable to either right
+					// and Group4 cannot enroll on the CA.
+					require.ElementsMatch(t, []string{"Group0", "Group1", "Group5"}, names)
+				}
+
+				return nil
+			})
+
+			requireComposition := func(t *testing.T, principalName string, expectedNodeNames []string, expectedRight, unexpectedRight graph.Kind) {
+				var edge *graph.Relationship
+
+				require.Nil(t, db.ReadTransaction(context.Background(), func(tx graph.Transaction) error {
+					var err error
+
+					edge, err = tx.Relationships().Filterf(func() graph.Criteria {
+						return query.And(
+							query.Kind(query.Relationship(), ad.ADCSESC7),
+							query.Equals(query.StartProperty(common.Name.String()), principalName),
+						)
+					}).First()
+					return err
+				}))
+
+				composition, err := ad2.GetADCSESC7EdgeComposition(context.Background(), db, edge)
+				require.Nil(t, err)
+
+				names := []string{}
+				for _, node := range composition.AllNodes().Slice() {
+					name, _ := node.Properties.Get(common.Name.String()).String()
+					names = append(names, name)
+				}
+
+				require.ElementsMatch(t, expectedNodeNames, names)
+
+				// the composition must only show the management right the edge was created for
+				kinds := graph.Kinds{}
+				for _, p := range composition.Paths() {
+					for _, rel := range p.Edges {
+						kinds = kinds.Add(rel.Kind)
+					}
+				}
+
+				require.True(t, kinds.ContainsOneOf(expectedRight))
+				require.False(t, kinds.ContainsOneOf(unexpectedRight))
+			}
+
+			t.Run("ManageCertificates", func(t *testing.T) {
+				requireComposition(t, "Group0", []string{"Group0", "CertTemplate0", "EnterpriseCA0", "NTAuthStore0", "RootCA0", "Domain0"}, ad.ManageCertificates, ad.ManageCA)
+			})
+
+			t.Run("ManageCA", func(t *testing.T) {
+				requireComposition(t, "Group1", []string{"Group1", "CertTemplate0", "EnterpriseCA0", "NTAuthStore0", "RootCA0", "Domain0"}, ad.ManageCA, ad.ManageCertificates)
+				requireComposition(t, "Group5", []string{"Group5", "CertTemplate2", "EnterpriseCA0", "NTAuthStore0", "RootCA0", "Domain0"}, ad.ManageCA, ad.ManageCertificates)
+			})
+		})
+	})
+}
+
 func TestADCSESC10a(t *testing.T) {
 	t.Run("ADCSESC10aPrincipalHarness", func(t *testing.T) {
 		testContext := integration.NewGraphTestContext(t, graphschema.DefaultGraphSchema())
@@ -3710,6 +3816,75 @@ func TestADCSESC10b(t *testing.T) {
 	})
 }
 
+func TestADCSESC15(t *testing.T) {
+	t.Run("ESC15HarnessPrincipalEdges", func(t *testing.T) {
+		testContext := integration.NewGraphTestContext(t, graphschema.DefaultGraphSchema())
+		testContext.DatabaseTestWithSetup(func(harness *integration.HarnessDetails) error {
+			harness.ESC15HarnessPrincipalEdges.Setup(testContext)
+			return nil
+		}, func(harness integration.HarnessDetails, db graph.Database) {
+			operation := analysis.NewPostRelationshipOperation(context.Background(), db, "ADCS Post Process Test - ESC15")
+
+			localGroupData, enterpriseCertAuthorities, _, domains, cache, err := FetchADCSPrereqs(db)
+			require.Nil(t, err)
+
+			for _, enterpriseCA := range enterpriseCertAuthorities {
+				innerEnterpriseCA := enterpriseCA
+				targetDomains := &graph.NodeSet{}
+				for _, domain := range domains {
+					innerDomain := domain
+
+					if cache.DoesCAChainProperlyToDomain(innerEnterpriseCA, innerDomain) {
+						targetDomains.Add(innerDomain)
+					}
+				}
+
+				operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
+					if err := ad2.PostADCSESC15(ctx, tx, outC, localGroupData, innerEnterpriseCA, targetDomains, cache); err != nil {
+						t.Logf("failed post processing for %s: %v", ad.ADCSESC15.String(), err)
+					}
+					return nil
+				})
+			}
+			err = operation.Done()
+			require.Nil(t, err)
+
+			db.ReadTransaction(context.Background(), func(tx graph.Transaction) error {
+				if results, err := ops.FetchStartNodes(tx.Relationships().Filterf(func() graph.Criteria {
+					return query.Kind(query.Relationship(), ad.ADCSESC15)
+				})); err != nil {
+					t.Fatalf("error fetching esc15 edges in integration test; %v", err)
+				} else {
+					// Group1 only enrolls on a patched CA, Group2 and Group3 enroll on templates that are not schema
+					// version 1 with an enrollee supplied subject and Group4 cannot enroll on the CA
+					require.Equal(t, 1, len(results))
+					name, _ := results.Pick().Properties.Get(common.Name.String()).String()
+					require.Equal(t, "Group0", name)
+				}
+
+				if edge, err := tx.Relationships().Filterf(func() graph.Criteria {
+					return query.Kind(query.Relationship(), ad.ADCSESC15)
+				}).First(); err != nil {
+					t.Fatalf("error fetching esc15 edge in integration test; %v", err)
+				} else {
+					composition, err := ad2.GetADCSESC15EdgeComposition(context.Background(), db, edge)
+					require.Nil(t, err)
+
+					names := []string{}
+					for _, node := range composition.AllNodes().Slice() {
+						name, _ := node.Properties.Get(common.Name.String()).String()
+						names = append(names, name)
+					}
+
+					require.ElementsMatch(t, []string{"Group0", "CertTemplate0", "EnterpriseCA0", "NTAuthStore0", "RootCA0", "Domain0"}, names)
+				}
+
+				return nil
+			})
+		})
+	})
+}
+
 func TestExtendedByPolicyBinding(t *testing.T) {
 	testContext := integration.NewGraphTestContext(t, graphschema.DefaultGraphSchema())
 	testContext.DatabaseTestWithSetup(func(harness *integration.HarnessDetails) error {
@@ -142,6 +142,125 @@ func TestNTLMRelayToADCSComposition(t *testing.T) {
 
 }
 
+func TestPostNTLMRelayADCSRPC(t *testing.T) {
+	testContext := integration.NewGraphTestContext(t, graphschema.DefaultGraphSchema())
+
+	testContext.DatabaseTestWithSetup(func(harness *integration.HarnessDetails) error {
+		harness.NTLMCoerceAndRelayNTLMToADCSRPC.Setup(testContext)
+		return nil
+	}, func(harness integration.HarnessDetails, db graph.Database) {
+		operation := analysis.NewPostRelationshipOperation(context.Background(), db, "NTLM Post Process Test - CoerceAndRelayNTLMToADCSRPC")
+		localGroupData, _, _, _, err := fetchNTLMPrereqs(db)
+		require.NoError(t, err)
+		ntlmCache, err := ad2.NewNTLMCache(context.Background(), db, localGroupData)
+		require.NoError(t, err)
+
+		cache := ad2.NewADCSCache()
+		enterpriseCertAuthorities, err := ad2.FetchNodesByKind(context.Background(), db, ad.EnterpriseCA)
+		require.NoError(t, err)
+		certTemplates, err := ad2.FetchNodesByKind(context.Background(), db, ad.CertTemplate)
+		require.NoError(t, err)
+		err = cache.BuildCache(context.Background(), db, enterpriseCertAuthorities, certTemplates)
+		require.NoError(t, err)
+
+		err = ad2.PostCoerceAndRelayNTLMToADCS(cache, operation, ntlmCache)
+		require.NoError(t, err)
+		err = ad2.PostCoerceAndRelayNTLMToADCSRPC(cache, operation, ntlmCache)
+		require.NoError(t, err)
+
+		operation.Done()
+
+		db.ReadTransaction(context.Background(), func(tx graph.Transaction) error {
+			if results, err := ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+				return query.Kind(query.Relationship(), ad.CoerceAndRelayNTLMToADCSRPC)
+			})); err != nil {
+				t.Fatalf("error fetching ntlm to adcs rpc edges in integration test; %v", err)
+			} else {
+				require.Len(t, results, 1)
+				rel := results[0]
+
+				start, end, err := ops.FetchRelationshipNodes(tx, rel)
+				require.NoError(t, err)
+
+				require.Equal(t, start.ID, harness.NTLMCoerceAndRelayNTLMToADCSRPC.AuthenticatedUsersGroup.ID)
+				require.Equal(t, end.ID, harness.NTLMCoerceAndRelayNTLMToADCSRPC.Computer.ID)
+			}
+
+			// Neither CA has a vulnerable web enrollment endpoint, so no ESC8 edge may be created
+			if count, err := tx.Relationships().Filterf(func() graph.Criteria {
+				return query.Kind(query.Relationship(), ad.CoerceAndRelayNTLMToADCS)
+			}).Count(); err != nil {
+				t.Fatalf("error counting ntlm to adcs edges in integration test; %v", err)
+			} else {
+				require.Zero(t, count)
+			}
+			return nil
+		})
+	})
+}
+
+func TestNTLMRelayToADCSRPCComposition(t *testing.T) {
+	testContext := integration.NewGraphTestContext(t, graphschema.DefaultGraphSchema())
+
+	testContext.DatabaseTestWithSetup(func(harness *integration.HarnessDetails) error {
+		harness.NTLMCoerceAndRelayNTLMToADCSRPC.Setup(testContext)
+		return nil
+	}, func(harness integration.HarnessDetails, db graph.Database) {
+		operation := analysis.NewPostRelationshipOperation(context.Background(), db, "NTLM Composition Test - CoerceAndRelayNTLMToADCSRPC")
+		localGroupData, _, _, _, err := fetchNTLMPrereqs(db)
+		require.NoError(t, err)
+		ntlmCache, err := ad2.NewNTLMCache(context.Background(), db, localGroupData)
+		require.NoError(t, err)
+
+		cache := ad2.NewADCSCache()
+		enterpriseCertAuthorities, err := ad2.FetchNodesByKind(context.Background(), db, ad.EnterpriseCA)
+		require.NoError(t, err)
+		certTemplates, err := ad2.FetchNodesByKind(context.Background(), db, ad.CertTemplate)
+		require.NoError(t, err)
+		err = cache.BuildCache(context.Background(), db, enterpriseCertAuthorities, certTemplates)
+		require.NoError(t, err)
+
+		err = ad2.PostCoerceAndRelayNTLMToADCSRPC(cache, operation, ntlmCache)
+		require.NoError(t, err)
+
+		operation.Done()
+
+		db.ReadTransaction(context.Background(), func(tx graph.Transaction) error {
+			if edge, err := tx.Relationships().Filterf(
+				func() graph.Criteria {
+					return query.And(
+						query.Kind(query.Relationship(), ad.CoerceAndRelayNTLMToADCSRPC),
+						query.Equals(query.StartProperty(common.Name.String()), "Authenticated Users Group"),
+					)
+				}).First(); err != nil {
+
+				t.Fatalf("error fetching NTLM to ADCS RPC edge in integration test: %v", err)
+			} else {
+				composition, err := ad2.GetCoerceAndRelayNTLMtoADCSRPCEdgeComposition(context.Background(), db, edge)
+				require.Nil(t, err)
+
+				nodes := composition.AllNodes()
+
+				require.Equal(t, 7, len(nodes))
+				require.True(t, nodes.Contains(harness.NTLMCoerceAndRelayNTLMToADCSRPC.Computer))
+				require.True(t, nodes.Contains(harness.NTLMCoerceAndRelayNTLMToADCSRPC.CertTemplate1))
+				require.True(t, nodes.Contains(harness.NTLMCoerceAndRelayNTLMToADCSRPC.EnterpriseCA1))
+				require.True(t, nodes.Contains(harness.NTLMCoerceAndRelayNTLMToADCSRPC.RootCA))
+				require.True(t, nodes.Contains(harness.NTLMCoerceAndRelayNTLMToADCSRPC.Domain))
+				require.True(t, nodes.Contains(harness.NTLMCoerceAndRelayNTLMToADCSRPC.NTAuthStore))
+				require.True(t, nodes.Contains(harness.NTLMCoerceAndRelayNTLMToADCSRPC.AuthenticatedUsersGroup))
+				require.False(t, nodes.Contains(harness.NTLMCoerceAndRelayNTLMToADCSRPC.EnterpriseCA2))
+
+				relayTargets, err := ad2.GetVulnerableEnterpriseCAsForRelayNTLMtoADCSRPC(context.Background(), db, edge)
+				require.Nil(t, err)
+				require.Equal(t, 1, relayTargets.Len())
+				require.True(t, relayTargets.Contains(harness.NTLMCoerceAndRelayNTLMToADCSRPC.EnterpriseCA1))
+			}
+			return nil
+		})
+	})
+}
+
 func TestPostNTLMRelaySMB(t *testing.T) {
 	t.Run("NTLMCoerceAndRelayNTLMToSMB Success", func(t *testing.T) {
 		testContext := integration.NewGraphTestContext(t, graphschema.DefaultGraphSchema())
@@ -29,7 +29,190 @@ import (
 	"github.com/specterops/dawgs/graph"
 )
 
-func Post(ctx context.Context, db graph.Database, adcsEnabled, citrixEnabled, ntlmEnabled bool, compositionCounter *analysis.CompositionCounter) (*analysis.AtomicPostProcessingStats, error) {
+const (
+	PostProcessorWellKnownNodeTypes = "ad.well_known_node_types"
+	PostProcessorDomainAssociations = "ad.domain_associations"
+	PostProcessorWellKnownNodeLinks = "ad.well_known_node_links"
+	PostProcessorTransitEdges       = "ad.transit_edges"
+	PostProcessorDCSync             = "ad.dcsync"
+	PostProcessorProtectAdminGroups = "ad.protect_admin_groups"
+	PostProcessorSyncLAPSPassword   = "ad.sync_laps_password"
+	PostProcessorHasTrustKeys       = "ad.has_trust_keys"
+	PostProcessorLocalGroups        = "ad.local_groups"
+	PostProcessorCanRDP             = "ad.can_rdp"
+	PostProcessorADCS               = "ad.adcs"
+	PostProcessorOwns               = "ad.owns"
+	PostProcessorNTLM               = "ad.ntlm"
+)
+
+// postState is shared by the Active Directory post-processors of a single run
+type postState struct {
+	adcsEnabled        bool
+	citrixEnabled      bool
+	ntlmEnabled        bool
+	compositionCounter *analysis.CompositionCounter
+	domainSIDs         []string
+
+	scope          *adAnalysis.DomainScope
+	localGroupData *adAnalysis.LocalGroupData
+	adcsCache      adAnalysis.ADCSCache
+}
+
+// fetchLocalGroupData fetches local group membership the first time a post-processor needs it, so that it is not
+// fetched at all when every post-processor relying on it is disabled
+func (s *postState) fetchLocalGroupData(ctx context.Context, db graph.Database) (*adAnalysis.LocalGroupData, error) {
+	if s.localGroupData == nil {
+		if localGroupData, err := adAnalysis.FetchLocalGroupData(ctx, db); err != nil {
+			return nil, err
+		} else {
+			s.localGroupData = localGroupData
+		}
+	}
+
+	return s.localGroupData, nil
+}
+
+// withLocalGroupData adapts a post-processor that requires local group membership
+func withLocalGroupData(delegate func(ctx context.Context, db graph.Database, state *postState, localGroupData *adAnalysis.LocalGroupData) (*analysis.AtomicPostProcessingStats, error)) func(ctx context.Context, db graph.Database, state *postState) (*analysis.AtomicPostProcessingStats, error) {
+	return func(ctx context.Context, db graph.Database, state *postState) (*analysis.AtomicPostProcessingStats, error) {
+		if localGroupData, err := state.fetchLocalGroupData(ctx, db); err != nil {
+			return nil, err
+		} else {
+			return delegate(ctx, db, state, localGroupData)
+		}
+	}
+}
+
+// PostProcessors are the Active Directory post-processors in the order they run
+var PostProcessors = analysis.MustNewPostProcessorRegistry(
+	analysis.PostProcessor[*postState]{
+		Name:     PostProcessorWellKnownNodeTypes,
+		Required: true,
+		Run: func(ctx context.Context, db graph.Database, _ *postState) (*analysis.AtomicPostProcessingStats, error) {
+			return nil, adAnalysis.FixWellKnownNodeTypes(ctx, db)
+		},
+	},
+	analysis.PostProcessor[*postState]{
+		Name:      PostProcessorDomainAssociations,
+		DependsOn: []string{PostProcessorWellKnownNodeTypes},
+		Required:  true,
+		Run: func(ctx context.Context, db graph.Database, _ *postState) (*analysis.AtomicPostProcessingStats, error) {
+			return nil, adAnalysis.RunDomainAssociations(ctx, db)
+		},
+	},
+	analysis.PostProcessor[*postState]{
+		Name:      PostProcessorWellKnownNodeLinks,
+		DependsOn: []string{PostProcessorDomainAssociations},
+		Required:  true,
+		Run: func(ctx context.Context, db graph.Database, _ *postState) (*analysis.AtomicPostProcessingStats, error) {
+			return nil, adAnalysis.LinkWellKnownNodes(ctx, db)
+		},
+	},
+	analysis.PostProcessor[*postState]{
+		// Resolves the domains the run rebuilds and deletes their post-processed relationships
+		Name:      PostProcessorTransitEdges,
+		DependsOn: []string{PostProcessorDomainAssociations},
+		Required:  true,
+		Run: func(ctx context.Context, db graph.Database, state *postState) (*analysis.AtomicPostProcessingStats, error) {
+			if scope, err := adAnalysis.FetchDomainScope(ctx, db, state.domainSIDs); err != nil {
+				return nil, err
+			} else {
+				state.scope = scope
+				return adAnalysis.DeleteScopedTransitEdges(ctx, db, scope)
+			}
+		},
+	},
+	analysis.PostProcessor[*postState]{
+		Name:          PostProcessorDCSync,
+		DependsOn:     []string{PostProcessorTransitEdges},
+		Relationships: graph.Kinds{ad.DCSync},
+		Run: withLocalGroupData(func(ctx context.Context, db graph.Database, state *postState, localGroupData *adAnalysis.LocalGroupData) (*analysis.AtomicPostProcessingStats, error) {
+			return adAnalysis.PostDCSync(ctx, db, localGroupData, state.scope)
+		}),
+	},
+	analysis.PostProcessor[*postState]{
+		Name:          PostProcessorProtectAdminGroups,
+		DependsOn:     []string{PostProcessorTransitEdges},
+		Relationships: graph.Kinds{ad.ProtectAdminGroups},
+		Run: func(ctx context.Context, db graph.Database, state *postState) (*analysis.AtomicPostProcessingStats, error) {
+			return adAnalysis.PostProtectAdminGroups(ctx, db, state.scope)
+		},
+	},
+	analysis.PostProcessor[*postState]{
+		Name:          PostProcessorSyncLAPSPassword,
+		DependsOn:     []string{PostProcessorTransitEdges},
+		Relationships: graph.Kinds{ad.SyncLAPSPassword},
+		Run: withLocalGroupData(func(ctx context.Context, db graph.Database, state *postState, localGroupData *adAnalysis.LocalGroupData) (*analysis.AtomicPostProcessingStats, error) {
+			return adAnalysis.PostSyncLAPSPassword(ctx, db, localGroupData, state.scope)
+		}),
+	},
+	analysis.PostProcessor[*postState]{
+		Name:          PostProcessorHasTrustKeys,
+		DependsOn:     []string{PostProcessorTransitEdges},
+		Relationships: graph.Kinds{ad.HasTrustKeys},
+		Run: func(ctx context.Context, db graph.Database, state *postState) (*analysis.AtomicPostProcessingStats, error) {
+			return adAnalysis.PostHasTrustKeys(ctx, db, state.scope)
+		},
+	},
+	analysis.PostProcessor[*postState]{
+		Name:          PostProcessorLocalGroups,
+		DependsOn:     []string{PostProcessorTransitEdges},
+		Relationships: graph.Kinds{ad.AdminTo, ad.CanPSRemote, ad.ExecuteDCOM},
+		Run: withLocalGroupData(func(ctx context.Context, db graph.Database, state *postState, localGroupData *adAnalysis.LocalGroupData) (*analysis.AtomicPostProcessingStats, error) {
+			return adAnalysis.PostLocalGroups(ctx, db, localGroupData, state.scope)
+		}),
+	},
+	analysis.PostProcessor[*postState]{
+		Name:          PostProcessorCanRDP,
+		DependsOn:     []string{PostProcessorTransitEdges},
+		Relationships: graph.Kinds{ad.CanRDP},
+		Run: withLocalGroupData(func(ctx context.Context, db graph.Database, state *postState, localGroupData *adAnalysis.LocalGroupData) (*analysis.AtomicPostProcessingStats, error) {
+			return adAnalysis.PostCanRDP(ctx, db, localGroupData, true, state.citrixEnabled, state.scope)
+		}),
+	},
+	analysis.PostProcessor[*postState]{
+		Name:      PostProcessorADCS,
+		DependsOn: []string{PostProcessorDomainAssociations, PostProcessorTransitEdges},
+		Relationships: graph.Kinds{
+			ad.TrustedForNTAuth, ad.IssuedSignedBy, ad.EnterpriseCAFor, ad.ExtendedByPolicy, ad.EnrollOnBehalfOf, ad.GoldenCert,
+			ad.ADCSESC1, ad.ADCSESC3, ad.ADCSESC4, ad.ADCSESC6a, ad.ADCSESC6b, ad.ADCSESC7, ad.ADCSESC9a, ad.ADCSESC9b,
+			ad.ADCSESC10a, ad.ADCSESC10b, ad.ADCSESC13, ad.ADCSESC15,
+		},
+		Run: withLocalGroupData(func(ctx context.Context, db graph.Database, state *postState, localGroupData *adAnalysis.LocalGroupData) (*analysis.AtomicPostProcessingStats, error) {
+			stats, adcsCache, err := adAnalysis.PostADCS(ctx, db, localGroupData, state.adcsEnabled, state.scope)
+			state.adcsCache = adcsCache
+
+			return stats, err
+		}),
+	},
+	analysis.PostProcessor[*postState]{
+		Name:          PostProcessorOwns,
+		DependsOn:     []string{PostProcessorTransitEdges},
+		Relationships: graph.Kinds{ad.Owns, ad.WriteOwner},
+		Run: withLocalGroupData(func(ctx context.Context, db graph.Database, state *postState, localGroupData *adAnalysis.LocalGroupData) (*analysis.AtomicPostProcessingStats, error) {
+			return adAnalysis.PostOwnsAndWriteOwner(ctx, db, localGroupData, state.scope)
+		}),
+	},
+	analysis.PostProcessor[*postState]{
+		// Relaying to ADCS is resolved from the cache built during ADCS post-processing
+		Name:      PostProcessorNTLM,
+		DependsOn: []string{PostProcessorADCS},
+		Relationships: graph.Kinds{
+			ad.CoerceAndRelayNTLMToSMB, ad.CoerceAndRelayNTLMToADCS, ad.CoerceAndRelayNTLMToADCSRPC, ad.CoerceAndRelayNTLMToLDAP,
+			ad.CoerceAndRelayNTLMToLDAPS,
+		},
+		Run: withLocalGroupData(func(ctx context.Context, db graph.Database, state *postState, localGroupData *adAnalysis.LocalGroupData) (*analysis.AtomicPostProcessingStats, error) {
+			return adAnalysis.PostNTLM(ctx, db, localGroupData, state.adcsCache, state.ntlmEnabled, state.compositionCounter, state.scope)
+		}),
+	},
+)
+
+// Post runs Active Directory post-processing. When domainSIDs is empty every post-processed relationship in the graph is
+// deleted and rebuilt. Otherwise only relationships ending in the given domains, or in domains directly trust-connected
+// to them, are deleted and recomputed. The post-processors named in disabledPostProcessors, along with those depending
+// on them, are skipped and the relationships they create are removed from the entire graph. Each post-processor is
+// reported to the recorder unless it is nil.
+func Post(ctx context.Context, db graph.Database, adcsEnabled, citrixEnabled, ntlmEnabled bool, compositionCounter *analysis.CompositionCounter, domainSIDs []string, disabledPostProcessors []string, recorder analysis.PostProcessorRecorder) (*analysis.AtomicPostProcessingStats, error) {
 	defer measure.ContextLogAndMeasure(
 		ctx,
 		slog.LevelInfo,
@@ -39,48 +222,39 @@ func Post(ctx context.Context, db graph.Database, adcsEnabled, citrixEnabled, nt
 		attr.Scope("step"),
 	)()
 
-	aggregateStats := analysis.NewAtomicPostProcessingStats()
+	var (
+		aggregateStats = analysis.NewAtomicPostProcessingStats()
+		plan           = PostProcessors.Plan(disabledPostProcessors)
+		state          = &postState{
+			adcsEnabled:        adcsEnabled,
+			citrixEnabled:      citrixEnabled,
+			ntlmEnabled:        ntlmEnabled,
+			compositionCounter: compositionCounter,
+			domainSIDs:         domainSIDs,
+			adcsCache:          adAnalysis.NewADCSCache(),
+		}
+	)
 
-	if err := adAnalysis.FixWellKnownNodeTypes(ctx, db); err != nil {
-		return &aggregateStats, err
-	} else if err := adAnalysis.RunDomainAssociations(ctx, db); err != nil {
-		return &aggregateStats, err
-	} else if err := adAnalysis.LinkWellKnownNodes(ctx, db); err != nil {
-		return &aggregateStats, err
-	} else if deleteTransitEdgesStats, err := analysis.DeleteTransitEdges(ctx, db, graph.Kinds{ad.Entity, azure.Entity}, ad.PostProcessedRelationships()); err != nil {
-		return &aggregateStats, err
-	} else if localGroupData, err := adAnalysis.FetchLocalGroupData(ctx, db); err != nil {
-		return &aggregateStats, err
-	} else if dcSyncStats, err := adAnalysis.PostDCSync(ctx, db, localGroupData); err != nil {
-		return &aggregateStats, err
-	} else if protectAdminGroupsStats, err := adAnalysis.PostProtectAdminGroups(ctx, db); err != nil {
-		return &aggregateStats, err
-	} else if syncLAPSStats, err := adAnalysis.PostSyncLAPSPassword(ctx, db, localGroupData); err != nil {
-		return &aggregateStats, err
-	} else if hasTrustKeyStats, err := adAnalysis.PostHasTrustKeys(ctx, db); err != nil {
-		return &aggregateStats, err
-	} else if localGroupStats, err := adAnalysis.PostLocalGroups(ctx, db, localGroupData); err != nil {
-		return &aggregateStats, err
-	} else if canRDPStats, err := adAnalysis.PostCanRDP(ctx, db, localGroupData, true, citrixEnabled); err != nil {
-		return &aggregateStats, err
-	} else if adcsStats, adcsCache, err := adAnalysis.PostADCS(ctx, db, localGroupData, adcsEnabled); err != nil {
-		return &aggregateStats, err
-	} else if ownsStats, err := adAnalysis.PostOwnsAndWriteOwner(ctx, db, localGroupData); err != nil {
-		return &aggregateStats, err
-	} else if ntlmStats, err := adAnalysis.PostNTLM(ctx, db, localGroupData, adcsCache, ntlmEnabled, compositionCounter); err != nil {
+	if stats, err := DeleteDisabledRelationships(ctx, db, disabledPostProcessors); err != nil {
 		return &aggregateStats, err
 	} else {
-		aggregateStats.Merge(deleteTransitEdgesStats)
-		aggregateStats.Merge(syncLAPSStats)
-		aggregateStats.Merge(hasTrustKeyStats)
-		aggregateStats.Merge(dcSyncStats)
-		aggregateStats.Merge(protectAdminGroupsStats)
-		aggregateStats.Merge(localGroupStats)
-		aggregateStats.Merge(canRDPStats)
-		aggregateStats.Merge(adcsStats)
-		aggregateStats.Merge(ownsStats)
-		aggregateStats.Merge(ntlmStats)
-
-		return &aggregateStats, nil
+		aggregateStats.Merge(stats)
+	}
+
+	stats, err := PostProcessors.Run(ctx, db, state, plan, recorder)
+	aggregateStats.Merge(stats)
+
+	return &aggregateStats, err
+}
+
+// DeleteDisabledRelationships removes the relationships created by the disabled post-processors, and those depending
+// on them, from every domain. Scoped post-processing only clears the domains it rebuilds which would otherwise leave
+// them behind everywhere else.
+func DeleteDisabledRelationships(ctx context.Context, db graph.Database, disabledPostProcessors []string) (*analysis.AtomicPostProcessingStats, error) {
+	if disabledKinds := PostProcessors.DisabledRelationships(PostProcessors.Plan(disabledPostProcessors)); len(disabledKinds) > 0 {
+		return analysis.DeleteTransitEdges(ctx, db, graph.Kinds{ad.Entity, azure.Entity}, disabledKinds)
 	}
+
+	stats := analysis.NewAtomicPostProcessingStats()
+	return &stats, nil
 }
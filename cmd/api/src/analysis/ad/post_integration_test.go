@@ -0,0 +1,88 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+
+package ad_test
+
+import (
+	"testing"
+
+	analysisAD "github.com/specterops/bloodhound/cmd/api/src/analysis/ad"
+	"github.com/specterops/bloodhound/cmd/api/src/test/integration"
+	"github.com/specterops/bloodhound/packages/go/analysis"
+	adAnalysis "github.com/specterops/bloodhound/packages/go/analysis/ad"
+	schema "github.com/specterops/bloodhound/packages/go/graphschema"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/query"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostScopedDeleteAndRebuild(t *testing.T) {
+	testContext := integration.NewGraphTestContext(t, schema.DefaultGraphSchema())
+
+	testContext.DatabaseTestWithSetup(func(harness *integration.HarnessDetails) error {
+		harness.DCSyncHarness.Setup(testContext)
+		return nil
+	}, func(harness integration.HarnessDetails, db graph.Database) {
+		var (
+			ctx                = testContext.Context()
+			compositionCounter = analysis.NewCompositionCounter()
+		)
+
+		domainSID, err := harness.DCSyncHarness.Domain1.Properties.Get(common.ObjectID.String()).String()
+		require.NoError(t, err)
+
+		countDCSync := func() int64 {
+			var count int64
+
+			require.NoError(t, db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+				var err error
+				count, err = tx.Relationships().Filter(query.Kind(query.Relationship(), ad.DCSync)).Count()
+				return err
+			}))
+
+			return count
+		}
+
+		_, err = analysisAD.Post(ctx, db, false, false, false, &compositionCounter, nil, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), countDCSync())
+
+		// A scoped run starts by deleting the post-processed relationships of its domains
+		scope, err := adAnalysis.FetchDomainScope(ctx, db, []string{domainSID})
+		require.NoError(t, err)
+
+		_, err = adAnalysis.DeleteScopedTransitEdges(ctx, db, scope)
+		require.NoError(t, err)
+		require.Zero(t, countDCSync())
+
+		// Rebuilding the scope restores them
+		_, err = analysisAD.Post(ctx, db, false, false, false, &compositionCounter, []string{domainSID}, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), countDCSync())
+
+		// As does the full rebuild that follows a scoped run that did not complete
+		_, err = adAnalysis.DeleteScopedTransitEdges(ctx, db, scope)
+		require.NoError(t, err)
+
+		_, err = analysisAD.Post(ctx, db, false, false, false, &compositionCounter, nil, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), countDCSync())
+	})
+}
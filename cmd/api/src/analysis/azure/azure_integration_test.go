@@ -675,6 +675,101 @@ func TestEntityDetails(t *testing.T) {
 			}
 		})
 	})
+	t.Run("AdministrativeUnitScopedRoles", func(t *testing.T) {
+		testContext.ReadTransactionTestWithSetup(func(harness *integration.HarnessDetails) error {
+			harness.AZAdministrativeUnitHarness.Setup(testContext)
+			return nil
+		}, func(harness integration.HarnessDetails, tx graph.Transaction) {
+			_, err := azureanalysis.UserRoleAssignments(context.Background(), testContext.Graph.Database)
+			require.Nil(t, err)
+
+			scopedAdminID := harness.AZAdministrativeUnitHarness.ScopedAdmin.ID
+
+			// The scoped admin may only reset passwords of users in the administrative unit
+			resetPasswordEdges, err := ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+				return query.And(
+					query.Kind(query.Relationship(), azure.ResetPassword),
+					query.Equals(query.StartID(), scopedAdminID),
+				)
+			}))
+			require.Nil(t, err)
+			require.Len(t, resetPasswordEdges, 1)
+			assert.Equal(t, harness.AZAdministrativeUnitHarness.MemberUser.ID, resetPasswordEdges[0].EndID)
+
+			// The scoped admin may only add members to groups in the administrative unit
+			addMembersEdges, err := ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+				return query.And(
+					query.Kind(query.Relationship(), azure.AddMembers),
+					query.Equals(query.StartID(), scopedAdminID),
+				)
+			}))
+			require.Nil(t, err)
+			require.Len(t, addMembersEdges, 1)
+			assert.Equal(t, harness.AZAdministrativeUnitHarness.MemberGroup.ID, addMembersEdges[0].EndID)
+
+			// The scoped assignment must not be expanded into tenant wide edges from the role nodes
+			roleEdges, err := ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+				return query.And(
+					query.KindIn(query.Relationship(), azure.ResetPassword, azure.AddMembers),
+					query.InIDs(query.StartID(), harness.AZAdministrativeUnitHarness.PasswordAdminRole.ID, harness.AZAdministrativeUnitHarness.GroupsAdminRole.ID),
+					query.Equals(query.EndID(), scopedAdminID),
+				)
+			}))
+			require.Nil(t, err)
+			assert.Len(t, roleEdges, 0)
+
+			// The tenant wide role can reset the password of the user outside of the administrative unit...
+			outsideUserID := harness.AZAdministrativeUnitHarness.OutsideUser.ID
+			tenantWideEdges, err := ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+				return query.And(
+					query.Kind(query.Relationship(), azure.ResetPassword),
+					query.Equals(query.StartID(), harness.AZAdministrativeUnitHarness.PasswordAdminRole.ID),
+					query.Equals(query.EndID(), outsideUserID),
+				)
+			}))
+			require.Nil(t, err)
+			require.Len(t, tenantWideEdges, 1)
+
+			// ...but the scoped assignment must not be traversable to the role, so the scoped admin has no path to that user
+			var paths graph.PathSet
+			require.Nil(t, tx.Relationships().Filter(query.And(
+				query.Equals(query.StartID(), scopedAdminID),
+				query.Equals(query.EndID(), outsideUserID),
+				query.KindIn(query.Relationship(), azure.PathfindingRelationships()...),
+			)).FetchAllShortestPaths(func(cursor graph.Cursor[graph.Path]) error {
+				for path := range cursor.Chan() {
+					if len(path.Edges) > 0 {
+						paths.AddPath(path)
+					}
+				}
+				return cursor.Error()
+			}))
+			assert.Len(t, paths, 0)
+		})
+	})
+	t.Run("GetStorageKeys", func(t *testing.T) {
+		testContext.ReadTransactionTestWithSetup(func(harness *integration.HarnessDetails) error {
+			harness.AZStorageAccountHarness.Setup(testContext)
+			return nil
+		}, func(harness integration.HarnessDetails, tx graph.Transaction) {
+			_, err := azureanalysis.GetStorageKeys(context.Background(), testContext.Graph.Database)
+			require.Nil(t, err)
+
+			getStorageKeysEdges, err := ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+				return query.Kind(query.Relationship(), azure.GetStorageKeys)
+			}))
+			require.Nil(t, err)
+
+			// Accounts with shared key authorization disabled must not receive any edges
+			require.Len(t, getStorageKeysEdges, 2)
+			for _, edge := range getStorageKeysEdges {
+				assert.Equal(t, harness.AZStorageAccountHarness.StorageAccount.ID, edge.EndID)
+			}
+
+			startIDs := []graph.ID{getStorageKeysEdges[0].StartID, getStorageKeysEdges[1].StartID}
+			assert.ElementsMatch(t, []graph.ID{harness.AZStorageAccountHarness.Contributor.ID, harness.AZStorageAccountHarness.Owner.ID}, startIDs)
+		})
+	})
 	t.Run("ServicePrincipalEntityDetails", func(t *testing.T) {
 		testContext.ReadTransactionTestWithSetup(func(harness *integration.HarnessDetails) error {
 			harness.AZEntityPanelHarness.Setup(testContext)
@@ -30,7 +30,98 @@ import (
 	"github.com/specterops/dawgs/graph"
 )
 
-func Post(ctx context.Context, db graph.Database) (*analysis.AtomicPostProcessingStats, error) {
+const (
+	PostProcessorManagementGroupNames = "azure.management_group_names"
+	PostProcessorTransitEdges         = "azure.transit_edges"
+	PostProcessorUserRoleAssignments  = "azure.user_role_assignments"
+	PostProcessorExecuteCommand       = "azure.execute_command"
+	PostProcessorAppRoleAssignments   = "azure.app_role_assignments"
+	PostProcessorGetStorageKeys       = "azure.get_storage_keys"
+	PostProcessorHybrid               = "azure.hybrid"
+	PostProcessorRoleApprovers        = "azure.role_approvers"
+)
+
+// PostProcessors are the Azure post-processors in the order they run. Azure post-processing keeps no state between
+// post-processors.
+var PostProcessors = analysis.MustNewPostProcessorRegistry(
+	analysis.PostProcessor[struct{}]{
+		Name:     PostProcessorManagementGroupNames,
+		Required: true,
+		Run: func(ctx context.Context, db graph.Database, _ struct{}) (*analysis.AtomicPostProcessingStats, error) {
+			// Unnamed management groups are cosmetic and must not fail post-processing
+			if err := azureAnalysis.FixManagementGroupNames(ctx, db); err != nil {
+				slog.WarnContext(ctx, "Error fixing management group names", attr.Error(err))
+			}
+
+			return nil, nil
+		},
+	},
+	analysis.PostProcessor[struct{}]{
+		Name:     PostProcessorTransitEdges,
+		Required: true,
+		Run: func(ctx context.Context, db graph.Database, _ struct{}) (*analysis.AtomicPostProcessingStats, error) {
+			return analysis.DeleteTransitEdges(ctx, db, graph.Kinds{ad.Entity, azure.Entity}, azure.PostProcessedRelationships())
+		},
+	},
+	analysis.PostProcessor[struct{}]{
+		Name:          PostProcessorUserRoleAssignments,
+		DependsOn:     []string{PostProcessorTransitEdges},
+		Relationships: graph.Kinds{azure.GlobalAdmin, azure.PrivilegedRoleAdmin, azure.PrivilegedAuthAdmin, azure.AddMembers, azure.ResetPassword},
+		Run: func(ctx context.Context, db graph.Database, _ struct{}) (*analysis.AtomicPostProcessingStats, error) {
+			return azureAnalysis.UserRoleAssignments(ctx, db)
+		},
+	},
+	analysis.PostProcessor[struct{}]{
+		Name:          PostProcessorExecuteCommand,
+		DependsOn:     []string{PostProcessorTransitEdges},
+		Relationships: graph.Kinds{azure.ExecuteCommand},
+		Run: func(ctx context.Context, db graph.Database, _ struct{}) (*analysis.AtomicPostProcessingStats, error) {
+			return azureAnalysis.ExecuteCommand(ctx, db)
+		},
+	},
+	analysis.PostProcessor[struct{}]{
+		Name:      PostProcessorAppRoleAssignments,
+		DependsOn: []string{PostProcessorTransitEdges},
+		Relationships: graph.Kinds{
+			azure.AZMGAddMember, azure.AZMGAddOwner, azure.AZMGAddSecret, azure.AZMGGrantAppRoles, azure.AZMGGrantRole, azure.AddSecret,
+		},
+		Run: func(ctx context.Context, db graph.Database, _ struct{}) (*analysis.AtomicPostProcessingStats, error) {
+			return azureAnalysis.AppRoleAssignments(ctx, db)
+		},
+	},
+	analysis.PostProcessor[struct{}]{
+		Name:          PostProcessorGetStorageKeys,
+		DependsOn:     []string{PostProcessorTransitEdges},
+		Relationships: graph.Kinds{azure.GetStorageKeys},
+		Run: func(ctx context.Context, db graph.Database, _ struct{}) (*analysis.AtomicPostProcessingStats, error) {
+			return azureAnalysis.GetStorageKeys(ctx, db)
+		},
+	},
+	analysis.PostProcessor[struct{}]{
+		Name:      PostProcessorHybrid,
+		DependsOn: []string{PostProcessorTransitEdges},
+		Relationships: graph.Kinds{
+			azure.SyncedToEntraUser, azure.SyncedToEntraGroup, azure.SyncedToEntraDevice,
+			ad.SyncedToADUser, ad.SyncedToADGroup, ad.SyncedToADComputer,
+		},
+		Run: func(ctx context.Context, db graph.Database, _ struct{}) (*analysis.AtomicPostProcessingStats, error) {
+			return hybrid.PostHybrid(ctx, db)
+		},
+	},
+	analysis.PostProcessor[struct{}]{
+		Name:          PostProcessorRoleApprovers,
+		DependsOn:     []string{PostProcessorTransitEdges},
+		Relationships: graph.Kinds{azure.AZRoleApprover},
+		Run: func(ctx context.Context, db graph.Database, _ struct{}) (*analysis.AtomicPostProcessingStats, error) {
+			return azureAnalysis.CreateAZRoleApproverEdge(ctx, db)
+		},
+	},
+)
+
+// Post runs Azure post-processing. The post-processors named in disabledPostProcessors, along with those depending on
+// them, are skipped and the relationships they create are removed from the graph. Each post-processor is reported to
+// the recorder unless it is nil.
+func Post(ctx context.Context, db graph.Database, disabledPostProcessors []string, recorder analysis.PostProcessorRecorder) (*analysis.AtomicPostProcessingStats, error) {
 	defer measure.ContextLogAndMeasure(
 		ctx,
 		slog.LevelInfo,
@@ -40,29 +131,23 @@ func Post(ctx context.Context, db graph.Database) (*analysis.AtomicPostProcessin
 		attr.Scope("step"),
 	)()
 
-	aggregateStats := analysis.NewAtomicPostProcessingStats()
-	if err := azureAnalysis.FixManagementGroupNames(ctx, db); err != nil {
-		slog.WarnContext(ctx, "Error fixing management group names", attr.Error(err))
-	}
-	if stats, err := analysis.DeleteTransitEdges(ctx, db, graph.Kinds{ad.Entity, azure.Entity}, azure.PostProcessedRelationships()); err != nil {
-		return &aggregateStats, err
-	} else if userRoleStats, err := azureAnalysis.UserRoleAssignments(ctx, db); err != nil {
-		return &aggregateStats, err
-	} else if executeCommandStats, err := azureAnalysis.ExecuteCommand(ctx, db); err != nil {
-		return &aggregateStats, err
-	} else if appRoleAssignmentStats, err := azureAnalysis.AppRoleAssignments(ctx, db); err != nil {
-		return &aggregateStats, err
-	} else if hybridStats, err := hybrid.PostHybrid(ctx, db); err != nil {
-		return &aggregateStats, err
-	} else if pimRolesStats, err := azureAnalysis.CreateAZRoleApproverEdge(ctx, db); err != nil {
-		return &aggregateStats, err
-	} else {
-		aggregateStats.Merge(stats)
-		aggregateStats.Merge(userRoleStats)
-		aggregateStats.Merge(executeCommandStats)
-		aggregateStats.Merge(appRoleAssignmentStats)
-		aggregateStats.Merge(hybridStats)
-		aggregateStats.Merge(pimRolesStats)
-		return &aggregateStats, nil
+	var (
+		aggregateStats = analysis.NewAtomicPostProcessingStats()
+		plan           = PostProcessors.Plan(disabledPostProcessors)
+	)
+
+	// Transit edge deletion covers Azure post-processed relationships only, which leaves the hybrid relationships into
+	// AD behind if hybrid post-processing is disabled
+	if disabledKinds := PostProcessors.DisabledRelationships(plan); len(disabledKinds) > 0 {
+		if stats, err := analysis.DeleteTransitEdges(ctx, db, graph.Kinds{ad.Entity, azure.Entity}, disabledKinds); err != nil {
+			return &aggregateStats, err
+		} else {
+			aggregateStats.Merge(stats)
+		}
 	}
+
+	stats, err := PostProcessors.Run(ctx, db, struct{}{}, plan, recorder)
+	aggregateStats.Merge(stats)
+
+	return &aggregateStats, err
 }
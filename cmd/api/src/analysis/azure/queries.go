@@ -190,6 +190,41 @@ func GraphStats(ctx context.Context, db graph.Database) (model.AzureDataQualityS
 						return fmt.Errorf("failed while submitting reader for relationship counts in tenant %s: %w", tenantObjectID, err)
 					}
 
+					for _, kind := range []graph.Kind{azure.SyncedToEntraUser, azure.SyncedToEntraGroup, azure.SyncedToEntraDevice} {
+						innerKind := kind
+
+						if err := operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, _ chan<- any) error {
+							if count, err := tx.Relationships().Filterf(func() graph.Criteria {
+								return query.And(
+									query.Kind(query.Relationship(), innerKind),
+									query.Equals(query.EndProperty(azure.TenantID.String()), tenantObjectID),
+								)
+							}).Count(); err != nil {
+								return err
+							} else {
+								mutex.Lock()
+								switch innerKind {
+								case azure.SyncedToEntraUser:
+									stat.SyncedUsers = int(count)
+									aggregation.SyncedUsers += int(count)
+
+								case azure.SyncedToEntraGroup:
+									stat.SyncedGroups = int(count)
+									aggregation.SyncedGroups += int(count)
+
+								case azure.SyncedToEntraDevice:
+									stat.SyncedDevices = int(count)
+									aggregation.SyncedDevices += int(count)
+								}
+
+								mutex.Unlock()
+								return nil
+							}
+						}); err != nil {
+							return fmt.Errorf("failed while submitting reader for synced identity counts of type %s in tenant %s: %w", innerKind, tenantObjectID, err)
+						}
+					}
+
 					if err := operation.Done(); err != nil {
 						return err
 					}
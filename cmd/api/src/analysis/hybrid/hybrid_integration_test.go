@@ -20,8 +20,15 @@ package hybrid
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 
+	azureAnalysis "github.com/specterops/bloodhound/cmd/api/src/analysis/azure"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/services/graphify"
+	"github.com/specterops/bloodhound/cmd/api/src/services/graphify/endpoint"
+	"github.com/specterops/bloodhound/cmd/api/src/services/upload"
 	"github.com/specterops/bloodhound/cmd/api/src/test/integration"
 	"github.com/specterops/bloodhound/packages/go/analysis"
 	"github.com/specterops/bloodhound/packages/go/analysis/hybrid"
@@ -33,6 +40,7 @@ import (
 	"github.com/specterops/dawgs/ops"
 	"github.com/specterops/dawgs/query"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestHybridAttackPaths(t *testing.T) {
@@ -183,6 +191,168 @@ func TestHybridAttackPaths(t *testing.T) {
 	})
 }
 
+func TestHybridSyncedGroupsAndDevices(t *testing.T) {
+	testContext := integration.NewGraphTestContext(t, graphschema.DefaultGraphSchema())
+	testContext.DatabaseTestWithSetup(
+		func(harness *integration.HarnessDetails) error {
+			harness.HybridSyncedGroupsAndDevices.Setup(testContext)
+			return nil
+		},
+		func(harness integration.HarnessDetails, db graph.Database) {
+			if _, err := hybrid.PostHybrid(context.Background(), db); err != nil {
+				t.Fatalf("failed post processing for hybrid attack paths: %v", err)
+			}
+
+			db.ReadTransaction(context.Background(), func(tx graph.Transaction) error {
+				// Both Entra groups share the on-prem SID of the AD group, so each is linked to it. The unmatched group is skipped
+				// and no placeholder AD group is created for it
+				syncedToADGroupEdges, err := ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+					return query.Kind(query.Relationship(), ad.SyncedToADGroup)
+				}))
+				require.Nil(t, err)
+				require.Len(t, syncedToADGroupEdges, 2)
+
+				for _, edge := range syncedToADGroupEdges {
+					assert.Equal(t, harness.HybridSyncedGroupsAndDevices.ADGroup.ID, edge.EndID)
+					assert.Contains(t, []graph.ID{harness.HybridSyncedGroupsAndDevices.AZGroup1.ID, harness.HybridSyncedGroupsAndDevices.AZGroup2.ID}, edge.StartID)
+				}
+
+				syncedToEntraGroupEdges, err := ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+					return query.Kind(query.Relationship(), azure.SyncedToEntraGroup)
+				}))
+				require.Nil(t, err)
+				require.Len(t, syncedToEntraGroupEdges, 2)
+
+				for _, edge := range syncedToEntraGroupEdges {
+					assert.Equal(t, harness.HybridSyncedGroupsAndDevices.ADGroup.ID, edge.StartID)
+					assert.NotEqual(t, harness.HybridSyncedGroupsAndDevices.AZUnmatchedGroup.ID, edge.EndID)
+				}
+
+				if count, err := tx.Nodes().Filterf(func() graph.Criteria {
+					return query.Kind(query.Node(), ad.Group)
+				}).Count(); err != nil {
+					t.Fatalf("failed counting AD groups: %v", err)
+				} else {
+					assert.Equal(t, int64(1), count)
+				}
+
+				// Only the synced device is linked to its AD computer
+				syncedToADComputerEdges, err := ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+					return query.Kind(query.Relationship(), ad.SyncedToADComputer)
+				}))
+				require.Nil(t, err)
+				require.Len(t, syncedToADComputerEdges, 1)
+				assert.Equal(t, harness.HybridSyncedGroupsAndDevices.AZDevice.ID, syncedToADComputerEdges[0].StartID)
+				assert.Equal(t, harness.HybridSyncedGroupsAndDevices.ADComputer.ID, syncedToADComputerEdges[0].EndID)
+
+				syncedToEntraDeviceEdges, err := ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+					return query.Kind(query.Relationship(), azure.SyncedToEntraDevice)
+				}))
+				require.Nil(t, err)
+				require.Len(t, syncedToEntraDeviceEdges, 1)
+				assert.Equal(t, harness.HybridSyncedGroupsAndDevices.ADComputer.ID, syncedToEntraDeviceEdges[0].StartID)
+				assert.Equal(t, harness.HybridSyncedGroupsAndDevices.AZDevice.ID, syncedToEntraDeviceEdges[0].EndID)
+
+				return nil
+			})
+
+			stats, aggregation, err := azureAnalysis.GraphStats(context.Background(), db)
+			require.Nil(t, err)
+
+			tenant1ID := testContext.NodeObjectID(harness.HybridSyncedGroupsAndDevices.AZTenant1)
+			tenant2ID := testContext.NodeObjectID(harness.HybridSyncedGroupsAndDevices.AZTenant2)
+
+			for _, stat := range stats {
+				switch stat.TenantID {
+				case tenant1ID:
+					assert.Equal(t, 1, stat.SyncedGroups)
+					assert.Equal(t, 1, stat.SyncedDevices)
+				case tenant2ID:
+					assert.Equal(t, 1, stat.SyncedGroups)
+					assert.Equal(t, 0, stat.SyncedDevices)
+				}
+			}
+
+			assert.Equal(t, 0, aggregation.SyncedUsers)
+			assert.Equal(t, 2, aggregation.SyncedGroups)
+			assert.Equal(t, 1, aggregation.SyncedDevices)
+		},
+	)
+}
+
+// TestHybridSyncedDevicesFromIngestedData ingests Entra devices as the collector sends them, so that the on-prem properties
+// used for matching come from the device converter rather than from a harness
+func TestHybridSyncedDevicesFromIngestedData(t *testing.T) {
+	const (
+		tenantID    = "6C12B0B0-B2CC-4A73-8252-0B94BFCA2145"
+		computerSID = "S-1-5-21-2697957641-2271029196-387917394-1104"
+	)
+
+	var (
+		testContext = integration.NewGraphTestContext(t, graphschema.DefaultGraphSchema())
+		computer    *graph.Node
+		payload     = fmt.Sprintf(`{"meta": {"type": "azure", "version": 5, "count": 2}, "data": [
+			{"kind": "AZDevice", "data": {"id": "8d0f6a4c-2b1e-4f3a-9c7d-5e6f7a8b9c0d", "displayName": "WS01", "onPremisesSyncEnabled": true, "onPremisesSecurityIdentifier": "%s", "tenantId": "%s", "tenantName": "contoso.com"}},
+			{"kind": "AZDevice", "data": {"id": "0e1f2a3b-4c5d-4e6f-8a7b-9c0d1e2f3a4b", "displayName": "CLOUD01", "tenantId": "%s", "tenantName": "contoso.com"}}
+		]}`, computerSID, tenantID, tenantID)
+	)
+
+	testContext.DatabaseTestWithSetup(
+		func(harness *integration.HarnessDetails) error {
+			testContext.NewAzureTenant(tenantID)
+			computer = testContext.NewNode(graph.AsProperties(graph.PropertyMap{
+				common.Name:     "WS01.CONTOSO.LOCAL",
+				common.ObjectID: computerSID,
+			}), ad.Entity, ad.Computer)
+			return nil
+		},
+		func(harness integration.HarnessDetails, db graph.Database) {
+			ingestSchema, err := upload.LoadIngestSchema()
+			require.Nil(t, err)
+
+			require.Nil(t, db.BatchOperation(context.Background(), func(batch graph.Batch) error {
+				ingestContext := graphify.NewIngestContext(context.Background(), graphify.WithEndpointResolver(endpoint.NewResolver(db)))
+				ingestContext.BindBatchUpdater(batch)
+
+				return graphify.ReadFileForIngest(ingestContext, strings.NewReader(payload), graphify.ReadOptions{
+					FileType:           model.FileTypeJson,
+					IngestSchema:       ingestSchema,
+					RegisterSourceKind: func(graph.Kind) error { return nil },
+				})
+			}))
+
+			_, err = hybrid.PostHybrid(context.Background(), db)
+			require.Nil(t, err)
+
+			db.ReadTransaction(context.Background(), func(tx graph.Transaction) error {
+				syncedDevice, err := tx.Nodes().Filterf(func() graph.Criteria {
+					return query.Equals(query.NodeProperty(common.ObjectID.String()), "8D0F6A4C-2B1E-4F3A-9C7D-5E6F7A8B9C0D")
+				}).First()
+				require.Nil(t, err)
+
+				// Only the hybrid joined device is linked to its AD computer, the cloud only device is left alone
+				syncedToADComputerEdges, err := ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+					return query.Kind(query.Relationship(), ad.SyncedToADComputer)
+				}))
+				require.Nil(t, err)
+				require.Len(t, syncedToADComputerEdges, 1)
+				assert.Equal(t, syncedDevice.ID, syncedToADComputerEdges[0].StartID)
+				assert.Equal(t, computer.ID, syncedToADComputerEdges[0].EndID)
+
+				syncedToEntraDeviceEdges, err := ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+					return query.Kind(query.Relationship(), azure.SyncedToEntraDevice)
+				}))
+				require.Nil(t, err)
+				require.Len(t, syncedToEntraDeviceEdges, 1)
+				assert.Equal(t, computer.ID, syncedToEntraDeviceEdges[0].StartID)
+				assert.Equal(t, syncedDevice.ID, syncedToEntraDeviceEdges[0].EndID)
+
+				return nil
+			})
+		},
+	)
+}
+
 func verifyHybridPaths(t *testing.T, db graph.Database, harness integration.HarnessDetails, shouldHaveEdges bool, shouldHaveUserNode bool) {
 	expectedEdgeCount := 1
 	if !shouldHaveEdges {
@@ -213,6 +213,18 @@ func (s *BloodHoundGraphNode) SetIcon(nType string) {
 		s.FontIcon = &BloodHoundGraphFontIcon{
 			Text: "fas fa-cog",
 		}
+	case "AZAdministrativeUnit":
+		s.FontIcon = &BloodHoundGraphFontIcon{
+			Text: "fas fa-layer-group",
+		}
+	case "AZStorageAccount":
+		s.FontIcon = &BloodHoundGraphFontIcon{
+			Text: "fas fa-hard-drive",
+		}
+	case "AZSQLServer":
+		s.FontIcon = &BloodHoundGraphFontIcon{
+			Text: "fas fa-database",
+		}
 	case "User":
 		s.FontIcon = &BloodHoundGraphFontIcon{
 			Text: "fas fa-user",
@@ -322,6 +334,12 @@ func (s *BloodHoundGraphNode) SetBackground(nType string) {
 		s.Color = "#9EE047"
 	case "AZAutomationAccount":
 		s.Color = "#F4BA44"
+	case "AZAdministrativeUnit":
+		s.Color = "#7EB2DD"
+	case "AZStorageAccount":
+		s.Color = "#5A9BD5"
+	case "AZSQLServer":
+		s.Color = "#D96C8A"
 	case "User":
 		s.Color = "#17E625"
 	case "Group":
@@ -52,6 +52,7 @@ const (
 
 	// URI path parameters
 	URIPathVariableApplicationConfigurationParameter = "parameter"
+	URIPathVariableAnalysisRunID                     = "analysis_run_id"
 	URIPathVariableAssetGroupID                      = "asset_group_id"
 	URIPathVariableAssetGroupSelectorID              = "asset_group_selector_id"
 	URIPathVariableAssetGroupTagID                   = "asset_group_tag_id"
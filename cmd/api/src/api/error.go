@@ -70,6 +70,11 @@ const (
 	ErrorResponseSSOProviderDuplicateName                            = "sso provider name must be unique"
 	ErrorResponseUserDuplicatePrincipal                              = "principal name must be unique"
 	ErrorResponseUserDuplicateEmail                                  = "email must be unique"
+	ErrorResponseRoleNameEmpty                                       = "role name must not be empty"
+	ErrorResponseRoleDuplicateName                                   = "role name must be unique"
+	ErrorResponseRoleBuiltIn                                         = "built-in roles cannot be modified or deleted"
+	ErrorResponseRoleInUse                                           = "role is assigned to users or SSO providers and cannot be deleted"
+	ErrorResponsePermissionEscalation                                = "cannot grant permissions beyond those held by the requesting user"
 	ErrorResponseDetailsUniqueViolation                              = "unique constraint was violated"
 	ErrorResponseDetailsNotImplemented                               = "All good things to those who wait. Not implemented."
 	ErrorResponseAssetGroupTagExceededNameLimit                      = "asset group tag name is limited to 250 characters"
@@ -87,6 +92,11 @@ const (
 	ErrorResponseAssetGroupAutoCertifyInvalid                        = "auto_certify must be an input value of 0 to 2"
 	ErrorResponseAssetGroupAutoCertifyOnlyAvailableForPrivilegeZones = "auto_certify is only available for asset group tags of tag_type = 1 (zones)"
 	ErrorResponseAGTCannotUpdateAutoCertifiedNodes                   = "cannot change certification status for auto-certified members"
+	ErrorResponseAssetGroupCertifyOnlyAvailableForPrivilegeZones     = "certification is only available for asset group tags of tag_type = 1 (zones)"
+	ErrorResponseAssetGroupMemberNotSelected                         = "asset group member is not selected by this asset group tag"
+	ErrorResponseAssetGroupTagMultiTierAnalysisDisabled              = "multi-tier analysis is not enabled for privilege zones"
+	ErrorResponseAssetGroupTagViolationsOnlyAvailableForZones        = "tier violations are only available for asset group tags of tag_type = 1 (zones)"
+	ErrorResponseAssetGroupTagBeyondTierLimit                        = "zone is beyond the privilege zone tier limit and is not analyzed"
 	ErrorResponseETACBadRequest                                      = "cannot specify environments when all_environments is true"
 	ErrorResponseETACInvalidRoles                                    = "administrators and power users may not have an ETAC list applied to them"
 	ErrorResponseAssetGroupTagInvalidTagName                         = "asset group tag name must contain only alphanumeric characters, spaces, and underscores"
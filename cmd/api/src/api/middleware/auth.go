@@ -31,6 +31,7 @@ import (
 
 	"github.com/specterops/bloodhound/cmd/api/src/api"
 	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
 	"github.com/specterops/bloodhound/cmd/api/src/model"
 
 	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
@@ -168,8 +169,9 @@ func RequireUserId() mux.MiddlewareFunc {
 // permission set to reference permission definitions and validate API access to user management related calls.
 //
 // An actor may operate on other actor entities if they have the permission "permission://auth/ManageUsers." If not the
-// actor may only exercise auth management calls on auth entities that are explicitly owned by the actor.
-func AuthorizeAuthManagementAccess(permissions auth.PermissionSet, authorizer auth.Authorizer) mux.MiddlewareFunc {
+// actor may only exercise auth management calls on auth entities that are explicitly owned by the actor. To prevent
+// privilege escalation an actor may not operate on another user that holds permissions the actor does not have itself.
+func AuthorizeAuthManagementAccess(permissions auth.PermissionSet, authorizer auth.Authorizer, db database.Database) mux.MiddlewareFunc {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
 			bhCtx := ctx.FromRequest(request)
@@ -193,6 +195,20 @@ func AuthorizeAuthManagementAccess(permissions auth.PermissionSet, authorizer au
 						// If we're operating on a user account that is different from our own user context then check to make sure we
 						// have the other permission
 						authorized = authorizer.AllowsPermission(bhCtx.AuthCtx, permissions.AuthManageUsers)
+
+						// Managing a user may not grant the actor control over permissions it does not hold. Targets that can not
+						// be found are left for the handler to report, any other lookup failure denies the request.
+						if authorized {
+							if targetID, err := uuid.FromString(userID); err == nil {
+								if target, err := db.GetUser(request.Context(), targetID); err != nil && !errors.Is(err, database.ErrNotFound) {
+									slog.ErrorContext(request.Context(), "Error looking up user for auth management authorization", attr.Error(err))
+									api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, api.ErrorResponseDetailsInternalServerError, request), response)
+									return
+								} else if err == nil {
+									authorized = authorizer.AllowsGrantingPermissions(bhCtx.AuthCtx, target.Roles.Permissions())
+								}
+							}
+						}
 					}
 				}
 
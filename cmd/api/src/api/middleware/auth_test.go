@@ -18,6 +18,7 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -28,6 +29,7 @@ import (
 	"github.com/specterops/bloodhound/cmd/api/src/api/mocks"
 	"github.com/specterops/bloodhound/cmd/api/src/auth"
 	"github.com/specterops/bloodhound/cmd/api/src/ctx"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
 	dbmocks "github.com/specterops/bloodhound/cmd/api/src/database/mocks"
 	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
 	"github.com/specterops/bloodhound/cmd/api/src/model"
@@ -306,3 +308,127 @@ func Test_AuthMiddleware(t *testing.T) {
 		}
 	})
 }
+
+func TestAuthorizeAuthManagementAccess(t *testing.T) {
+	var (
+		permissions      = auth.Permissions()
+		handlerReturn200 = func(response http.ResponseWriter, request *http.Request) {
+			response.WriteHeader(http.StatusOK)
+		}
+		managerID = uuid.FromStringOrNil("22222222-2222-2222-2222-222222222222")
+		adminID   = uuid.FromStringOrNil("33333333-3333-3333-3333-333333333333")
+		readerID  = uuid.FromStringOrNil("44444444-4444-4444-4444-444444444444")
+
+		managerCtx = ctx.Context{
+			AuthCtx: auth.Context{
+				Owner: model.User{
+					PrincipalName: "manager",
+					Roles: model.Roles{{
+						Name:        "User Manager",
+						Permissions: model.Permissions{permissions.AuthManageSelf, permissions.AuthManageUsers, permissions.GraphDBRead},
+					}},
+					Unique: model.Unique{ID: managerID},
+				},
+			},
+		}
+		admin = model.User{
+			PrincipalName: "admin",
+			Roles:         model.Roles{{Name: auth.RoleAdministrator, Permissions: permissions.All()}},
+			Unique:        model.Unique{ID: adminID},
+		}
+		reader = model.User{
+			PrincipalName: "reader",
+			Roles:         model.Roles{{Name: auth.RoleReadOnly, Permissions: model.Permissions{permissions.GraphDBRead}}},
+			Unique:        model.Unique{ID: readerID},
+		}
+	)
+
+	t.Run("users with fewer permissions may be managed", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = dbmocks.NewMockDatabase(mockCtrl)
+		)
+
+		mockDB.EXPECT().GetUser(gomock.Any(), readerID).Return(reader, nil)
+
+		test.Request(t).
+			WithURL("http://example.com/api/v2/bloodhound-users/%s/secret", readerID).
+			WithMethod(http.MethodPut).
+			WithContext(&managerCtx).
+			WithURLPathVars(map[string]string{api.URIPathVariableUserID: readerID.String()}).
+			OnHandler(AuthorizeAuthManagementAccess(permissions, auth.NewAuthorizer(mockDB), mockDB)(http.HandlerFunc(handlerReturn200))).
+			Require().
+			ResponseStatusCode(http.StatusOK)
+	})
+
+	t.Run("users with permissions the actor lacks may not be managed", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = dbmocks.NewMockDatabase(mockCtrl)
+		)
+
+		mockDB.EXPECT().GetUser(gomock.Any(), adminID).Return(admin, nil)
+		mockDB.EXPECT().AppendAuditLog(gomock.Any(), gomock.Any()).Return(nil)
+
+		test.Request(t).
+			WithURL("http://example.com/api/v2/bloodhound-users/%s/secret", adminID).
+			WithMethod(http.MethodPut).
+			WithContext(&managerCtx).
+			WithURLPathVars(map[string]string{api.URIPathVariableUserID: adminID.String()}).
+			OnHandler(AuthorizeAuthManagementAccess(permissions, auth.NewAuthorizer(mockDB), mockDB)(http.HandlerFunc(handlerReturn200))).
+			Require().
+			ResponseStatusCode(http.StatusUnauthorized)
+	})
+
+	t.Run("users that can not be looked up are not managed", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = dbmocks.NewMockDatabase(mockCtrl)
+		)
+
+		mockDB.EXPECT().GetUser(gomock.Any(), adminID).Return(model.User{}, errors.New("connection reset"))
+
+		test.Request(t).
+			WithURL("http://example.com/api/v2/bloodhound-users/%s/secret", adminID).
+			WithMethod(http.MethodPut).
+			WithContext(&managerCtx).
+			WithURLPathVars(map[string]string{api.URIPathVariableUserID: adminID.String()}).
+			OnHandler(AuthorizeAuthManagementAccess(permissions, auth.NewAuthorizer(mockDB), mockDB)(http.HandlerFunc(handlerReturn200))).
+			Require().
+			ResponseStatusCode(http.StatusInternalServerError)
+	})
+
+	t.Run("users that do not exist are left for the handler to report", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = dbmocks.NewMockDatabase(mockCtrl)
+		)
+
+		mockDB.EXPECT().GetUser(gomock.Any(), adminID).Return(model.User{}, database.ErrNotFound)
+
+		test.Request(t).
+			WithURL("http://example.com/api/v2/bloodhound-users/%s/secret", adminID).
+			WithMethod(http.MethodPut).
+			WithContext(&managerCtx).
+			WithURLPathVars(map[string]string{api.URIPathVariableUserID: adminID.String()}).
+			OnHandler(AuthorizeAuthManagementAccess(permissions, auth.NewAuthorizer(mockDB), mockDB)(http.HandlerFunc(handlerReturn200))).
+			Require().
+			ResponseStatusCode(http.StatusOK)
+	})
+
+	t.Run("the actor may manage itself", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = dbmocks.NewMockDatabase(mockCtrl)
+		)
+
+		test.Request(t).
+			WithURL("http://example.com/api/v2/bloodhound-users/%s/secret", managerID).
+			WithMethod(http.MethodPut).
+			WithContext(&managerCtx).
+			WithURLPathVars(map[string]string{api.URIPathVariableUserID: managerID.String()}).
+			OnHandler(AuthorizeAuthManagementAccess(permissions, auth.NewAuthorizer(mockDB), mockDB)(http.HandlerFunc(handlerReturn200))).
+			Require().
+			ResponseStatusCode(http.StatusOK)
+	})
+}
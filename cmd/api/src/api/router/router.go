@@ -81,8 +81,8 @@ func (s *Route) RequireAtLeastOnePermission(permissions ...model.Permission) *Ro
 	return s
 }
 
-func (s *Route) AuthorizeUserManagementAccess() *Route {
-	s.handler.Use(middleware.AuthorizeAuthManagementAccess(auth.Permissions(), s.authorizer))
+func (s *Route) AuthorizeUserManagementAccess(db database.Database) *Route {
+	s.handler.Use(middleware.AuthorizeAuthManagementAccess(auth.Permissions(), s.authorizer, db))
 	return s
 }
 
@@ -0,0 +1,195 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/ctx"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+)
+
+const (
+	AnalysisRunsDefaultLimit    = 50
+	AnalysisRunDiffDefaultLimit = 100
+
+	queryParameterBaseRunID = "base_run_id"
+
+	ErrorResponseAnalysisRunNoBaseRun           = "no earlier analysis run is available to compare against"
+	ErrorResponseAnalysisRunSnapshotNotRetained = "the snapshot of analysis run %d is no longer retained"
+)
+
+type AnalysisRunsResponse struct {
+	Runs []model.AnalysisRun `json:"runs"`
+}
+
+type AnalysisRunResponse struct {
+	model.AnalysisRun
+	Steps []model.AnalysisRunStep `json:"steps"`
+}
+
+type AnalysisRunDiffResponse struct {
+	BaseRun model.AnalysisRun `json:"base_run"`
+	Run     model.AnalysisRun `json:"run"`
+	model.AnalysisRunDiff
+}
+
+func (s *Resources) ListAnalysisRuns(response http.ResponseWriter, request *http.Request) {
+	var (
+		rCtx        = request.Context()
+		queryParams = request.URL.Query()
+	)
+
+	if skip, err := ParseSkipQueryParameter(queryParams, 0); err != nil {
+		api.WriteErrorResponse(rCtx, ErrBadQueryParameter(request, model.PaginationQueryParameterSkip, err), response)
+	} else if limit, err := ParseLimitQueryParameter(queryParams, AnalysisRunsDefaultLimit); err != nil {
+		api.WriteErrorResponse(rCtx, ErrBadQueryParameter(request, model.PaginationQueryParameterLimit, err), response)
+	} else if runs, count, err := s.DB.GetAnalysisRuns(rCtx, skip, limit); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteResponseWrapperWithPagination(rCtx, AnalysisRunsResponse{Runs: runs}, limit, skip, count, http.StatusOK, response)
+	}
+}
+
+// GetAnalysisRun returns an analysis run along with the duration, outcome and relationship counts of each of its steps
+func (s *Resources) GetAnalysisRun(response http.ResponseWriter, request *http.Request) {
+	rCtx := request.Context()
+
+	if runID, err := strconv.ParseInt(mux.Vars(request)[api.URIPathVariableAnalysisRunID], 10, 64); err != nil {
+		api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if run, err := s.DB.GetAnalysisRun(rCtx, runID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if steps, err := s.DB.GetAnalysisRunSteps(rCtx, runID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		if steps == nil {
+			steps = []model.AnalysisRunStep{}
+		}
+
+		api.WriteBasicResponse(rCtx, AnalysisRunResponse{AnalysisRun: run, Steps: steps}, http.StatusOK, response)
+	}
+}
+
+// GetAnalysisRunDiff compares the post-processed edges and tier zero membership of an analysis run against an
+// earlier one. The run immediately preceding it is used unless base_run_id is supplied. Listed edges and principals
+// are capped at limit entries per group while the counts cover every change.
+func (s *Resources) GetAnalysisRunDiff(response http.ResponseWriter, request *http.Request) {
+	defer measure.ContextMeasureWithThreshold(request.Context(), slog.LevelDebug, "Get Analysis Run Diff")()
+
+	var (
+		rCtx        = request.Context()
+		queryParams = request.URL.Query()
+		baseRun     model.AnalysisRun
+	)
+
+	user, isUser := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx)
+	if !isUser {
+		slog.ErrorContext(rCtx, "Unable to get user from auth context")
+		api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusInternalServerError, api.ErrorResponseDetailsInternalServerError, request), response)
+		return
+	}
+
+	runID, err := strconv.ParseInt(mux.Vars(request)[api.URIPathVariableAnalysisRunID], 10, 64)
+	if err != nil {
+		api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
+		return
+	}
+
+	limit, err := ParseLimitQueryParameter(queryParams, AnalysisRunDiffDefaultLimit)
+	if err != nil {
+		api.WriteErrorResponse(rCtx, ErrBadQueryParameter(request, model.PaginationQueryParameterLimit, err), response)
+		return
+	}
+
+	run, err := s.DB.GetAnalysisRun(rCtx, runID)
+	if err != nil {
+		api.HandleDatabaseError(request, response, err)
+		return
+	}
+
+	if param := queryParams.Get(queryParameterBaseRunID); param != "" {
+		if baseRunID, err := strconv.ParseInt(param, 10, 64); err != nil {
+			api.WriteErrorResponse(rCtx, ErrBadQueryParameter(request, queryParameterBaseRunID, err), response)
+			return
+		} else if baseRun, err = s.DB.GetAnalysisRun(rCtx, baseRunID); err != nil {
+			api.HandleDatabaseError(request, response, err)
+			return
+		}
+	} else if baseRun, err = s.DB.GetPreviousAnalysisRun(rCtx, runID); errors.Is(err, database.ErrNotFound) {
+		api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusNotFound, ErrorResponseAnalysisRunNoBaseRun, request), response)
+		return
+	} else if err != nil {
+		api.HandleDatabaseError(request, response, err)
+		return
+	}
+
+	baseSnapshot, ok := s.getAnalysisRunSnapshot(response, request, baseRun.ID)
+	if !ok {
+		return
+	}
+
+	snapshot, ok := s.getAnalysisRunSnapshot(response, request, run.ID)
+	if !ok {
+		return
+	}
+
+	includeEnvironment := func(string) bool { return true }
+
+	if ShouldFilterForETAC(s.DogTags, user) {
+		environmentIDs := ExtractEnvironmentIDsFromUser(&user)
+
+		includeEnvironment = func(environmentID string) bool {
+			return slices.Contains(environmentIDs, environmentID)
+		}
+	}
+
+	api.WriteBasicResponse(rCtx, AnalysisRunDiffResponse{
+		BaseRun:         baseRun,
+		Run:             run,
+		AnalysisRunDiff: model.DiffAnalysisRunSnapshots(baseSnapshot, snapshot, limit, includeEnvironment),
+	}, http.StatusOK, response)
+}
+
+// getAnalysisRunSnapshot fetches and decodes the snapshot of an analysis run. An error response is written if the
+// snapshot is not available.
+func (s *Resources) getAnalysisRunSnapshot(response http.ResponseWriter, request *http.Request, runID int64) (model.AnalysisRunSnapshot, bool) {
+	rCtx := request.Context()
+
+	if encoded, err := s.DB.GetAnalysisRunSnapshot(rCtx, runID); errors.Is(err, database.ErrNotFound) {
+		api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusNotFound, fmt.Sprintf(ErrorResponseAnalysisRunSnapshotNotRetained, runID), request), response)
+	} else if err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if snapshot, err := model.DecodeAnalysisRunSnapshot(encoded); err != nil {
+		slog.ErrorContext(rCtx, "Unable to decode analysis run snapshot", slog.Int64("analysis_run_id", runID), attr.Error(err))
+		api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusInternalServerError, api.ErrorResponseDetailsInternalServerError, request), response)
+	} else {
+		return snapshot, true
+	}
+
+	return model.AnalysisRunSnapshot{}, false
+}
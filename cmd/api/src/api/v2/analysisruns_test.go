@@ -0,0 +1,347 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	dbmocks "github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/services/dogtags"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func serveAnalysisRuns(t *testing.T, mockDB *dbmocks.MockDatabase, dogTagsOverrides dogtags.TestOverrides, user model.User, target string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var (
+		resources = v2.Resources{
+			DB:      mockDB,
+			DogTags: dogtags.NewTestService(dogTagsOverrides),
+		}
+		response = httptest.NewRecorder()
+		router   = mux.NewRouter()
+	)
+
+	request, err := http.NewRequestWithContext(setupUserCtx(user), http.MethodGet, target, nil)
+	require.NoError(t, err)
+
+	router.HandleFunc("/api/v2/analysis/runs", resources.ListAnalysisRuns).Methods(http.MethodGet)
+	router.HandleFunc("/api/v2/analysis/runs/{"+api.URIPathVariableAnalysisRunID+"}", resources.GetAnalysisRun).Methods(http.MethodGet)
+	router.HandleFunc("/api/v2/analysis/runs/{"+api.URIPathVariableAnalysisRunID+"}/diff", resources.GetAnalysisRunDiff).Methods(http.MethodGet)
+	router.ServeHTTP(response, request)
+
+	return response
+}
+
+func encodeAnalysisRunSnapshot(t *testing.T, snapshot model.AnalysisRunSnapshot) []byte {
+	t.Helper()
+
+	encoded, err := snapshot.Encode()
+	require.NoError(t, err)
+
+	return encoded
+}
+
+func TestResources_ListAnalysisRuns(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name         string
+		target       string
+		setupMocks   func(mockDB *dbmocks.MockDatabase)
+		expectedCode int
+	}{
+		{
+			name:         "Error: malformed limit - Bad Request",
+			target:       "/api/v2/analysis/runs?limit=ten",
+			setupMocks:   func(mockDB *dbmocks.MockDatabase) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:   "Error: database error - Internal Server Error",
+			target: "/api/v2/analysis/runs",
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetAnalysisRuns(gomock.Any(), 0, v2.AnalysisRunsDefaultLimit).Return(nil, 0, errors.New("error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+		{
+			name:   "Success",
+			target: "/api/v2/analysis/runs?skip=1&limit=2",
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetAnalysisRuns(gomock.Any(), 1, 2).Return([]model.AnalysisRun{{ID: 2}, {ID: 1}}, 3, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, testCase := range tt {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mockCtrl = gomock.NewController(t)
+				mockDB   = dbmocks.NewMockDatabase(mockCtrl)
+			)
+
+			testCase.setupMocks(mockDB)
+
+			response := serveAnalysisRuns(t, mockDB, dogtags.TestOverrides{}, model.User{AllEnvironments: true}, testCase.target)
+			require.Equal(t, testCase.expectedCode, response.Code)
+		})
+	}
+}
+
+func TestResources_GetAnalysisRun(t *testing.T) {
+	t.Parallel()
+
+	steps := []model.AnalysisRunStep{
+		{
+			Name:                 "ad_post_processing",
+			Status:               model.AnalysisRunStepStatusSucceeded,
+			DurationMs:           1500,
+			RelationshipsCreated: model.AnalysisRunRelationshipCounts{"DCSync": 2},
+			RelationshipsDeleted: model.AnalysisRunRelationshipCounts{"DCSync": 1},
+		},
+		{
+			Name:   "azure_post_processing",
+			Status: model.AnalysisRunStepStatusFailed,
+			Error:  "error",
+		},
+	}
+
+	tt := []struct {
+		name          string
+		target        string
+		setupMocks    func(mockDB *dbmocks.MockDatabase)
+		expectedCode  int
+		expectedSteps []model.AnalysisRunStep
+	}{
+		{
+			name:         "Error: malformed id - Not Found",
+			target:       "/api/v2/analysis/runs/one",
+			setupMocks:   func(mockDB *dbmocks.MockDatabase) {},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "Error: run not found - Not Found",
+			target: "/api/v2/analysis/runs/3",
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetAnalysisRun(gomock.Any(), int64(3)).Return(model.AnalysisRun{}, database.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "Error: steps database error - Internal Server Error",
+			target: "/api/v2/analysis/runs/2",
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetAnalysisRun(gomock.Any(), int64(2)).Return(model.AnalysisRun{ID: 2}, nil)
+				mockDB.EXPECT().GetAnalysisRunSteps(gomock.Any(), int64(2)).Return(nil, errors.New("error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+		{
+			name:   "Success: run recorded before steps were tracked",
+			target: "/api/v2/analysis/runs/1",
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetAnalysisRun(gomock.Any(), int64(1)).Return(model.AnalysisRun{ID: 1, Status: model.AnalysisRunStatusComplete}, nil)
+				mockDB.EXPECT().GetAnalysisRunSteps(gomock.Any(), int64(1)).Return(nil, nil)
+			},
+			expectedCode:  http.StatusOK,
+			expectedSteps: []model.AnalysisRunStep{},
+		},
+		{
+			name:   "Success",
+			target: "/api/v2/analysis/runs/2",
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetAnalysisRun(gomock.Any(), int64(2)).Return(model.AnalysisRun{ID: 2, Status: model.AnalysisRunStatusPartial}, nil)
+				mockDB.EXPECT().GetAnalysisRunSteps(gomock.Any(), int64(2)).Return(steps, nil)
+			},
+			expectedCode:  http.StatusOK,
+			expectedSteps: steps,
+		},
+	}
+
+	for _, testCase := range tt {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mockCtrl = gomock.NewController(t)
+				mockDB   = dbmocks.NewMockDatabase(mockCtrl)
+			)
+
+			testCase.setupMocks(mockDB)
+
+			response := serveAnalysisRuns(t, mockDB, dogtags.TestOverrides{}, model.User{AllEnvironments: true}, testCase.target)
+			require.Equal(t, testCase.expectedCode, response.Code)
+
+			if testCase.expectedCode == http.StatusOK {
+				var body struct {
+					Data v2.AnalysisRunResponse `json:"data"`
+				}
+
+				require.NoError(t, json.Unmarshal(response.Body.Bytes(), &body))
+				require.Equal(t, testCase.expectedSteps, body.Data.Steps)
+			}
+		})
+	}
+}
+
+func TestResources_GetAnalysisRunDiff(t *testing.T) {
+	t.Parallel()
+
+	var (
+		baseSnapshot = model.AnalysisRunSnapshot{
+			Edges: []model.AnalysisRunEdge{
+				{Kind: "DCSync", EnvironmentId: "S-1-5-21-1", Start: "S-1-5-21-1-1105", End: "S-1-5-21-1"},
+				{Kind: "DCSync", EnvironmentId: "S-1-5-21-2", Start: "S-1-5-21-2-1105", End: "S-1-5-21-2"},
+			},
+			TierZero: []model.AnalysisRunPrincipal{{EnvironmentId: "S-1-5-21-1", ObjectId: "S-1-5-21-1-512"}},
+		}
+		snapshot = model.AnalysisRunSnapshot{
+			Edges: []model.AnalysisRunEdge{
+				{Kind: "DCSync", EnvironmentId: "S-1-5-21-1", Start: "S-1-5-21-1-1106", End: "S-1-5-21-1"},
+				{Kind: "DCSync", EnvironmentId: "S-1-5-21-2", Start: "S-1-5-21-2-1106", End: "S-1-5-21-2"},
+			},
+			TierZero:      []model.AnalysisRunPrincipal{{EnvironmentId: "S-1-5-21-1", ObjectId: "S-1-5-21-1-512"}},
+			TierZeroPaths: []model.AnalysisRunPrincipal{{EnvironmentId: "S-1-5-21-1", ObjectId: "S-1-5-21-1-1106"}},
+		}
+	)
+
+	tt := []struct {
+		name             string
+		target           string
+		user             model.User
+		dogTagsOverrides dogtags.TestOverrides
+		setupMocks       func(mockDB *dbmocks.MockDatabase)
+		expectedCode     int
+		assertDiff       func(t *testing.T, diff v2.AnalysisRunDiffResponse)
+	}{
+		{
+			name:         "Error: malformed id - Not Found",
+			target:       "/api/v2/analysis/runs/two/diff",
+			user:         model.User{AllEnvironments: true},
+			setupMocks:   func(mockDB *dbmocks.MockDatabase) {},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "Error: malformed base run id - Bad Request",
+			target: "/api/v2/analysis/runs/2/diff?base_run_id=one",
+			user:   model.User{AllEnvironments: true},
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetAnalysisRun(gomock.Any(), int64(2)).Return(model.AnalysisRun{ID: 2}, nil)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:   "Error: first run has nothing to compare against - Not Found",
+			target: "/api/v2/analysis/runs/1/diff",
+			user:   model.User{AllEnvironments: true},
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetAnalysisRun(gomock.Any(), int64(1)).Return(model.AnalysisRun{ID: 1}, nil)
+				mockDB.EXPECT().GetPreviousAnalysisRun(gomock.Any(), int64(1)).Return(model.AnalysisRun{}, database.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "Error: base snapshot pruned - Not Found",
+			target: "/api/v2/analysis/runs/40/diff?base_run_id=1",
+			user:   model.User{AllEnvironments: true},
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetAnalysisRun(gomock.Any(), int64(40)).Return(model.AnalysisRun{ID: 40}, nil)
+				mockDB.EXPECT().GetAnalysisRun(gomock.Any(), int64(1)).Return(model.AnalysisRun{ID: 1}, nil)
+				mockDB.EXPECT().GetAnalysisRunSnapshot(gomock.Any(), int64(1)).Return(nil, database.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "Success: compares against the previous run",
+			target: "/api/v2/analysis/runs/2/diff",
+			user:   model.User{AllEnvironments: true},
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetAnalysisRun(gomock.Any(), int64(2)).Return(model.AnalysisRun{ID: 2}, nil)
+				mockDB.EXPECT().GetPreviousAnalysisRun(gomock.Any(), int64(2)).Return(model.AnalysisRun{ID: 1}, nil)
+				mockDB.EXPECT().GetAnalysisRunSnapshot(gomock.Any(), int64(1)).Return(encodeAnalysisRunSnapshot(t, baseSnapshot), nil)
+				mockDB.EXPECT().GetAnalysisRunSnapshot(gomock.Any(), int64(2)).Return(encodeAnalysisRunSnapshot(t, snapshot), nil)
+			},
+			expectedCode: http.StatusOK,
+			assertDiff: func(t *testing.T, diff v2.AnalysisRunDiffResponse) {
+				require.Equal(t, int64(1), diff.BaseRun.ID)
+				require.Equal(t, int64(2), diff.Run.ID)
+				require.Len(t, diff.Edges, 2)
+				require.Equal(t, 1, diff.Edges[0].AddedCount)
+				require.Equal(t, 1, diff.Edges[0].RemovedCount)
+				require.Equal(t, 0, diff.TierZero.AddedCount)
+				require.Equal(t, []model.AnalysisRunPrincipal{{EnvironmentId: "S-1-5-21-1", ObjectId: "S-1-5-21-1-1106"}}, diff.TierZeroPaths.Added)
+			},
+		},
+		{
+			name:             "Success: ETAC restricted users only see their environments",
+			target:           "/api/v2/analysis/runs/2/diff?base_run_id=1&limit=1",
+			user:             model.User{EnvironmentTargetedAccessControl: []model.EnvironmentTargetedAccessControl{{EnvironmentID: "S-1-5-21-2"}}},
+			dogTagsOverrides: attackPathFindingsETACEnabled,
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetAnalysisRun(gomock.Any(), int64(2)).Return(model.AnalysisRun{ID: 2}, nil)
+				mockDB.EXPECT().GetAnalysisRun(gomock.Any(), int64(1)).Return(model.AnalysisRun{ID: 1}, nil)
+				mockDB.EXPECT().GetAnalysisRunSnapshot(gomock.Any(), int64(1)).Return(encodeAnalysisRunSnapshot(t, baseSnapshot), nil)
+				mockDB.EXPECT().GetAnalysisRunSnapshot(gomock.Any(), int64(2)).Return(encodeAnalysisRunSnapshot(t, snapshot), nil)
+			},
+			expectedCode: http.StatusOK,
+			assertDiff: func(t *testing.T, diff v2.AnalysisRunDiffResponse) {
+				require.Len(t, diff.Edges, 1)
+				require.Equal(t, "S-1-5-21-2", diff.Edges[0].EnvironmentId)
+				require.Empty(t, diff.TierZeroPaths.Added)
+			},
+		},
+	}
+
+	for _, testCase := range tt {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mockCtrl = gomock.NewController(t)
+				mockDB   = dbmocks.NewMockDatabase(mockCtrl)
+			)
+
+			testCase.setupMocks(mockDB)
+
+			response := serveAnalysisRuns(t, mockDB, testCase.dogTagsOverrides, testCase.user, testCase.target)
+			require.Equal(t, testCase.expectedCode, response.Code)
+
+			if testCase.assertDiff != nil {
+				var body struct {
+					Data v2.AnalysisRunDiffResponse `json:"data"`
+				}
+
+				require.NoError(t, json.Unmarshal(response.Body.Bytes(), &body))
+				testCase.assertDiff(t, body.Data)
+			}
+		})
+	}
+}
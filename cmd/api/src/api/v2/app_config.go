@@ -21,6 +21,7 @@ import (
 	"net/http"
 
 	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/daemons/datapipe"
 	"github.com/specterops/bloodhound/cmd/api/src/model"
 	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
 )
@@ -67,9 +68,23 @@ func (s Resources) SetApplicationConfiguration(response http.ResponseWriter, req
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("Configuration parameter %s is not valid.", parameter.Key), request), response)
 	} else if errs := parameter.Validate(); errs != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, errs.Error(), request), response)
+	} else if err := validatePostProcessingParameter(parameter); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
 	} else if err = s.DB.SetConfigurationParameter(request.Context(), parameter); err != nil {
 		api.HandleDatabaseError(request, response, err)
 	} else {
 		api.WriteBasicResponse(request.Context(), appConfig, http.StatusOK, response)
 	}
 }
+
+func validatePostProcessingParameter(parameter appcfg.Parameter) error {
+	var postProcessing appcfg.PostProcessingParameter
+
+	if parameter.Key != appcfg.PostProcessingKey {
+		return nil
+	} else if err := parameter.Map(&postProcessing); err != nil {
+		return err
+	} else {
+		return datapipe.ValidateDisabledPostProcessors(postProcessing.DisabledPostProcessors)
+	}
+}
@@ -178,6 +178,46 @@ func Test_SetApplicationConfiguration(t *testing.T) {
 		}
 	})
 
+	t.Run("Unknown Post-Processor", func(t *testing.T) {
+		invalidRequest := appcfg.AppConfigUpdateRequest{
+			Key: string(appcfg.PostProcessingKey),
+			Value: map[string]any{
+				"disabled_post_processors": []string{"ad.not_a_post_processor"},
+			},
+		}
+		reqBody, _ := json.Marshal(invalidRequest)
+		req := httptest.NewRequest(http.MethodPost, "/api/v2/config", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		resources.SetApplicationConfiguration(rec, req)
+
+		if status := rec.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v",
+				status, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("Required Post-Processor", func(t *testing.T) {
+		invalidRequest := appcfg.AppConfigUpdateRequest{
+			Key: string(appcfg.PostProcessingKey),
+			Value: map[string]any{
+				"disabled_post_processors": []string{"ad.domain_associations"},
+			},
+		}
+		reqBody, _ := json.Marshal(invalidRequest)
+		req := httptest.NewRequest(http.MethodPost, "/api/v2/config", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		resources.SetApplicationConfiguration(rec, req)
+
+		if status := rec.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v",
+				status, http.StatusBadRequest)
+		}
+	})
+
 	t.Run("Error from DB", func(t *testing.T) {
 		appConfigRequest = appcfg.AppConfigUpdateRequest{
 			Key: string(appcfg.ReconciliationKey),
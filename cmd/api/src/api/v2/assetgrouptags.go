@@ -685,7 +685,7 @@ func (s *Resources) UpdateAssetGroupTag(response http.ResponseWriter, request *h
 				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, "tier zero analysis_enabled cannot be modified", request), response)
 				return
 			} else if !s.DogTags.GetFlagAsBool(dogtags.PZ_MULTI_TIER_ANALYSIS) {
-				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, "multi-tier analysis is not enabled for privilege zones", request), response)
+				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, api.ErrorResponseAssetGroupTagMultiTierAnalysisDisabled, request), response)
 				return
 			}
 
@@ -1176,6 +1176,237 @@ func (s *Resources) GetAssetGroupMembersBySelector(response http.ResponseWriter,
 	}
 }
 
+const queryParameterPendingCertification = "pending"
+
+type AssetGroupMemberCertificationView struct {
+	NodeId          graph.ID                      `json:"id"`
+	ObjectID        string                        `json:"object_id"`
+	EnvironmentID   string                        `json:"environment_id"`
+	PrimaryKind     string                        `json:"primary_kind"`
+	Name            string                        `json:"name"`
+	AssetGroupTagId int                           `json:"asset_group_tag_id"`
+	CreatedAt       time.Time                     `json:"created_at"`
+	CertifiedBy     null.String                   `json:"certified_by"`
+	Certified       model.AssetGroupCertification `json:"certified"`
+}
+
+type GetAssetGroupTagMemberCertificationsResponse struct {
+	Members []AssetGroupMemberCertificationView `json:"members"`
+}
+
+// GetAssetGroupTagMemberCertifications lists the certification state of the members of a zone that requires
+// certification. Setting the pending query parameter limits the list to the members still awaiting review.
+func (s *Resources) GetAssetGroupTagMemberCertifications(response http.ResponseWriter, request *http.Request) {
+	var (
+		queryParams           = request.URL.Query()
+		environmentIds        = queryParams[api.QueryParameterEnvironments]
+		translatedQueryFilter = make(model.QueryParameterFilterMap)
+	)
+
+	if queryFilters, err := model.NewQueryParameterFilterParser().ParseQueryParameterFilters(request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsBadQueryParameterFilters, request), response)
+	} else if assetTagId, err := strconv.Atoi(mux.Vars(request)[api.URIPathVariableAssetGroupTagID]); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if assetGroupTag, err := s.DB.GetAssetGroupTag(request.Context(), assetTagId); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if assetGroupTag.Type != model.AssetGroupTagTypeTier {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseAssetGroupCertifyOnlyAvailableForPrivilegeZones, request), response)
+	} else if pending, err := api.ParseOptionalBool(queryParams.Get(queryParameterPendingCertification), false); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, queryParameterPendingCertification, err), response)
+	} else if skip, err := ParseSkipQueryParameter(queryParams, 0); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterSkip, err), response)
+	} else if limit, err := ParseOptionalLimitQueryParameter(queryParams, AssetGroupTagDefaultLimit); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterLimit, err), response)
+	} else {
+		for name, filters := range queryFilters {
+			if validPredicates, err := api.GetValidFilterPredicatesAsStrings(model.AssetGroupSelectorNode{}, name); err != nil {
+				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s", api.ErrorResponseDetailsColumnNotFilterable, name), request), response)
+				return
+			} else {
+				for i, filter := range filters {
+					filter.SetOperator = model.FilterOr
+
+					if !slices.Contains(validPredicates, string(filter.Operator)) {
+						api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s %s", api.ErrorResponseDetailsFilterPredicateNotSupported, filter.Name, filter.Operator), request), response)
+						return
+					}
+
+					// some of the API filter names do not match the DB column names - so we have to do a translation here
+					originalName := filter.Name
+					switch filter.Name {
+					case "name":
+						filter.Name = "node_name"
+					case "object_id":
+						filter.Name = "node_object_id"
+					case "primary_kind":
+						filter.Name = "node_primary_kind"
+					}
+					translatedQueryFilter.AddFilter(filter)
+					translatedQueryFilter[filter.Name][i].IsStringData = model.AssetGroupSelectorNode{}.IsStringColumn(originalName)
+				}
+			}
+		}
+
+		sqlFilter, err := translatedQueryFilter.BuildSQLFilter()
+		if err != nil {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "error building SQL for filter", request), response)
+			return
+		}
+
+		conditions := []string{"asset_group_tag_id = ?"}
+		params := []any{assetGroupTag.ID}
+
+		if sqlFilter.SQLString != "" {
+			conditions = append(conditions, sqlFilter.SQLString)
+			params = append(params, sqlFilter.Params...)
+		}
+
+		if len(environmentIds) > 0 {
+			conditions = append(conditions, "node_environment_id IN ?")
+			params = append(params, environmentIds)
+		}
+
+		if pending {
+			conditions = append(conditions, "certified = ?")
+			params = append(params, model.AssetGroupCertificationPending)
+		}
+
+		if selectorNodes, count, err := s.DB.GetAggregatedSelectorNodesCertification(request.Context(), model.SQLFilter{SQLString: strings.Join(conditions, " AND "), Params: params}, skip, limit); err != nil {
+			api.HandleDatabaseError(request, response, err)
+		} else {
+			members := make([]AssetGroupMemberCertificationView, 0, len(selectorNodes))
+			for _, selectorNode := range selectorNodes {
+				members = append(members, AssetGroupMemberCertificationView{
+					NodeId:          selectorNode.NodeId,
+					ObjectID:        selectorNode.NodeObjectId,
+					EnvironmentID:   selectorNode.NodeEnvironmentId,
+					PrimaryKind:     selectorNode.NodePrimaryKind,
+					Name:            selectorNode.NodeName,
+					AssetGroupTagId: selectorNode.AssetGroupTagId,
+					CreatedAt:       selectorNode.CreatedAt,
+					CertifiedBy:     selectorNode.CertifiedBy,
+					Certified:       selectorNode.Certified,
+				})
+			}
+
+			api.WriteResponseWrapperWithPagination(request.Context(), GetAssetGroupTagMemberCertificationsResponse{Members: members}, limit, skip, count, http.StatusOK, response)
+		}
+	}
+}
+
+type UpdateAssetGroupTagMemberCertificationRequest struct {
+	MemberIds []graph.ID                    `json:"member_ids"`
+	Action    model.AssetGroupCertification `json:"action"`
+	Note      null.String                   `json:"note"`
+}
+
+// UpdateAssetGroupTagMemberCertification certifies (action 2) or revokes (action 1) a batch of zone members. Every
+// selector that selected a member is updated so the member is treated consistently during tagging. Members certified
+// automatically by their selectors cannot be changed.
+func (s *Resources) UpdateAssetGroupTagMemberCertification(response http.ResponseWriter, request *http.Request) {
+	var certificationRequest UpdateAssetGroupTagMemberCertificationRequest
+
+	defer measure.ContextMeasureWithThreshold(request.Context(), slog.LevelDebug, "Asset Group Tag Member Certification Update")()
+
+	if actor, isUser := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx); !isUser {
+		slog.Error("Unable to get user from auth context")
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "unknown user", request), response)
+	} else if assetTagId, err := strconv.Atoi(mux.Vars(request)[api.URIPathVariableAssetGroupTagID]); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if assetGroupTag, err := s.DB.GetAssetGroupTag(request.Context(), assetTagId); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if assetGroupTag.Type != model.AssetGroupTagTypeTier {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseAssetGroupCertifyOnlyAvailableForPrivilegeZones, request), response)
+	} else if err := json.NewDecoder(request.Body).Decode(&certificationRequest); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, request), response)
+	} else if certified := certificationRequest.Action; certified != model.AssetGroupCertificationManual && certified != model.AssetGroupCertificationRevoked {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseAssetGroupCertTypeInvalid, request), response)
+	} else if len(certificationRequest.MemberIds) == 0 {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseAssetGroupMemberIDsRequired, request), response)
+	} else if selectors, _, err := s.DB.GetAssetGroupTagSelectorsByTagId(request.Context(), assetGroupTag.ID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		selectorIds := make([]int, 0, len(selectors))
+		for _, selector := range selectors {
+			selectorIds = append(selectorIds, selector.ID)
+		}
+
+		selectorNodes, _, err := s.DB.GetSelectorNodesBySelectorIdsFilteredAndPaginated(request.Context(), model.SQLFilter{SQLString: "AND node_id IN ?", Params: []any{certificationRequest.MemberIds}}, model.Sort{}, 0, 0, selectorIds...)
+		if err != nil {
+			api.HandleDatabaseError(request, response, err)
+			return
+		}
+
+		selectorNodesByNodeId := make(map[graph.ID][]model.AssetGroupSelectorNode, len(certificationRequest.MemberIds))
+		for _, selectorNode := range selectorNodes {
+			selectorNodesByNodeId[selectorNode.NodeId] = append(selectorNodesByNodeId[selectorNode.NodeId], selectorNode)
+		}
+
+		var (
+			inputs        []database.UpdateCertificationBySelectorNodeInput
+			membersSeen   = make(map[graph.ID]struct{}, len(certificationRequest.MemberIds))
+			certification = model.AssetGroupTagMemberCertification{
+				AssetGroupTagId: assetGroupTag.ID,
+				Certified:       certified,
+				Note:            certificationRequest.Note,
+			}
+		)
+
+		for _, memberId := range certificationRequest.MemberIds {
+			if _, seen := membersSeen[memberId]; seen {
+				continue
+			}
+			membersSeen[memberId] = struct{}{}
+
+			memberSelectorNodes, ok := selectorNodesByNodeId[memberId]
+			if !ok {
+				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, fmt.Sprintf("%s: %d", api.ErrorResponseAssetGroupMemberNotSelected, memberId), request), response)
+				return
+			}
+
+			for _, selectorNode := range memberSelectorNodes {
+				if selectorNode.Certified == model.AssetGroupCertificationAuto {
+					api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %d", api.ErrorResponseAGTCannotUpdateAutoCertifiedNodes, memberId), request), response)
+					return
+				}
+
+				inputs = append(inputs, database.UpdateCertificationBySelectorNodeInput{
+					AssetGroupTagId:     assetGroupTag.ID,
+					SelectorId:          selectorNode.SelectorId,
+					CertifiedBy:         actor.EmailAddress,
+					CertificationStatus: certified,
+					NodeId:              selectorNode.NodeId,
+					NodeName:            selectorNode.NodeName,
+					Note:                certificationRequest.Note,
+					UserId:              actor.ID.String(),
+				})
+			}
+
+			certification.MemberIds = append(certification.MemberIds, memberId)
+		}
+
+		if err := s.DB.UpdateAssetGroupTagMemberCertification(request.Context(), certification, inputs); err != nil {
+			api.HandleDatabaseError(request, response, err)
+			return
+		}
+
+		// Certification only changes zone membership for zones that require it
+		if assetGroupTag.RequireCertify.ValueOrZero() {
+			if config, err := appcfg.GetScheduledAnalysisParameter(request.Context(), s.DB); err != nil {
+				api.HandleDatabaseError(request, response, err)
+				return
+			} else if !config.Enabled {
+				if err := s.DB.RequestAnalysis(request.Context(), actor.ID.String()); err != nil {
+					api.HandleDatabaseError(request, response, err)
+					return
+				}
+			}
+		}
+
+		response.WriteHeader(http.StatusNoContent)
+	}
+}
+
 func validateAssetGroupExpansionMethodWithFallback(maybeMethod *model.AssetGroupExpansionMethod) (model.AssetGroupExpansionMethod, error) {
 	if maybeMethod == nil {
 		return model.AssetGroupExpansionMethodAll, nil
@@ -1489,3 +1720,55 @@ func (s *Resources) GetAssetGroupSelectorMemberCountsByKind(response http.Respon
 		}
 	}
 }
+
+type AssetGroupTagViolationView struct {
+	model.AssetGroupTagViolation
+	SourceAssetGroupTagName string `json:"source_asset_group_tag_name,omitempty"`
+}
+
+type GetAssetGroupTagViolationsResponse struct {
+	TotalCount int                          `json:"total_count"`
+	Violations []AssetGroupTagViolationView `json:"violations"`
+}
+
+// GetAssetGroupTagViolations lists the attack path edges that principals of less privileged zones, or of no zone at
+// all, hold over the members of a zone, as found by the last multi-tier analysis. Zones beyond the tier limit are not
+// analyzed and cannot be queried.
+func (s *Resources) GetAssetGroupTagViolations(response http.ResponseWriter, request *http.Request) {
+	if tagId, err := strconv.Atoi(mux.Vars(request)[api.URIPathVariableAssetGroupTagID]); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if !s.DogTags.GetFlagAsBool(dogtags.PZ_MULTI_TIER_ANALYSIS) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, api.ErrorResponseAssetGroupTagMultiTierAnalysisDisabled, request), response)
+	} else if assetGroupTag, err := s.DB.GetAssetGroupTag(request.Context(), tagId); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if assetGroupTag.Type != model.AssetGroupTagTypeTier {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseAssetGroupTagViolationsOnlyAvailableForZones, request), response)
+	} else if tiers, err := s.DB.GetOrderedAssetGroupTagTiers(request.Context()); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if analyzedTiers := datapipe.LimitTiers(tiers, int(s.DogTags.GetFlagAsInt(dogtags.PZ_TIER_LIMIT))); !slices.ContainsFunc(analyzedTiers, func(tier model.AssetGroupTag) bool {
+		return tier.ID == assetGroupTag.ID
+	}) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, api.ErrorResponseAssetGroupTagBeyondTierLimit, request), response)
+	} else if violations, err := s.DB.GetAssetGroupTagViolations(request.Context(), assetGroupTag.ID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		var (
+			tierNames = make(map[int]string, len(analyzedTiers))
+			data      = GetAssetGroupTagViolationsResponse{Violations: make([]AssetGroupTagViolationView, 0, len(violations))}
+		)
+
+		for _, tier := range analyzedTiers {
+			tierNames[tier.ID] = tier.Name
+		}
+
+		for _, violation := range violations {
+			data.TotalCount += violation.ViolationCount
+			data.Violations = append(data.Violations, AssetGroupTagViolationView{
+				AssetGroupTagViolation:  violation,
+				SourceAssetGroupTagName: tierNames[violation.SourceAssetGroupTagId],
+			})
+		}
+
+		api.WriteBasicResponse(request.Context(), data, http.StatusOK, response)
+	}
+}
@@ -4662,3 +4662,435 @@ func TestResources_GetAssetGroupSelectorMemberCountsByKind(t *testing.T) {
 			},
 		})
 }
+
+func TestResources_GetAssetGroupTagMemberCertifications(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{
+			DB:      mockDB,
+			DogTags: dogtags.NewDefaultService(),
+		}
+		zone   = model.AssetGroupTag{ID: 1, Type: model.AssetGroupTagTypeTier, RequireCertify: null.BoolFrom(true)}
+		member = model.AssetGroupSelectorNodeExpanded{
+			AssetGroupSelectorNode: model.AssetGroupSelectorNode{SelectorId: 1, NodeId: 10, Certified: model.AssetGroupCertificationPending, NodeName: "USER@DOMAIN"},
+			AssetGroupTagId:        1,
+		}
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.GetAssetGroupTagMemberCertifications).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidTagUrlId",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "non-numeric")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+					apitest.BodyContains(output, api.ErrorResponseDetailsIDMalformed)
+				},
+			},
+			{
+				Name: "LabelsCannotBeCertified",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).
+						Return(model.AssetGroupTag{ID: 2, Type: model.AssetGroupTagTypeLabel}, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponseAssetGroupCertifyOnlyAvailableForPrivilegeZones)
+				},
+			},
+			{
+				Name: "InvalidPendingParameter",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.AddQueryParam(input, "pending", "maybe")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(zone, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+			{
+				Name: "ColumnNotFilterable",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.AddQueryParam(input, "certified_by", "eq:someone")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(zone, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponseDetailsColumnNotFilterable)
+				},
+			},
+			{
+				Name: "PendingReviewQueue",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.AddQueryParam(input, "pending", "true")
+					apitest.AddQueryParam(input, api.QueryParameterEnvironments, "S-1-5-21-1")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(zone, nil).Times(1)
+					mockDB.EXPECT().
+						GetAggregatedSelectorNodesCertification(gomock.Any(), model.SQLFilter{
+							SQLString: "asset_group_tag_id = ? AND node_environment_id IN ? AND certified = ?",
+							Params:    []any{1, []string{"S-1-5-21-1"}, model.AssetGroupCertificationPending},
+						}, 0, v2.AssetGroupTagDefaultLimit).
+						Return([]model.AssetGroupSelectorNodeExpanded{member}, 1, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					var result v2.GetAssetGroupTagMemberCertificationsResponse
+
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.UnmarshalData(output, &result)
+					apitest.Equal(output, []v2.AssetGroupMemberCertificationView{{
+						NodeId:          10,
+						Name:            "USER@DOMAIN",
+						AssetGroupTagId: 1,
+						Certified:       model.AssetGroupCertificationPending,
+					}}, result.Members)
+				},
+			},
+			{
+				Name: "DatabaseError",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(zone, nil).Times(1)
+					mockDB.EXPECT().
+						GetAggregatedSelectorNodesCertification(gomock.Any(), model.SQLFilter{SQLString: "asset_group_tag_id = ?", Params: []any{1}}, 0, v2.AssetGroupTagDefaultLimit).
+						Return(nil, 0, errors.New("db error")).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+		})
+}
+
+func TestResources_UpdateAssetGroupTagMemberCertification(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{
+			DB:      mockDB,
+			DogTags: dogtags.NewDefaultService(),
+		}
+		user    = setupUser()
+		userCtx = setupUserCtx(user)
+
+		zone      = model.AssetGroupTag{ID: 1, Type: model.AssetGroupTagTypeTier, RequireCertify: null.BoolFrom(true)}
+		selectors = model.AssetGroupTagSelectors{{ID: 1, AssetGroupTagId: 1}, {ID: 2, AssetGroupTagId: 1}}
+		memberIds = []graph.ID{10, 11}
+
+		expectTagAndSelectors = func() {
+			mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(zone, nil).Times(1)
+			mockDB.EXPECT().GetAssetGroupTagSelectorsByTagId(gomock.Any(), 1).Return(selectors, len(selectors), nil).Times(1)
+		}
+		expectSelectorNodes = func(selectorNodes ...model.AssetGroupSelectorNode) {
+			mockDB.EXPECT().
+				GetSelectorNodesBySelectorIdsFilteredAndPaginated(gomock.Any(), model.SQLFilter{SQLString: "AND node_id IN ?", Params: []any{memberIds}}, model.Sort{}, 0, 0, 1, 2).
+				Return(selectorNodes, len(selectorNodes), nil).Times(1)
+		}
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.UpdateAssetGroupTagMemberCertification).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidTagUrlId",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "non-numeric")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+					apitest.BodyContains(output, api.ErrorResponseDetailsIDMalformed)
+				},
+			},
+			{
+				Name: "LabelsCannotBeCertified",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+					apitest.BodyStruct(input, v2.UpdateAssetGroupTagMemberCertificationRequest{MemberIds: memberIds, Action: model.AssetGroupCertificationManual})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).
+						Return(model.AssetGroupTag{ID: 2, Type: model.AssetGroupTagTypeLabel}, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponseAssetGroupCertifyOnlyAvailableForPrivilegeZones)
+				},
+			},
+			{
+				Name: "BadPayload",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.BodyString(input, `{"member_ids": "10"}`)
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(zone, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponsePayloadUnmarshalError)
+				},
+			},
+			{
+				Name: "InvalidAction",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.BodyStruct(input, v2.UpdateAssetGroupTagMemberCertificationRequest{MemberIds: memberIds, Action: model.AssetGroupCertificationAuto})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(zone, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponseAssetGroupCertTypeInvalid)
+				},
+			},
+			{
+				Name: "MissingMemberIds",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.BodyStruct(input, v2.UpdateAssetGroupTagMemberCertificationRequest{Action: model.AssetGroupCertificationRevoked})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(zone, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponseAssetGroupMemberIDsRequired)
+				},
+			},
+			{
+				Name: "MemberNotSelected",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.BodyStruct(input, v2.UpdateAssetGroupTagMemberCertificationRequest{MemberIds: memberIds, Action: model.AssetGroupCertificationManual})
+				},
+				Setup: func() {
+					expectTagAndSelectors()
+					expectSelectorNodes(model.AssetGroupSelectorNode{SelectorId: 1, NodeId: 10})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+					apitest.BodyContains(output, api.ErrorResponseAssetGroupMemberNotSelected)
+				},
+			},
+			{
+				Name: "AutoCertifiedMember",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.BodyStruct(input, v2.UpdateAssetGroupTagMemberCertificationRequest{MemberIds: memberIds, Action: model.AssetGroupCertificationRevoked})
+				},
+				Setup: func() {
+					expectTagAndSelectors()
+					expectSelectorNodes(
+						model.AssetGroupSelectorNode{SelectorId: 1, NodeId: 10},
+						model.AssetGroupSelectorNode{SelectorId: 2, NodeId: 11, Certified: model.AssetGroupCertificationAuto},
+					)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponseAGTCannotUpdateAutoCertifiedNodes)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.BodyStruct(input, v2.UpdateAssetGroupTagMemberCertificationRequest{MemberIds: memberIds, Action: model.AssetGroupCertificationManual, Note: null.StringFrom("reviewed")})
+				},
+				Setup: func() {
+					expectTagAndSelectors()
+					expectSelectorNodes(
+						model.AssetGroupSelectorNode{SelectorId: 1, NodeId: 10, NodeName: "USER1"},
+						model.AssetGroupSelectorNode{SelectorId: 2, NodeId: 10, NodeName: "USER1"},
+						model.AssetGroupSelectorNode{SelectorId: 2, NodeId: 11, NodeName: "USER2", Certified: model.AssetGroupCertificationRevoked},
+					)
+
+					newInput := func(selectorId int, nodeId graph.ID, nodeName string) database.UpdateCertificationBySelectorNodeInput {
+						return database.UpdateCertificationBySelectorNodeInput{
+							AssetGroupTagId:     1,
+							SelectorId:          selectorId,
+							CertifiedBy:         user.EmailAddress,
+							CertificationStatus: model.AssetGroupCertificationManual,
+							NodeId:              nodeId,
+							NodeName:            nodeName,
+							Note:                null.StringFrom("reviewed"),
+							UserId:              user.ID.String(),
+						}
+					}
+
+					mockDB.EXPECT().
+						UpdateAssetGroupTagMemberCertification(gomock.Any(), model.AssetGroupTagMemberCertification{
+							AssetGroupTagId: 1,
+							MemberIds:       memberIds,
+							Certified:       model.AssetGroupCertificationManual,
+							Note:            null.StringFrom("reviewed"),
+						}, []database.UpdateCertificationBySelectorNodeInput{
+							newInput(1, 10, "USER1"),
+							newInput(2, 10, "USER1"),
+							newInput(2, 11, "USER2"),
+						}).
+						Return(nil).Times(1)
+
+					value, _ := types.NewJSONBObject(map[string]any{"enabled": false})
+					mockDB.EXPECT().
+						GetConfigurationParameter(gomock.Any(), appcfg.ScheduledAnalysis).
+						Return(appcfg.Parameter{Key: appcfg.ScheduledAnalysis, Value: value}, nil).Times(1)
+					mockDB.EXPECT().RequestAnalysis(gomock.Any(), user.ID.String()).Return(nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNoContent)
+				},
+			},
+		})
+}
+
+func TestResources_GetAssetGroupTagViolations(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{
+			DB: mockDB,
+			DogTags: dogtags.NewTestService(dogtags.TestOverrides{
+				Bools: map[dogtags.BoolDogTag]bool{dogtags.PZ_MULTI_TIER_ANALYSIS: true},
+				Ints:  map[dogtags.IntDogTag]int64{dogtags.PZ_TIER_LIMIT: 2},
+			}),
+		}
+		tierZero = model.AssetGroupTag{ID: 1, Name: "Tier Zero", Type: model.AssetGroupTagTypeTier, Position: null.Int32From(1)}
+		tierOne  = model.AssetGroupTag{ID: 2, Name: "Tier One", Type: model.AssetGroupTagTypeTier, Position: null.Int32From(2)}
+		tierTwo  = model.AssetGroupTag{ID: 3, Name: "Tier Two", Type: model.AssetGroupTagTypeTier, Position: null.Int32From(3)}
+		tiers    = []model.AssetGroupTag{tierZero, tierOne, tierTwo}
+		samples  = model.AssetGroupTagViolationEdges{{Kind: "GenericAll", FromPrincipal: "S-1-5-21-1-1105", FromKind: "User", ToPrincipal: "S-1-5-21-1-512", ToKind: "Group"}}
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.GetAssetGroupTagViolations).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidTagUrlId",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "non-numeric")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+					apitest.BodyContains(output, api.ErrorResponseDetailsIDMalformed)
+				},
+			},
+			{
+				Name: "LabelsHaveNoViolations",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "4")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 4).
+						Return(model.AssetGroupTag{ID: 4, Type: model.AssetGroupTagTypeLabel}, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponseAssetGroupTagViolationsOnlyAvailableForZones)
+				},
+			},
+			{
+				Name: "ZoneBeyondTierLimit",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "3")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 3).Return(tierTwo, nil).Times(1)
+					mockDB.EXPECT().GetOrderedAssetGroupTagTiers(gomock.Any()).Return(tiers, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusForbidden)
+					apitest.BodyContains(output, api.ErrorResponseAssetGroupTagBeyondTierLimit)
+				},
+			},
+			{
+				Name: "DatabaseError",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(tierOne, nil).Times(1)
+					mockDB.EXPECT().GetOrderedAssetGroupTagTiers(gomock.Any()).Return(tiers, nil).Times(1)
+					mockDB.EXPECT().GetAssetGroupTagViolations(gomock.Any(), 2).Return(nil, errors.New("db error")).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+					mockDB.EXPECT().GetOrderedAssetGroupTagTiers(gomock.Any()).Return(tiers, nil).Times(1)
+					mockDB.EXPECT().GetAssetGroupTagViolations(gomock.Any(), 1).Return([]model.AssetGroupTagViolation{
+						{AssetGroupTagId: 1, SourceAssetGroupTagId: model.AssetGroupTagViolationUntieredSourceId, ViolationCount: 5, SampleEdges: samples},
+						{AssetGroupTagId: 1, SourceAssetGroupTagId: 2, ViolationCount: 2, SampleEdges: samples},
+					}, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					var result v2.GetAssetGroupTagViolationsResponse
+
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.UnmarshalData(output, &result)
+					apitest.Equal(output, 7, result.TotalCount)
+					apitest.Equal(output, 2, len(result.Violations))
+					apitest.Equal(output, "", result.Violations[0].SourceAssetGroupTagName)
+					apitest.Equal(output, "Tier One", result.Violations[1].SourceAssetGroupTagName)
+					apitest.Equal(output, samples, result.Violations[1].SampleEdges)
+				},
+			},
+		})
+
+	t.Run("MultiTierAnalysisDisabled", func(t *testing.T) {
+		resources := v2.Resources{DB: mockDB, DogTags: dogtags.NewDefaultService()}
+
+		apitest.
+			NewHarness(t, resources.GetAssetGroupTagViolations).
+			Run([]apitest.Case{
+				{
+					Name: "Forbidden",
+					Input: func(input *apitest.Input) {
+						apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					},
+					Test: func(output apitest.Output) {
+						apitest.StatusCode(output, http.StatusForbidden)
+						apitest.BodyContains(output, api.ErrorResponseAssetGroupTagMultiTierAnalysisDisabled)
+					},
+				},
+			})
+	})
+}
@@ -0,0 +1,260 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/lib/pq"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/ctx"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+)
+
+const (
+	AttackPathFindingsDefaultLimit = 100
+
+	queryParameterAccepted = "accepted"
+	queryParameterResolved = "resolved"
+
+	ErrorResponseAttackPathFindingAcceptedUntilInPast = "accepted_until must be in the future"
+)
+
+type AttackPathFindingsResponse struct {
+	Findings []model.AttackPathFinding `json:"findings"`
+}
+
+type AttackPathFindingDetailsResponse struct {
+	model.AttackPathFinding
+	FindingType        model.SchemaFindingType `json:"finding_type"`
+	FindingDisplayName string                  `json:"finding_display_name"`
+	FindingKind        string                  `json:"finding_kind"`
+	ShortDescription   string                  `json:"short_description"`
+	LongDescription    string                  `json:"long_description"`
+	ShortRemediation   string                  `json:"short_remediation"`
+	LongRemediation    string                  `json:"long_remediation"`
+}
+
+type AcceptAttackPathFindingRiskRequest struct {
+	// AcceptedUntil is the time the acceptance expires; null clears a previous acceptance
+	AcceptedUntil null.Time `json:"accepted_until"`
+	Reason        string    `json:"reason"`
+}
+
+// ListAttackPathFindings lists findings recorded by analysis. Findings default to the tier zero and hygiene asset
+// group tags when no asset_group_tag_id is supplied.
+func (s *Resources) ListAttackPathFindings(response http.ResponseWriter, request *http.Request) {
+	defer measure.ContextMeasureWithThreshold(request.Context(), slog.LevelDebug, "List Attack Path Findings")()
+
+	var (
+		rCtx        = request.Context()
+		queryParams = request.URL.Query()
+		finding     = model.AttackPathFinding{}
+	)
+
+	user, isUser := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx)
+	if !isUser {
+		slog.ErrorContext(rCtx, "Unable to get user from auth context")
+		api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusInternalServerError, api.ErrorResponseDetailsInternalServerError, request), response)
+		return
+	}
+
+	if queryFilters, err := model.NewQueryParameterFilterParser().ParseQueryParameterFilters(request); err != nil {
+		api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsBadQueryParameterFilters, request), response)
+	} else if skip, err := ParseSkipQueryParameter(queryParams, 0); err != nil {
+		api.WriteErrorResponse(rCtx, ErrBadQueryParameter(request, model.PaginationQueryParameterSkip, err), response)
+	} else if limit, err := ParseOptionalLimitQueryParameter(queryParams, AttackPathFindingsDefaultLimit); err != nil {
+		api.WriteErrorResponse(rCtx, ErrBadQueryParameter(request, model.PaginationQueryParameterLimit, err), response)
+	} else if sort, err := api.ParseSortParameters(finding, queryParams); err != nil {
+		api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsNotSortable, request), response)
+	} else if accepted, err := parseAcceptedQueryParameter(queryParams.Get(queryParameterAccepted)); err != nil {
+		api.WriteErrorResponse(rCtx, ErrBadQueryParameter(request, queryParameterAccepted, err), response)
+	} else if resolved, err := api.ParseOptionalBool(queryParams.Get(queryParameterResolved), false); err != nil {
+		api.WriteErrorResponse(rCtx, ErrBadQueryParameter(request, queryParameterResolved, err), response)
+	} else if assetGroupTagIds, err := api.ParseAssetGroupTagIdWithFallback(rCtx, s.DB, queryParams.Get(api.QueryParameterAssetGroupTagId)); errors.Is(err, database.ErrNotFound) {
+		api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsResourceNotFound, request), response)
+	} else if err != nil {
+		var numErr *strconv.NumError
+		if errors.As(err, &numErr) {
+			api.WriteErrorResponse(rCtx, ErrBadQueryParameter(request, api.QueryParameterAssetGroupTagId, err), response)
+		} else {
+			api.HandleDatabaseError(request, response, err)
+		}
+	} else {
+		for name, filters := range queryFilters {
+			if validPredicates, err := api.GetValidFilterPredicatesAsStrings(finding, name); err != nil {
+				api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s", api.ErrorResponseDetailsColumnNotFilterable, name), request), response)
+				return
+			} else {
+				for i, filter := range filters {
+					if !slices.Contains(validPredicates, string(filter.Operator)) {
+						api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s %s", api.ErrorResponseDetailsFilterPredicateNotSupported, filter.Name, filter.Operator), request), response)
+						return
+					}
+					queryFilters[name][i].IsStringData = finding.IsStringColumn(filter.Name)
+				}
+			}
+		}
+
+		sqlFilter, err := queryFilters.BuildSQLFilter()
+		if err != nil {
+			api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusBadRequest, "error building SQL for filter", request), response)
+			return
+		}
+
+		var (
+			conditions = []string{"asset_group_tag_id = ANY(?)"}
+			params     = []any{pq.Array(assetGroupTagIds)}
+		)
+
+		if findingName := queryParams.Get(api.QueryParameterFindingType); findingName != "" {
+			conditions = append(conditions, "finding_name = ?")
+			params = append(params, findingName)
+		}
+
+		if environmentIds := queryParams[api.QueryParameterEnvironments]; len(environmentIds) > 0 {
+			conditions = append(conditions, "environment_id = ANY(?)")
+			params = append(params, pq.StringArray(environmentIds))
+		}
+
+		if ShouldFilterForETAC(s.DogTags, user) {
+			conditions = append(conditions, "environment_id = ANY(?)")
+			params = append(params, pq.StringArray(ExtractEnvironmentIDsFromUser(&user)))
+		}
+
+		if !resolved {
+			conditions = append(conditions, "resolved_at IS NULL")
+		}
+
+		if accepted.Valid {
+			if accepted.Bool {
+				conditions = append(conditions, "accepted_until > ?")
+			} else {
+				conditions = append(conditions, "(accepted_until IS NULL OR accepted_until <= ?)")
+			}
+			params = append(params, time.Now().UTC())
+		}
+
+		if sqlFilter.SQLString != "" {
+			conditions = append(conditions, sqlFilter.SQLString)
+			params = append(params, sqlFilter.Params...)
+		}
+
+		sqlFilter = model.SQLFilter{SQLString: strings.Join(conditions, " AND "), Params: params}
+
+		if len(sort) == 0 {
+			sort = model.Sort{{Column: "last_seen", Direction: model.DescendingSortDirection}}
+		}
+
+		if findings, count, err := s.DB.GetAttackPathFindings(rCtx, sqlFilter, sort, skip, limit); err != nil {
+			api.HandleDatabaseError(request, response, err)
+		} else {
+			api.WriteResponseWrapperWithPagination(rCtx, AttackPathFindingsResponse{Findings: findings}, limit, skip, count, http.StatusOK, response)
+		}
+	}
+}
+
+// parseAcceptedQueryParameter returns an invalid null.Bool if findings should not be filtered by risk acceptance
+func parseAcceptedQueryParameter(value string) (null.Bool, error) {
+	if value == "" {
+		return null.Bool{}, nil
+	} else if accepted, err := strconv.ParseBool(value); err != nil {
+		return null.Bool{}, err
+	} else {
+		return null.BoolFrom(accepted), nil
+	}
+}
+
+// getAccessibleAttackPathFinding fetches the finding identified by the request path and verifies the requesting user
+// may access its environment. An error response is written if the finding is not available.
+func (s *Resources) getAccessibleAttackPathFinding(response http.ResponseWriter, request *http.Request) (model.AttackPathFinding, bool) {
+	rCtx := request.Context()
+
+	if user, isUser := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx); !isUser {
+		slog.ErrorContext(rCtx, "Unable to get user from auth context")
+		api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusInternalServerError, api.ErrorResponseDetailsInternalServerError, request), response)
+	} else if findingId, err := strconv.ParseInt(mux.Vars(request)[api.URIPathVariableAttackPathID], 10, 64); err != nil {
+		api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if finding, err := s.DB.GetAttackPathFinding(rCtx, findingId); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if !ShouldFilterForETAC(s.DogTags, user) {
+		return finding, true
+	} else if hasAccess, err := CheckUserAccessToEnvironments(rCtx, s.DB, user, finding.EnvironmentId); err != nil {
+		slog.ErrorContext(rCtx, "Error checking if user has access to attack path finding environment", attr.Error(err))
+		api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusInternalServerError, api.ErrorResponseDetailsInternalServerError, request), response)
+	} else if !hasAccess {
+		api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusForbidden, api.ErrorResponseDetailsForbidden, request), response)
+	} else {
+		return finding, true
+	}
+
+	return model.AttackPathFinding{}, false
+}
+
+func (s *Resources) GetAttackPathFinding(response http.ResponseWriter, request *http.Request) {
+	if finding, ok := s.getAccessibleAttackPathFinding(response, request); !ok {
+		return
+	} else if schemaFinding, err := s.DB.GetSchemaFindingById(request.Context(), finding.SchemaFindingId); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if remediation, err := s.DB.GetRemediationByFindingId(request.Context(), finding.SchemaFindingId); err != nil && !errors.Is(err, database.ErrNotFound) {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), AttackPathFindingDetailsResponse{
+			AttackPathFinding:  finding,
+			FindingType:        schemaFinding.Type,
+			FindingDisplayName: schemaFinding.DisplayName,
+			FindingKind:        schemaFinding.Kind.String(),
+			ShortDescription:   remediation.ShortDescription,
+			LongDescription:    remediation.LongDescription,
+			ShortRemediation:   remediation.ShortRemediation,
+			LongRemediation:    remediation.LongRemediation,
+		}, http.StatusOK, response)
+	}
+}
+
+func (s *Resources) UpdateAttackPathFindingAcceptedRisk(response http.ResponseWriter, request *http.Request) {
+	var acceptRequest AcceptAttackPathFindingRiskRequest
+
+	if actor, isUser := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx); !isUser {
+		slog.ErrorContext(request.Context(), "Unable to get user from auth context")
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, api.ErrorResponseDetailsInternalServerError, request), response)
+	} else if err := json.NewDecoder(request.Body).Decode(&acceptRequest); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, request), response)
+	} else if acceptRequest.AcceptedUntil.Valid && !acceptRequest.AcceptedUntil.Time.After(time.Now()) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, ErrorResponseAttackPathFindingAcceptedUntilInPast, request), response)
+	} else if finding, ok := s.getAccessibleAttackPathFinding(response, request); !ok {
+		return
+	} else if updated, err := s.DB.UpdateAttackPathFindingAcceptedRisk(request.Context(), finding.ID, acceptRequest.AcceptedUntil, null.StringFrom(actor.EmailAddress.ValueOrZero()), null.StringFrom(acceptRequest.Reason)); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), updated, http.StatusOK, response)
+	}
+}
@@ -0,0 +1,302 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	dbmocks "github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/services/dogtags"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var attackPathFindingsETACEnabled = dogtags.TestOverrides{
+	Bools: map[dogtags.BoolDogTag]bool{
+		dogtags.ETAC_ENABLED: true,
+	},
+}
+
+func serveAttackPathFindings(t *testing.T, mockDB *dbmocks.MockDatabase, dogTagsOverrides dogtags.TestOverrides, user model.User, method, target string, body any) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var (
+		payload   bytes.Buffer
+		resources = v2.Resources{
+			DB:      mockDB,
+			DogTags: dogtags.NewTestService(dogTagsOverrides),
+		}
+		response = httptest.NewRecorder()
+		router   = mux.NewRouter()
+	)
+
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&payload).Encode(body))
+	}
+
+	request, err := http.NewRequestWithContext(setupUserCtx(user), method, target, &payload)
+	require.NoError(t, err)
+
+	router.HandleFunc("/api/v2/attack-path-findings", resources.ListAttackPathFindings).Methods(http.MethodGet)
+	router.HandleFunc("/api/v2/attack-path-findings/{"+api.URIPathVariableAttackPathID+"}", resources.GetAttackPathFinding).Methods(http.MethodGet)
+	router.HandleFunc("/api/v2/attack-path-findings/{"+api.URIPathVariableAttackPathID+"}/accepted-risk", resources.UpdateAttackPathFindingAcceptedRisk).Methods(http.MethodPut)
+	router.ServeHTTP(response, request)
+
+	return response
+}
+
+func TestResources_ListAttackPathFindings(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name             string
+		target           string
+		user             model.User
+		dogTagsOverrides dogtags.TestOverrides
+		setupMocks       func(mockDB *dbmocks.MockDatabase)
+		expectedCode     int
+	}{
+		{
+			name:         "Error: malformed asset group tag id - Bad Request",
+			target:       "/api/v2/attack-path-findings?asset_group_tag_id=one",
+			user:         model.User{AllEnvironments: true},
+			setupMocks:   func(mockDB *dbmocks.MockDatabase) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "Error: column not filterable - Bad Request",
+			target:       "/api/v2/attack-path-findings?asset_group_tag_id=0&accepted_by=eq:someone",
+			user:         model.User{AllEnvironments: true},
+			setupMocks:   func(mockDB *dbmocks.MockDatabase) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:   "Error: unknown asset group tag - Not Found",
+			target: "/api/v2/attack-path-findings?asset_group_tag_id=5",
+			user:   model.User{AllEnvironments: true},
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 5).Return(model.AssetGroupTag{}, database.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "Success: defaults to tier zero and hygiene findings",
+			target: "/api/v2/attack-path-findings",
+			user:   model.User{AllEnvironments: true},
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetAssetGroupTags(gomock.Any(), gomock.Any()).Return(model.AssetGroupTags{{ID: 1}}, nil)
+				mockDB.EXPECT().GetAttackPathFindings(gomock.Any(), gomock.Any(), model.Sort{{Column: "last_seen", Direction: model.DescendingSortDirection}}, 0, v2.AttackPathFindingsDefaultLimit).
+					DoAndReturn(func(_ context.Context, sqlFilter model.SQLFilter, _ model.Sort, _, _ int) ([]model.AttackPathFinding, int, error) {
+						require.Equal(t, "asset_group_tag_id = ANY(?) AND resolved_at IS NULL", sqlFilter.SQLString)
+						return []model.AttackPathFinding{{ID: 1}}, 1, nil
+					})
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:             "Success: ETAC restricted users only see their environments",
+			target:           "/api/v2/attack-path-findings?asset_group_tag_id=0&finding=T0GenericAll&accepted=false&from_principal=eq:S-1-5-21-1-1105",
+			user:             model.User{EnvironmentTargetedAccessControl: []model.EnvironmentTargetedAccessControl{{EnvironmentID: "S-1-5-21-1"}}},
+			dogTagsOverrides: attackPathFindingsETACEnabled,
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetAttackPathFindings(gomock.Any(), gomock.Any(), gomock.Any(), 0, v2.AttackPathFindingsDefaultLimit).
+					DoAndReturn(func(_ context.Context, sqlFilter model.SQLFilter, _ model.Sort, _, _ int) ([]model.AttackPathFinding, int, error) {
+						require.Contains(t, sqlFilter.SQLString, "finding_name = ?")
+						require.Contains(t, sqlFilter.SQLString, "environment_id = ANY(?)")
+						require.Contains(t, sqlFilter.SQLString, "(accepted_until IS NULL OR accepted_until <= ?)")
+						require.Contains(t, sqlFilter.SQLString, "from_principal")
+						return nil, 0, nil
+					})
+			},
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, testCase := range tt {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mockCtrl = gomock.NewController(t)
+				mockDB   = dbmocks.NewMockDatabase(mockCtrl)
+			)
+
+			testCase.setupMocks(mockDB)
+
+			response := serveAttackPathFindings(t, mockDB, testCase.dogTagsOverrides, testCase.user, http.MethodGet, testCase.target, nil)
+			require.Equal(t, testCase.expectedCode, response.Code)
+		})
+	}
+}
+
+func TestResources_GetAttackPathFinding(t *testing.T) {
+	t.Parallel()
+
+	var finding = model.AttackPathFinding{ID: 4, SchemaFindingId: 10, EnvironmentId: "S-1-5-21-2", FromPrincipal: "S-1-5-21-2-1105"}
+
+	tt := []struct {
+		name             string
+		target           string
+		user             model.User
+		dogTagsOverrides dogtags.TestOverrides
+		setupMocks       func(mockDB *dbmocks.MockDatabase)
+		expectedCode     int
+	}{
+		{
+			name:         "Error: malformed id - Not Found",
+			target:       "/api/v2/attack-path-findings/four",
+			user:         model.User{AllEnvironments: true},
+			setupMocks:   func(mockDB *dbmocks.MockDatabase) {},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "Error: finding does not exist - Not Found",
+			target: "/api/v2/attack-path-findings/4",
+			user:   model.User{AllEnvironments: true},
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetAttackPathFinding(gomock.Any(), int64(4)).Return(model.AttackPathFinding{}, database.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:             "Error: environment not accessible - Forbidden",
+			target:           "/api/v2/attack-path-findings/4",
+			user:             model.User{},
+			dogTagsOverrides: attackPathFindingsETACEnabled,
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetAttackPathFinding(gomock.Any(), int64(4)).Return(finding, nil)
+				mockDB.EXPECT().GetEnvironmentTargetedAccessControlForUser(gomock.Any(), gomock.Any()).Return([]model.EnvironmentTargetedAccessControl{{EnvironmentID: "S-1-5-21-1"}}, nil)
+			},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:   "Success: finding without remediation content",
+			target: "/api/v2/attack-path-findings/4",
+			user:   model.User{AllEnvironments: true},
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetAttackPathFinding(gomock.Any(), int64(4)).Return(finding, nil)
+				mockDB.EXPECT().GetSchemaFindingById(gomock.Any(), int32(10)).Return(model.SchemaFinding{ID: 10, DisplayName: "Generic All", Kind: graph.StringKind("GenericAll")}, nil)
+				mockDB.EXPECT().GetRemediationByFindingId(gomock.Any(), int32(10)).Return(model.Remediation{}, database.ErrNotFound)
+			},
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, testCase := range tt {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mockCtrl = gomock.NewController(t)
+				mockDB   = dbmocks.NewMockDatabase(mockCtrl)
+			)
+
+			testCase.setupMocks(mockDB)
+
+			response := serveAttackPathFindings(t, mockDB, testCase.dogTagsOverrides, testCase.user, http.MethodGet, testCase.target, nil)
+			require.Equal(t, testCase.expectedCode, response.Code)
+
+			if testCase.expectedCode == http.StatusOK {
+				var body struct {
+					Data v2.AttackPathFindingDetailsResponse `json:"data"`
+				}
+
+				require.NoError(t, json.Unmarshal(response.Body.Bytes(), &body))
+				require.Equal(t, "Generic All", body.Data.FindingDisplayName)
+				require.Equal(t, "GenericAll", body.Data.FindingKind)
+				require.Equal(t, finding.FromPrincipal, body.Data.FromPrincipal)
+			}
+		})
+	}
+}
+
+func TestResources_UpdateAttackPathFindingAcceptedRisk(t *testing.T) {
+	t.Parallel()
+
+	var (
+		user          = model.User{AllEnvironments: true, EmailAddress: null.StringFrom("analyst@example.com")}
+		acceptedUntil = time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	)
+
+	tt := []struct {
+		name         string
+		body         any
+		setupMocks   func(mockDB *dbmocks.MockDatabase)
+		expectedCode int
+	}{
+		{
+			name:         "Error: invalid payload - Bad Request",
+			body:         "accepted",
+			setupMocks:   func(mockDB *dbmocks.MockDatabase) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "Error: acceptance already expired - Bad Request",
+			body:         v2.AcceptAttackPathFindingRiskRequest{AcceptedUntil: null.TimeFrom(time.Now().Add(-time.Hour))},
+			setupMocks:   func(mockDB *dbmocks.MockDatabase) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Success: risk accepted",
+			body: v2.AcceptAttackPathFindingRiskRequest{AcceptedUntil: null.TimeFrom(acceptedUntil), Reason: "compensating control"},
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetAttackPathFinding(gomock.Any(), int64(4)).Return(model.AttackPathFinding{ID: 4}, nil)
+				mockDB.EXPECT().UpdateAttackPathFindingAcceptedRisk(gomock.Any(), int64(4), null.TimeFrom(acceptedUntil), null.StringFrom("analyst@example.com"), null.StringFrom("compensating control")).
+					Return(model.AttackPathFinding{ID: 4, AcceptedUntil: null.TimeFrom(acceptedUntil)}, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Success: acceptance cleared",
+			body: v2.AcceptAttackPathFindingRiskRequest{},
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetAttackPathFinding(gomock.Any(), int64(4)).Return(model.AttackPathFinding{ID: 4}, nil)
+				mockDB.EXPECT().UpdateAttackPathFindingAcceptedRisk(gomock.Any(), int64(4), null.Time{}, gomock.Any(), gomock.Any()).Return(model.AttackPathFinding{ID: 4}, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, testCase := range tt {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mockCtrl = gomock.NewController(t)
+				mockDB   = dbmocks.NewMockDatabase(mockCtrl)
+			)
+
+			testCase.setupMocks(mockDB)
+
+			response := serveAttackPathFindings(t, mockDB, dogtags.TestOverrides{}, user, http.MethodPut, "/api/v2/attack-path-findings/4/accepted-risk", testCase.body)
+			require.Equal(t, testCase.expectedCode, response.Code)
+		})
+	}
+}
@@ -240,6 +240,132 @@ func (s ManagementResource) GetRole(response http.ResponseWriter, request *http.
 	}
 }
 
+var (
+	errRolePermissionInvalid = errors.New("permission cannot be assigned to a role")
+	errPermissionEscalation  = errors.New(api.ErrorResponsePermissionEscalation)
+)
+
+// applyRoleRequest validates a role request and applies it to the given role. Roles may only be composed from the
+// permission set returned by auth.PermissionSet.All() and only from permissions the requesting user holds itself.
+func (s ManagementResource) applyRoleRequest(request *http.Request, role model.Role, roleRequest v2.UpsertRoleRequest) (model.Role, error) {
+	var (
+		bhCtx       = ctx.FromRequest(request)
+		permissions = model.Permissions{}
+		assignable  = auth.Permissions().All()
+	)
+
+	if len(roleRequest.Permissions) > 0 {
+		if found, err := s.db.GetAllPermissions(request.Context(), "", model.SQLFilter{SQLString: "id IN ?", Params: []any{roleRequest.Permissions}}); err != nil {
+			return role, err
+		} else {
+			foundIDs := make(map[int32]struct{}, len(found))
+
+			for _, permission := range found {
+				if !assignable.Has(permission) {
+					return role, fmt.Errorf("%w: %s", errRolePermissionInvalid, permission)
+				}
+
+				foundIDs[permission.ID] = struct{}{}
+				permissions = append(permissions, permission)
+			}
+
+			for _, id := range roleRequest.Permissions {
+				if _, ok := foundIDs[id]; !ok {
+					return role, fmt.Errorf("%w: %d", errRolePermissionInvalid, id)
+				}
+			}
+		}
+	}
+
+	if !s.authorizer.AllowsGrantingPermissions(bhCtx.AuthCtx, permissions) {
+		return role, errPermissionEscalation
+	}
+
+	role.Name = strings.TrimSpace(roleRequest.Name)
+	role.Description = roleRequest.Description
+	role.Permissions = permissions
+
+	return role, nil
+}
+
+func (s ManagementResource) handleRoleError(request *http.Request, response http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, errRolePermissionInvalid):
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	case errors.Is(err, errPermissionEscalation):
+		s.authorizer.AuditLogUnauthorizedAccess(request)
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, api.ErrorResponsePermissionEscalation, request), response)
+	case errors.Is(err, database.ErrDuplicateRoleName):
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, api.ErrorResponseRoleDuplicateName, request), response)
+	case errors.Is(err, database.ErrRoleInUse):
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, api.ErrorResponseRoleInUse, request), response)
+	default:
+		api.HandleDatabaseError(request, response, err)
+	}
+}
+
+func (s ManagementResource) CreateRole(response http.ResponseWriter, request *http.Request) {
+	var createRoleRequest v2.UpsertRoleRequest
+
+	if err := api.ReadJSONRequestPayloadLimited(&createRoleRequest, request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if strings.TrimSpace(createRoleRequest.Name) == "" {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseRoleNameEmpty, request), response)
+	} else if role, err := s.applyRoleRequest(request, model.Role{}, createRoleRequest); err != nil {
+		s.handleRoleError(request, response, err)
+	} else if newRole, err := s.db.CreateRole(request.Context(), role); err != nil {
+		s.handleRoleError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), newRole, http.StatusCreated, response)
+	}
+}
+
+func (s ManagementResource) UpdateRole(response http.ResponseWriter, request *http.Request) {
+	var (
+		updateRoleRequest v2.UpsertRoleRequest
+		rawRoleID         = mux.Vars(request)[api.URIPathVariableRoleID]
+	)
+
+	if roleID, err := strconv.ParseInt(rawRoleID, 10, 32); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if err := api.ReadJSONRequestPayloadLimited(&updateRoleRequest, request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if strings.TrimSpace(updateRoleRequest.Name) == "" {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseRoleNameEmpty, request), response)
+	} else if role, err := s.db.GetRole(request.Context(), int32(roleID)); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if role.BuiltIn {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseRoleBuiltIn, request), response)
+	} else if !s.authorizer.AllowsGrantingPermissions(ctx.FromRequest(request).AuthCtx, role.Permissions) {
+		// Rewriting a role that holds permissions the actor lacks would strip them from every user of the role
+		s.handleRoleError(request, response, errPermissionEscalation)
+	} else if role, err = s.applyRoleRequest(request, role, updateRoleRequest); err != nil {
+		s.handleRoleError(request, response, err)
+	} else if err := s.db.UpdateRole(request.Context(), role); err != nil {
+		s.handleRoleError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), role, http.StatusOK, response)
+	}
+}
+
+func (s ManagementResource) DeleteRole(response http.ResponseWriter, request *http.Request) {
+	rawRoleID := mux.Vars(request)[api.URIPathVariableRoleID]
+
+	if roleID, err := strconv.ParseInt(rawRoleID, 10, 32); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if role, err := s.db.GetRole(request.Context(), int32(roleID)); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if role.BuiltIn {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseRoleBuiltIn, request), response)
+	} else if !s.authorizer.AllowsGrantingPermissions(ctx.FromRequest(request).AuthCtx, role.Permissions) {
+		s.handleRoleError(request, response, errPermissionEscalation)
+	} else if err := s.db.DeleteRole(request.Context(), role); err != nil {
+		s.handleRoleError(request, response, err)
+	} else {
+		response.WriteHeader(http.StatusNoContent)
+	}
+}
+
 func (s ManagementResource) ListUsers(response http.ResponseWriter, request *http.Request) {
 	var (
 		order         []string
@@ -315,6 +441,9 @@ func (s ManagementResource) CreateUser(response http.ResponseWriter, request *ht
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, ErrResponseDetailsNumRoles, request), response)
 	} else if roles, err := s.db.GetRoles(request.Context(), createUserRequest.Roles); err != nil {
 		api.HandleDatabaseError(request, response, err)
+	} else if !s.authorizer.AllowsGrantingPermissions(ctx.FromRequest(request).AuthCtx, roles.Permissions()) {
+		s.authorizer.AuditLogUnauthorizedAccess(request)
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, api.ErrorResponsePermissionEscalation, request), response)
 	} else {
 		userTemplate.Roles = roles
 		userTemplate.FirstName = null.StringFrom(createUserRequest.FirstName)
@@ -415,6 +544,9 @@ func (s ManagementResource) UpdateUser(response http.ResponseWriter, request *ht
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "a user can only have one role", request), response)
 	} else if roles, err := s.db.GetRoles(request.Context(), updateUserRequest.Roles); err != nil {
 		api.HandleDatabaseError(request, response, err)
+	} else if updateUserRequest.Roles != nil && !s.authorizer.AllowsGrantingPermissions(authCtx.AuthCtx, roles.Permissions()) {
+		s.authorizer.AuditLogUnauthorizedAccess(request)
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, api.ErrorResponsePermissionEscalation, request), response)
 	} else {
 		// PATCH requests may not contain every field, only conditionally update if fields exist
 		if updateUserRequest.FirstName != "" {
@@ -942,7 +942,7 @@ func TestManagementResource_GetRole(t *testing.T) {
 			expected: expected{
 				responseCode:   http.StatusOK,
 				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
-				responseBody:   `{"data":{"created_at":"0001-01-01T00:00:00Z","deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"description":"System administrator role","id":123,"name":"Administrator","permissions":[{"authority":"read:users","created_at":"0001-01-01T00:00:00Z","deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"id":1,"name":"Read Users","updated_at":"0001-01-01T00:00:00Z"},{"authority":"write:users","created_at":"0001-01-01T00:00:00Z","deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"id":2,"name":"Write Users","updated_at":"0001-01-01T00:00:00Z"}],"updated_at":"0001-01-01T00:00:00Z"}}`,
+				responseBody:   `{"data":{"created_at":"0001-01-01T00:00:00Z","deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"built_in":false,"description":"System administrator role","id":123,"name":"Administrator","permissions":[{"authority":"read:users","created_at":"0001-01-01T00:00:00Z","deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"id":1,"name":"Read Users","updated_at":"0001-01-01T00:00:00Z"},{"authority":"write:users","created_at":"0001-01-01T00:00:00Z","deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"id":2,"name":"Write Users","updated_at":"0001-01-01T00:00:00Z"}],"updated_at":"0001-01-01T00:00:00Z"}}`,
 			},
 		},
 	}
@@ -976,6 +976,288 @@ func TestManagementResource_GetRole(t *testing.T) {
 	}
 }
 
+func roleManagerContext(permissions ...model.Permission) *ctx.Context {
+	return &ctx.Context{
+		AuthCtx: authz.Context{
+			Owner: model.User{
+				PrincipalName: "role.manager",
+				Roles:         model.Roles{{Name: "Role Manager", Permissions: permissions}},
+				Unique:        model.Unique{ID: must.NewUUIDv4()},
+			},
+		},
+	}
+}
+
+func TestManagementResource_CreateRole(t *testing.T) {
+	var (
+		permissions = authz.Permissions()
+		graphRead   = model.Permission{Authority: permissions.GraphDBRead.Authority, Name: permissions.GraphDBRead.Name, Serial: model.Serial{ID: 1}}
+		wipeDB      = model.Permission{Authority: permissions.WipeDB.Authority, Name: permissions.WipeDB.Name, Serial: model.Serial{ID: 2}}
+		acceptEULA  = model.Permission{Authority: permissions.AuthAcceptEULA.Authority, Name: permissions.AuthAcceptEULA.Name, Serial: model.Serial{ID: 3}}
+		adminCtx    = roleManagerContext(permissions.All()...)
+		managerCtx  = roleManagerContext(permissions.AuthManageUsers, permissions.GraphDBRead)
+	)
+
+	t.Run("name is required", func(t *testing.T) {
+		resources, _, _ := apitest.NewAuthManagementResource(gomock.NewController(t))
+
+		test.Request(t).
+			WithContext(adminCtx).
+			WithBody(v2.UpsertRoleRequest{Name: " ", Permissions: []int32{1}}).
+			OnHandlerFunc(resources.CreateRole).
+			Require().
+			ResponseStatusCode(http.StatusBadRequest)
+	})
+
+	t.Run("unknown permissions are rejected", func(t *testing.T) {
+		resources, mockDB, _ := apitest.NewAuthManagementResource(gomock.NewController(t))
+
+		mockDB.EXPECT().GetAllPermissions(gomock.Any(), "", model.SQLFilter{SQLString: "id IN ?", Params: []any{[]int32{1, 99}}}).Return(model.Permissions{graphRead}, nil)
+
+		test.Request(t).
+			WithContext(adminCtx).
+			WithBody(v2.UpsertRoleRequest{Name: "Graph Reader", Permissions: []int32{1, 99}}).
+			OnHandlerFunc(resources.CreateRole).
+			Require().
+			ResponseStatusCode(http.StatusBadRequest)
+	})
+
+	t.Run("permissions outside of the role permission set are rejected", func(t *testing.T) {
+		resources, mockDB, _ := apitest.NewAuthManagementResource(gomock.NewController(t))
+
+		mockDB.EXPECT().GetAllPermissions(gomock.Any(), "", gomock.Any()).Return(model.Permissions{acceptEULA}, nil)
+
+		test.Request(t).
+			WithContext(adminCtx).
+			WithBody(v2.UpsertRoleRequest{Name: "EULA", Permissions: []int32{3}}).
+			OnHandlerFunc(resources.CreateRole).
+			Require().
+			ResponseStatusCode(http.StatusBadRequest)
+	})
+
+	t.Run("permissions the actor does not hold can not be granted", func(t *testing.T) {
+		resources, mockDB, _ := apitest.NewAuthManagementResource(gomock.NewController(t))
+
+		mockDB.EXPECT().GetAllPermissions(gomock.Any(), "", gomock.Any()).Return(model.Permissions{graphRead, wipeDB}, nil)
+		mockDB.EXPECT().AppendAuditLog(gomock.Any(), gomock.Any()).Return(nil)
+
+		test.Request(t).
+			WithMethod(http.MethodPost).
+			WithURL("/api/v2/roles").
+			WithContext(managerCtx).
+			WithBody(v2.UpsertRoleRequest{Name: "Wiper", Permissions: []int32{1, 2}}).
+			OnHandlerFunc(resources.CreateRole).
+			Require().
+			ResponseStatusCode(http.StatusForbidden)
+	})
+
+	t.Run("duplicate names conflict", func(t *testing.T) {
+		resources, mockDB, _ := apitest.NewAuthManagementResource(gomock.NewController(t))
+
+		mockDB.EXPECT().GetAllPermissions(gomock.Any(), "", gomock.Any()).Return(model.Permissions{graphRead}, nil)
+		mockDB.EXPECT().CreateRole(gomock.Any(), gomock.Any()).Return(model.Role{}, database.ErrDuplicateRoleName)
+
+		test.Request(t).
+			WithContext(managerCtx).
+			WithBody(v2.UpsertRoleRequest{Name: "Graph Reader", Permissions: []int32{1}}).
+			OnHandlerFunc(resources.CreateRole).
+			Require().
+			ResponseStatusCode(http.StatusConflict)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		var (
+			resources, mockDB, _ = apitest.NewAuthManagementResource(gomock.NewController(t))
+			expected             = model.Role{Name: "Graph Reader", Description: "Reads the graph", Permissions: model.Permissions{graphRead}}
+		)
+
+		mockDB.EXPECT().GetAllPermissions(gomock.Any(), "", gomock.Any()).Return(model.Permissions{graphRead}, nil)
+		mockDB.EXPECT().CreateRole(gomock.Any(), expected).Return(expected, nil)
+
+		test.Request(t).
+			WithContext(managerCtx).
+			WithBody(v2.UpsertRoleRequest{Name: " Graph Reader ", Description: "Reads the graph", Permissions: []int32{1}}).
+			OnHandlerFunc(resources.CreateRole).
+			Require().
+			ResponseStatusCode(http.StatusCreated)
+	})
+}
+
+func TestManagementResource_UpdateRole(t *testing.T) {
+	var (
+		permissions = authz.Permissions()
+		graphRead   = model.Permission{Authority: permissions.GraphDBRead.Authority, Name: permissions.GraphDBRead.Name, Serial: model.Serial{ID: 1}}
+		wipeDB      = model.Permission{Authority: permissions.WipeDB.Authority, Name: permissions.WipeDB.Name, Serial: model.Serial{ID: 2}}
+		adminCtx    = roleManagerContext(permissions.All()...)
+		managerCtx  = roleManagerContext(permissions.AuthManageUsers, permissions.GraphDBRead)
+		customRole  = model.Role{Name: "Custom", Serial: model.Serial{ID: 7}}
+	)
+
+	t.Run("malformed id", func(t *testing.T) {
+		resources, _, _ := apitest.NewAuthManagementResource(gomock.NewController(t))
+
+		test.Request(t).
+			WithContext(adminCtx).
+			WithURLPathVars(map[string]string{api.URIPathVariableRoleID: "seven"}).
+			WithBody(v2.UpsertRoleRequest{Name: "Custom"}).
+			OnHandlerFunc(resources.UpdateRole).
+			Require().
+			ResponseStatusCode(http.StatusBadRequest)
+	})
+
+	t.Run("built-in roles are immutable", func(t *testing.T) {
+		resources, mockDB, _ := apitest.NewAuthManagementResource(gomock.NewController(t))
+
+		mockDB.EXPECT().GetRole(gomock.Any(), int32(1)).Return(model.Role{Name: authz.RoleAdministrator, BuiltIn: true, Serial: model.Serial{ID: 1}}, nil)
+
+		test.Request(t).
+			WithContext(adminCtx).
+			WithURLPathVars(map[string]string{api.URIPathVariableRoleID: "1"}).
+			WithBody(v2.UpsertRoleRequest{Name: "Renamed"}).
+			OnHandlerFunc(resources.UpdateRole).
+			Require().
+			ResponseStatusCode(http.StatusBadRequest)
+	})
+
+	t.Run("roles holding permissions the actor does not hold can not be updated", func(t *testing.T) {
+		resources, mockDB, _ := apitest.NewAuthManagementResource(gomock.NewController(t))
+
+		mockDB.EXPECT().GetRole(gomock.Any(), int32(7)).Return(model.Role{Name: "Wiper", Permissions: model.Permissions{graphRead, wipeDB}, Serial: model.Serial{ID: 7}}, nil)
+		mockDB.EXPECT().AppendAuditLog(gomock.Any(), gomock.Any()).Return(nil)
+
+		test.Request(t).
+			WithMethod(http.MethodPut).
+			WithURL("/api/v2/roles/7").
+			WithContext(managerCtx).
+			WithURLPathVars(map[string]string{api.URIPathVariableRoleID: "7"}).
+			WithBody(v2.UpsertRoleRequest{Name: "Wiper", Permissions: []int32{1}}).
+			OnHandlerFunc(resources.UpdateRole).
+			Require().
+			ResponseStatusCode(http.StatusForbidden)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		var (
+			resources, mockDB, _ = apitest.NewAuthManagementResource(gomock.NewController(t))
+			expected             = model.Role{Name: "Renamed", Description: "Now reads the graph", Permissions: model.Permissions{graphRead}, Serial: model.Serial{ID: 7}}
+		)
+
+		mockDB.EXPECT().GetRole(gomock.Any(), int32(7)).Return(customRole, nil)
+		mockDB.EXPECT().GetAllPermissions(gomock.Any(), "", gomock.Any()).Return(model.Permissions{graphRead}, nil)
+		mockDB.EXPECT().UpdateRole(gomock.Any(), expected).Return(nil)
+
+		test.Request(t).
+			WithContext(adminCtx).
+			WithURLPathVars(map[string]string{api.URIPathVariableRoleID: "7"}).
+			WithBody(v2.UpsertRoleRequest{Name: "Renamed", Description: "Now reads the graph", Permissions: []int32{1}}).
+			OnHandlerFunc(resources.UpdateRole).
+			Require().
+			ResponseStatusCode(http.StatusOK)
+	})
+}
+
+func TestManagementResource_DeleteRole(t *testing.T) {
+	var (
+		permissions = authz.Permissions()
+		adminCtx    = roleManagerContext(permissions.All()...)
+		managerCtx  = roleManagerContext(permissions.AuthManageUsers, permissions.GraphDBRead)
+		customRole  = model.Role{Name: "Custom", Serial: model.Serial{ID: 7}}
+	)
+
+	t.Run("role not found", func(t *testing.T) {
+		resources, mockDB, _ := apitest.NewAuthManagementResource(gomock.NewController(t))
+
+		mockDB.EXPECT().GetRole(gomock.Any(), int32(7)).Return(model.Role{}, database.ErrNotFound)
+
+		test.Request(t).
+			WithContext(adminCtx).
+			WithURLPathVars(map[string]string{api.URIPathVariableRoleID: "7"}).
+			OnHandlerFunc(resources.DeleteRole).
+			Require().
+			ResponseStatusCode(http.StatusNotFound)
+	})
+
+	t.Run("built-in roles are immutable", func(t *testing.T) {
+		resources, mockDB, _ := apitest.NewAuthManagementResource(gomock.NewController(t))
+
+		mockDB.EXPECT().GetRole(gomock.Any(), int32(1)).Return(model.Role{Name: authz.RoleAdministrator, BuiltIn: true, Serial: model.Serial{ID: 1}}, nil)
+
+		test.Request(t).
+			WithContext(adminCtx).
+			WithURLPathVars(map[string]string{api.URIPathVariableRoleID: "1"}).
+			OnHandlerFunc(resources.DeleteRole).
+			Require().
+			ResponseStatusCode(http.StatusBadRequest)
+	})
+
+	t.Run("roles holding permissions the actor does not hold can not be deleted", func(t *testing.T) {
+		var (
+			resources, mockDB, _ = apitest.NewAuthManagementResource(gomock.NewController(t))
+			wipeDB               = model.Permission{Authority: permissions.WipeDB.Authority, Name: permissions.WipeDB.Name, Serial: model.Serial{ID: 2}}
+		)
+
+		mockDB.EXPECT().GetRole(gomock.Any(), int32(7)).Return(model.Role{Name: "Wiper", Permissions: model.Permissions{wipeDB}, Serial: model.Serial{ID: 7}}, nil)
+		mockDB.EXPECT().AppendAuditLog(gomock.Any(), gomock.Any()).Return(nil)
+
+		test.Request(t).
+			WithMethod(http.MethodDelete).
+			WithURL("/api/v2/roles/7").
+			WithContext(managerCtx).
+			WithURLPathVars(map[string]string{api.URIPathVariableRoleID: "7"}).
+			OnHandlerFunc(resources.DeleteRole).
+			Require().
+			ResponseStatusCode(http.StatusForbidden)
+	})
+
+	t.Run("roles in use are protected", func(t *testing.T) {
+		resources, mockDB, _ := apitest.NewAuthManagementResource(gomock.NewController(t))
+
+		mockDB.EXPECT().GetRole(gomock.Any(), int32(7)).Return(customRole, nil)
+		mockDB.EXPECT().DeleteRole(gomock.Any(), customRole).Return(database.ErrRoleInUse)
+
+		test.Request(t).
+			WithContext(adminCtx).
+			WithURLPathVars(map[string]string{api.URIPathVariableRoleID: "7"}).
+			OnHandlerFunc(resources.DeleteRole).
+			Require().
+			ResponseStatusCode(http.StatusConflict)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		resources, mockDB, _ := apitest.NewAuthManagementResource(gomock.NewController(t))
+
+		mockDB.EXPECT().GetRole(gomock.Any(), int32(7)).Return(customRole, nil)
+		mockDB.EXPECT().DeleteRole(gomock.Any(), customRole).Return(nil)
+
+		test.Request(t).
+			WithContext(adminCtx).
+			WithURLPathVars(map[string]string{api.URIPathVariableRoleID: "7"}).
+			OnHandlerFunc(resources.DeleteRole).
+			Require().
+			ResponseStatusCode(http.StatusNoContent)
+	})
+}
+
+func TestCreateUser_PermissionEscalation(t *testing.T) {
+	var (
+		permissions          = authz.Permissions()
+		resources, mockDB, _ = apitest.NewAuthManagementResource(gomock.NewController(t))
+		managerCtx           = roleManagerContext(permissions.AuthManageUsers, permissions.GraphDBRead)
+	)
+
+	mockDB.EXPECT().GetRoles(gomock.Any(), []int32{1}).Return(model.Roles{{Name: authz.RoleAdministrator, Permissions: permissions.All()}}, nil)
+	mockDB.EXPECT().AppendAuditLog(gomock.Any(), gomock.Any()).Return(nil)
+
+	test.Request(t).
+		WithMethod(http.MethodPost).
+		WithURL("/api/v2/bloodhound-users").
+		WithContext(managerCtx).
+		WithBody(v2.CreateUserRequest{UpdateUserRequest: v2.UpdateUserRequest{Principal: "new.admin", Roles: []int32{1}}}).
+		OnHandlerFunc(resources.CreateUser).
+		Require().
+		ResponseStatusCode(http.StatusForbidden)
+}
+
 func TestExpireUserAuthSecret_Failure(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -2554,7 +2836,7 @@ func TestManagementResource_GetSelf(t *testing.T) {
 			expected: expected{
 				responseCode:   http.StatusOK,
 				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
-				responseBody:   `{"data":{"AuthSecret":null, "all_environments":false, "created_at":"0001-01-01T00:00:00Z", "deleted_at":{"Time":"0001-01-01T00:00:00Z", "Valid":false}, "email_address":"john.doe@example.com", "environment_targeted_access_control":null, "eula_accepted":false, "first_name":"John", "id":"00000000-0000-0000-0000-000000000000", "is_disabled":false, "last_login":"0001-01-01T00:00:00Z", "last_name":"Doe", "principal_name":"john.doe", "roles":[{"created_at":"0001-01-01T00:00:00Z", "deleted_at":{"Time":"0001-01-01T00:00:00Z", "Valid":false}, "description":"The big boy.", "id":0, "name":"Big Boy", "permissions":[], "updated_at":"0001-01-01T00:00:00Z"}], "sso_provider_id":null, "updated_at":"0001-01-01T00:00:00Z"}}`,
+				responseBody:   `{"data":{"AuthSecret":null, "all_environments":false, "created_at":"0001-01-01T00:00:00Z", "deleted_at":{"Time":"0001-01-01T00:00:00Z", "Valid":false}, "email_address":"john.doe@example.com", "environment_targeted_access_control":null, "eula_accepted":false, "first_name":"John", "id":"00000000-0000-0000-0000-000000000000", "is_disabled":false, "last_login":"0001-01-01T00:00:00Z", "last_name":"Doe", "principal_name":"john.doe", "roles":[{"created_at":"0001-01-01T00:00:00Z", "deleted_at":{"Time":"0001-01-01T00:00:00Z", "Valid":false}, "built_in":false, "description":"The big boy.", "id":0, "name":"Big Boy", "permissions":[], "updated_at":"0001-01-01T00:00:00Z"}], "sso_provider_id":null, "updated_at":"0001-01-01T00:00:00Z"}}`,
 			},
 		},
 		{
@@ -68,6 +68,8 @@ const (
 	entityTypeServicePrincipals   = "service-principals"
 	entityTypeRoles               = "roles"
 	entityTypeFunctionApps        = "function-apps"
+	entityTypeStorageAccounts     = "storage-accounts"
+	entityTypeSQLServers          = "sql-servers"
 )
 
 var (
@@ -100,6 +102,7 @@ func graphRelatedEntityType(request *http.Request, db database.Database, graphDb
 		azure.RelatedEntityTypeDescendentContainerRegistries,
 		azure.RelatedEntityTypeDescendentWebApps,
 		azure.RelatedEntityTypeDescendentAutomationAccounts,
+		azure.RelatedEntityTypeDescendentStorageAccounts, azure.RelatedEntityTypeDescendentSQLServers,
 		azure.RelatedEntityTypeDescendentLogicApps, azure.RelatedEntityTypeDescendentFunctionApps:
 		if descendents, err := azure.ListEntityDescendentPaths(ctx, graphDb, relatedEntityType, objectID); err != nil {
 			return nil, 0, api.BuildErrorResponse(http.StatusInternalServerError, fmt.Sprintf("error fetching related entity type %s: %v", entityType, err), request)
@@ -133,6 +136,13 @@ func graphRelatedEntityType(request *http.Request, db database.Database, graphDb
 			return bloodhoundgraph.PathSetToBloodHoundGraph(validPrimaryKinds, customNodeKinds, groupMembers), groupMembers.Len(), nil
 		}
 
+	case azure.RelatedEntityTypeStorageKeyReaders:
+		if storageKeyReaders, err := azure.ListStorageKeyReaderPaths(ctx, graphDb, objectID); err != nil {
+			return nil, 0, api.BuildErrorResponse(http.StatusInternalServerError, fmt.Sprintf("error fetching related entity type %s: %v", entityType, err), request)
+		} else {
+			return bloodhoundgraph.PathSetToBloodHoundGraph(validPrimaryKinds, customNodeKinds, storageKeyReaders), storageKeyReaders.Len(), nil
+		}
+
 	case azure.RelatedEntityTypeGroupMembers:
 		if groupMembers, err := azure.ListEntityGroupMemberPaths(ctx, graphDb, objectID); err != nil {
 			return nil, 0, api.BuildErrorResponse(http.StatusInternalServerError, fmt.Sprintf("error fetching related entity type %s: %v", entityType, err), request)
@@ -228,7 +238,8 @@ func listRelatedEntityType(ctx context.Context, db graph.Database, validPrimaryK
 		azure.RelatedEntityTypeDescendentContainerRegistries,
 		azure.RelatedEntityTypeDescendentWebApps,
 		azure.RelatedEntityTypeDescendentLogicApps, azure.RelatedEntityTypeDescendentFunctionApps,
-		azure.RelatedEntityTypeDescendentAutomationAccounts:
+		azure.RelatedEntityTypeDescendentAutomationAccounts,
+		azure.RelatedEntityTypeDescendentStorageAccounts, azure.RelatedEntityTypeDescendentSQLServers:
 		if nodeSet, err = azure.ListEntityDescendents(ctx, db, relatedEntityType, objectID, 0, 0); err != nil {
 			return nil, 0, err
 		}
@@ -250,6 +261,11 @@ func listRelatedEntityType(ctx context.Context, db graph.Database, validPrimaryK
 			return nil, 0, err
 		}
 
+	case azure.RelatedEntityTypeStorageKeyReaders:
+		if nodeSet, err = azure.ListStorageKeyReaders(ctx, db, objectID, 0, 0); err != nil {
+			return nil, 0, err
+		}
+
 	case azure.RelatedEntityTypeGroupMembers:
 		if nodeSet, err = azure.ListEntityGroupMembers(ctx, db, objectID, 0, 0); err != nil {
 			return nil, 0, err
@@ -404,6 +420,10 @@ func GetAZEntityInformation(ctx context.Context, db database.Database, graphDb g
 		return azure.RoleEntityDetails(ctx, graphDb, validPrimaryKinds, objectID, hydrateCounts)
 	case entityTypeFunctionApps:
 		return azure.FunctionAppEntityDetails(ctx, graphDb, validPrimaryKinds, objectID, hydrateCounts)
+	case entityTypeStorageAccounts:
+		return azure.StorageAccountEntityDetails(ctx, graphDb, validPrimaryKinds, objectID, hydrateCounts)
+	case entityTypeSQLServers:
+		return azure.SQLServerEntityDetails(ctx, graphDb, validPrimaryKinds, objectID, hydrateCounts)
 	default:
 		return nil, fmt.Errorf("unknown azure entity %s", entityType)
 	}
@@ -513,6 +533,12 @@ func azEntityParamToKind(entityType string) (graph.Kind, error) {
 	case entityTypeFunctionApps:
 		return azure_schema.FunctionApp, nil
 
+	case entityTypeStorageAccounts:
+		return azure_schema.StorageAccount, nil
+
+	case entityTypeSQLServers:
+		return azure_schema.SQLServer, nil
+
 	default:
 		return nil, fmt.Errorf("unknown azure entity %s", entityType)
 	}
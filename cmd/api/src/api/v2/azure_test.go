@@ -1133,6 +1133,66 @@ func TestResources_GetAZEntityInformation(t *testing.T) {
 				err: nil,
 			},
 		},
+		{
+			name: "Error: entityTypeStorageAccounts",
+			args: args{
+				entityType: "storage-accounts",
+			},
+			setupMocks: func(t *testing.T, mocks *mock) {
+				t.Helper()
+				mocks.mockDatabase.EXPECT().GetDisplayNodeGraphKinds(gomock.Any())
+				mocks.mockGraphDB.EXPECT().ReadTransaction(gomock.Any(), gomock.Any()).Return(errors.New("error"))
+			},
+			want: want{
+				res: nil,
+				err: errors.New("error"),
+			},
+		},
+		{
+			name: "Success: entityTypeStorageAccounts",
+			args: args{
+				entityType: "storage-accounts",
+			},
+			setupMocks: func(t *testing.T, mocks *mock) {
+				t.Helper()
+				mocks.mockDatabase.EXPECT().GetDisplayNodeGraphKinds(gomock.Any())
+				mocks.mockGraphDB.EXPECT().ReadTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			want: want{
+				res: azure.StorageAccountDetails{Node: azure.Node{Kind: "", Properties: map[string]interface{}(nil)}, InboundObjectControl: 0, StorageKeyReaders: 0},
+				err: nil,
+			},
+		},
+		{
+			name: "Error: entityTypeSQLServers",
+			args: args{
+				entityType: "sql-servers",
+			},
+			setupMocks: func(t *testing.T, mocks *mock) {
+				t.Helper()
+				mocks.mockDatabase.EXPECT().GetDisplayNodeGraphKinds(gomock.Any())
+				mocks.mockGraphDB.EXPECT().ReadTransaction(gomock.Any(), gomock.Any()).Return(errors.New("error"))
+			},
+			want: want{
+				res: nil,
+				err: errors.New("error"),
+			},
+		},
+		{
+			name: "Success: entityTypeSQLServers",
+			args: args{
+				entityType: "sql-servers",
+			},
+			setupMocks: func(t *testing.T, mocks *mock) {
+				t.Helper()
+				mocks.mockDatabase.EXPECT().GetDisplayNodeGraphKinds(gomock.Any())
+				mocks.mockGraphDB.EXPECT().ReadTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			want: want{
+				res: azure.SQLServerDetails{Node: azure.Node{Kind: "", Properties: map[string]interface{}(nil)}, InboundObjectControl: 0},
+				err: nil,
+			},
+		},
 		{
 			name: "Error: unknown azure entity",
 			args: args{
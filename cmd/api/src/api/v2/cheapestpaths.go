@@ -0,0 +1,199 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/ctx"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/specterops/bloodhound/cmd/api/src/queries"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
+	"github.com/specterops/bloodhound/packages/go/graphschema"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/params"
+	"github.com/specterops/dawgs/graph"
+)
+
+const (
+	cheapestPathsDefaultLimit = 3
+	cheapestPathsMaxLimit     = 10
+)
+
+type CheapestPath struct {
+	Cost  float64             `json:"cost"`
+	Nodes []string            `json:"nodes"`
+	Edges []model.UnifiedEdge `json:"edges"`
+}
+
+type CheapestPathsResponse struct {
+	CostProfile string                       `json:"cost_profile"`
+	Nodes       map[string]model.UnifiedNode `json:"nodes"`
+	Paths       []CheapestPath               `json:"paths"`
+}
+
+func parseCheapestPathsLimit(value string) (int, error) {
+	if value == "" {
+		return cheapestPathsDefaultLimit, nil
+	} else if limit, err := strconv.Atoi(value); err != nil || limit < 1 || limit > cheapestPathsMaxLimit {
+		return 0, fmt.Errorf("invalid query parameter 'limit': must be a number between 1 and %d", cheapestPathsMaxLimit)
+	} else {
+		return limit, nil
+	}
+}
+
+// GetCheapestPaths returns the cheapest loop-free paths between two nodes where each relationship costs what the
+// requested cost profile assigns to its kind
+func (s Resources) GetCheapestPaths(response http.ResponseWriter, request *http.Request) {
+	var (
+		queryParams            = request.URL.Query()
+		startNode              = queryParams.Get(params.StartNode.String())
+		endNode                = queryParams.Get(params.EndNode.String())
+		costProfileName        = queryParams.Get(params.CostProfile.String())
+		relationshipKindsParam = queryParams.Get(params.RelationshipKinds.String())
+		requestContext         = request.Context()
+	)
+
+	onlyIncludeTraversableKinds, err := api.ParseOptionalBool(queryParams.Get(api.QueryParameterIncludeOnlyTraversableKinds), false)
+	if err != nil {
+		slog.ErrorContext(requestContext, "Error parsing optional boolean parameter", attr.Error(err))
+	}
+
+	if startNode == "" {
+		api.WriteErrorResponse(requestContext, api.BuildErrorResponse(http.StatusBadRequest, "Missing query parameter: start_node", request), response)
+	} else if endNode == "" {
+		api.WriteErrorResponse(requestContext, api.BuildErrorResponse(http.StatusBadRequest, "Missing query parameter: end_node", request), response)
+	} else if costProfileName == "" {
+		api.WriteErrorResponse(requestContext, api.BuildErrorResponse(http.StatusBadRequest, "Missing query parameter: cost_profile", request), response)
+	} else if limit, err := parseCheapestPathsLimit(queryParams.Get(model.PaginationQueryParameterLimit)); err != nil {
+		api.WriteErrorResponse(requestContext, api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if costProfile, err := s.DB.GetPathfindingCostProfile(requestContext, costProfileName); errors.Is(err, database.ErrNotFound) {
+		api.WriteErrorResponse(requestContext, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("Cost profile %s does not exist", costProfileName), request), response)
+	} else if err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if ogExtensionManagementFeatureFlag, err := s.DB.GetFlagByKey(requestContext, appcfg.FeatureOpenGraphExtensionManagement); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if validPrimaryKinds, err := s.DB.GetDisplayNodeGraphKinds(requestContext); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if user, isUser := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx); !isUser {
+		slog.ErrorContext(requestContext, "Unable to get user from auth context")
+		api.WriteErrorResponse(requestContext, api.BuildErrorResponse(http.StatusInternalServerError, "unknown user", request), response)
+	} else if kindFilter, apiError := s.cheapestPathsKindFilter(requestContext, relationshipKindsParam, onlyIncludeTraversableKinds, ogExtensionManagementFeatureFlag.Enabled, request); apiError != nil {
+		api.WriteErrorResponse(requestContext, apiError, response)
+	} else if paths, err := s.GraphQuery.GetCheapestPaths(requestContext, startNode, endNode, kindFilter, costProfile, limit, ogExtensionManagementFeatureFlag.Enabled); err != nil {
+		api.WriteErrorResponse(requestContext, api.BuildErrorResponse(http.StatusInternalServerError, err.Error(), request), response)
+	} else if len(paths) == 0 {
+		api.WriteErrorResponse(requestContext, api.BuildErrorResponse(http.StatusNotFound, "path not found", request), response)
+	} else if cheapestPaths, err := newCheapestPathsResponse(validPrimaryKinds, costProfile.Name, paths, ShouldFilterForETAC(s.DogTags, user), user); err != nil {
+		api.WriteErrorResponse(requestContext, api.BuildErrorResponse(http.StatusInternalServerError, "error filtering graph for ETAC", request), response)
+	} else {
+		api.WriteBasicResponse(requestContext, cheapestPaths, http.StatusOK, response)
+	}
+}
+
+// cheapestPathsKindFilter restricts traversal to the requested relationship kinds the same way shortest path
+// searches do
+func (s Resources) cheapestPathsKindFilter(ctx context.Context, relationshipKindsParam string, onlyIncludeTraversableKinds, includeOpenGraph bool, request *http.Request) (graph.Criteria, *api.ErrorWrapper) {
+	// note: this uses relationships from cue files, not from schema database
+	validKinds := graph.Kinds(ad.Relationships()).Concatenate(azure.Relationships())
+
+	if onlyIncludeTraversableKinds {
+		validKinds = graph.Kinds(ad.PathfindingRelationshipsMatchFrontend()).Concatenate(azure.PathfindingRelationships())
+	}
+
+	if includeOpenGraph {
+		if openGraphKinds, err := s.withOpenGraphRelationshipKinds(ctx, onlyIncludeTraversableKinds, validKinds); err != nil {
+			return nil, api.BuildErrorResponse(http.StatusInternalServerError, api.FormatDatabaseError(err).Error(), request)
+		} else {
+			validKinds = openGraphKinds
+		}
+	}
+
+	if kindFilter, err := createRelationshipKindFilterCriteria(relationshipKindsParam, onlyIncludeTraversableKinds, validKinds); err != nil {
+		return nil, api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request)
+	} else {
+		return kindFilter, nil
+	}
+}
+
+// newCheapestPathsResponse converts weighted paths into a response that lists the nodes of all paths once. Node
+// properties are only fetched for ETAC filtering and are not part of the response.
+func newCheapestPathsResponse(validPrimaryKinds graphschema.ValidPrimaryKinds, costProfileName string, paths []queries.WeightedPath, filterForETAC bool, user model.User) (CheapestPathsResponse, error) {
+	var (
+		graphResponse = model.NewUnifiedGraph()
+		cheapestPaths = CheapestPathsResponse{
+			CostProfile: costProfileName,
+			Nodes:       map[string]model.UnifiedNode{},
+			Paths:       make([]CheapestPath, 0, len(paths)),
+		}
+	)
+
+	for _, path := range paths {
+		for _, node := range path.Path.Nodes {
+			graphResponse.Nodes[node.ID.String()] = model.FromDAWGSNode(validPrimaryKinds, node, true)
+		}
+	}
+
+	if filterForETAC {
+		if filteredGraph, err := filterETACGraph(graphResponse, user); err != nil {
+			return cheapestPaths, err
+		} else {
+			graphResponse = filteredGraph
+		}
+	}
+
+	for id, node := range graphResponse.Nodes {
+		node.Properties = make(map[string]any)
+		cheapestPaths.Nodes[id] = node
+	}
+
+	for _, path := range paths {
+		cheapestPath := CheapestPath{
+			Cost:  path.Cost,
+			Nodes: make([]string, 0, len(path.Path.Nodes)),
+			Edges: make([]model.UnifiedEdge, 0, len(path.Path.Edges)),
+		}
+
+		for _, node := range path.Path.Nodes {
+			cheapestPath.Nodes = append(cheapestPath.Nodes, node.ID.String())
+		}
+
+		for _, relationship := range path.Path.Edges {
+			edge := model.FromDAWGSRelationship(false)(relationship)
+
+			if cheapestPaths.Nodes[edge.Source].Hidden || cheapestPaths.Nodes[edge.Target].Hidden {
+				edge = hiddenETACEdge(edge)
+			}
+
+			cheapestPath.Edges = append(cheapestPath.Edges, edge)
+		}
+
+		cheapestPaths.Paths = append(cheapestPaths.Paths, cheapestPath)
+	}
+
+	return cheapestPaths, nil
+}
@@ -0,0 +1,291 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/api/v2/apitest"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/specterops/bloodhound/cmd/api/src/queries"
+	mocks_graph "github.com/specterops/bloodhound/cmd/api/src/queries/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/services/dogtags"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/query"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResources_GetCheapestPaths(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockGraph = mocks_graph.NewMockGraph(mockCtrl)
+		mockDB    = mocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{GraphQuery: mockGraph, DB: mockDB, DogTags: dogtags.NewTestService(dogtags.TestOverrides{})}
+
+		user    = setupUser()
+		userCtx = setupUserCtx(user)
+
+		costProfile = model.PathfindingCostProfile{
+			Name:        "reliability",
+			DefaultCost: 1,
+			Costs:       model.PathfindingCosts{ad.HasSession.String(): 5},
+		}
+
+		start = &graph.Node{ID: 1, Kinds: graph.Kinds{ad.Entity, ad.User}, Properties: graph.AsProperties(graph.PropertyMap{common.Name: "start"})}
+		group = &graph.Node{ID: 2, Kinds: graph.Kinds{ad.Entity, ad.Group}, Properties: graph.AsProperties(graph.PropertyMap{common.Name: "group"})}
+		end   = &graph.Node{ID: 3, Kinds: graph.Kinds{ad.Entity, ad.Computer}, Properties: graph.AsProperties(graph.PropertyMap{common.Name: "end"})}
+
+		paths = []queries.WeightedPath{
+			{
+				Path: graph.Path{
+					Nodes: []*graph.Node{start, group, end},
+					Edges: []*graph.Relationship{
+						{ID: 1, StartID: 1, EndID: 2, Kind: ad.MemberOf, Properties: graph.NewProperties()},
+						{ID: 2, StartID: 2, EndID: 3, Kind: ad.AdminTo, Properties: graph.NewProperties()},
+					},
+				},
+				Cost: 2,
+			},
+			{
+				Path: graph.Path{
+					Nodes: []*graph.Node{start, end},
+					Edges: []*graph.Relationship{
+						{ID: 3, StartID: 1, EndID: 3, Kind: ad.HasSession, Properties: graph.NewProperties()},
+					},
+				},
+				Cost: 5,
+			},
+		}
+	)
+	defer mockCtrl.Finish()
+
+	withRequiredParams := func(input *apitest.Input) {
+		apitest.AddQueryParam(input, "start_node", "someID")
+		apitest.AddQueryParam(input, "end_node", "someOtherID")
+		apitest.AddQueryParam(input, "cost_profile", costProfile.Name)
+		apitest.SetContext(input, userCtx)
+	}
+
+	apitest.NewHarness(t, resources.GetCheapestPaths).
+		Run([]apitest.Case{
+			{
+				Name: "MissingStartNodeIDParam",
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "Missing query parameter: start_node")
+				},
+			},
+			{
+				Name: "MissingCostProfileParam",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "start_node", "someID")
+					apitest.AddQueryParam(input, "end_node", "someOtherID")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "Missing query parameter: cost_profile")
+				},
+			},
+			{
+				Name: "InvalidLimit",
+				Input: func(input *apitest.Input) {
+					withRequiredParams(input)
+					apitest.AddQueryParam(input, "limit", "11")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "invalid query parameter 'limit'")
+				},
+			},
+			{
+				Name:  "UnknownCostProfile",
+				Input: withRequiredParams,
+				Setup: func() {
+					mockDB.EXPECT().GetPathfindingCostProfile(gomock.Any(), costProfile.Name).Return(model.PathfindingCostProfile{}, database.ErrNotFound)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "Cost profile reliability does not exist")
+				},
+			},
+			{
+				Name:  "GetCostProfileError",
+				Input: withRequiredParams,
+				Setup: func() {
+					mockDB.EXPECT().GetPathfindingCostProfile(gomock.Any(), costProfile.Name).Return(model.PathfindingCostProfile{}, errors.New("database error"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "InvalidRelationshipKindsQuery",
+				Input: func(input *apitest.Input) {
+					withRequiredParams(input)
+					apitest.AddQueryParam(input, "relationship_kinds", "wrx")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetPathfindingCostProfile(gomock.Any(), costProfile.Name).Return(costProfile, nil)
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{}, nil)
+					mockDB.EXPECT().GetDisplayNodeGraphKinds(gomock.Any())
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "invalid query parameter 'relationship_kinds'")
+				},
+			},
+			{
+				Name: "GraphQueryError",
+				Input: func(input *apitest.Input) {
+					withRequiredParams(input)
+					apitest.AddQueryParam(input, "relationship_kinds", "in:MemberOf,AdminTo,HasSession")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetPathfindingCostProfile(gomock.Any(), costProfile.Name).Return(costProfile, nil)
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{}, nil)
+					mockDB.EXPECT().GetDisplayNodeGraphKinds(gomock.Any())
+					mockGraph.EXPECT().
+						GetCheapestPaths(gomock.Any(), "someID", "someOtherID", query.KindIn(query.Relationship(), ad.MemberOf, ad.AdminTo, ad.HasSession), costProfile, 3, false).
+						Return(nil, errors.New("graph error"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+					apitest.BodyContains(output, "graph error")
+				},
+			},
+			{
+				Name: "NoPathsFound",
+				Input: func(input *apitest.Input) {
+					withRequiredParams(input)
+					apitest.AddQueryParam(input, "limit", "5")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetPathfindingCostProfile(gomock.Any(), costProfile.Name).Return(costProfile, nil)
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{}, nil)
+					mockDB.EXPECT().GetDisplayNodeGraphKinds(gomock.Any())
+					mockGraph.EXPECT().GetCheapestPaths(gomock.Any(), "someID", "someOtherID", gomock.Any(), costProfile, 5, false).Return(nil, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+					apitest.BodyContains(output, "path not found")
+				},
+			},
+			{
+				Name: "SuccessWithOpenGraph",
+				Input: func(input *apitest.Input) {
+					withRequiredParams(input)
+					apitest.AddQueryParam(input, "relationship_kinds", "in:OpenGraphKindA")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetPathfindingCostProfile(gomock.Any(), costProfile.Name).Return(costProfile, nil)
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{Enabled: true}, nil)
+					mockDB.EXPECT().GetDisplayNodeGraphKinds(gomock.Any())
+					mockDB.EXPECT().
+						GetGraphSchemaRelationshipKinds(gomock.Any(), model.Filters{}, model.Sort{}, 0, 0).
+						Return(model.GraphSchemaRelationshipKinds{{Name: "OpenGraphKindA"}}, 1, nil)
+					mockGraph.EXPECT().
+						GetCheapestPaths(gomock.Any(), "someID", "someOtherID", query.KindIn(query.Relationship(), graph.StringKind("OpenGraphKindA")), costProfile, 3, true).
+						Return(paths, nil)
+				},
+				Test: func(output apitest.Output) {
+					var response v2.CheapestPathsResponse
+
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.UnmarshalData(output, &response)
+
+					require.Equal(t, "reliability", response.CostProfile)
+					require.Len(t, response.Nodes, 3)
+					require.Len(t, response.Paths, 2)
+					require.Equal(t, 2.0, response.Paths[0].Cost)
+					require.Equal(t, []string{"1", "2", "3"}, response.Paths[0].Nodes)
+					require.Equal(t, ad.AdminTo.String(), response.Paths[0].Edges[1].Kind)
+					require.Equal(t, 5.0, response.Paths[1].Cost)
+					require.Equal(t, ad.HasSession.String(), response.Paths[1].Edges[0].Kind)
+				},
+			},
+		})
+}
+
+func TestResources_GetCheapestPaths_ETAC(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockGraph = mocks_graph.NewMockGraph(mockCtrl)
+		mockDB    = mocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{GraphQuery: mockGraph, DB: mockDB, DogTags: dogtags.NewTestService(dogtags.TestOverrides{
+			Bools: map[dogtags.BoolDogTag]bool{
+				dogtags.ETAC_ENABLED: true,
+			},
+		})}
+
+		user = model.User{
+			EnvironmentTargetedAccessControl: []model.EnvironmentTargetedAccessControl{
+				{EnvironmentID: "12345"},
+			},
+		}
+
+		costProfile = model.PathfindingCostProfile{Name: "reliability", DefaultCost: 1}
+	)
+	defer mockCtrl.Finish()
+
+	apitest.NewHarness(t, resources.GetCheapestPaths).
+		Run([]apitest.Case{
+			{
+				Name: "HidesInaccessibleNodes",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "start_node", "0")
+					apitest.AddQueryParam(input, "end_node", "1")
+					apitest.AddQueryParam(input, "cost_profile", costProfile.Name)
+					apitest.SetContext(input, setupUserCtx(user))
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetPathfindingCostProfile(gomock.Any(), costProfile.Name).Return(costProfile, nil)
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{}, nil)
+					mockDB.EXPECT().GetDisplayNodeGraphKinds(gomock.Any())
+					mockGraph.EXPECT().
+						GetCheapestPaths(gomock.Any(), "0", "1", gomock.Any(), costProfile, 3, false).
+						Return([]queries.WeightedPath{{
+							Path: graph.Path{
+								Nodes: []*graph.Node{
+									{ID: 0, Kinds: graph.Kinds{ad.Entity, ad.Computer}, Properties: graph.AsProperties(graph.PropertyMap{ad.DomainSID: "inaccessible", common.Name: "invisible"})},
+									{ID: 1, Kinds: graph.Kinds{ad.Entity, ad.User}, Properties: graph.AsProperties(graph.PropertyMap{ad.DomainSID: "12345", common.Name: "visible"})},
+								},
+								Edges: []*graph.Relationship{
+									{ID: 0, StartID: 0, EndID: 1, Kind: ad.GenericWrite, Properties: graph.NewProperties()},
+								},
+							},
+							Cost: 1,
+						}}, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, "Hidden")
+					apitest.BodyContains(output, "visible")
+					apitest.BodyNotContains(output, "invisible")
+					apitest.BodyNotContains(output, ad.GenericWrite.String())
+				},
+			},
+		})
+}
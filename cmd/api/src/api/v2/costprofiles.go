@@ -0,0 +1,116 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+)
+
+const (
+	CostProfileNameParameter = "cost_profile_name"
+)
+
+type UpsertCostProfileRequest struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	DefaultCost float64                `json:"default_cost"`
+	Costs       model.PathfindingCosts `json:"costs"`
+}
+
+// costProfile converts the request into a validated cost profile with the given name
+func (s UpsertCostProfileRequest) costProfile(name string) (model.PathfindingCostProfile, error) {
+	profile := model.PathfindingCostProfile{
+		Name:        name,
+		Description: s.Description,
+		DefaultCost: s.DefaultCost,
+		Costs:       s.Costs,
+	}
+
+	if profile.Costs == nil {
+		profile.Costs = model.PathfindingCosts{}
+	}
+
+	return profile, profile.Validate()
+}
+
+func (s *Resources) GetCostProfiles(response http.ResponseWriter, request *http.Request) {
+	if profiles, err := s.DB.GetPathfindingCostProfiles(request.Context()); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), profiles, http.StatusOK, response)
+	}
+}
+
+func (s *Resources) GetCostProfile(response http.ResponseWriter, request *http.Request) {
+	if profile, err := s.DB.GetPathfindingCostProfile(request.Context(), mux.Vars(request)[CostProfileNameParameter]); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), profile, http.StatusOK, response)
+	}
+}
+
+func (s *Resources) CreateCostProfile(response http.ResponseWriter, request *http.Request) {
+	var profileRequest UpsertCostProfileRequest
+
+	if err := json.NewDecoder(request.Body).Decode(&profileRequest); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, request), response)
+	} else if profile, err := profileRequest.costProfile(profileRequest.Name); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s", api.ErrorResponseCodeBadRequest, err), request), response)
+	} else if profile, err := s.DB.CreatePathfindingCostProfile(request.Context(), profile); errors.Is(err, database.ErrDuplicateCostProfileName) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, fmt.Sprintf("%s: duplicate cost profile name", api.ErrorResponseConflict), request), response)
+	} else if err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), profile, http.StatusCreated, response)
+	}
+}
+
+// UpdateCostProfile replaces the description and costs of a cost profile. Cost profiles can not be renamed.
+func (s *Resources) UpdateCostProfile(response http.ResponseWriter, request *http.Request) {
+	var (
+		profileName    = mux.Vars(request)[CostProfileNameParameter]
+		profileRequest UpsertCostProfileRequest
+	)
+
+	if err := json.NewDecoder(request.Body).Decode(&profileRequest); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, request), response)
+	} else if profileRequest.Name != "" && profileRequest.Name != profileName {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: cost profiles can not be renamed", api.ErrorResponseCodeBadRequest), request), response)
+	} else if profile, err := profileRequest.costProfile(profileName); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s", api.ErrorResponseCodeBadRequest, err), request), response)
+	} else if profile, err := s.DB.UpdatePathfindingCostProfile(request.Context(), profile); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), profile, http.StatusOK, response)
+	}
+}
+
+func (s *Resources) DeleteCostProfile(response http.ResponseWriter, request *http.Request) {
+	if err := s.DB.DeletePathfindingCostProfile(request.Context(), mux.Vars(request)[CostProfileNameParameter]); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		response.WriteHeader(http.StatusOK)
+	}
+}
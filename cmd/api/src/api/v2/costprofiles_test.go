@@ -0,0 +1,283 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/api/v2/apitest"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResources_GetCostProfiles(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = mocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB}
+	)
+	defer mockCtrl.Finish()
+
+	apitest.NewHarness(t, resources.GetCostProfiles).
+		Run([]apitest.Case{
+			{
+				Name: "DatabaseError",
+				Setup: func() {
+					mockDB.EXPECT().GetPathfindingCostProfiles(gomock.Any()).Return(nil, errors.New("database error"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "Success",
+				Setup: func() {
+					mockDB.EXPECT().GetPathfindingCostProfiles(gomock.Any()).Return([]model.PathfindingCostProfile{{Name: "reliability", DefaultCost: 1}}, nil)
+				},
+				Test: func(output apitest.Output) {
+					var profiles []model.PathfindingCostProfile
+
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.UnmarshalData(output, &profiles)
+					require.Len(t, profiles, 1)
+					require.Equal(t, "reliability", profiles[0].Name)
+				},
+			},
+		})
+}
+
+func TestResources_GetCostProfile(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = mocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB}
+	)
+	defer mockCtrl.Finish()
+
+	apitest.NewHarness(t, resources.GetCostProfile).
+		Run([]apitest.Case{
+			{
+				Name: "NotFound",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, v2.CostProfileNameParameter, "missing")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetPathfindingCostProfile(gomock.Any(), "missing").Return(model.PathfindingCostProfile{}, database.ErrNotFound)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, v2.CostProfileNameParameter, "reliability")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetPathfindingCostProfile(gomock.Any(), "reliability").Return(model.PathfindingCostProfile{Name: "reliability", DefaultCost: 1}, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, "reliability")
+				},
+			},
+		})
+}
+
+func TestResources_CreateCostProfile(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = mocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB}
+
+		expected = model.PathfindingCostProfile{
+			Name:        "reliability",
+			DefaultCost: 1,
+			Costs:       model.PathfindingCosts{"HasSession": 5},
+		}
+	)
+	defer mockCtrl.Finish()
+
+	apitest.NewHarness(t, resources.CreateCostProfile).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidBody",
+				Input: func(input *apitest.Input) {
+					apitest.BodyString(input, "not json")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+			{
+				Name: "MissingName",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.UpsertCostProfileRequest{DefaultCost: 1})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "cost profile name is required")
+				},
+			},
+			{
+				Name: "NegativeCost",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.UpsertCostProfileRequest{Name: "reliability", DefaultCost: 1, Costs: model.PathfindingCosts{"HasSession": -1}})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "cost of HasSession must be a positive number")
+				},
+			},
+			{
+				Name: "DuplicateName",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.UpsertCostProfileRequest{Name: "reliability", DefaultCost: 1, Costs: expected.Costs})
+				},
+				Setup: func() {
+					mockDB.EXPECT().CreatePathfindingCostProfile(gomock.Any(), expected).Return(model.PathfindingCostProfile{}, database.ErrDuplicateCostProfileName)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusConflict)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.UpsertCostProfileRequest{Name: "reliability", DefaultCost: 1, Costs: expected.Costs})
+				},
+				Setup: func() {
+					mockDB.EXPECT().CreatePathfindingCostProfile(gomock.Any(), expected).Return(expected, nil)
+				},
+				Test: func(output apitest.Output) {
+					var profile model.PathfindingCostProfile
+
+					apitest.StatusCode(output, http.StatusCreated)
+					apitest.UnmarshalData(output, &profile)
+					require.Equal(t, expected.Costs, profile.Costs)
+				},
+			},
+		})
+}
+
+func TestResources_UpdateCostProfile(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = mocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB}
+
+		expected = model.PathfindingCostProfile{
+			Name:        "reliability",
+			Description: "updated",
+			DefaultCost: 2,
+			Costs:       model.PathfindingCosts{},
+		}
+	)
+	defer mockCtrl.Finish()
+
+	apitest.NewHarness(t, resources.UpdateCostProfile).
+		WithCommonRequest(func(input *apitest.Input) {
+			apitest.SetURLVar(input, v2.CostProfileNameParameter, "reliability")
+		}).
+		Run([]apitest.Case{
+			{
+				Name: "Rename",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.UpsertCostProfileRequest{Name: "renamed", DefaultCost: 1})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "cost profiles can not be renamed")
+				},
+			},
+			{
+				Name: "InvalidDefaultCost",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.UpsertCostProfileRequest{DefaultCost: 0})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "default_cost must be a positive number")
+				},
+			},
+			{
+				Name: "NotFound",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.UpsertCostProfileRequest{Description: "updated", DefaultCost: 2})
+				},
+				Setup: func() {
+					mockDB.EXPECT().UpdatePathfindingCostProfile(gomock.Any(), expected).Return(model.PathfindingCostProfile{}, database.ErrNotFound)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.UpsertCostProfileRequest{Name: "reliability", Description: "updated", DefaultCost: 2})
+				},
+				Setup: func() {
+					mockDB.EXPECT().UpdatePathfindingCostProfile(gomock.Any(), expected).Return(expected, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, "updated")
+				},
+			},
+		})
+}
+
+func TestResources_DeleteCostProfile(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = mocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB}
+	)
+	defer mockCtrl.Finish()
+
+	apitest.NewHarness(t, resources.DeleteCostProfile).
+		WithCommonRequest(func(input *apitest.Input) {
+			apitest.SetURLVar(input, v2.CostProfileNameParameter, "reliability")
+		}).
+		Run([]apitest.Case{
+			{
+				Name: "NotFound",
+				Setup: func() {
+					mockDB.EXPECT().DeletePathfindingCostProfile(gomock.Any(), "reliability").Return(database.ErrNotFound)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+				},
+			},
+			{
+				Name: "Success",
+				Setup: func() {
+					mockDB.EXPECT().DeletePathfindingCostProfile(gomock.Any(), "reliability").Return(nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+				},
+			},
+		})
+}
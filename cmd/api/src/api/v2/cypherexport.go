@@ -0,0 +1,819 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/ctx"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/queries"
+	"github.com/specterops/bloodhound/cmd/api/src/utils"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
+	"github.com/specterops/bloodhound/packages/go/graphschema"
+	"github.com/specterops/bloodhound/packages/go/headers"
+	"github.com/specterops/bloodhound/packages/go/mediatypes"
+	"github.com/specterops/dawgs/cardinality"
+	"github.com/specterops/dawgs/graph"
+)
+
+const (
+	// MediaTypeNDJSON is not part of the IANA registry the mediatypes package is generated from
+	MediaTypeNDJSON  = "application/x-ndjson"
+	MediaTypeGraphML = "application/graphml+xml"
+
+	// cypherExportFlushInterval is the number of result rows written between flushes to the client
+	cypherExportFlushInterval = 500
+	cypherExportBufferSize    = 32 * 1024
+	cypherExportFileName      = "cypher-export"
+
+	ErrorResponseCypherExportNotAcceptable = "accepted media types for cypher exports are " + MediaTypeNDJSON + ", " + MediaTypeGraphML + " and text/csv"
+	ErrorResponseCypherExportMutation      = "graph mutations can not be exported"
+	ErrorResponseCypherExportCSVETAC       = "CSV exports are not available to users with restricted environment access"
+)
+
+// cypherExportEncoder writes mapped cypher result rows in a single export format
+type cypherExportEncoder interface {
+	ContentType() string
+	FileExtension() string
+	Begin(keys []string) error
+	WriteRow(row cypherExportRow) error
+	WriteError(err error) error
+	End() error
+}
+
+// negotiateCypherExportEncoder selects an export encoder from the request's Accept header. Media types are considered
+// in the order they are listed; quality values are not taken into account.
+func negotiateCypherExportEncoder(header http.Header, output io.Writer) (cypherExportEncoder, bool) {
+	for _, acceptValue := range strings.Split(strings.Join(header.Values(headers.Accept.String()), ","), ",") {
+		if mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(acceptValue)); err != nil {
+			continue
+		} else {
+			switch mediaType {
+			case MediaTypeNDJSON:
+				return newNDJSONCypherExportEncoder(output), true
+			case mediatypes.TextCsv.String():
+				return newCSVCypherExportEncoder(output), true
+			case MediaTypeGraphML:
+				return newGraphMLCypherExportEncoder(output), true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// CypherQueryExport runs a read-only cypher query and streams its results to the client in the format requested by
+// the Accept header. Rows are written as they are read from the database rather than being collected into a single
+// response, which allows exports far larger than the CypherQuery endpoint can return.
+func (s Resources) CypherQueryExport(response http.ResponseWriter, request *http.Request) {
+	var (
+		payload CypherQueryPayload
+		stream  = &cypherExportStream{response: response}
+	)
+
+	user, isUser := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx)
+	if !isUser {
+		slog.Error("Unable to get user from auth context")
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "unknown user", request), response)
+		return
+	}
+
+	if err := api.ReadJSONRequestPayloadLimited(&payload, request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "JSON malformed.", request), response)
+		return
+	}
+
+	filterETAC := ShouldFilterForETAC(s.DogTags, user)
+
+	encoder, ok := negotiateCypherExportEncoder(request.Header, stream)
+	if !ok {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotAcceptable, ErrorResponseCypherExportNotAcceptable, request), response)
+		return
+	} else if filterETAC && encoder.ContentType() == mediatypes.TextCsv.String() {
+		// CSV exports consist of literal rows which are never shown to users with restricted environment access
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, ErrorResponseCypherExportCSVETAC, request), response)
+		return
+	}
+
+	preparedQuery, err := s.GraphQuery.PrepareCypherQuery(payload.Query, queries.DefaultQueryFitnessLowerBoundExplore)
+	if err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+		return
+	} else if preparedQuery.HasMutation {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, ErrorResponseCypherExportMutation, request), response)
+		return
+	}
+
+	validPrimaryKinds, err := s.DB.GetDisplayNodeGraphKinds(request.Context())
+	if err != nil {
+		api.HandleDatabaseError(request, response, err)
+		return
+	}
+
+	exporter := newCypherExporter(request.Context(), encoder, validPrimaryKinds, payload.IncludeProperties)
+	if filterETAC {
+		exporter.filterForETAC(ExtractEnvironmentIDsFromUser(&user))
+	}
+
+	stream.header = func(header http.Header) {
+		header.Set(headers.ContentType.String(), encoder.ContentType())
+		header.Set(headers.ContentDisposition.String(), fmt.Sprintf(utils.ContentDispositionAttachmentTemplate, cypherExportFileName+encoder.FileExtension()))
+	}
+
+	if err := s.GraphQuery.StreamCypherQuery(request.Context(), preparedQuery, exporter.Export); err != nil {
+		if !stream.started {
+			// Nothing has reached the client yet so a regular error response can still be written
+			handleCypherDBErrors(response, request, err)
+		} else {
+			slog.ErrorContext(request.Context(), "Cypher export failed after the response was started", attr.Error(err))
+
+			if err := encoder.WriteError(err); err != nil {
+				slog.ErrorContext(request.Context(), "Failed to write cypher export error", attr.Error(err))
+			}
+
+			exporter.flush()
+		}
+	} else {
+		// Exports without any results still respond with an empty document of the negotiated type
+		stream.start()
+	}
+}
+
+// cypherExportStream delays writing the response status and headers until the first bytes of the export are written
+// so that failures that happen before any rows are read can still be reported as a regular error response
+type cypherExportStream struct {
+	response http.ResponseWriter
+	header   func(header http.Header)
+	started  bool
+}
+
+func (s *cypherExportStream) start() {
+	if !s.started {
+		s.started = true
+
+		if s.header != nil {
+			s.header(s.response.Header())
+		}
+
+		s.response.WriteHeader(http.StatusOK)
+	}
+}
+
+func (s *cypherExportStream) Write(data []byte) (int, error) {
+	s.start()
+	return s.response.Write(data)
+}
+
+func (s *cypherExportStream) Flush() error {
+	if !s.started {
+		return nil
+	}
+
+	if err := http.NewResponseController(s.response).Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	return nil
+}
+
+// cypherValueMapper maps a raw result value onto a typed graph target
+type cypherValueMapper interface {
+	Map(value, target any) bool
+}
+
+type cypherExportNode struct {
+	ID   graph.ID
+	Node model.UnifiedNode
+}
+
+type cypherExportEdge struct {
+	ID      graph.ID
+	StartID graph.ID
+	EndID   graph.ID
+	Edge    model.UnifiedEdge
+}
+
+// cypherExportValue is a single column of a result row. Graph elements are collected in Nodes and Edges while any
+// other value is kept as a literal.
+type cypherExportValue struct {
+	Nodes     []cypherExportNode
+	Edges     []cypherExportEdge
+	Literal   any
+	IsLiteral bool
+}
+
+type cypherExportRow struct {
+	Keys   []string
+	Values []cypherExportValue
+}
+
+// Nodes returns the nodes of every column in the row
+func (s cypherExportRow) Nodes() []cypherExportNode {
+	var nodes []cypherExportNode
+
+	for _, value := range s.Values {
+		nodes = append(nodes, value.Nodes...)
+	}
+
+	return nodes
+}
+
+// Edges returns the edges of every column in the row
+func (s cypherExportRow) Edges() []cypherExportEdge {
+	var edges []cypherExportEdge
+
+	for _, value := range s.Values {
+		edges = append(edges, value.Edges...)
+	}
+
+	return edges
+}
+
+// cypherExporter maps streamed cypher results into rows, applies ETAC filtering and hands them to an encoder
+type cypherExporter struct {
+	ctx               context.Context
+	encoder           cypherExportEncoder
+	validPrimaryKinds graphschema.ValidPrimaryKinds
+	includeProperties bool
+	filterETAC        bool
+	accessList        []string
+	visibleNodes      cardinality.Duplex[uint64]
+	rowsWritten       int
+}
+
+func newCypherExporter(ctx context.Context, encoder cypherExportEncoder, validPrimaryKinds graphschema.ValidPrimaryKinds, includeProperties bool) *cypherExporter {
+	return &cypherExporter{
+		ctx:               ctx,
+		encoder:           encoder,
+		validPrimaryKinds: validPrimaryKinds,
+		includeProperties: includeProperties,
+	}
+}
+
+// filterForETAC hides nodes outside of the given environments along with every edge attached to them. Literals are
+// dropped entirely, matching the filtering applied by the CypherQuery endpoint.
+func (s *cypherExporter) filterForETAC(accessList []string) {
+	s.filterETAC = true
+	s.accessList = accessList
+	s.visibleNodes = cardinality.NewBitmap64()
+}
+
+func (s *cypherExporter) flush() {
+	if flusher, ok := s.encoder.(interface{ Flush() error }); ok {
+		if err := flusher.Flush(); err != nil {
+			slog.WarnContext(s.ctx, "Failed to flush cypher export", attr.Error(err))
+		}
+	}
+}
+
+// Export consumes every row of the given result. It is intended to be used as a StreamCypherQuery delegate.
+func (s *cypherExporter) Export(result graph.Result) error {
+	keys := result.Keys()
+
+	if err := s.encoder.Begin(keys); err != nil {
+		return err
+	}
+
+	for result.Next() {
+		if err := s.encoder.WriteRow(s.mapRow(result.Mapper(), keys, result.Values())); err != nil {
+			return err
+		}
+
+		s.rowsWritten++
+
+		if s.rowsWritten%cypherExportFlushInterval == 0 {
+			s.flush()
+		}
+	}
+
+	if err := result.Error(); err != nil {
+		return err
+	} else if err := s.encoder.End(); err != nil {
+		return err
+	}
+
+	s.flush()
+	return nil
+}
+
+// mapRow converts the raw values of a result row. All nodes in a row are resolved before its edges so that ETAC
+// visibility of an edge can take the nodes returned alongside it into account, regardless of column order.
+func (s *cypherExporter) mapRow(mapper cypherValueMapper, keys []string, values []any) cypherExportRow {
+	var (
+		row           = cypherExportRow{Keys: keys, Values: make([]cypherExportValue, len(values))}
+		relationships = make([][]*graph.Relationship, len(values))
+	)
+
+	for idx, value := range values {
+		var (
+			relationship = &graph.Relationship{}
+			node         = &graph.Node{}
+			path         = &graph.Path{}
+		)
+
+		if mapper.Map(value, relationship) {
+			relationships[idx] = []*graph.Relationship{relationship}
+		} else if mapper.Map(value, node) {
+			row.Values[idx].Nodes = append(row.Values[idx].Nodes, s.exportNode(node))
+		} else if mapper.Map(value, path) {
+			for _, pathNode := range path.Nodes {
+				row.Values[idx].Nodes = append(row.Values[idx].Nodes, s.exportNode(pathNode))
+			}
+
+			relationships[idx] = path.Edges
+		} else if !s.filterETAC {
+			row.Values[idx].Literal = value
+			row.Values[idx].IsLiteral = true
+		}
+	}
+
+	for idx, valueRelationships := range relationships {
+		for _, relationship := range valueRelationships {
+			row.Values[idx].Edges = append(row.Values[idx].Edges, s.exportEdge(relationship))
+		}
+	}
+
+	return row
+}
+
+func (s *cypherExporter) exportNode(node *graph.Node) cypherExportNode {
+	// properties are always mapped so that ETAC filtering has access to the node's environment
+	unifiedNode := model.FromDAWGSNode(s.validPrimaryKinds, node, true)
+
+	if s.filterETAC {
+		if nodeInETACAccessList(unifiedNode, s.accessList) {
+			s.visibleNodes.Add(node.ID.Uint64())
+		} else {
+			unifiedNode = hiddenETACNode(unifiedNode)
+		}
+	}
+
+	if !s.includeProperties {
+		unifiedNode.Properties = nil
+	}
+
+	return cypherExportNode{
+		ID:   node.ID,
+		Node: unifiedNode,
+	}
+}
+
+func (s *cypherExporter) exportEdge(relationship *graph.Relationship) cypherExportEdge {
+	unifiedEdge := model.FromDAWGSRelationship(s.includeProperties)(relationship)
+
+	// Edges whose nodes have not been seen in the export are hidden as their environment can not be verified
+	if s.filterETAC && !(s.visibleNodes.Contains(relationship.StartID.Uint64()) && s.visibleNodes.Contains(relationship.EndID.Uint64())) {
+		unifiedEdge = hiddenETACEdge(unifiedEdge)
+	}
+
+	return cypherExportEdge{
+		ID:      relationship.ID,
+		StartID: relationship.StartID,
+		EndID:   relationship.EndID,
+		Edge:    unifiedEdge,
+	}
+}
+
+// exportedElements tracks the graph elements an encoder has already written so that nodes and edges returned by
+// multiple rows are only written once
+type exportedElements struct {
+	nodes cardinality.Duplex[uint64]
+	edges cardinality.Duplex[uint64]
+}
+
+func newExportedElements() exportedElements {
+	return exportedElements{
+		nodes: cardinality.NewBitmap64(),
+		edges: cardinality.NewBitmap64(),
+	}
+}
+
+func (s exportedElements) addNode(id graph.ID) bool {
+	return s.nodes.CheckedAdd(id.Uint64())
+}
+
+func (s exportedElements) hasNode(id graph.ID) bool {
+	return s.nodes.Contains(id.Uint64())
+}
+
+func (s exportedElements) addEdge(id graph.ID) bool {
+	return s.edges.CheckedAdd(id.Uint64())
+}
+
+type ndjsonNodeRecord struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+	model.UnifiedNode
+}
+
+type ndjsonEdgeRecord struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+	model.UnifiedEdge
+}
+
+type ndjsonLiteralRecord struct {
+	Type  string `json:"type"`
+	Key   string `json:"key"`
+	Value any    `json:"value"`
+}
+
+type ndjsonErrorRecord struct {
+	Type  string `json:"type"`
+	Error string `json:"error"`
+}
+
+// ndjsonCypherExportEncoder writes one JSON document per line for every distinct node and edge and every literal
+type ndjsonCypherExportEncoder struct {
+	output   *bufio.Writer
+	flusher  func() error
+	encoder  *json.Encoder
+	exported exportedElements
+}
+
+func newNDJSONCypherExportEncoder(output io.Writer) *ndjsonCypherExportEncoder {
+	bufferedOutput := bufio.NewWriterSize(output, cypherExportBufferSize)
+
+	return &ndjsonCypherExportEncoder{
+		output:   bufferedOutput,
+		flusher:  flushFunc(output),
+		encoder:  json.NewEncoder(bufferedOutput),
+		exported: newExportedElements(),
+	}
+}
+
+func (s *ndjsonCypherExportEncoder) ContentType() string {
+	return MediaTypeNDJSON
+}
+
+func (s *ndjsonCypherExportEncoder) FileExtension() string {
+	return ".ndjson"
+}
+
+func (s *ndjsonCypherExportEncoder) Begin(_ []string) error {
+	return nil
+}
+
+func (s *ndjsonCypherExportEncoder) WriteRow(row cypherExportRow) error {
+	for _, node := range row.Nodes() {
+		if s.exported.addNode(node.ID) {
+			if err := s.encoder.Encode(ndjsonNodeRecord{Type: "node", ID: node.ID.String(), UnifiedNode: node.Node}); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, edge := range row.Edges() {
+		if s.exported.addEdge(edge.ID) {
+			if err := s.encoder.Encode(ndjsonEdgeRecord{Type: "edge", ID: edge.ID.String(), UnifiedEdge: edge.Edge}); err != nil {
+				return err
+			}
+		}
+	}
+
+	for idx, value := range row.Values {
+		if value.IsLiteral {
+			if err := s.encoder.Encode(ndjsonLiteralRecord{Type: "literal", Key: rowKey(row.Keys, idx), Value: value.Literal}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// WriteError appends a trailing error record so that consumers can tell a failed export from a complete one
+func (s *ndjsonCypherExportEncoder) WriteError(err error) error {
+	return s.encoder.Encode(ndjsonErrorRecord{Type: "error", Error: err.Error()})
+}
+
+func (s *ndjsonCypherExportEncoder) End() error {
+	return nil
+}
+
+func (s *ndjsonCypherExportEncoder) Flush() error {
+	if err := s.output.Flush(); err != nil {
+		return err
+	}
+
+	return s.flusher()
+}
+
+// csvCypherExportEncoder writes one CSV record per result row using the result keys as the header. Nodes are written
+// as their object ID, edges as their kind and paths are left empty.
+type csvCypherExportEncoder struct {
+	output  *csv.Writer
+	flusher func() error
+}
+
+func newCSVCypherExportEncoder(output io.Writer) *csvCypherExportEncoder {
+	return &csvCypherExportEncoder{
+		output:  csv.NewWriter(output),
+		flusher: flushFunc(output),
+	}
+}
+
+func (s *csvCypherExportEncoder) ContentType() string {
+	return mediatypes.TextCsv.String()
+}
+
+func (s *csvCypherExportEncoder) FileExtension() string {
+	return ".csv"
+}
+
+func (s *csvCypherExportEncoder) Begin(keys []string) error {
+	return s.output.Write(keys)
+}
+
+func (s *csvCypherExportEncoder) WriteRow(row cypherExportRow) error {
+	record := make([]string, len(row.Values))
+
+	for idx, value := range row.Values {
+		switch {
+		case value.IsLiteral:
+			if formatted, err := formatCSVLiteral(value.Literal); err != nil {
+				return err
+			} else {
+				record[idx] = formatted
+			}
+
+		case len(value.Nodes) == 1 && len(value.Edges) == 0:
+			record[idx] = value.Nodes[0].Node.ObjectId
+
+		case len(value.Edges) == 1 && len(value.Nodes) == 0:
+			record[idx] = value.Edges[0].Edge.Kind
+		}
+	}
+
+	return s.output.Write(record)
+}
+
+func (s *csvCypherExportEncoder) WriteError(_ error) error {
+	return nil
+}
+
+func (s *csvCypherExportEncoder) End() error {
+	return nil
+}
+
+func (s *csvCypherExportEncoder) Flush() error {
+	s.output.Flush()
+
+	if err := s.output.Error(); err != nil {
+		return err
+	}
+
+	return s.flusher()
+}
+
+func formatCSVLiteral(value any) (string, error) {
+	switch typedValue := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return typedValue, nil
+	case time.Time:
+		return typedValue.Format(time.RFC3339Nano), nil
+	case []any, map[string]any:
+		if content, err := json.Marshal(typedValue); err != nil {
+			return "", err
+		} else {
+			return string(content), nil
+		}
+	default:
+		return fmt.Sprint(typedValue), nil
+	}
+}
+
+const (
+	graphMLHeader = `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+<key id="label" for="all" attr.name="label" attr.type="string"/>
+<key id="kind" for="all" attr.name="kind" attr.type="string"/>
+<key id="kinds" for="node" attr.name="kinds" attr.type="string"/>
+<key id="objectid" for="node" attr.name="objectid" attr.type="string"/>
+<key id="lastseen" for="all" attr.name="lastseen" attr.type="string"/>
+<key id="properties" for="all" attr.name="properties" attr.type="string"/>
+<graph id="cypher" edgedefault="directed">
+`
+	graphMLFooter = "</graph>\n</graphml>\n"
+)
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+type graphMLNode struct {
+	XMLName xml.Name      `xml:"node"`
+	ID      string        `xml:"id,attr"`
+	Data    []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	XMLName xml.Name      `xml:"edge"`
+	ID      string        `xml:"id,attr"`
+	Source  string        `xml:"source,attr"`
+	Target  string        `xml:"target,attr"`
+	Data    []graphMLData `xml:"data"`
+}
+
+// graphMLCypherExportEncoder writes every distinct node and edge as a GraphML element. Property keys can not be known
+// up front when streaming, so properties are written as a single JSON encoded attribute. Edge endpoints that no row
+// returned, such as those of relationship-only results, are written as bare nodes once the export ends so that every
+// edge refers to a node of the document.
+type graphMLCypherExportEncoder struct {
+	output          *bufio.Writer
+	flusher         func() error
+	exported        exportedElements
+	unseenEndpoints cardinality.Duplex[uint64]
+}
+
+func newGraphMLCypherExportEncoder(output io.Writer) *graphMLCypherExportEncoder {
+	return &graphMLCypherExportEncoder{
+		output:          bufio.NewWriterSize(output, cypherExportBufferSize),
+		flusher:         flushFunc(output),
+		exported:        newExportedElements(),
+		unseenEndpoints: cardinality.NewBitmap64(),
+	}
+}
+
+func (s *graphMLCypherExportEncoder) ContentType() string {
+	return MediaTypeGraphML
+}
+
+func (s *graphMLCypherExportEncoder) FileExtension() string {
+	return ".graphml"
+}
+
+func (s *graphMLCypherExportEncoder) Begin(_ []string) error {
+	_, err := s.output.WriteString(graphMLHeader)
+	return err
+}
+
+func (s *graphMLCypherExportEncoder) WriteRow(row cypherExportRow) error {
+	for _, node := range row.Nodes() {
+		if !s.exported.addNode(node.ID) {
+			continue
+		}
+
+		s.unseenEndpoints.Remove(node.ID.Uint64())
+
+		element := graphMLNode{
+			ID: graphMLNodeID(node.ID.String()),
+			Data: []graphMLData{
+				{Key: "label", Value: node.Node.Label},
+				{Key: "kind", Value: node.Node.Kind},
+				{Key: "kinds", Value: strings.Join(node.Node.Kinds, ",")},
+				{Key: "objectid", Value: node.Node.ObjectId},
+				{Key: "lastseen", Value: node.Node.LastSeen.Format(time.RFC3339Nano)},
+			},
+		}
+
+		if properties, err := graphMLProperties(node.Node.Properties); err != nil {
+			return err
+		} else if properties != nil {
+			element.Data = append(element.Data, *properties)
+		}
+
+		if err := s.writeElement(element); err != nil {
+			return err
+		}
+	}
+
+	for _, edge := range row.Edges() {
+		if !s.exported.addEdge(edge.ID) {
+			continue
+		}
+
+		for _, endpointID := range []graph.ID{edge.StartID, edge.EndID} {
+			if !s.exported.hasNode(endpointID) {
+				s.unseenEndpoints.Add(endpointID.Uint64())
+			}
+		}
+
+		element := graphMLEdge{
+			ID:     "e" + edge.ID.String(),
+			Source: graphMLNodeID(edge.Edge.Source),
+			Target: graphMLNodeID(edge.Edge.Target),
+			Data: []graphMLData{
+				{Key: "label", Value: edge.Edge.Label},
+				{Key: "kind", Value: edge.Edge.Kind},
+				{Key: "lastseen", Value: edge.Edge.LastSeen.Format(time.RFC3339Nano)},
+			},
+		}
+
+		if properties, err := graphMLProperties(edge.Edge.Properties); err != nil {
+			return err
+		} else if properties != nil {
+			element.Data = append(element.Data, *properties)
+		}
+
+		if err := s.writeElement(element); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *graphMLCypherExportEncoder) writeElement(element any) error {
+	if content, err := xml.Marshal(element); err != nil {
+		return err
+	} else if _, err := s.output.Write(content); err != nil {
+		return err
+	} else {
+		return s.output.WriteByte('\n')
+	}
+}
+
+// WriteError leaves the document unterminated so that consumers reject the truncated export
+func (s *graphMLCypherExportEncoder) WriteError(_ error) error {
+	return nil
+}
+
+func (s *graphMLCypherExportEncoder) End() error {
+	var err error
+
+	s.unseenEndpoints.Each(func(nodeID uint64) bool {
+		err = s.writeElement(graphMLNode{ID: graphMLNodeID(graph.ID(nodeID).String())})
+		return err == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	_, err = s.output.WriteString(graphMLFooter)
+	return err
+}
+
+func (s *graphMLCypherExportEncoder) Flush() error {
+	if err := s.output.Flush(); err != nil {
+		return err
+	}
+
+	return s.flusher()
+}
+
+func graphMLNodeID(id string) string {
+	return "n" + id
+}
+
+func graphMLProperties(properties map[string]any) (*graphMLData, error) {
+	if len(properties) == 0 {
+		return nil, nil
+	} else if content, err := json.Marshal(properties); err != nil {
+		return nil, err
+	} else {
+		return &graphMLData{Key: "properties", Value: string(content)}, nil
+	}
+}
+
+// flushFunc returns a function that flushes the given writer through to the client if it supports flushing
+func flushFunc(output io.Writer) func() error {
+	return func() error {
+		if flusher, ok := output.(interface{ Flush() error }); ok {
+			return flusher.Flush()
+		}
+
+		return nil
+	}
+}
+
+func rowKey(keys []string, idx int) string {
+	if idx < len(keys) {
+		return keys[idx]
+	}
+
+	return ""
+}
@@ -0,0 +1,294 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/specterops/bloodhound/packages/go/graphschema"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/require"
+)
+
+// typedValueMapper maps values that are already of the target's type
+type typedValueMapper struct{}
+
+func (typedValueMapper) Map(value, target any) bool {
+	switch typedTarget := target.(type) {
+	case *graph.Relationship:
+		if relationship, ok := value.(*graph.Relationship); ok {
+			*typedTarget = *relationship
+			return true
+		}
+
+	case *graph.Node:
+		if node, ok := value.(*graph.Node); ok {
+			*typedTarget = *node
+			return true
+		}
+
+	case *graph.Path:
+		if path, ok := value.(*graph.Path); ok {
+			*typedTarget = *path
+			return true
+		}
+	}
+
+	return false
+}
+
+func exportTestGraph() (*graph.Node, *graph.Node, *graph.Relationship) {
+	var (
+		user = graph.NewNode(1, graph.AsProperties(map[string]any{
+			common.ObjectID.String(): "S-1-5-21-1-1105",
+			common.Name.String():     "USER@ALLOWED.LOCAL",
+			ad.DomainSID.String():    "S-1-5-21-1",
+		}), ad.Entity, ad.User)
+		group = graph.NewNode(2, graph.AsProperties(map[string]any{
+			common.ObjectID.String(): "S-1-5-21-2-512",
+			common.Name.String():     "DOMAIN ADMINS@RESTRICTED.LOCAL",
+			ad.DomainSID.String():    "S-1-5-21-2",
+		}), ad.Entity, ad.Group)
+		memberOf = graph.NewRelationship(3, user.ID, group.ID, graph.NewProperties(), ad.MemberOf)
+	)
+
+	return user, group, memberOf
+}
+
+func writeExportRows(t *testing.T, exporter *cypherExporter, keys []string, rows ...[]any) {
+	t.Helper()
+
+	require.NoError(t, exporter.encoder.Begin(keys))
+
+	for _, row := range rows {
+		require.NoError(t, exporter.encoder.WriteRow(exporter.mapRow(typedValueMapper{}, keys, row)))
+	}
+
+	require.NoError(t, exporter.encoder.End())
+	exporter.flush()
+}
+
+func decodeNDJSON(t *testing.T, output *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+
+	for _, line := range strings.Split(strings.TrimSpace(output.String()), "\n") {
+		var record map[string]any
+
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func TestNegotiateCypherExportEncoder(t *testing.T) {
+	for accept, expected := range map[string]string{
+		"application/x-ndjson":                      MediaTypeNDJSON,
+		"text/csv; charset=utf-8":                   "text/csv",
+		"application/json, application/graphml+xml": MediaTypeGraphML,
+	} {
+		encoder, ok := negotiateCypherExportEncoder(http.Header{"Accept": []string{accept}}, &bytes.Buffer{})
+		require.True(t, ok, accept)
+		require.Equal(t, expected, encoder.ContentType())
+	}
+
+	_, ok := negotiateCypherExportEncoder(http.Header{"Accept": []string{"application/json"}}, &bytes.Buffer{})
+	require.False(t, ok)
+}
+
+func TestCypherExporter_NDJSON(t *testing.T) {
+	var (
+		output                = &bytes.Buffer{}
+		exporter              = newCypherExporter(context.Background(), newNDJSONCypherExportEncoder(output), graphschema.ValidPrimaryKinds{}, false)
+		user, group, memberOf = exportTestGraph()
+	)
+
+	// The relationship column comes first to verify that nodes in the same row are still written before it
+	writeExportRows(t, exporter, []string{"r", "n", "m"}, []any{memberOf, user, group}, []any{memberOf, user, int64(42)})
+
+	records := decodeNDJSON(t, output)
+	require.Len(t, records, 4)
+
+	require.Equal(t, "node", records[0]["type"])
+	require.Equal(t, "1", records[0]["id"])
+	require.Equal(t, "USER@ALLOWED.LOCAL", records[0]["label"])
+	require.Nil(t, records[0]["properties"])
+
+	require.Equal(t, "node", records[1]["type"])
+	require.Equal(t, "2", records[1]["id"])
+
+	require.Equal(t, "edge", records[2]["type"])
+	require.Equal(t, "3", records[2]["id"])
+	require.Equal(t, ad.MemberOf.String(), records[2]["kind"])
+
+	// Elements repeated by the second row are only written once
+	require.Equal(t, "literal", records[3]["type"])
+	require.Equal(t, "m", records[3]["key"])
+	require.Equal(t, float64(42), records[3]["value"])
+}
+
+func TestCypherExporter_NDJSONFilteredForETAC(t *testing.T) {
+	var (
+		output                = &bytes.Buffer{}
+		exporter              = newCypherExporter(context.Background(), newNDJSONCypherExportEncoder(output), graphschema.ValidPrimaryKinds{}, true)
+		user, group, memberOf = exportTestGraph()
+		unseen                = graph.NewRelationship(4, user.ID, 99, graph.NewProperties(), ad.GenericAll)
+	)
+
+	exporter.filterForETAC([]string{"S-1-5-21-1"})
+
+	writeExportRows(t, exporter, []string{"n", "m", "r", "c"},
+		[]any{user, group, memberOf, "literal"},
+		[]any{user, group, unseen, "literal"},
+	)
+
+	records := decodeNDJSON(t, output)
+	require.Len(t, records, 4)
+
+	require.Equal(t, "USER@ALLOWED.LOCAL", records[0]["label"])
+	require.NotNil(t, records[0]["properties"])
+
+	require.Equal(t, "** Hidden Base Object **", records[1]["label"])
+	require.Equal(t, "HIDDEN", records[1]["objectId"])
+	require.Nil(t, records[1]["properties"])
+
+	require.Equal(t, "** Hidden Edge **", records[2]["label"])
+
+	// Edges to nodes that were never part of the export can not be verified and are hidden
+	require.Equal(t, "4", records[3]["id"])
+	require.Equal(t, "HIDDEN", records[3]["kind"])
+
+	for _, record := range records {
+		require.NotEqual(t, "literal", record["type"])
+	}
+}
+
+func TestCypherExporter_NDJSONError(t *testing.T) {
+	var (
+		output  = &bytes.Buffer{}
+		encoder = newNDJSONCypherExportEncoder(output)
+	)
+
+	require.NoError(t, encoder.WriteError(http.ErrHandlerTimeout))
+	require.NoError(t, encoder.Flush())
+
+	records := decodeNDJSON(t, output)
+	require.Equal(t, []map[string]any{{"type": "error", "error": http.ErrHandlerTimeout.Error()}}, records)
+}
+
+func TestCypherExporter_CSV(t *testing.T) {
+	var (
+		output                = &bytes.Buffer{}
+		exporter              = newCypherExporter(context.Background(), newCSVCypherExportEncoder(output), graphschema.ValidPrimaryKinds{}, false)
+		user, group, memberOf = exportTestGraph()
+	)
+
+	writeExportRows(t, exporter, []string{"n.name", "count", "tags", "n", "r"},
+		[]any{"USER@ALLOWED.LOCAL", int64(3), []any{"a", "b"}, user, memberOf},
+		[]any{"DOMAIN, ADMINS", nil, []any{}, group, nil},
+	)
+
+	require.Equal(t, strings.Join([]string{
+		"n.name,count,tags,n,r",
+		`USER@ALLOWED.LOCAL,3,"[""a"",""b""]",S-1-5-21-1-1105,MemberOf`,
+		`"DOMAIN, ADMINS",,[],S-1-5-21-2-512,`,
+	}, "\n")+"\n", output.String())
+}
+
+func TestCypherExporter_GraphML(t *testing.T) {
+	type document struct {
+		Graph struct {
+			Nodes []struct {
+				ID   string        `xml:"id,attr"`
+				Data []graphMLData `xml:"data"`
+			} `xml:"node"`
+			Edges []struct {
+				ID     string `xml:"id,attr"`
+				Source string `xml:"source,attr"`
+				Target string `xml:"target,attr"`
+			} `xml:"edge"`
+		} `xml:"graph"`
+	}
+
+	var (
+		output                = &bytes.Buffer{}
+		exporter              = newCypherExporter(context.Background(), newGraphMLCypherExportEncoder(output), graphschema.ValidPrimaryKinds{}, true)
+		user, group, memberOf = exportTestGraph()
+		path                  = &graph.Path{Nodes: []*graph.Node{user, group}, Edges: []*graph.Relationship{memberOf}}
+		parsed                document
+	)
+
+	writeExportRows(t, exporter, []string{"p"}, []any{path}, []any{path})
+
+	require.NoError(t, xml.Unmarshal(output.Bytes(), &parsed))
+	require.Len(t, parsed.Graph.Nodes, 2)
+	require.Len(t, parsed.Graph.Edges, 1)
+
+	require.Equal(t, "n1", parsed.Graph.Nodes[0].ID)
+	require.Contains(t, parsed.Graph.Nodes[0].Data, graphMLData{Key: "objectid", Value: "S-1-5-21-1-1105"})
+
+	require.Equal(t, "e3", parsed.Graph.Edges[0].ID)
+	require.Equal(t, "n1", parsed.Graph.Edges[0].Source)
+	require.Equal(t, "n2", parsed.Graph.Edges[0].Target)
+}
+
+func TestCypherExporter_GraphMLRelationshipEndpoints(t *testing.T) {
+	type document struct {
+		Graph struct {
+			Nodes []struct {
+				ID   string        `xml:"id,attr"`
+				Data []graphMLData `xml:"data"`
+			} `xml:"node"`
+			Edges []struct {
+				Source string `xml:"source,attr"`
+				Target string `xml:"target,attr"`
+			} `xml:"edge"`
+		} `xml:"graph"`
+	}
+
+	var (
+		output            = &bytes.Buffer{}
+		exporter          = newCypherExporter(context.Background(), newGraphMLCypherExportEncoder(output), graphschema.ValidPrimaryKinds{}, true)
+		user, _, memberOf = exportTestGraph()
+		parsed            document
+	)
+
+	// The start node is returned by a later row, the end node is never returned
+	writeExportRows(t, exporter, []string{"r"}, []any{memberOf}, []any{user}, []any{memberOf})
+
+	require.NoError(t, xml.Unmarshal(output.Bytes(), &parsed))
+	require.Len(t, parsed.Graph.Edges, 1)
+	require.Len(t, parsed.Graph.Nodes, 2)
+
+	require.Equal(t, "n1", parsed.Graph.Nodes[0].ID)
+	require.Contains(t, parsed.Graph.Nodes[0].Data, graphMLData{Key: "objectid", Value: "S-1-5-21-1-1105"})
+
+	// Endpoints missing from the results are written as bare nodes
+	require.Equal(t, "n2", parsed.Graph.Nodes[1].ID)
+	require.Empty(t, parsed.Graph.Nodes[1].Data)
+	require.Equal(t, "n2", parsed.Graph.Edges[0].Target)
+}
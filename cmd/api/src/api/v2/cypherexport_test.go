@@ -0,0 +1,176 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	dbmocks "github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/queries"
+	"github.com/specterops/bloodhound/cmd/api/src/queries/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/services/dogtags"
+	"github.com/specterops/bloodhound/packages/go/headers"
+	"github.com/specterops/bloodhound/packages/go/mediatypes"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResources_CypherQueryExport(t *testing.T) {
+	t.Parallel()
+
+	const exportPath = "/api/v2/graphs/cypher/export"
+
+	type mock struct {
+		mockGraphQuery *mocks.MockGraph
+		mockDatabase   *dbmocks.MockDatabase
+	}
+
+	var (
+		etacEnabled = dogtags.TestOverrides{
+			Bools: map[dogtags.BoolDogTag]bool{
+				dogtags.ETAC_ENABLED: true,
+			},
+		}
+
+		buildRequest = func(t *testing.T, accept string, user model.User) *http.Request {
+			t.Helper()
+
+			payload, err := json.Marshal(v2.CypherQueryPayload{Query: "query"})
+			require.NoError(t, err)
+
+			request, err := http.NewRequestWithContext(setupUserCtx(user), http.MethodPost, exportPath, bytes.NewReader(payload))
+			require.NoError(t, err)
+
+			request.Header.Set(headers.ContentType.String(), mediatypes.ApplicationJson.String())
+			request.Header.Set(headers.Accept.String(), accept)
+
+			return request
+		}
+	)
+
+	tt := []struct {
+		name                string
+		accept              string
+		user                model.User
+		dogTagsOverrides    dogtags.TestOverrides
+		setupMocks          func(mock *mock)
+		expectedCode        int
+		expectedContentType string
+	}{
+		{
+			name:                "Error: unsupported Accept header - Not Acceptable",
+			accept:              mediatypes.ApplicationJson.String(),
+			user:                model.User{AllEnvironments: true},
+			setupMocks:          func(mock *mock) {},
+			expectedCode:        http.StatusNotAcceptable,
+			expectedContentType: mediatypes.ApplicationJson.String(),
+		},
+		{
+			name:                "Error: CSV export with ETAC restrictions - Forbidden",
+			accept:              mediatypes.TextCsv.String(),
+			user:                model.User{},
+			dogTagsOverrides:    etacEnabled,
+			setupMocks:          func(mock *mock) {},
+			expectedCode:        http.StatusForbidden,
+			expectedContentType: mediatypes.ApplicationJson.String(),
+		},
+		{
+			name:   "Error: PrepareCypherQuery error - Bad Request",
+			accept: v2.MediaTypeNDJSON,
+			user:   model.User{AllEnvironments: true},
+			setupMocks: func(mock *mock) {
+				mock.mockGraphQuery.EXPECT().PrepareCypherQuery("query", int64(queries.DefaultQueryFitnessLowerBoundExplore)).Return(queries.PreparedQuery{}, queries.ErrCypherQueryTooComplex)
+			},
+			expectedCode:        http.StatusBadRequest,
+			expectedContentType: mediatypes.ApplicationJson.String(),
+		},
+		{
+			name:   "Error: mutation - Bad Request",
+			accept: v2.MediaTypeNDJSON,
+			user:   model.User{AllEnvironments: true},
+			setupMocks: func(mock *mock) {
+				mock.mockGraphQuery.EXPECT().PrepareCypherQuery("query", int64(queries.DefaultQueryFitnessLowerBoundExplore)).Return(queries.PreparedQuery{HasMutation: true}, nil)
+			},
+			expectedCode:        http.StatusBadRequest,
+			expectedContentType: mediatypes.ApplicationJson.String(),
+		},
+		{
+			name:   "Error: query fails before streaming - Internal Server Error",
+			accept: v2.MediaTypeGraphML,
+			user:   model.User{AllEnvironments: true},
+			setupMocks: func(mock *mock) {
+				mock.mockGraphQuery.EXPECT().PrepareCypherQuery("query", int64(queries.DefaultQueryFitnessLowerBoundExplore)).Return(queries.PreparedQuery{}, nil)
+				mock.mockDatabase.EXPECT().GetDisplayNodeGraphKinds(gomock.Any())
+				mock.mockGraphQuery.EXPECT().StreamCypherQuery(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("query failed"))
+			},
+			expectedCode:        http.StatusInternalServerError,
+			expectedContentType: mediatypes.ApplicationJson.String(),
+		},
+		{
+			name:             "Success: empty result with ETAC restrictions",
+			accept:           "application/json, " + v2.MediaTypeNDJSON,
+			user:             model.User{},
+			dogTagsOverrides: etacEnabled,
+			setupMocks: func(mock *mock) {
+				mock.mockGraphQuery.EXPECT().PrepareCypherQuery("query", int64(queries.DefaultQueryFitnessLowerBoundExplore)).Return(queries.PreparedQuery{}, nil)
+				mock.mockDatabase.EXPECT().GetDisplayNodeGraphKinds(gomock.Any())
+				mock.mockGraphQuery.EXPECT().StreamCypherQuery(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedCode:        http.StatusOK,
+			expectedContentType: v2.MediaTypeNDJSON,
+		},
+	}
+
+	for _, testCase := range tt {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				ctrl  = gomock.NewController(t)
+				mocks = &mock{
+					mockGraphQuery: mocks.NewMockGraph(ctrl),
+					mockDatabase:   dbmocks.NewMockDatabase(ctrl),
+				}
+				resources = v2.Resources{
+					GraphQuery: mocks.mockGraphQuery,
+					DB:         mocks.mockDatabase,
+					Authorizer: auth.NewAuthorizer(mocks.mockDatabase),
+					DogTags:    dogtags.NewTestService(testCase.dogTagsOverrides),
+				}
+				response = httptest.NewRecorder()
+				router   = mux.NewRouter()
+			)
+
+			testCase.setupMocks(mocks)
+
+			router.HandleFunc(exportPath, resources.CypherQueryExport).Methods(http.MethodPost)
+			router.ServeHTTP(response, buildRequest(t, testCase.accept, testCase.user))
+
+			require.Equal(t, testCase.expectedCode, response.Code)
+			require.Equal(t, testCase.expectedContentType, response.Header().Get(headers.ContentType.String()))
+		})
+	}
+}
@@ -17,15 +17,31 @@
 package v2
 
 import (
+	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/daemons/datapipe"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
 )
 
 func (s Resources) GetDatapipeStatus(response http.ResponseWriter, request *http.Request) {
 	if datapipeStatus, err := s.DB.GetDatapipeStatus(request.Context()); err != nil {
 		api.HandleDatabaseError(request, response, err)
 	} else {
+		// The status stays available without the schedule, the next scheduled run is simply left out
+		if schedule, err := appcfg.GetScheduledAnalysisParameter(request.Context(), s.DB); err != nil {
+			slog.WarnContext(request.Context(), "Unable to fetch the analysis schedule", attr.Error(err))
+		} else if nextRun, err := datapipe.NextScheduledAnalysisRun(schedule, datapipeStatus, time.Now().UTC()); err != nil {
+			slog.WarnContext(request.Context(), "Unable to determine next scheduled analysis run", attr.Error(err))
+		} else {
+			datapipeStatus.NextScheduledAnalysisRunAt = nextRun
+		}
+
+		datapipeStatus.PostProcessing = datapipe.PostProcessingPlan(appcfg.GetPostProcessingParameter(request.Context(), s.DB).DisabledPostProcessors)
+
 		api.WriteBasicResponse(request.Context(), datapipeStatus, http.StatusOK, response)
 	}
 }
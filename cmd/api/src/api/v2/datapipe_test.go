@@ -0,0 +1,112 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	dbmocks "github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResources_GetDatapipeStatus(t *testing.T) {
+	t.Parallel()
+
+	dailySchedule, err := types.NewJSONBObject(appcfg.ScheduledAnalysisParameter{
+		Enabled: true,
+		RRule:   "DTSTART:20250101T000000Z\nRRULE:FREQ=DAILY;INTERVAL=1",
+	})
+	require.NoError(t, err)
+
+	tt := []struct {
+		name            string
+		setupMocks      func(mockDB *dbmocks.MockDatabase)
+		expectedCode    int
+		expectedNextRun bool
+	}{
+		{
+			name: "Error: database error - Internal Server Error",
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetDatapipeStatus(gomock.Any()).Return(model.DatapipeStatusWrapper{}, errors.New("error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+		{
+			name: "Success: schedule lookup fails - OK without next scheduled run",
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetDatapipeStatus(gomock.Any()).Return(model.DatapipeStatusWrapper{Status: model.DatapipeStatusIdle}, nil)
+				mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.ScheduledAnalysis).Return(appcfg.Parameter{}, errors.New("error"))
+				mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PostProcessingKey).Return(appcfg.Parameter{}, errors.New("error"))
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Success: scheduled analysis enabled - OK with next scheduled run",
+			setupMocks: func(mockDB *dbmocks.MockDatabase) {
+				mockDB.EXPECT().GetDatapipeStatus(gomock.Any()).Return(model.DatapipeStatusWrapper{Status: model.DatapipeStatusIdle}, nil)
+				mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.ScheduledAnalysis).Return(appcfg.Parameter{Key: appcfg.ScheduledAnalysis, Value: dailySchedule}, nil)
+				mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PostProcessingKey).Return(appcfg.Parameter{}, errors.New("error"))
+			},
+			expectedCode:    http.StatusOK,
+			expectedNextRun: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mockCtrl  = gomock.NewController(t)
+				mockDB    = dbmocks.NewMockDatabase(mockCtrl)
+				resources = v2.Resources{DB: mockDB}
+				response  = httptest.NewRecorder()
+			)
+
+			tc.setupMocks(mockDB)
+
+			request, err := http.NewRequest(http.MethodGet, "/api/v2/datapipe/status", nil)
+			require.NoError(t, err)
+
+			resources.GetDatapipeStatus(response, request)
+			require.Equal(t, tc.expectedCode, response.Code)
+
+			if tc.expectedCode == http.StatusOK {
+				var body struct {
+					Data struct {
+						Status                     model.DatapipeStatus `json:"status"`
+						NextScheduledAnalysisRunAt *time.Time           `json:"next_scheduled_analysis_run_at"`
+					} `json:"data"`
+				}
+
+				require.NoError(t, json.Unmarshal(response.Body.Bytes(), &body))
+				require.Equal(t, model.DatapipeStatusIdle, body.Data.Status)
+				require.Equal(t, tc.expectedNextRun, body.Data.NextScheduledAnalysisRunAt != nil)
+			}
+		})
+	}
+}
@@ -128,6 +128,57 @@ func ShouldFilterForETAC(dogTagsService dogtags.Service, user model.User) bool {
 	return true
 }
 
+// etacEnvironmentKeys are the node properties that identify the environment a node belongs to
+var etacEnvironmentKeys = []string{"domainsid", "tenantid"}
+
+// nodeInETACAccessList returns whether the node belongs to one of the environments in the given access list
+func nodeInETACAccessList(node model.UnifiedNode, accessList []string) bool {
+	for _, key := range etacEnvironmentKeys {
+		if val, ok := node.Properties[key]; ok {
+			if envStr, ok := val.(string); ok && slices.Contains(accessList, envStr) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// hiddenETACNode returns the placeholder that replaces a node the user does not have access to
+func hiddenETACNode(node model.UnifiedNode) model.UnifiedNode {
+	// extract node source kind for display in hidden label
+	var kind string
+	if len(node.Kinds) > 0 && node.Kinds[0] != "" {
+		kind = node.Kinds[0]
+	} else {
+		kind = "Unknown"
+	}
+
+	return model.UnifiedNode{
+		Label:         fmt.Sprintf("** Hidden %s Object **", kind),
+		Kind:          "HIDDEN",
+		Kinds:         []string{},
+		ObjectId:      "HIDDEN",
+		IsTierZero:    false,
+		IsOwnedObject: false,
+		LastSeen:      time.Time{},
+		Properties:    nil,
+		Hidden:        true,
+	}
+}
+
+// hiddenETACEdge returns the placeholder that replaces an edge attached to a hidden node
+func hiddenETACEdge(edge model.UnifiedEdge) model.UnifiedEdge {
+	return model.UnifiedEdge{
+		Source:     edge.Source,
+		Target:     edge.Target,
+		Label:      "** Hidden Edge **",
+		Kind:       "HIDDEN",
+		LastSeen:   time.Time{},
+		Properties: nil,
+	}
+}
+
 // filterETACGraph applies ETAC(Environment-based Access Control) filtering for the CypherQuery endpoint.
 // Nodes that the user does not have access to are replaced with hidden placeholder nodes,
 // and edges connected to hidden nodes are marked as hidden.
@@ -137,44 +188,13 @@ func filterETACGraph(graphResponse model.UnifiedGraph, user model.User) (model.U
 	filteredResponse := model.UnifiedGraph{}
 	filteredNodes := make(map[string]model.UnifiedNode)
 
-	environmentKeys := []string{"domainsid", "tenantid"}
-
 	// filter nodes based on environment access
 	for id, node := range graphResponse.Nodes {
-		include := false
-		for _, key := range environmentKeys {
-			if val, ok := node.Properties[key]; ok {
-				if envStr, ok := val.(string); ok && slices.Contains(accessList, envStr) {
-					include = true
-					break
-				}
-			}
-		}
-
-		if include {
+		if nodeInETACAccessList(node, accessList) {
 			// user has access, we keep original node
 			filteredNodes[id] = node
 		} else {
-			// extract node source kind for display in hidden label
-			var kind string
-			if len(node.Kinds) > 0 && node.Kinds[0] != "" {
-				kind = node.Kinds[0]
-			} else {
-				kind = "Unknown"
-			}
-
-			label := fmt.Sprintf("** Hidden %s Object **", kind)
-			filteredNodes[id] = model.UnifiedNode{
-				Label:         label,
-				Kind:          "HIDDEN",
-				Kinds:         []string{},
-				ObjectId:      "HIDDEN",
-				IsTierZero:    false,
-				IsOwnedObject: false,
-				LastSeen:      time.Time{},
-				Properties:    nil,
-				Hidden:        true,
-			}
+			filteredNodes[id] = hiddenETACNode(node)
 		}
 	}
 
@@ -184,14 +204,7 @@ func filterETACGraph(graphResponse model.UnifiedGraph, user model.User) (model.U
 	// mark edges as hidden if attached to a hidden node
 	for _, edge := range graphResponse.Edges {
 		if filteredNodes[edge.Target].Hidden || filteredNodes[edge.Source].Hidden {
-			filteredEdges = append(filteredEdges, model.UnifiedEdge{
-				Source:     edge.Source,
-				Target:     edge.Target,
-				Label:      "** Hidden Edge **",
-				Kind:       "HIDDEN",
-				LastSeen:   time.Time{},
-				Properties: nil,
-			})
+			filteredEdges = append(filteredEdges, hiddenETACEdge(edge))
 		} else {
 			// nodes on both ends of edge are accessible, we keep original edge
 			filteredEdges = append(filteredEdges, edge)
@@ -32,6 +32,7 @@ import (
 	"github.com/specterops/bloodhound/cmd/api/src/api"
 	"github.com/specterops/bloodhound/cmd/api/src/auth"
 	"github.com/specterops/bloodhound/cmd/api/src/ctx"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
 	"github.com/specterops/bloodhound/cmd/api/src/model"
 	ingestModel "github.com/specterops/bloodhound/cmd/api/src/model/ingest"
 	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
@@ -43,6 +44,7 @@ import (
 
 const FileUploadJobIdPathParameterName = "file_upload_job_id"
 const FileUploadFileNameHeader = "X-File-Upload-Name"
+const FileUploadDryRunQueryParameterName = "dry_run"
 
 func (s Resources) ListIngestJobs(response http.ResponseWriter, request *http.Request) {
 	var (
@@ -118,7 +120,9 @@ func (s Resources) StartIngestJob(response http.ResponseWriter, request *http.Re
 
 	if user, valid := auth.GetUserFromAuthCtx(reqCtx.AuthCtx); !valid {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusUnauthorized, api.ErrorResponseDetailsAuthenticationInvalid, request), response)
-	} else if ingestJob, err := job.StartIngestJob(request.Context(), s.DB, user); err != nil {
+	} else if dryRun, err := api.ParseOptionalBool(request.URL.Query().Get(FileUploadDryRunQueryParameterName), false); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, FileUploadDryRunQueryParameterName, err), response)
+	} else if ingestJob, err := job.StartIngestJob(request.Context(), s.DB, user, dryRun); err != nil {
 		api.HandleDatabaseError(request, response, err)
 	} else {
 		api.WriteBasicResponse(request.Context(), ingestJob, http.StatusCreated, response)
@@ -127,7 +131,6 @@ func (s Resources) StartIngestJob(response http.ResponseWriter, request *http.Re
 
 func (s Resources) ProcessIngestTask(response http.ResponseWriter, request *http.Request) {
 	var (
-		requestId   = ctx.FromRequest(request).RequestID
 		jobIdString = mux.Vars(request)[FileUploadJobIdPathParameterName]
 		validator   = upload.NewIngestValidator(s.IngestSchema)
 		fileName    = request.Header.Get(FileUploadFileNameHeader)
@@ -138,14 +141,24 @@ func (s Resources) ProcessIngestTask(response http.ResponseWriter, request *http
 	}
 
 	if !IsValidContentTypeForUpload(request.Header) {
-		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "Content type must be application/json or application/zip", request), response)
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "Content type must be application/json, application/x-ndjson, application/zip or application/gzip", request), response)
 	} else if jobID, err := strconv.Atoi(jobIdString); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
 	} else if ingestJob, err := job.GetIngestJobByID(request.Context(), s.DB, int64(jobID)); err != nil {
 		api.HandleDatabaseError(request, response, err)
 	} else if ingestJob.Status != model.JobStatusRunning {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "job must be in running status to attach files", request), response)
-	} else if ingestTaskParams, err := upload.SaveIngestFile(s.Config.TempDirectory(), request, validator); errors.Is(err, upload.ErrInvalidJSON) {
+	} else if ingestTaskParams, err := upload.SaveIngestFile(s.Config.TempDirectory(), request, validator); err != nil {
+		writeSaveIngestFileError(response, request, err)
+	} else {
+		s.createIngestTask(response, request, ingestJob, ingestTaskParams, fileName)
+	}
+}
+
+// writeSaveIngestFileError reports an ingest file that could not be saved, including the validation report if the
+// file failed validation
+func writeSaveIngestFileError(response http.ResponseWriter, request *http.Request, err error) {
+	if errors.Is(err, upload.ErrInvalidJSON) {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("Error saving ingest file: %v", err), request), response)
 	} else if report, ok := err.(upload.ValidationReport); ok {
 		var (
@@ -165,9 +178,16 @@ func (s Resources) ProcessIngestTask(response http.ResponseWriter, request *http
 		}
 
 		api.WriteErrorResponse(request.Context(), e, response)
-	} else if err != nil {
+	} else {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, fmt.Sprintf("Error saving ingest file: %v", err), request), response)
-	} else if _, err = upload.CreateIngestTask(request.Context(), s.DB, upload.IngestTaskParams{Filename: ingestTaskParams.Filename, ProvidedFileName: checkFileName(fileName, ingestTaskParams.FileType), FileType: ingestTaskParams.FileType, RequestID: requestId, JobID: int64(jobID)}); err != nil {
+	}
+}
+
+// createIngestTask queues a saved ingest file for the datapipe and records the activity on the ingest job
+func (s Resources) createIngestTask(response http.ResponseWriter, request *http.Request, ingestJob model.IngestJob, ingestTaskParams upload.IngestTaskParams, fileName string) {
+	requestId := ctx.FromRequest(request).RequestID
+
+	if _, err := upload.CreateIngestTask(request.Context(), s.DB, upload.IngestTaskParams{Filename: ingestTaskParams.Filename, ProvidedFileName: checkFileName(fileName, ingestTaskParams.FileType), FileType: ingestTaskParams.FileType, RequestID: requestId, JobID: ingestJob.ID, DryRun: ingestJob.DryRun}); err != nil {
 		if removeErr := os.Remove(ingestTaskParams.Filename); removeErr != nil {
 			slog.WarnContext(request.Context(), fmt.Sprintf("Failed to clean up file after task creation error: %v", removeErr))
 		}
@@ -184,6 +204,10 @@ func checkFileName(filename string, fileType model.FileType) string {
 		return filename
 	} else if fileType == model.FileTypeJson {
 		return "UnknownFileName.json"
+	} else if fileType == model.FileTypeNDJSON {
+		return "UnknownFileName.ndjson"
+	} else if fileType == model.FileTypeGzip {
+		return "UnknownFileName.gz"
 	} else {
 		return "UnknownFileName.zip"
 	}
@@ -207,6 +231,28 @@ func (s Resources) EndIngestJob(response http.ResponseWriter, request *http.Requ
 	}
 }
 
+// GetIngestJobPreview returns the report of what the files of a finished dry run ingest job would change in the graph
+func (s Resources) GetIngestJobPreview(response http.ResponseWriter, request *http.Request) {
+	jobIdString := mux.Vars(request)[FileUploadJobIdPathParameterName]
+
+	if jobID, err := strconv.Atoi(jobIdString); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if ingestJob, err := job.GetIngestJobByID(request.Context(), s.DB, int64(jobID)); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if !ingestJob.DryRun {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, "job is not a dry run", request), response)
+	} else if ingestJob.Status != model.JobStatusPreviewed {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "job must be in previewed status to report its preview", request), response)
+	} else if preview, err := s.DB.GetIngestPreview(request.Context(), ingestJob.ID); errors.Is(err, database.ErrNotFound) {
+		// A dry run job without any processed files has nothing to report
+		api.WriteBasicResponse(request.Context(), model.NewIngestPreviewReport(), http.StatusOK, response)
+	} else if err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), preview.Report, http.StatusOK, response)
+	}
+}
+
 func (s Resources) ListAcceptedFileUploadTypes(response http.ResponseWriter, request *http.Request) {
 	api.WriteBasicResponse(request.Context(), ingestModel.AllowedFileUploadTypes, http.StatusOK, response)
 }
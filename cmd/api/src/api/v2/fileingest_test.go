@@ -39,6 +39,7 @@ import (
 	"github.com/specterops/bloodhound/cmd/api/src/auth"
 	"github.com/specterops/bloodhound/cmd/api/src/config"
 	"github.com/specterops/bloodhound/cmd/api/src/ctx"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
 	dbmocks "github.com/specterops/bloodhound/cmd/api/src/database/mocks"
 	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
 	"github.com/specterops/bloodhound/cmd/api/src/model"
@@ -215,7 +216,63 @@ func TestResources_StartIngestJob(t *testing.T) {
 			},
 			expected: expected{
 				responseCode:   http.StatusCreated,
-				responseBody:   `{"data":{"created_at":"0001-01-01T00:00:00Z", "deleted_at":{"Time":"0001-01-01T00:00:00Z", "Valid":false}, "end_time":"0001-01-01T00:00:00Z", "failed_files":0, "id":0, "last_ingest":"0001-01-01T00:00:00Z", "partial_failed_files":0, "start_time":"0001-01-01T00:00:00Z", "status":1, "status_message":"", "total_files":0, "updated_at":"0001-01-01T00:00:00Z", "user_email_address": "email@notreal.com", "user_id":"00000000-0000-0000-0000-000000000000"}}`,
+				responseBody:   `{"data":{"created_at":"0001-01-01T00:00:00Z", "cross_domain":false, "deleted_at":{"Time":"0001-01-01T00:00:00Z", "Valid":false}, "domain_sids":null, "dry_run":false, "end_time":"0001-01-01T00:00:00Z", "failed_files":0, "id":0, "last_ingest":"0001-01-01T00:00:00Z", "partial_failed_files":0, "start_time":"0001-01-01T00:00:00Z", "status":1, "status_message":"", "total_files":0, "updated_at":"0001-01-01T00:00:00Z", "user_email_address": "email@notreal.com", "user_id":"00000000-0000-0000-0000-000000000000"}}`,
+				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
+			},
+		}, {
+			name: "Error: Malformed dry_run Query Parameter - 400",
+			buildRequest: func() *http.Request {
+				request := &http.Request{
+					URL: &url.URL{Path: "/api/v2/file-upload/start", RawQuery: "dry_run=maybe"}, Method: http.MethodPost,
+				}
+
+				requestCtx := ctx.Context{
+					RequestID: "id",
+					AuthCtx: auth.Context{
+						Owner:   model.User{},
+						Session: model.UserSession{},
+					},
+				}
+
+				return request.WithContext(context.WithValue(context.Background(), ctx.ValueKey, requestCtx.WithRequestID("id")))
+			},
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+			},
+			expected: expected{
+				responseCode:   http.StatusBadRequest,
+				responseBody:   `{"errors":[{"context":"", "message":"query parameter \"dry_run\" is malformed: error converting value to boolean, defaulting to false: strconv.ParseBool: parsing \"maybe\": invalid syntax"}],"http_status":400,"request_id":"id","timestamp":"0001-01-01T00:00:00Z"}`,
+				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
+			},
+		}, {
+			name: "Success: Dry Run - 201",
+			buildRequest: func() *http.Request {
+				request := &http.Request{
+					URL: &url.URL{Path: "/api/v2/file-upload/start", RawQuery: "dry_run=true"}, Method: http.MethodPost,
+				}
+
+				requestCtx := ctx.Context{
+					RequestID: "id",
+					AuthCtx: auth.Context{
+						Owner:   model.User{},
+						Session: model.UserSession{},
+					},
+				}
+
+				return request.WithContext(context.WithValue(context.Background(), ctx.ValueKey, requestCtx.WithRequestID("id")))
+			},
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+				mock.mockDatabase.EXPECT().CreateIngestJob(gomock.Any(), gomock.Cond(func(job model.IngestJob) bool {
+					return job.DryRun
+				})).Return(model.IngestJob{
+					Status: model.JobStatusRunning,
+					DryRun: true,
+				}, nil)
+			},
+			expected: expected{
+				responseCode:   http.StatusCreated,
+				responseBody:   `{"data":{"created_at":"0001-01-01T00:00:00Z", "cross_domain":false, "deleted_at":{"Time":"0001-01-01T00:00:00Z", "Valid":false}, "domain_sids":null, "dry_run":true, "end_time":"0001-01-01T00:00:00Z", "failed_files":0, "id":0, "last_ingest":"0001-01-01T00:00:00Z", "partial_failed_files":0, "start_time":"0001-01-01T00:00:00Z", "status":1, "status_message":"", "total_files":0, "updated_at":"0001-01-01T00:00:00Z", "user_email_address":null, "user_id":null}}`,
 				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
 			},
 		},
@@ -240,7 +297,7 @@ func TestResources_StartIngestJob(t *testing.T) {
 			response := httptest.NewRecorder()
 
 			router := mux.NewRouter()
-			router.HandleFunc(request.URL.String(), resources.StartIngestJob).Methods(request.Method)
+			router.HandleFunc(request.URL.Path, resources.StartIngestJob).Methods(request.Method)
 
 			router.ServeHTTP(response, request)
 
@@ -461,6 +518,248 @@ func TestResources_EndIngestJob(t *testing.T) {
 	}
 }
 
+func TestResources_GetIngestJobPreview(t *testing.T) {
+	t.Parallel()
+
+	type mock struct {
+		mockDatabase *dbmocks.MockDatabase
+	}
+	type expected struct {
+		responseBody   string
+		responseCode   int
+		responseHeader http.Header
+	}
+	type testData struct {
+		name         string
+		buildRequest func() *http.Request
+		setupMocks   func(t *testing.T, mock *mock)
+		expected     expected
+	}
+
+	var (
+		report = model.NewIngestPreviewReport()
+	)
+
+	report.AddNodeToCreate(model.IngestPreviewNode{Identity: "S-1-5-21-1", Kinds: []string{"Base", "User"}})
+	report.AddEdgeToAdd(model.IngestPreviewEdge{Kind: "MemberOf", Start: "S-1-5-21-1", End: "S-1-5-21-2"})
+	report.Counts.NodesUnchanged = 4
+
+	tt := []testData{
+		{
+			name: "Error: Invalid Job - 400",
+			buildRequest: func() *http.Request {
+				request := &http.Request{
+					URL: &url.URL{Path: "/api/v2/file-upload/invalid/preview"}, Method: http.MethodGet,
+				}
+
+				requestCtx := ctx.Context{
+					RequestID: "id",
+					AuthCtx: auth.Context{
+						Owner:   model.User{},
+						Session: model.UserSession{},
+					},
+				}
+
+				return request.WithContext(context.WithValue(context.Background(), ctx.ValueKey, requestCtx.WithRequestID("id")))
+			},
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+			},
+			expected: expected{
+				responseCode:   http.StatusBadRequest,
+				responseBody:   `{"errors":[{"context":"", "message":"id is malformed"}], "http_status":400, "request_id":"id", "timestamp":"0001-01-01T00:00:00Z"}`,
+				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
+			},
+		},
+		{
+			name: "Error: Not A Dry Run - 404",
+			buildRequest: func() *http.Request {
+				request := &http.Request{
+					URL: &url.URL{Path: "/api/v2/file-upload/123/preview"}, Method: http.MethodGet,
+				}
+
+				requestCtx := ctx.Context{
+					RequestID: "id",
+					AuthCtx: auth.Context{
+						Owner:   model.User{},
+						Session: model.UserSession{},
+					},
+				}
+
+				return request.WithContext(context.WithValue(context.Background(), ctx.ValueKey, requestCtx.WithRequestID("id")))
+			},
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+				mock.mockDatabase.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{
+					Status: model.JobStatusComplete,
+				}, nil)
+			},
+			expected: expected{
+				responseCode:   http.StatusNotFound,
+				responseBody:   `{"errors":[{"context":"", "message":"job is not a dry run"}], "http_status":404, "request_id":"id", "timestamp":"0001-01-01T00:00:00Z"}`,
+				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
+			},
+		},
+		{
+			name: "Error: Invalid Job Status - 400",
+			buildRequest: func() *http.Request {
+				request := &http.Request{
+					URL: &url.URL{Path: "/api/v2/file-upload/123/preview"}, Method: http.MethodGet,
+				}
+
+				requestCtx := ctx.Context{
+					RequestID: "id",
+					AuthCtx: auth.Context{
+						Owner:   model.User{},
+						Session: model.UserSession{},
+					},
+				}
+
+				return request.WithContext(context.WithValue(context.Background(), ctx.ValueKey, requestCtx.WithRequestID("id")))
+			},
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+				mock.mockDatabase.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{
+					Status: model.JobStatusIngesting,
+					DryRun: true,
+				}, nil)
+			},
+			expected: expected{
+				responseCode:   http.StatusBadRequest,
+				responseBody:   `{"errors":[{"context":"", "message":"job must be in previewed status to report its preview"}], "http_status":400, "request_id":"id", "timestamp":"0001-01-01T00:00:00Z"}`,
+				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
+			},
+		},
+		{
+			name: "Error: GetIngestPreview Database Error - 500",
+			buildRequest: func() *http.Request {
+				request := &http.Request{
+					URL: &url.URL{Path: "/api/v2/file-upload/123/preview"}, Method: http.MethodGet,
+				}
+
+				requestCtx := ctx.Context{
+					RequestID: "id",
+					AuthCtx: auth.Context{
+						Owner:   model.User{},
+						Session: model.UserSession{},
+					},
+				}
+
+				return request.WithContext(context.WithValue(context.Background(), ctx.ValueKey, requestCtx.WithRequestID("id")))
+			},
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+				mock.mockDatabase.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{
+					BigSerial: model.BigSerial{ID: 123},
+					Status:    model.JobStatusPreviewed,
+					DryRun:    true,
+				}, nil)
+				mock.mockDatabase.EXPECT().GetIngestPreview(gomock.Any(), int64(123)).Return(model.IngestPreview{}, errors.New("db error"))
+			},
+			expected: expected{
+				responseCode:   http.StatusInternalServerError,
+				responseBody:   `{"errors":[{"context":"","message":"an internal error has occurred that is preventing the service from servicing this request"}],"http_status":500,"request_id":"id","timestamp":"0001-01-01T00:00:00Z"}`,
+				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
+			},
+		},
+		{
+			name: "Success: No Files Previewed - 200",
+			buildRequest: func() *http.Request {
+				request := &http.Request{
+					URL: &url.URL{Path: "/api/v2/file-upload/123/preview"}, Method: http.MethodGet,
+				}
+
+				requestCtx := ctx.Context{
+					RequestID: "id",
+					AuthCtx: auth.Context{
+						Owner:   model.User{},
+						Session: model.UserSession{},
+					},
+				}
+
+				return request.WithContext(context.WithValue(context.Background(), ctx.ValueKey, requestCtx.WithRequestID("id")))
+			},
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+				mock.mockDatabase.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{
+					BigSerial: model.BigSerial{ID: 123},
+					Status:    model.JobStatusPreviewed,
+					DryRun:    true,
+				}, nil)
+				mock.mockDatabase.EXPECT().GetIngestPreview(gomock.Any(), int64(123)).Return(model.IngestPreview{}, database.ErrNotFound)
+			},
+			expected: expected{
+				responseCode:   http.StatusOK,
+				responseBody:   `{"data":{"counts":{"nodes_to_create":0, "nodes_to_update":0, "nodes_unchanged":0, "edges_to_add":0, "edges_unchanged":0, "unresolved_endpoints":0, "schema_warnings":0}, "nodes_to_create":[], "nodes_to_update":[], "edges_to_add":[], "unresolved_endpoints":[], "new_kinds":[], "schema_warnings":[]}}`,
+				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
+			},
+		},
+		{
+			name: "Success: Happy Path - 200",
+			buildRequest: func() *http.Request {
+				request := &http.Request{
+					URL: &url.URL{Path: "/api/v2/file-upload/123/preview"}, Method: http.MethodGet,
+				}
+
+				requestCtx := ctx.Context{
+					RequestID: "id",
+					AuthCtx: auth.Context{
+						Owner:   model.User{},
+						Session: model.UserSession{},
+					},
+				}
+
+				return request.WithContext(context.WithValue(context.Background(), ctx.ValueKey, requestCtx.WithRequestID("id")))
+			},
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+				mock.mockDatabase.EXPECT().GetIngestJob(gomock.Any(), int64(123)).Return(model.IngestJob{
+					BigSerial: model.BigSerial{ID: 123},
+					Status:    model.JobStatusPreviewed,
+					DryRun:    true,
+				}, nil)
+				mock.mockDatabase.EXPECT().GetIngestPreview(gomock.Any(), int64(123)).Return(model.IngestPreview{JobID: 123, Report: report}, nil)
+			},
+			expected: expected{
+				responseCode:   http.StatusOK,
+				responseBody:   `{"data":{"counts":{"nodes_to_create":1, "nodes_to_update":0, "nodes_unchanged":4, "edges_to_add":1, "edges_unchanged":0, "unresolved_endpoints":0, "schema_warnings":0}, "nodes_to_create":[{"identity":"S-1-5-21-1", "kinds":["Base", "User"]}], "nodes_to_update":[], "edges_to_add":[{"kind":"MemberOf", "start":"S-1-5-21-1", "end":"S-1-5-21-2"}], "unresolved_endpoints":[], "new_kinds":[], "schema_warnings":[]}}`,
+				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
+			},
+		},
+	}
+
+	for _, testCase := range tt {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mocks := &mock{
+				mockDatabase: dbmocks.NewMockDatabase(ctrl),
+			}
+
+			request := testCase.buildRequest()
+			testCase.setupMocks(t, mocks)
+
+			resources := v2.Resources{
+				DB: mocks.mockDatabase,
+			}
+
+			response := httptest.NewRecorder()
+
+			router := mux.NewRouter()
+			router.HandleFunc(fmt.Sprintf("/api/v2/file-upload/{%s}/preview", v2.FileUploadJobIdPathParameterName), resources.GetIngestJobPreview).Methods(request.Method)
+
+			router.ServeHTTP(response, request)
+
+			status, header, body := test.ProcessResponse(t, response)
+
+			assert.Equal(t, testCase.expected.responseCode, status)
+			assert.Equal(t, testCase.expected.responseHeader, header)
+			assert.JSONEq(t, testCase.expected.responseBody, body)
+		})
+	}
+}
+
 func TestResources_ListAcceptedFileUploadTypes(t *testing.T) {
 	bytes, err := json.Marshal(ingest.AllowedFileUploadTypes)
 	if err != nil {
@@ -509,7 +808,7 @@ func TestResources_ProcessIngestTask(t *testing.T) {
 			setupMocks: func(t *testing.T, mock *mock) {},
 			expected: expected{
 				responseCode:   http.StatusBadRequest,
-				responseBody:   `{"errors":[{"context":"","message":"Content type must be application/json or application/zip"}],"http_status":400,"request_id":"","timestamp":"0001-01-01T00:00:00Z"}`,
+				responseBody:   `{"errors":[{"context":"","message":"Content type must be application/json, application/x-ndjson, application/zip or application/gzip"}],"http_status":400,"request_id":"","timestamp":"0001-01-01T00:00:00Z"}`,
 				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
 			},
 		},
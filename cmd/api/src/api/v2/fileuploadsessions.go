@@ -0,0 +1,145 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	ingestModel "github.com/specterops/bloodhound/cmd/api/src/model/ingest"
+	"github.com/specterops/bloodhound/cmd/api/src/services/job"
+	"github.com/specterops/bloodhound/cmd/api/src/services/upload"
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+)
+
+const (
+	FileUploadSessionIdPathParameterName  = "upload_session_id"
+	FileUploadChunkIndexPathParameterName = "chunk_index"
+	FileUploadChunkHashHeader             = "X-File-Upload-Chunk-SHA256"
+)
+
+// getRunningIngestJob looks up the ingest job in the request path and checks that it still accepts files. The error
+// response has already been written when ok is false.
+func (s Resources) getRunningIngestJob(response http.ResponseWriter, request *http.Request) (model.IngestJob, bool) {
+	if jobID, err := strconv.ParseInt(mux.Vars(request)[FileUploadJobIdPathParameterName], 10, 64); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if ingestJob, err := job.GetIngestJobByID(request.Context(), s.DB, jobID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if ingestJob.Status != model.JobStatusRunning {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "job must be in running status to attach files", request), response)
+	} else {
+		return ingestJob, true
+	}
+
+	return model.IngestJob{}, false
+}
+
+// getFileUploadSession looks up the upload session in the request path, which must belong to the running ingest job
+// of the request path. The error response has already been written when ok is false.
+func (s Resources) getFileUploadSession(response http.ResponseWriter, request *http.Request) (model.IngestJob, model.IngestUploadSession, bool) {
+	if ingestJob, ok := s.getRunningIngestJob(response, request); !ok {
+		return model.IngestJob{}, model.IngestUploadSession{}, false
+	} else if sessionID, err := strconv.ParseInt(mux.Vars(request)[FileUploadSessionIdPathParameterName], 10, 64); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if session, err := s.DB.GetIngestUploadSession(request.Context(), sessionID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if session.JobID != ingestJob.ID {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsResourceNotFound, request), response)
+	} else {
+		return ingestJob, session, true
+	}
+
+	return model.IngestJob{}, model.IngestUploadSession{}, false
+}
+
+func (s Resources) CreateFileUploadSession(response http.ResponseWriter, request *http.Request) {
+	var params upload.UploadSessionParams
+
+	if ingestJob, ok := s.getRunningIngestJob(response, request); !ok {
+		return
+	} else if err := api.ReadJSONRequestPayloadLimited(&params, request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, request), response)
+	} else if err := params.Validate(); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if session, err := upload.CreateUploadSession(request.Context(), s.DB, s.Config.UploadSessionsDirectory(), ingestJob.ID, params); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if err := job.TouchIngestJobLastIngest(request.Context(), s.DB, ingestJob); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), upload.NewUploadSessionStatus(session, nil), http.StatusCreated, response)
+	}
+}
+
+func (s Resources) GetFileUploadSession(response http.ResponseWriter, request *http.Request) {
+	if _, session, ok := s.getFileUploadSession(response, request); !ok {
+		return
+	} else if chunks, err := s.DB.GetIngestUploadChunks(request.Context(), session.ID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), upload.NewUploadSessionStatus(session, chunks), http.StatusOK, response)
+	}
+}
+
+func (s Resources) PutFileUploadChunk(response http.ResponseWriter, request *http.Request) {
+	defer measure.ContextMeasureWithThreshold(request.Context(), slog.LevelDebug, "Saving file upload chunk")()
+
+	if request.Body != nil {
+		defer request.Body.Close()
+	}
+
+	if ingestJob, session, ok := s.getFileUploadSession(response, request); !ok {
+		return
+	} else if index, err := strconv.ParseInt(mux.Vars(request)[FileUploadChunkIndexPathParameterName], 10, 64); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if checksum := request.Header.Get(FileUploadChunkHashHeader); checksum == "" {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("missing %s header", FileUploadChunkHashHeader), request), response)
+	} else if chunk, err := upload.SaveUploadChunk(request.Context(), s.DB, s.Config.UploadSessionsDirectory(), session, index, checksum, request.Body); errors.Is(err, upload.ErrUploadChunkOutOfRange) || errors.Is(err, upload.ErrUploadChunkLength) || errors.Is(err, upload.ErrUploadChunkChecksum) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if errors.Is(err, ingestModel.ErrUploadChunkConflict) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, err.Error(), request), response)
+	} else if err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if err := job.TouchIngestJobLastIngest(request.Context(), s.DB, ingestJob); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), chunk, http.StatusOK, response)
+	}
+}
+
+func (s Resources) FinalizeFileUploadSession(response http.ResponseWriter, request *http.Request) {
+	defer measure.ContextMeasureWithThreshold(request.Context(), slog.LevelDebug, "Finalizing file upload session")()
+
+	validator := upload.NewIngestValidator(s.IngestSchema)
+
+	if ingestJob, session, ok := s.getFileUploadSession(response, request); !ok {
+		return
+	} else if ingestTaskParams, err := upload.FinalizeUploadSession(request.Context(), s.DB, s.Config.UploadSessionsDirectory(), s.Config.TempDirectory(), session, validator); errors.Is(err, upload.ErrUploadSessionIncomplete) || errors.Is(err, upload.ErrUploadSessionMismatch) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if errors.Is(err, ingestModel.ErrUploadSessionFinalizing) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, err.Error(), request), response)
+	} else if err != nil {
+		writeSaveIngestFileError(response, request, err)
+	} else {
+		s.createIngestTask(response, request, ingestJob, ingestTaskParams, session.FileName)
+	}
+}
@@ -0,0 +1,401 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/api/v2/apitest"
+	"github.com/specterops/bloodhound/cmd/api/src/config"
+	dbmocks "github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/ingest"
+	"github.com/specterops/bloodhound/cmd/api/src/services/upload"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func sha256Hex(data []byte) string {
+	digest := sha256.Sum256(data)
+	return hex.EncodeToString(digest[:])
+}
+
+func setupFileUploadSessionResources(t *testing.T) (v2.Resources, *dbmocks.MockDatabase) {
+	var (
+		mockDB    = dbmocks.NewMockDatabase(gomock.NewController(t))
+		resources = v2.Resources{DB: mockDB, Config: config.Configuration{WorkDir: t.TempDir()}}
+	)
+
+	require.Nil(t, os.Mkdir(resources.Config.TempDirectory(), 0755))
+	require.Nil(t, os.Mkdir(resources.Config.UploadSessionsDirectory(), 0755))
+
+	return resources, mockDB
+}
+
+func setFileUploadSessionURLVars(input *apitest.Input) {
+	apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "1")
+	apitest.SetURLVar(input, v2.FileUploadSessionIdPathParameterName, "7")
+}
+
+func newFileUploadSession(data []byte, chunkSize int64) model.IngestUploadSession {
+	session := model.IngestUploadSession{
+		JobID:       1,
+		FileName:    "collection.json.gz",
+		ContentType: "application/gzip",
+		TotalSize:   int64(len(data)),
+		ChunkSize:   chunkSize,
+		SHA256:      sha256Hex(data),
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+
+	session.ID = 7
+	return session
+}
+
+func TestResources_CreateFileUploadSession(t *testing.T) {
+	var (
+		resources, mockDB = setupFileUploadSessionResources(t)
+		runningJob        = model.IngestJob{Status: model.JobStatusRunning, BigSerial: model.BigSerial{ID: 1}}
+		params            = upload.UploadSessionParams{
+			FileName:    "collection.zip",
+			ContentType: "application/zip",
+			TotalSize:   1024,
+			ChunkSize:   512,
+			SHA256:      sha256Hex([]byte("collection")),
+		}
+	)
+
+	apitest.
+		NewHarness(t, resources.CreateFileUploadSession).
+		WithCommonRequest(func(input *apitest.Input) {
+			apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "1")
+		}).
+		Run([]apitest.Case{
+			{
+				Name: "MalformedJobID",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, v2.FileUploadJobIdPathParameterName, "job")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "id is malformed")
+				},
+			},
+			{
+				Name: "JobNotRunning",
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(1)).Return(model.IngestJob{Status: model.JobStatusComplete}, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "job must be in running status to attach files")
+				},
+			},
+			{
+				Name: "InvalidParams",
+				Input: func(input *apitest.Input) {
+					invalid := params
+					invalid.ChunkSize = 0
+					apitest.BodyStruct(input, invalid)
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(1)).Return(runningJob, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "chunk_size must be greater than zero")
+				},
+			},
+			{
+				Name: "DatabaseError",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, params)
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(1)).Return(runningJob, nil)
+					mockDB.EXPECT().CreateIngestUploadSession(gomock.Any(), gomock.Any()).Return(model.IngestUploadSession{}, errors.New("database error"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, params)
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(1)).Return(runningJob, nil)
+					mockDB.EXPECT().CreateIngestUploadSession(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, session model.IngestUploadSession) (model.IngestUploadSession, error) {
+						session.ID = 7
+						return session, nil
+					})
+					mockDB.EXPECT().UpdateIngestJob(gomock.Any(), gomock.Any()).Return(nil)
+				},
+				Test: func(output apitest.Output) {
+					var status upload.UploadSessionStatus
+
+					apitest.StatusCode(output, http.StatusCreated)
+					apitest.UnmarshalData(output, &status)
+					apitest.Equal(output, int64(7), status.ID)
+					apitest.Equal(output, int64(2), status.ChunkCount)
+					apitest.Equal(output, []int64{0, 1}, status.MissingChunks)
+
+					_, err := os.Stat(upload.UploadSessionDirectory(resources.Config.UploadSessionsDirectory(), 7))
+					apitest.Equal(output, nil, err)
+				},
+			},
+		})
+}
+
+func TestResources_GetFileUploadSession(t *testing.T) {
+	var (
+		resources, mockDB = setupFileUploadSessionResources(t)
+		runningJob        = model.IngestJob{Status: model.JobStatusRunning, BigSerial: model.BigSerial{ID: 1}}
+		session           = newFileUploadSession([]byte("0123456789"), 4)
+	)
+
+	apitest.
+		NewHarness(t, resources.GetFileUploadSession).
+		WithCommonRequest(setFileUploadSessionURLVars).
+		Run([]apitest.Case{
+			{
+				Name: "MalformedSessionID",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, v2.FileUploadSessionIdPathParameterName, "session")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(1)).Return(runningJob, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "id is malformed")
+				},
+			},
+			{
+				Name: "SessionOfAnotherJob",
+				Setup: func() {
+					otherSession := session
+					otherSession.JobID = 2
+
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(1)).Return(runningJob, nil)
+					mockDB.EXPECT().GetIngestUploadSession(gomock.Any(), int64(7)).Return(otherSession, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+				},
+			},
+			{
+				Name: "Success",
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(1)).Return(runningJob, nil)
+					mockDB.EXPECT().GetIngestUploadSession(gomock.Any(), int64(7)).Return(session, nil)
+					mockDB.EXPECT().GetIngestUploadChunks(gomock.Any(), int64(7)).Return(model.IngestUploadChunks{{SessionID: 7, ChunkIndex: 2, Size: 2}}, nil)
+				},
+				Test: func(output apitest.Output) {
+					var status upload.UploadSessionStatus
+
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.UnmarshalData(output, &status)
+					apitest.Equal(output, int64(2), status.ReceivedBytes)
+					apitest.Equal(output, []upload.ByteRange{{Start: 8, End: 9}}, status.ReceivedRanges)
+					apitest.Equal(output, []int64{0, 1}, status.MissingChunks)
+				},
+			},
+		})
+}
+
+func TestResources_PutFileUploadChunk(t *testing.T) {
+	var (
+		resources, mockDB = setupFileUploadSessionResources(t)
+		runningJob        = model.IngestJob{Status: model.JobStatusRunning, BigSerial: model.BigSerial{ID: 1}}
+		session           = newFileUploadSession([]byte("0123456789"), 4)
+	)
+
+	require.Nil(t, os.Mkdir(upload.UploadSessionDirectory(resources.Config.UploadSessionsDirectory(), session.ID), 0755))
+
+	apitest.
+		NewHarness(t, resources.PutFileUploadChunk).
+		WithCommonRequest(func(input *apitest.Input) {
+			setFileUploadSessionURLVars(input)
+			apitest.SetURLVar(input, v2.FileUploadChunkIndexPathParameterName, "1")
+			apitest.SetHeader(input, v2.FileUploadChunkHashHeader, sha256Hex([]byte("4567")))
+			apitest.BodyString(input, "4567")
+		}).
+		Run([]apitest.Case{
+			{
+				Name: "MissingChecksum",
+				Input: func(input *apitest.Input) {
+					apitest.SetHeader(input, v2.FileUploadChunkHashHeader, "")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(1)).Return(runningJob, nil)
+					mockDB.EXPECT().GetIngestUploadSession(gomock.Any(), int64(7)).Return(session, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "missing "+v2.FileUploadChunkHashHeader+" header")
+				},
+			},
+			{
+				Name: "ChunkOutOfRange",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, v2.FileUploadChunkIndexPathParameterName, "3")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(1)).Return(runningJob, nil)
+					mockDB.EXPECT().GetIngestUploadSession(gomock.Any(), int64(7)).Return(session, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, upload.ErrUploadChunkOutOfRange.Error())
+				},
+			},
+			{
+				Name: "ChecksumMismatch",
+				Input: func(input *apitest.Input) {
+					apitest.BodyString(input, "7654")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(1)).Return(runningJob, nil)
+					mockDB.EXPECT().GetIngestUploadSession(gomock.Any(), int64(7)).Return(session, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, upload.ErrUploadChunkChecksum.Error())
+				},
+			},
+			{
+				Name: "ConflictingChunk",
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(1)).Return(runningJob, nil)
+					mockDB.EXPECT().GetIngestUploadSession(gomock.Any(), int64(7)).Return(session, nil)
+					mockDB.EXPECT().CreateIngestUploadChunk(gomock.Any(), gomock.Any()).Return(model.IngestUploadChunk{}, ingest.ErrUploadChunkConflict)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusConflict)
+				},
+			},
+			{
+				Name: "Success",
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(1)).Return(runningJob, nil)
+					mockDB.EXPECT().GetIngestUploadSession(gomock.Any(), int64(7)).Return(session, nil)
+					mockDB.EXPECT().CreateIngestUploadChunk(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, chunk model.IngestUploadChunk) (model.IngestUploadChunk, error) {
+						return chunk, nil
+					})
+					mockDB.EXPECT().UpdateIngestUploadSession(gomock.Any(), gomock.Any()).Return(nil)
+					mockDB.EXPECT().UpdateIngestJob(gomock.Any(), gomock.Any()).Return(nil)
+				},
+				Test: func(output apitest.Output) {
+					var chunk model.IngestUploadChunk
+
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.UnmarshalData(output, &chunk)
+					apitest.Equal(output, int64(1), chunk.ChunkIndex)
+					apitest.Equal(output, int64(4), chunk.Size)
+				},
+			},
+		})
+}
+
+func TestResources_FinalizeFileUploadSession(t *testing.T) {
+	var (
+		resources, mockDB = setupFileUploadSessionResources(t)
+		runningJob        = model.IngestJob{Status: model.JobStatusRunning, BigSerial: model.BigSerial{ID: 1}}
+		compressed        = bytes.Buffer{}
+		gzipWriter        = gzip.NewWriter(&compressed)
+	)
+
+	_, err := gzipWriter.Write([]byte(`{"meta": {"type": "domains", "version": 6, "count": 0}, "data": []}`))
+	require.Nil(t, err)
+	require.Nil(t, gzipWriter.Close())
+
+	var (
+		data      = compressed.Bytes()
+		session   = newFileUploadSession(data, int64(len(data)))
+		directory = upload.UploadSessionDirectory(resources.Config.UploadSessionsDirectory(), session.ID)
+	)
+
+	require.Nil(t, os.Mkdir(directory, 0755))
+	require.Nil(t, os.WriteFile(filepath.Join(directory, "chunk-0-"+session.SHA256), data, 0644))
+
+	apitest.
+		NewHarness(t, resources.FinalizeFileUploadSession).
+		WithCommonRequest(setFileUploadSessionURLVars).
+		Run([]apitest.Case{
+			{
+				Name: "IncompleteSession",
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(1)).Return(runningJob, nil)
+					mockDB.EXPECT().GetIngestUploadSession(gomock.Any(), int64(7)).Return(session, nil)
+					mockDB.EXPECT().GetIngestUploadChunks(gomock.Any(), int64(7)).Return(model.IngestUploadChunks{}, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, upload.ErrUploadSessionIncomplete.Error())
+				},
+			},
+			{
+				Name: "AlreadyFinalizing",
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(1)).Return(runningJob, nil)
+					mockDB.EXPECT().GetIngestUploadSession(gomock.Any(), int64(7)).Return(session, nil)
+					mockDB.EXPECT().GetIngestUploadChunks(gomock.Any(), int64(7)).Return(model.IngestUploadChunks{{SessionID: 7, ChunkIndex: 0, Size: session.TotalSize, SHA256: session.SHA256}}, nil)
+					mockDB.EXPECT().ClaimIngestUploadSession(gomock.Any(), int64(7), gomock.Any()).Return(ingest.ErrUploadSessionFinalizing)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusConflict)
+
+					_, err := os.Stat(directory)
+					apitest.Equal(output, nil, err)
+				},
+			},
+			{
+				Name: "Success",
+				Setup: func() {
+					mockDB.EXPECT().GetIngestJob(gomock.Any(), int64(1)).Return(runningJob, nil)
+					mockDB.EXPECT().GetIngestUploadSession(gomock.Any(), int64(7)).Return(session, nil)
+					mockDB.EXPECT().GetIngestUploadChunks(gomock.Any(), int64(7)).Return(model.IngestUploadChunks{{SessionID: 7, ChunkIndex: 0, Size: session.TotalSize, SHA256: session.SHA256}}, nil)
+					mockDB.EXPECT().ClaimIngestUploadSession(gomock.Any(), int64(7), gomock.Any()).Return(nil)
+					mockDB.EXPECT().DeleteIngestUploadSession(gomock.Any(), int64(7)).Return(nil)
+					mockDB.EXPECT().CreateIngestTask(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, task model.IngestTask) (model.IngestTask, error) {
+						require.Equal(t, session.FileName, task.OriginalFileName)
+						require.Equal(t, model.FileTypeGzip, task.FileType)
+						return task, nil
+					})
+					mockDB.EXPECT().UpdateIngestJob(gomock.Any(), gomock.Any()).Return(nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusAccepted)
+
+					_, err := os.Stat(directory)
+					apitest.Equal(output, true, errors.Is(err, os.ErrNotExist))
+				},
+			},
+		})
+}
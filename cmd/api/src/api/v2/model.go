@@ -41,6 +41,14 @@ type ListRolesResponse struct {
 	Roles model.Roles `json:"roles"`
 }
 
+// UpsertRoleRequest describes a custom role. Permissions are referenced by id and replace the role's current
+// permissions on update.
+type UpsertRoleRequest struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Permissions []int32 `json:"permissions"`
+}
+
 type ListUsersResponse struct {
 	Users model.Users `json:"users"`
 }
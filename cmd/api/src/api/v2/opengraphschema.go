@@ -48,8 +48,8 @@ type OpenGraphSchemaService interface {
 }
 
 type GraphExtensionPayload struct {
-	GraphSchemaExtension GraphSchemaExtensionPayload `json:"schema"`
-	// GraphSchemaProperties        []GraphSchemaPropertiesPayload        `json:"properties"`
+	GraphSchemaExtension         GraphSchemaExtensionPayload           `json:"schema"`
+	GraphSchemaProperties        []GraphSchemaPropertiesPayload        `json:"properties"`
 	GraphSchemaRelationshipKinds []GraphSchemaRelationshipKindsPayload `json:"relationship_kinds"`
 	GraphSchemaNodeKinds         []GraphSchemaNodeKindsPayload         `json:"node_kinds"`
 	GraphEnvironments            []EnvironmentPayload                  `json:"environments"`
@@ -57,10 +57,11 @@ type GraphExtensionPayload struct {
 }
 
 type GraphSchemaExtensionPayload struct {
-	Name        string `json:"name"`
-	DisplayName string `json:"display_name"`
-	Version     string `json:"version"`
-	Namespace   string `json:"namespace"`
+	Name                     string `json:"name"`
+	DisplayName              string `json:"display_name"`
+	Version                  string `json:"version"`
+	Namespace                string `json:"namespace"`
+	StrictPropertyValidation bool   `json:"strict_property_validation"` // reject entities with invalid property values instead of dropping the properties
 }
 
 type GraphSchemaRelationshipKindsPayload struct {
@@ -78,10 +79,12 @@ type GraphSchemaNodeKindsPayload struct {
 	IconColor     string `json:"color"`           // icon hex color
 }
 type GraphSchemaPropertiesPayload struct {
-	Name        string `json:"name"`
-	DisplayName string `json:"display_name"`
-	DataType    string `json:"data_type"`
-	Description string `json:"description"`
+	Name        string   `json:"name"`
+	DisplayName string   `json:"display_name"`
+	DataType    string   `json:"data_type"`
+	Description string   `json:"description"`
+	IsRequired  bool     `json:"is_required"` // indicates whether ingested entities of the extension's kinds must have this property
+	EnumValues  []string `json:"enum_values"` // if not empty, the only values the property may have
 }
 
 type EnvironmentPayload struct {
@@ -189,10 +192,11 @@ func convertGraphExtensionPayloadToGraphExtension(payload GraphExtensionPayload)
 	var (
 		graphExtension = model.GraphExtensionInput{
 			ExtensionInput: model.ExtensionInput{
-				Name:        payload.GraphSchemaExtension.Name,
-				DisplayName: payload.GraphSchemaExtension.DisplayName,
-				Version:     payload.GraphSchemaExtension.Version,
-				Namespace:   payload.GraphSchemaExtension.Namespace,
+				Name:                     payload.GraphSchemaExtension.Name,
+				DisplayName:              payload.GraphSchemaExtension.DisplayName,
+				Version:                  payload.GraphSchemaExtension.Version,
+				Namespace:                payload.GraphSchemaExtension.Namespace,
+				StrictPropertyValidation: payload.GraphSchemaExtension.StrictPropertyValidation,
 			},
 			NodeKindsInput:         make(model.NodesInput, 0),
 			RelationshipKindsInput: make(model.RelationshipsInput, 0),
@@ -220,18 +224,17 @@ func convertGraphExtensionPayloadToGraphExtension(payload GraphExtensionPayload)
 				IsTraversable: edgeKindPayload.IsTraversable,
 			})
 	}
-	/*
-		for _, propertyPayload := range payload.GraphSchemaProperties {
-			graphExtension.PropertiesInput = append(graphExtension.PropertiesInput,
-				model.PropertyInput{
-					Name:        propertyPayload.Name,
-					DisplayName: propertyPayload.DisplayName,
-					DataType:    propertyPayload.DataType,
-					Description: propertyPayload.Description,
-				})
-		}
-
-	*/
+	for _, propertyPayload := range payload.GraphSchemaProperties {
+		graphExtension.PropertiesInput = append(graphExtension.PropertiesInput,
+			model.PropertyInput{
+				Name:        propertyPayload.Name,
+				DisplayName: propertyPayload.DisplayName,
+				DataType:    propertyPayload.DataType,
+				Description: propertyPayload.Description,
+				IsRequired:  propertyPayload.IsRequired,
+				EnumValues:  propertyPayload.EnumValues,
+			})
+	}
 	for _, environmentPayload := range payload.GraphEnvironments {
 		graphExtension.EnvironmentsInput = append(graphExtension.EnvironmentsInput,
 			model.EnvironmentInput{
@@ -52,19 +52,22 @@ func TestResources_OpenGraphSchemaIngest(t *testing.T) {
 
 		graphExtension = v2.GraphExtensionPayload{
 			GraphSchemaExtension: v2.GraphSchemaExtensionPayload{
-				Name:        "Test_Extension",
-				DisplayName: "Test Extension",
-				Version:     "1.0.0",
-				Namespace:   "TEST",
+				Name:                     "Test_Extension",
+				DisplayName:              "Test Extension",
+				Version:                  "1.0.0",
+				Namespace:                "TEST",
+				StrictPropertyValidation: true,
 			},
-			/*GraphSchemaProperties: []v2.GraphSchemaPropertiesPayload{
+			GraphSchemaProperties: []v2.GraphSchemaPropertiesPayload{
 				{
 					Name:        "Property_1",
 					DisplayName: "Property 1",
 					DataType:    "string",
 					Description: "a property",
+					IsRequired:  true,
+					EnumValues:  []string{"a", "b"},
 				},
-			},*/
+			},
 			GraphSchemaRelationshipKinds: []v2.GraphSchemaRelationshipKindsPayload{
 				{
 					Name:          "TEST_GraphSchemaEdgeKind_1",
@@ -106,20 +109,22 @@ func TestResources_OpenGraphSchemaIngest(t *testing.T) {
 		}
 		serviceGraphExtension = model.GraphExtensionInput{
 			ExtensionInput: model.ExtensionInput{
-				Name:        "Test_Extension",
-				DisplayName: "Test Extension",
-				Version:     "1.0.0",
-				Namespace:   "TEST",
+				Name:                     "Test_Extension",
+				DisplayName:              "Test Extension",
+				Version:                  "1.0.0",
+				Namespace:                "TEST",
+				StrictPropertyValidation: true,
 			},
-			PropertiesInput: model.PropertiesInput{},
-			/*PropertiesInput: model.PropertiesInput{
+			PropertiesInput: model.PropertiesInput{
 				{
 					Name:        "Property_1",
 					DisplayName: "Property 1",
 					DataType:    "string",
 					Description: "a property",
+					IsRequired:  true,
+					EnumValues:  []string{"a", "b"},
 				},
-			},*/
+			},
 			RelationshipKindsInput: model.RelationshipsInput{
 				{
 					Name:          "TEST_GraphSchemaEdgeKind_1",
@@ -223,18 +223,9 @@ func (s Resources) getAllShortestPaths(ctx context.Context, relationshipKindsPar
 }
 
 func (s Resources) getAllShortestPathsWithOpenGraph(ctx context.Context, relationshipKindsParam, startNode, endNode string, onlyIncludeTraversableKinds bool, validKinds graph.Kinds, request *http.Request) (graph.PathSet, *api.ErrorWrapper) {
-	relationshipKindFilters := model.Filters{}
-	if onlyIncludeTraversableKinds {
-		relationshipKindFilters["is_traversable"] = append(relationshipKindFilters["is_traversable"], model.Filter{Operator: model.Equals, Value: "true"})
-	}
-	if openGraphRelationships, _, err := s.DB.GetGraphSchemaRelationshipKinds(ctx, relationshipKindFilters, model.Sort{}, 0, 0); err != nil {
+	if validKinds, err := s.withOpenGraphRelationshipKinds(ctx, onlyIncludeTraversableKinds, validKinds); err != nil {
 		return nil, api.BuildErrorResponse(http.StatusInternalServerError, api.FormatDatabaseError(err).Error(), request)
 	} else {
-		openGraphRelationshipKinds := make(graph.Kinds, 0, len(openGraphRelationships))
-		for _, relationship := range openGraphRelationships {
-			openGraphRelationshipKinds = append(openGraphRelationshipKinds, graph.StringKind(relationship.Name))
-		}
-		validKinds = validKinds.Concatenate(openGraphRelationshipKinds)
 		if kindFilter, err := createRelationshipKindFilterCriteria(relationshipKindsParam, onlyIncludeTraversableKinds, validKinds); err != nil {
 			return nil, api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request)
 		} else if paths, err := s.GraphQuery.GetAllShortestPathsWithOpenGraph(ctx, startNode, endNode, kindFilter); err != nil {
@@ -247,6 +238,23 @@ func (s Resources) getAllShortestPathsWithOpenGraph(ctx context.Context, relatio
 
 }
 
+// withOpenGraphRelationshipKinds adds the relationship kinds of OpenGraph extensions to the given valid kinds
+func (s Resources) withOpenGraphRelationshipKinds(ctx context.Context, onlyIncludeTraversableKinds bool, validKinds graph.Kinds) (graph.Kinds, error) {
+	relationshipKindFilters := model.Filters{}
+	if onlyIncludeTraversableKinds {
+		relationshipKindFilters["is_traversable"] = append(relationshipKindFilters["is_traversable"], model.Filter{Operator: model.Equals, Value: "true"})
+	}
+	if openGraphRelationships, _, err := s.DB.GetGraphSchemaRelationshipKinds(ctx, relationshipKindFilters, model.Sort{}, 0, 0); err != nil {
+		return nil, err
+	} else {
+		openGraphRelationshipKinds := make(graph.Kinds, 0, len(openGraphRelationships))
+		for _, relationship := range openGraphRelationships {
+			openGraphRelationshipKinds = append(openGraphRelationshipKinds, graph.StringKind(relationship.Name))
+		}
+		return validKinds.Concatenate(openGraphRelationshipKinds), nil
+	}
+}
+
 const (
 	searchParameterQuery = "query"
 	searchParameterType  = "type"
@@ -132,6 +132,24 @@ func (s Authorizer) AllowsAllPermissions(ctx Context, requiredPermissions model.
 	return true
 }
 
+// AllowsGrantingPermissions returns true if the actor holds every one of the given permissions and may therefore hand
+// them out to a role or another user without escalating beyond its own access.
+func (s Authorizer) AllowsGrantingPermissions(ctx Context, permissions model.Permissions) bool {
+	if len(permissions) == 0 {
+		return true
+	} else if grantedPermissions, isAuthed := s.getPermissions(ctx); !isAuthed {
+		return false
+	} else {
+		for _, permission := range permissions {
+			if !hasPermission(ctx, permission, grantedPermissions) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
 func (s Authorizer) AllowsAtLeastOnePermission(ctx Context, requiredPermissions model.Permissions) bool {
 	if grantedPermissions, isAuthed := s.getPermissions(ctx); isAuthed {
 		for _, permission := range requiredPermissions {
@@ -134,6 +134,14 @@ type DefaultAdminConfiguration struct {
 	ExpireNow     bool   `json:"expire_now"`
 }
 
+// HAConfiguration controls primary election between API instances that share the same databases. Only the primary
+// instance runs the datapipe and changelog; every instance serves the API.
+type HAConfiguration struct {
+	Enabled           bool `json:"enabled"`
+	LeaseDuration     int  `json:"lease_duration"`     // Seconds a primary lease is valid for without renewal
+	HeartbeatInterval int  `json:"heartbeat_interval"` // Seconds between primary lease renewals
+}
+
 type Configuration struct {
 	Version                         int                       `json:"version"`
 	BindAddress                     string                    `json:"bind_addr"`
@@ -168,12 +176,21 @@ type Configuration struct {
 	EnableUserAnalytics             bool                      `json:"enable_user_analytics"`
 	ForceDownloadEmbeddedCollectors bool                      `json:"force_download_embedded_collectors"`
 	EnableAuditLogStdout            bool                      `json:"enable_audit_log_stdout"`
+	IngestArchiveSizeLimit          int64                     `json:"ingest_archive_size_limit"`
+	IngestWorkers                   int                       `json:"ingest_workers"`
+	HA                              HAConfiguration           `json:"ha"`
 }
 
 func (s Configuration) TempDirectory() string {
 	return filepath.Join(s.WorkDir, "tmp")
 }
 
+// UploadSessionsDirectory holds the chunks of resumable uploads. It is kept apart from the temp directory so that the
+// orphaned file sweeper of the datapipe does not remove chunks of uploads that are still in progress.
+func (s Configuration) UploadSessionsDirectory() string {
+	return filepath.Join(s.WorkDir, "upload_sessions")
+}
+
 func (s Configuration) RetainedFilesDirectory() string {
 	return filepath.Join(s.WorkDir, "retained")
 }
@@ -91,6 +91,15 @@ func NewDefaultConfiguration() (Configuration, error) {
 			},
 			EnableUserAnalytics:  false,
 			EnableAuditLogStdout: false,
+			// Maximum number of bytes extracted from a single ingest archive (64 GiB). Zero disables the limit.
+			IngestArchiveSizeLimit: 64 * 1024 * 1024 * 1024,
+			// Number of upload jobs ingested in parallel. Tasks of a single job are always ingested in order.
+			IngestWorkers: 2,
+			HA: HAConfiguration{
+				Enabled:           false,
+				LeaseDuration:     30,
+				HeartbeatInterval: 10,
+			},
 		}, nil
 	}
 }
@@ -725,6 +725,7 @@ func tagAssetGroupNodesForTag(ctx context.Context, db database.Database, graphDb
 			oldTaggedNodes         = cardinality.NewBitmap64()
 			newTaggedNodes         = cardinality.NewBitmap64()
 			missingSystemTagsNodes = cardinality.NewBitmap64()
+			uncertifiedNodes       = cardinality.NewBitmap64()
 		)
 
 		for _, selector := range selectors {
@@ -749,11 +750,15 @@ func tagAssetGroupNodesForTag(ctx context.Context, db database.Database, graphDb
 				// 3. Diff the sets filling the respective sets for later db updates
 				for _, nodeDb := range selectedNodes {
 					if !nodesSeen.Contains(nodeDb.NodeId.Uint64()) {
-						// Skip any that are not certified when tag requires certification or are selected by disabled selectors
-						if tag.RequireCertify.Bool && nodeDb.Certified <= model.AssetGroupCertificationRevoked {
+						// Skip any that are not certified when tag requires certification. Another selector may still have
+						// certified the node, so it is not marked as seen until it is accepted.
+						if !tag.AcceptsCertification(nodeDb.Certified) {
+							uncertifiedNodes.Add(nodeDb.NodeId.Uint64())
 							continue
 						}
 
+						uncertifiedNodes.Remove(nodeDb.NodeId.Uint64())
+
 						// If the id is not present, we must queue it for tagging
 						if !oldTaggedNodes.Contains(nodeDb.NodeId.Uint64()) {
 							newTaggedNodes.Add(nodeDb.NodeId.Uint64())
@@ -825,6 +830,7 @@ func tagAssetGroupNodesForTag(ctx context.Context, db database.Database, graphDb
 			slog.Int("total", countTotal),
 			slog.Uint64("tagged", newTaggedNodes.Cardinality()),
 			slog.Uint64("untagged", oldTaggedNodes.Cardinality()),
+			slog.Uint64("uncertified", uncertifiedNodes.Cardinality()),
 		)
 	}
 	return nil
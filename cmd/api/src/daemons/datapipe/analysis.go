@@ -21,14 +21,18 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"time"
 
 	"github.com/specterops/bloodhound/cmd/api/src/analysis/ad"
 	"github.com/specterops/bloodhound/cmd/api/src/analysis/azure"
 	"github.com/specterops/bloodhound/cmd/api/src/config"
 	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
 	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
 	"github.com/specterops/bloodhound/cmd/api/src/services/agi"
 	"github.com/specterops/bloodhound/cmd/api/src/services/dataquality"
+	"github.com/specterops/bloodhound/cmd/api/src/services/dogtags"
 	"github.com/specterops/bloodhound/packages/go/analysis"
 	"github.com/specterops/dawgs/graph"
 )
@@ -38,12 +42,74 @@ var (
 	ErrAnalysisPartiallyCompleted = errors.New("analysis partially completed")
 )
 
+// AnalysisScope describes how much of the graph an analysis run has to post-process
+type AnalysisScope struct {
+	// Full rebuilds every post-processed relationship regardless of DomainSIDs
+	Full bool
+	// DomainSIDs lists the Active Directory domains changed since the last analysis
+	DomainSIDs []string
+}
+
+// FullAnalysisScope returns a scope that rebuilds the entire graph
+func FullAnalysisScope() AnalysisScope {
+	return AnalysisScope{Full: true}
+}
+
+// NewAnalysisScope derives the analysis scope from the ingest jobs awaiting analysis. Any job that changed data
+// spanning domains forces a full rebuild, as does the absence of jobs.
+func NewAnalysisScope(jobs []model.IngestJob) AnalysisScope {
+	if len(jobs) == 0 {
+		return FullAnalysisScope()
+	}
+
+	var scope AnalysisScope
+
+	for _, job := range jobs {
+		if job.CrossDomain {
+			return FullAnalysisScope()
+		}
+
+		scope.DomainSIDs = append(scope.DomainSIDs, job.DomainSIDs...)
+	}
+
+	slices.Sort(scope.DomainSIDs)
+	scope.DomainSIDs = slices.Compact(scope.DomainSIDs)
+
+	return scope
+}
+
+// BeginAnalysisScope records that a scoped analysis run is in progress. Scoped runs delete the post-processed
+// relationships of their domains before rebuilding them, so the scope is widened to a full rebuild when the previous
+// scoped run did not complete.
+func BeginAnalysisScope(ctx context.Context, db database.DatapipeStatusData, scope AnalysisScope) (AnalysisScope, error) {
+	if scope.Full {
+		return scope, nil
+	} else if status, err := db.GetDatapipeStatus(ctx); err != nil {
+		return scope, fmt.Errorf("looking up datapipe status: %w", err)
+	} else if status.FullAnalysisRequired {
+		slog.InfoContext(ctx, "Previous scoped analysis did not complete; rebuilding the entire graph")
+		return FullAnalysisScope(), nil
+	} else if err := db.SetFullAnalysisRequired(ctx, true); err != nil {
+		return scope, fmt.Errorf("marking scoped analysis in progress: %w", err)
+	}
+
+	return scope, nil
+}
+
+func RunAnalysisOperations(ctx context.Context, db database.Database, graphDB graph.Database, cfg config.Configuration, dogTags dogtags.Service) error {
+	return RunScopedAnalysisOperations(ctx, db, graphDB, cfg, dogTags, FullAnalysisScope())
+}
+
 // TODO Cleanup tieringEnabled after Tiering GA
-func RunAnalysisOperations(ctx context.Context, db database.Database, graphDB graph.Database, _ config.Configuration) error {
+func RunScopedAnalysisOperations(ctx context.Context, db database.Database, graphDB graph.Database, _ config.Configuration, dogTags dogtags.Service, scope AnalysisScope) error {
 	var (
 		collectedErrors      []error
 		compositionIdCounter = analysis.NewCompositionCounter()
 		tieringEnabled       = appcfg.GetTieringEnabled(ctx, db)
+		postProcessing       = appcfg.GetPostProcessingParameter(ctx, db)
+		startedAt            = time.Now().UTC()
+		steps                analysisStepRecorder
+		result               error
 	)
 
 	var (
@@ -58,49 +124,94 @@ func RunAnalysisOperations(ctx context.Context, db database.Database, graphDB gr
 		collectedErrors = append(collectedErrors, fmt.Errorf("error retrieving ADCS feature flag: %w", err))
 	} else if ntlmFlag, err := db.GetFlagByKey(ctx, appcfg.FeatureNTLMPostProcessing); err != nil {
 		collectedErrors = append(collectedErrors, fmt.Errorf("error retrieving NTLM Post Processing feature flag: %w", err))
-	} else if stats, err := ad.Post(ctx, graphDB, adcsFlag.Enabled, appcfg.GetCitrixRDPSupport(ctx, db), ntlmFlag.Enabled, &compositionIdCounter); err != nil {
+	} else if !scope.Full && len(scope.DomainSIDs) == 0 {
+		slog.InfoContext(ctx, "Skipping Active Directory post-processing as no Active Directory data changed")
+		steps.Skip(analysisStepADPostProcessing)
+
+		// Post-processors disabled since the last run must not leave their relationships behind until AD data changes
+		if _, err := ad.DeleteDisabledRelationships(ctx, graphDB, postProcessing.DisabledPostProcessors); err != nil {
+			collectedErrors = append(collectedErrors, fmt.Errorf("error deleting relationships of disabled ad post-processors: %w", err))
+		}
+	} else if err := steps.RunPostProcessing(analysisStepADPostProcessing, func() (*analysis.AtomicPostProcessingStats, error) {
+		return ad.Post(ctx, graphDB, adcsFlag.Enabled, appcfg.GetCitrixRDPSupport(ctx, db), ntlmFlag.Enabled, &compositionIdCounter, scopedDomainSIDs(scope), postProcessing.DisabledPostProcessors, &steps)
+	}); err != nil {
 		collectedErrors = append(collectedErrors, fmt.Errorf("error during ad post: %w", err))
 		adFailed = true
-	} else {
-		stats.LogStats()
 	}
 
-	if stats, err := azure.Post(ctx, graphDB); err != nil {
+	if err := steps.RunPostProcessing(analysisStepAzurePostProcessing, func() (*analysis.AtomicPostProcessingStats, error) {
+		return azure.Post(ctx, graphDB, postProcessing.DisabledPostProcessors, &steps)
+	}); err != nil {
 		collectedErrors = append(collectedErrors, fmt.Errorf("error during azure post: %w", err))
 		azureFailed = true
-	} else {
-		stats.LogStats()
 	}
 
-	if errs := TagAssetGroupsAndTierZero(ctx, db, graphDB); len(errs) > 0 {
-		for _, err := range errs {
-			collectedErrors = append(collectedErrors, fmt.Errorf("tagging asset groups and tier zero failed: %w", err))
-		}
+	if err := steps.Run(analysisStepTagAssetGroups, func() error {
+		return errors.Join(TagAssetGroupsAndTierZero(ctx, db, graphDB)...)
+	}); err != nil {
+		collectedErrors = append(collectedErrors, fmt.Errorf("tagging asset groups and tier zero failed: %w", err))
+	}
+
+	if err := steps.Run(analysisStepAttackPathFindings, func() error {
+		return RunAttackPathFindings(ctx, db, graphDB, tieringEnabled, time.Now().UTC())
+	}); err != nil {
+		collectedErrors = append(collectedErrors, fmt.Errorf("attack path findings failed: %w", err))
 	}
 
-	if !tieringEnabled {
-		if err := agi.RunAssetGroupIsolationCollections(ctx, db, graphDB, analysis.GetNodeKindDisplayLabel); err != nil {
-			collectedErrors = append(collectedErrors, fmt.Errorf("asset group isolation collection failed: %w", err))
-			agiFailed = true
+	if !tieringEnabled || !dogTags.GetFlagAsBool(dogtags.PZ_MULTI_TIER_ANALYSIS) {
+		steps.Skip(analysisStepTierViolations)
+
+		// Violations found before multi-tier analysis was turned off are no longer maintained and must not be served
+		if err := db.SaveAssetGroupTagViolations(ctx, nil); err != nil {
+			collectedErrors = append(collectedErrors, fmt.Errorf("clearing tier violations failed: %w", err))
 		}
+	} else if err := steps.Run(analysisStepTierViolations, func() error {
+		return RunTierViolationAnalysis(ctx, db, graphDB, int(dogTags.GetFlagAsInt(dogtags.PZ_TIER_LIMIT)), time.Now().UTC())
+	}); err != nil {
+		collectedErrors = append(collectedErrors, fmt.Errorf("tier violation analysis failed: %w", err))
 	}
 
-	if err := dataquality.SaveDataQuality(ctx, db, graphDB); err != nil {
+	if tieringEnabled {
+		steps.Skip(analysisStepAssetGroupIsolation)
+	} else if err := steps.Run(analysisStepAssetGroupIsolation, func() error {
+		return agi.RunAssetGroupIsolationCollections(ctx, db, graphDB, analysis.GetNodeKindDisplayLabel)
+	}); err != nil {
+		collectedErrors = append(collectedErrors, fmt.Errorf("asset group isolation collection failed: %w", err))
+		agiFailed = true
+	}
+
+	if err := steps.Run(analysisStepDataQuality, func() error {
+		return dataquality.SaveDataQuality(ctx, db, graphDB)
+	}); err != nil {
 		collectedErrors = append(collectedErrors, fmt.Errorf("error saving data quality stat: %v", err))
 		dataQualityFailed = true
 	}
 
+	if adFailed && azureFailed && agiFailed && dataQualityFailed {
+		result = ErrAnalysisFailed
+	} else if adFailed || azureFailed || agiFailed || dataQualityFailed {
+		result = ErrAnalysisPartiallyCompleted
+	}
+
+	// The run is recorded last so that its status and steps cover every stage of the analysis
+	if err := RecordAnalysisRun(ctx, db, graphDB, tieringEnabled, startedAt, analysisRunStatus(result), steps.Steps()); err != nil {
+		collectedErrors = append(collectedErrors, fmt.Errorf("recording analysis run failed: %w", err))
+	}
+
 	if len(collectedErrors) > 0 {
 		for _, err := range collectedErrors {
 			slog.ErrorContext(ctx, fmt.Sprintf("Analysis error encountered: %v", err))
 		}
 	}
 
-	if adFailed && azureFailed && agiFailed && dataQualityFailed {
-		return ErrAnalysisFailed
-	} else if adFailed || azureFailed || agiFailed || dataQualityFailed {
-		return ErrAnalysisPartiallyCompleted
+	return result
+}
+
+// scopedDomainSIDs returns the domains AD post-processing is limited to. No domains means a full rebuild.
+func scopedDomainSIDs(scope AnalysisScope) []string {
+	if scope.Full {
+		return nil
 	}
 
-	return nil
+	return scope.DomainSIDs
 }
@@ -0,0 +1,116 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/daemons/datapipe"
+	"github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewAnalysisScope(t *testing.T) {
+	const (
+		domainA = "S-1-5-21-1000000000-1000000000-1000000000"
+		domainB = "S-1-5-21-2000000000-2000000000-2000000000"
+	)
+
+	t.Run("no jobs rebuilds everything", func(t *testing.T) {
+		require.Equal(t, datapipe.FullAnalysisScope(), datapipe.NewAnalysisScope(nil))
+	})
+
+	t.Run("domains are merged across jobs", func(t *testing.T) {
+		scope := datapipe.NewAnalysisScope([]model.IngestJob{
+			{DomainSIDs: []string{domainB}},
+			{DomainSIDs: []string{domainA, domainB}},
+		})
+
+		require.False(t, scope.Full)
+		require.Equal(t, []string{domainA, domainB}, scope.DomainSIDs)
+	})
+
+	t.Run("jobs without AD changes produce an empty scope", func(t *testing.T) {
+		scope := datapipe.NewAnalysisScope([]model.IngestJob{{}})
+
+		require.False(t, scope.Full)
+		require.Empty(t, scope.DomainSIDs)
+	})
+
+	t.Run("cross-domain changes rebuild everything", func(t *testing.T) {
+		scope := datapipe.NewAnalysisScope([]model.IngestJob{
+			{DomainSIDs: []string{domainA}},
+			{DomainSIDs: []string{domainB}, CrossDomain: true},
+		})
+
+		require.Equal(t, datapipe.FullAnalysisScope(), scope)
+	})
+}
+
+func TestBeginAnalysisScope(t *testing.T) {
+	var (
+		ctx         = context.Background()
+		scopedRun   = datapipe.AnalysisScope{DomainSIDs: []string{"S-1-5-21-1000000000-1000000000-1000000000"}}
+		idleStatus  = model.DatapipeStatusWrapper{Status: model.DatapipeStatusIdle}
+		statusError = errors.New("error")
+	)
+
+	t.Run("full rebuilds are not recorded", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(gomock.NewController(t))
+
+		scope, err := datapipe.BeginAnalysisScope(ctx, mockDB, datapipe.FullAnalysisScope())
+		require.NoError(t, err)
+		require.Equal(t, datapipe.FullAnalysisScope(), scope)
+	})
+
+	t.Run("scoped runs are recorded as in progress", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(gomock.NewController(t))
+
+		mockDB.EXPECT().GetDatapipeStatus(gomock.Any()).Return(idleStatus, nil)
+		mockDB.EXPECT().SetFullAnalysisRequired(gomock.Any(), true).Return(nil)
+
+		scope, err := datapipe.BeginAnalysisScope(ctx, mockDB, scopedRun)
+		require.NoError(t, err)
+		require.Equal(t, scopedRun, scope)
+	})
+
+	t.Run("an incomplete scoped run forces a full rebuild", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(gomock.NewController(t))
+
+		mockDB.EXPECT().GetDatapipeStatus(gomock.Any()).Return(model.DatapipeStatusWrapper{
+			Status:               model.DatapipeStatusIdle,
+			FullAnalysisRequired: true,
+		}, nil)
+
+		scope, err := datapipe.BeginAnalysisScope(ctx, mockDB, scopedRun)
+		require.NoError(t, err)
+		require.Equal(t, datapipe.FullAnalysisScope(), scope)
+	})
+
+	t.Run("status errors are returned", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(gomock.NewController(t))
+
+		mockDB.EXPECT().GetDatapipeStatus(gomock.Any()).Return(model.DatapipeStatusWrapper{}, statusError)
+
+		_, err := datapipe.BeginAnalysisScope(ctx, mockDB, scopedRun)
+		require.ErrorIs(t, err, statusError)
+	})
+}
@@ -0,0 +1,287 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/analysis/tiering"
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/dawgs/cardinality"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+)
+
+const (
+	// analysisRunSnapshotBatchSize bounds the number of nodes fetched or expanded by a single query while taking a
+	// snapshot
+	analysisRunSnapshotBatchSize = 10_000
+
+	// tierZeroPathLimit bounds the number of principals collected while walking paths into tier zero. Snapshots that
+	// hit the limit are marked as truncated.
+	tierZeroPathLimit = 1_000_000
+)
+
+type analysisRunDB interface {
+	CreateAnalysisRun(ctx context.Context, run model.AnalysisRun, steps []model.AnalysisRunStep, snapshot []byte) (model.AnalysisRun, error)
+}
+
+// analysisRunStatus maps the result of RunScopedAnalysisOperations to the status recorded for the run
+func analysisRunStatus(err error) model.AnalysisRunStatus {
+	switch {
+	case err == nil:
+		return model.AnalysisRunStatusComplete
+	case errors.Is(err, ErrAnalysisPartiallyCompleted):
+		return model.AnalysisRunStatusPartial
+	default:
+		return model.AnalysisRunStatusFailed
+	}
+}
+
+// RecordAnalysisRun snapshots the post-processed edges and tier zero membership left in the graph by an analysis run
+// and stores them, along with the run's status and steps, so that the run can later be inspected and diffed against
+// other runs
+func RecordAnalysisRun(ctx context.Context, db analysisRunDB, graphDB graph.Database, tieringEnabled bool, startedAt time.Time, status model.AnalysisRunStatus, steps []model.AnalysisRunStep) error {
+	defer measure.ContextMeasureWithThreshold(ctx, slog.LevelInfo, "Record Analysis Run")()
+
+	if snapshot, err := SnapshotAnalysisRun(ctx, graphDB, tieringEnabled); err != nil {
+		return err
+	} else if encoded, err := snapshot.Encode(); err != nil {
+		return err
+	} else if _, err := db.CreateAnalysisRun(ctx, model.AnalysisRun{
+		StartedAt:              startedAt,
+		CompletedAt:            time.Now().UTC(),
+		Status:                 status,
+		PostProcessedEdgeCount: len(snapshot.Edges),
+		TierZeroCount:          len(snapshot.TierZero),
+		TierZeroPathCount:      len(snapshot.TierZeroPaths),
+	}, steps, encoded); err != nil {
+		return fmt.Errorf("saving analysis run: %w", err)
+	}
+
+	return nil
+}
+
+// SnapshotAnalysisRun collects every post-processed relationship, the members of tier zero and the principals with
+// an attack path into tier zero. Graph elements are identified by object id so that snapshots remain comparable after
+// post-processed relationships have been recreated.
+//
+// Relationships are streamed by kind and only the object and environment ids of their endpoints are kept, so that
+// the cost of a snapshot is bounded by the snapshot itself rather than by the properties of the graph.
+func SnapshotAnalysisRun(ctx context.Context, graphDB graph.Database, tieringEnabled bool) (model.AnalysisRunSnapshot, error) {
+	var snapshot model.AnalysisRunSnapshot
+
+	err := graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		nodeIDs := cardinality.NewBitmap64()
+
+		if err := fetchPostProcessedTriples(tx, func(_ graph.Kind, triple graph.RelationshipTripleResult) {
+			nodeIDs.Add(triple.StartID.Uint64(), triple.EndID.Uint64())
+		}); err != nil {
+			return fmt.Errorf("fetching post-processed relationships: %w", err)
+		}
+
+		tierZeroIDs, err := ops.FetchNodeIDs(tx.Nodes().Filterf(func() graph.Criteria {
+			return tiering.SearchTierNodes(tieringEnabled)
+		}))
+		if err != nil {
+			return fmt.Errorf("fetching tier zero nodes: %w", err)
+		}
+
+		tierZeroPathIDs, truncated, err := fetchTierZeroPathNodeIDs(tx, tierZeroIDs)
+		if err != nil {
+			return fmt.Errorf("fetching nodes with a path to tier zero: %w", err)
+		} else if truncated {
+			slog.WarnContext(ctx, "Analysis run snapshot only lists some of the principals with a path to tier zero", slog.Int("limit", tierZeroPathLimit))
+		}
+
+		for _, nodeID := range tierZeroIDs {
+			nodeIDs.Add(nodeID.Uint64())
+		}
+
+		for _, nodeID := range tierZeroPathIDs {
+			nodeIDs.Add(nodeID.Uint64())
+		}
+
+		principals, err := fetchSnapshotPrincipals(tx, nodeIDs)
+		if err != nil {
+			return fmt.Errorf("fetching snapshot nodes: %w", err)
+		}
+
+		if err := fetchPostProcessedTriples(tx, func(kind graph.Kind, triple graph.RelationshipTripleResult) {
+			start, hasStart := principals[triple.StartID]
+			end, hasEnd := principals[triple.EndID]
+
+			if !hasStart || !hasEnd {
+				return
+			}
+
+			environmentID := end.EnvironmentId
+			if environmentID == "" {
+				environmentID = start.EnvironmentId
+			}
+
+			snapshot.Edges = append(snapshot.Edges, model.AnalysisRunEdge{
+				Kind:          kind.String(),
+				EnvironmentId: environmentID,
+				Start:         start.ObjectId,
+				End:           end.ObjectId,
+			})
+		}); err != nil {
+			return fmt.Errorf("fetching post-processed relationships: %w", err)
+		}
+
+		snapshot.TierZero = snapshotPrincipals(principals, tierZeroIDs)
+		snapshot.TierZeroPaths = snapshotPrincipals(principals, tierZeroPathIDs)
+		snapshot.TierZeroPathsTruncated = truncated
+
+		return nil
+	})
+
+	snapshot.Sort()
+	return snapshot, err
+}
+
+// fetchPostProcessedTriples streams the endpoints of every post-processed relationship to delegate, one kind at a time
+func fetchPostProcessedTriples(tx graph.Transaction, delegate func(kind graph.Kind, triple graph.RelationshipTripleResult)) error {
+	for _, kind := range postProcessedRelationshipKinds() {
+		if err := tx.Relationships().Filterf(func() graph.Criteria {
+			return query.Kind(query.Relationship(), kind)
+		}).FetchTriples(func(cursor graph.Cursor[graph.RelationshipTripleResult]) error {
+			for triple := range cursor.Chan() {
+				delegate(kind, triple)
+			}
+
+			return cursor.Error()
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fetchSnapshotPrincipals fetches the given nodes in batches and keeps only the ids a snapshot identifies them by
+func fetchSnapshotPrincipals(tx graph.Transaction, nodeIDs cardinality.Duplex[uint64]) (map[graph.ID]model.AnalysisRunPrincipal, error) {
+	var (
+		principals = make(map[graph.ID]model.AnalysisRunPrincipal, nodeIDs.Cardinality())
+		batch      = make([]graph.ID, 0, analysisRunSnapshotBatchSize)
+	)
+
+	fetchBatch := func() error {
+		defer func() {
+			batch = batch[:0]
+		}()
+
+		return tx.Nodes().Filterf(func() graph.Criteria {
+			return query.InIDs(query.NodeID(), batch...)
+		}).Fetch(func(cursor graph.Cursor[*graph.Node]) error {
+			for node := range cursor.Chan() {
+				principals[node.ID] = model.AnalysisRunPrincipal{
+					EnvironmentId: findingEnvironmentID(node),
+					ObjectId:      findingObjectID(node),
+				}
+			}
+
+			return cursor.Error()
+		})
+	}
+
+	var err error
+
+	nodeIDs.Each(func(nodeID uint64) bool {
+		if batch = append(batch, graph.ID(nodeID)); len(batch) == analysisRunSnapshotBatchSize {
+			err = fetchBatch()
+		}
+
+		return err == nil
+	})
+
+	if err == nil && len(batch) > 0 {
+		err = fetchBatch()
+	}
+
+	return principals, err
+}
+
+func snapshotPrincipals(principals map[graph.ID]model.AnalysisRunPrincipal, nodeIDs []graph.ID) []model.AnalysisRunPrincipal {
+	snapshotted := make([]model.AnalysisRunPrincipal, 0, len(nodeIDs))
+
+	for _, nodeID := range nodeIDs {
+		if principal, found := principals[nodeID]; found {
+			snapshotted = append(snapshotted, principal)
+		}
+	}
+
+	return snapshotted
+}
+
+// fetchTierZeroPathNodeIDs walks traversable relationships inbound from tier zero and returns the nodes outside of
+// tier zero that reach it. The walk stops once tierZeroPathLimit nodes were reached, which is reported as truncated.
+func fetchTierZeroPathNodeIDs(tx graph.Transaction, tierZeroIDs []graph.ID) ([]graph.ID, bool, error) {
+	var (
+		traversableKinds = graph.Kinds(ad.PathfindingRelationships()).Concatenate(azure.PathfindingRelationships())
+		visited          = cardinality.NewBitmap64()
+		frontier         = tierZeroIDs
+		reached          []graph.ID
+	)
+
+	for _, nodeID := range tierZeroIDs {
+		visited.Add(nodeID.Uint64())
+	}
+
+	for len(frontier) > 0 {
+		var next []graph.ID
+
+		for start := 0; start < len(frontier); start += analysisRunSnapshotBatchSize {
+			batch := frontier[start:min(start+analysisRunSnapshotBatchSize, len(frontier))]
+
+			if err := tx.Relationships().Filterf(func() graph.Criteria {
+				return query.And(
+					query.KindIn(query.Relationship(), traversableKinds...),
+					query.InIDs(query.EndID(), batch...),
+				)
+			}).FetchTriples(func(cursor graph.Cursor[graph.RelationshipTripleResult]) error {
+				for triple := range cursor.Chan() {
+					if visited.CheckedAdd(triple.StartID.Uint64()) {
+						next = append(next, triple.StartID)
+					}
+				}
+
+				return cursor.Error()
+			}); err != nil {
+				return nil, false, err
+			}
+
+			if len(reached)+len(next) >= tierZeroPathLimit {
+				return append(reached, next...)[:tierZeroPathLimit], true, nil
+			}
+		}
+
+		reached = append(reached, next...)
+		frontier = next
+	}
+
+	return reached, false, nil
+}
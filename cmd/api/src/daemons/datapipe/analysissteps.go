@@ -0,0 +1,133 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/analysis"
+	"github.com/specterops/dawgs/graph"
+)
+
+const (
+	analysisStepADPostProcessing    = "ad_post_processing"
+	analysisStepAzurePostProcessing = "azure_post_processing"
+	analysisStepTagAssetGroups      = "tag_asset_groups"
+	analysisStepAttackPathFindings  = "attack_path_findings"
+	analysisStepTierViolations      = "tier_violation_analysis"
+	analysisStepAssetGroupIsolation = "asset_group_isolation"
+	analysisStepDataQuality         = "data_quality"
+)
+
+var (
+	// analysisStepDuration remains nil, and step durations go unobserved, until InitializeAnalysisMetrics is called
+	analysisStepDuration *prometheus.HistogramVec
+)
+
+// InitializeAnalysisMetrics registers the analysis step duration histogram with the Prometheus registry
+func InitializeAnalysisMetrics(registerer prometheus.Registerer) error {
+	analysisStepDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "bhe_analysis_step_duration_seconds",
+			Help: "Duration of each analysis step in seconds",
+			// 0.5 seconds up to roughly an hour and a half
+			Buckets: prometheus.ExponentialBuckets(0.5, 2, 14),
+		},
+		[]string{"step", "status"},
+	)
+
+	return registerer.Register(analysisStepDuration)
+}
+
+// analysisStepRecorder times the steps of an analysis run, down to the individual post-processors, and collects their
+// outcome so that they can be stored with the run once it completes
+type analysisStepRecorder struct {
+	steps []model.AnalysisRunStep
+}
+
+// Run executes and records a step that does not post-process relationships
+func (s *analysisStepRecorder) Run(name string, step func() error) error {
+	return s.RunPostProcessing(name, func() (*analysis.AtomicPostProcessingStats, error) {
+		return nil, step()
+	})
+}
+
+// RunPostProcessing executes and records a step along with the relationships it deleted and created. Stats returned
+// alongside an error are still recorded as post-processing may fail part way through.
+func (s *analysisStepRecorder) RunPostProcessing(name string, step func() (*analysis.AtomicPostProcessingStats, error)) error {
+	startedAt := time.Now().UTC()
+	stats, err := step()
+	duration := time.Since(startedAt)
+
+	record := model.AnalysisRunStep{
+		Name:                 name,
+		Status:               model.AnalysisRunStepStatusSucceeded,
+		StartedAt:            startedAt,
+		DurationMs:           duration.Milliseconds(),
+		RelationshipsCreated: model.AnalysisRunRelationshipCounts{},
+		RelationshipsDeleted: model.AnalysisRunRelationshipCounts{},
+	}
+
+	if stats != nil {
+		stats.LogStats()
+
+		record.RelationshipsCreated = relationshipCounts(stats.RelationshipsCreated)
+		record.RelationshipsDeleted = relationshipCounts(stats.RelationshipsDeleted)
+	}
+
+	if err != nil {
+		record.Status = model.AnalysisRunStepStatusFailed
+		record.Error = err.Error()
+	}
+
+	s.steps = append(s.steps, record)
+
+	if analysisStepDuration != nil {
+		analysisStepDuration.WithLabelValues(name, string(record.Status)).Observe(duration.Seconds())
+	}
+
+	return err
+}
+
+// Skip records a step that was not run
+func (s *analysisStepRecorder) Skip(name string) {
+	s.steps = append(s.steps, model.AnalysisRunStep{
+		Name:                 name,
+		Status:               model.AnalysisRunStepStatusSkipped,
+		StartedAt:            time.Now().UTC(),
+		RelationshipsCreated: model.AnalysisRunRelationshipCounts{},
+		RelationshipsDeleted: model.AnalysisRunRelationshipCounts{},
+	})
+}
+
+func (s *analysisStepRecorder) Steps() []model.AnalysisRunStep {
+	return s.steps
+}
+
+func relationshipCounts(stats map[graph.Kind]*int32) model.AnalysisRunRelationshipCounts {
+	counts := make(model.AnalysisRunRelationshipCounts, len(stats))
+
+	for kind, count := range stats {
+		if count != nil && *count > 0 {
+			counts[kind.String()] = int(*count)
+		}
+	}
+
+	return counts
+}
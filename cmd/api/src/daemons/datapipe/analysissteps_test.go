@@ -0,0 +1,120 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/analysis"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalysisStepRecorder(t *testing.T) {
+	var (
+		recorder  analysisStepRecorder
+		stepError = errors.New("post-processing failed")
+	)
+
+	require.NoError(t, recorder.RunPostProcessing(analysisStepADPostProcessing, func() (*analysis.AtomicPostProcessingStats, error) {
+		stats := analysis.NewAtomicPostProcessingStats()
+		stats.AddRelationshipsDeleted(ad.DCSync, 2)
+		stats.AddRelationshipsCreated(ad.DCSync, 3)
+		stats.AddRelationshipsCreated(ad.CanRDP, 0)
+
+		return &stats, nil
+	}))
+
+	// Stats gathered before a failure are kept
+	require.ErrorIs(t, recorder.RunPostProcessing(analysisStepAzurePostProcessing, func() (*analysis.AtomicPostProcessingStats, error) {
+		stats := analysis.NewAtomicPostProcessingStats()
+		stats.AddRelationshipsDeleted(ad.DCSync, 1)
+
+		return &stats, stepError
+	}), stepError)
+
+	require.NoError(t, recorder.Run(analysisStepDataQuality, func() error { return nil }))
+	recorder.Skip(analysisStepTierViolations)
+
+	steps := recorder.Steps()
+	require.Len(t, steps, 4)
+
+	require.Equal(t, analysisStepADPostProcessing, steps[0].Name)
+	require.Equal(t, model.AnalysisRunStepStatusSucceeded, steps[0].Status)
+	require.Equal(t, model.AnalysisRunRelationshipCounts{ad.DCSync.String(): 3}, steps[0].RelationshipsCreated)
+	require.Equal(t, model.AnalysisRunRelationshipCounts{ad.DCSync.String(): 2}, steps[0].RelationshipsDeleted)
+
+	require.Equal(t, model.AnalysisRunStepStatusFailed, steps[1].Status)
+	require.Equal(t, stepError.Error(), steps[1].Error)
+	require.Empty(t, steps[1].RelationshipsCreated)
+	require.Equal(t, model.AnalysisRunRelationshipCounts{ad.DCSync.String(): 1}, steps[1].RelationshipsDeleted)
+
+	require.Equal(t, model.AnalysisRunStepStatusSucceeded, steps[2].Status)
+	require.NotNil(t, steps[2].RelationshipsCreated)
+
+	require.Equal(t, analysisStepTierViolations, steps[3].Name)
+	require.Equal(t, model.AnalysisRunStepStatusSkipped, steps[3].Status)
+	require.Zero(t, steps[3].DurationMs)
+}
+
+func TestAnalysisStepRecorder_PostProcessors(t *testing.T) {
+	var (
+		recorder     analysisStepRecorder
+		newProcessor = func(name string, dependsOn ...string) analysis.PostProcessor[struct{}] {
+			return analysis.PostProcessor[struct{}]{
+				Name:          name,
+				DependsOn:     dependsOn,
+				Relationships: graph.Kinds{ad.DCSync},
+				Run: func(ctx context.Context, db graph.Database, _ struct{}) (*analysis.AtomicPostProcessingStats, error) {
+					stats := analysis.NewAtomicPostProcessingStats()
+					stats.AddRelationshipsCreated(ad.DCSync, 1)
+
+					return &stats, nil
+				},
+			}
+		}
+	)
+
+	registry, err := analysis.NewPostProcessorRegistry(newProcessor("ad.dcsync"), newProcessor("ad.owns"), newProcessor("ad.ntlm", "ad.owns"))
+	require.NoError(t, err)
+
+	_, err = registry.Run(context.Background(), nil, struct{}{}, registry.Plan([]string{"ad.owns"}), &recorder)
+	require.NoError(t, err)
+
+	steps := recorder.Steps()
+	require.Len(t, steps, 3)
+
+	require.Equal(t, "ad.dcsync", steps[0].Name)
+	require.Equal(t, model.AnalysisRunStepStatusSucceeded, steps[0].Status)
+	require.Equal(t, model.AnalysisRunRelationshipCounts{ad.DCSync.String(): 1}, steps[0].RelationshipsCreated)
+
+	// Disabled post-processors and those depending on them are recorded as skipped
+	require.Equal(t, "ad.owns", steps[1].Name)
+	require.Equal(t, model.AnalysisRunStepStatusSkipped, steps[1].Status)
+	require.Equal(t, "ad.ntlm", steps[2].Name)
+	require.Equal(t, model.AnalysisRunStepStatusSkipped, steps[2].Status)
+}
+
+func TestAnalysisRunStatus(t *testing.T) {
+	require.Equal(t, model.AnalysisRunStatusComplete, analysisRunStatus(nil))
+	require.Equal(t, model.AnalysisRunStatusPartial, analysisRunStatus(ErrAnalysisPartiallyCompleted))
+	require.Equal(t, model.AnalysisRunStatusFailed, analysisRunStatus(ErrAnalysisFailed))
+}
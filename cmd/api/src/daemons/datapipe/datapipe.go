@@ -43,6 +43,8 @@ type Pipeline interface {
 	PruneData(context.Context) error
 	// DeleteData provides a way to handle requests for database table/graph deletion
 	DeleteData(context.Context) error
+	// Reconcile provides a way to remove graph data that collectors have stopped reporting
+	Reconcile(context.Context) error
 	// IngestTasks provides a way to ingest previously uploaded files
 	IngestTasks(context.Context) error
 	// Analyze provides a way to analyze and enhance graph data, including post processing
@@ -54,6 +56,7 @@ type Daemon struct {
 	tickInterval time.Duration
 	pipeline     Pipeline
 	db           database.Database
+	startedAt    time.Time
 }
 
 func (s *Daemon) Name() string {
@@ -78,6 +81,8 @@ func (s *Daemon) Start(ctx context.Context) {
 	defer datapipeLoopTimer.Stop()
 	defer pruningTicker.Stop()
 
+	s.startedAt = time.Now().UTC()
+
 	s.WithDatapipeStatus(ctx, model.DatapipeStatusStarting, s.pipeline.Start)
 
 	for {
@@ -85,11 +90,15 @@ func (s *Daemon) Start(ctx context.Context) {
 		case <-pruningTicker.C:
 			s.WithDatapipeStatus(ctx, model.DatapipeStatusPruning, s.pipeline.PruneData)
 
+			s.WithDatapipeStatus(ctx, model.DatapipeStatusReconciling, s.pipeline.Reconcile)
+
 		case <-datapipeLoopTimer.C:
 			s.WithDatapipeStatus(ctx, model.DatapipeStatusPurging, s.pipeline.DeleteData)
 
 			s.WithDatapipeStatus(ctx, model.DatapipeStatusIngesting, s.pipeline.IngestTasks)
 
+			s.requestScheduledAnalysis(ctx)
+
 			s.WithDatapipeStatus(ctx, model.DatapipeStatusAnalyzing, s.pipeline.Analyze)
 
 			datapipeLoopTimer.Reset(s.tickInterval)
@@ -100,6 +109,16 @@ func (s *Daemon) Start(ctx context.Context) {
 	}
 }
 
+// requestScheduledAnalysis queues an analysis request if a scheduled analysis run has come due. The request is
+// picked up by the analysis stage that follows it.
+func (s *Daemon) requestScheduledAnalysis(ctx context.Context) {
+	if active, pipelineContext := s.pipeline.IsPrimary(ctx, model.DatapipeStatusAnalyzing); !active {
+		return
+	} else if err := RequestScheduledAnalysis(pipelineContext, s.db, s.startedAt, time.Now().UTC()); err != nil {
+		slog.ErrorContext(pipelineContext, "Failed to evaluate analysis schedule", attr.Error(err))
+	}
+}
+
 func (s *Daemon) Stop(ctx context.Context) error {
 	return nil
 }
@@ -114,6 +133,12 @@ func (s *Daemon) WithDatapipeStatus(ctx context.Context, status model.DatapipeSt
 	}
 
 	defer func() {
+		// If primary status was lost during the action, another instance now owns the datapipe status
+		if pipelineContext.Err() != nil {
+			slog.WarnContext(ctx, "Datapipe action interrupted", slog.String("datapipe_status", string(status)), attr.Error(pipelineContext.Err()))
+			return
+		}
+
 		if err := s.db.SetDatapipeStatus(pipelineContext, model.DatapipeStatusIdle); err != nil {
 			slog.ErrorContext(pipelineContext, "Error setting datapipe status to idle", attr.Error(err))
 		}
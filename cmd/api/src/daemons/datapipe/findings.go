@@ -0,0 +1,292 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/analysis"
+	"github.com/specterops/bloodhound/packages/go/analysis/tiering"
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+	"github.com/specterops/bloodhound/packages/go/graphschema"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/query"
+)
+
+type attackPathFindingsDB interface {
+	GetSchemaFindings(ctx context.Context, filters model.Filters) ([]model.SchemaFinding, error)
+	GetOrderedAssetGroupTagTiers(ctx context.Context) ([]model.AssetGroupTag, error)
+	GetEnvironments(ctx context.Context) ([]model.SchemaEnvironment, error)
+	GetSourceKindsByIDs(ctx context.Context, ids ...int32) ([]database.SourceKind, error)
+	GetDisplayNodeGraphKinds(ctx context.Context) (map[graph.Kind]bool, error)
+	SaveAttackPathFindings(ctx context.Context, schemaFindingId int32, assetGroupTagId int, observations []model.AttackPathFindingObservation, evaluatedAt time.Time) error
+}
+
+// RunAttackPathFindings evaluates every registered schema finding against the graph and records the matches under
+// the asset group tag they were found for. Relationship findings are evaluated once per tier and match relationships
+// that reach into the tier from a principal outside of it or any tier above it. List findings are tiering agnostic,
+// match every node of the finding's kind and are recorded as hygiene findings.
+func RunAttackPathFindings(ctx context.Context, db attackPathFindingsDB, graphDB graph.Database, tieringEnabled bool, evaluatedAt time.Time) error {
+	defer measure.ContextMeasureWithThreshold(ctx, slog.LevelInfo, "Attack Path Findings")()
+
+	if findings, err := db.GetSchemaFindings(ctx, model.Filters{}); err != nil {
+		return fmt.Errorf("fetching schema findings: %w", err)
+	} else if len(findings) == 0 {
+		return nil
+	} else if tiers, err := db.GetOrderedAssetGroupTagTiers(ctx); err != nil {
+		return fmt.Errorf("fetching tiers: %w", err)
+	} else if sourceKinds, err := fetchEnvironmentSourceKinds(ctx, db); err != nil {
+		return fmt.Errorf("fetching environment source kinds: %w", err)
+	} else if validPrimaryKinds, err := db.GetDisplayNodeGraphKinds(ctx); err != nil {
+		return fmt.Errorf("fetching display kinds: %w", err)
+	} else {
+		var (
+			errs     []error
+			tierCtxs = newFindingTierContexts(tieringEnabled, tiers)
+		)
+
+		for _, finding := range findings {
+			evaluator := findingEvaluator{
+				finding:           finding,
+				sourceKind:        sourceKinds[finding.EnvironmentId],
+				validPrimaryKinds: validPrimaryKinds,
+			}
+
+			switch finding.Type {
+			case model.SchemaFindingTypeRelationship:
+				for _, tierCtx := range tierCtxs {
+					if observations, err := evaluator.relationshipObservations(ctx, graphDB, tierCtx); err != nil {
+						errs = append(errs, fmt.Errorf("evaluating finding %s for tier %d: %w", finding.Name, tierCtx.PrimaryTierID, err))
+					} else if err := db.SaveAttackPathFindings(ctx, finding.ID, tierCtx.PrimaryTierID, observations, evaluatedAt); err != nil {
+						errs = append(errs, fmt.Errorf("saving finding %s for tier %d: %w", finding.Name, tierCtx.PrimaryTierID, err))
+					}
+				}
+
+			case model.SchemaFindingTypeList:
+				if observations, err := evaluator.listObservations(ctx, graphDB); err != nil {
+					errs = append(errs, fmt.Errorf("evaluating finding %s: %w", finding.Name, err))
+				} else if err := db.SaveAttackPathFindings(ctx, finding.ID, model.AssetGroupTierHygienePlaceholderId, observations, evaluatedAt); err != nil {
+					errs = append(errs, fmt.Errorf("saving finding %s: %w", finding.Name, err))
+				}
+
+			default:
+				slog.WarnContext(ctx, fmt.Sprintf("Skipping finding %s with unknown type %d", finding.Name, finding.Type))
+			}
+		}
+
+		return errors.Join(errs...)
+	}
+}
+
+// newFindingTierContexts builds the search context of every tier findings are evaluated for. A tier's context
+// excludes principals belonging to the tier itself or any higher tier. Without tiering only tier zero is evaluated.
+func newFindingTierContexts(tieringEnabled bool, tiers []model.AssetGroupTag) []tiering.SearchTierNodesCtx {
+	var (
+		tierCtxs  []tiering.SearchTierNodesCtx
+		tierKinds graph.Kinds
+	)
+
+	for _, tier := range tiers {
+		tierKinds = append(tierKinds, tier.ToKind())
+
+		if tier.IsTierZero() {
+			tierCtxs = append(tierCtxs, tiering.NewSearchTierNodesCtx(tieringEnabled, true, tier.ID, tier.ToKind(), tierKinds...))
+		} else if tieringEnabled && tier.AnalysisEnabled.ValueOrZero() {
+			tierCtxs = append(tierCtxs, tiering.NewSearchTierNodesCtx(tieringEnabled, false, tier.ID, tier.ToKind(), tierKinds...))
+		}
+	}
+
+	return tierCtxs
+}
+
+// fetchEnvironmentSourceKinds maps schema environment ids to the source kind of the graph data they cover
+func fetchEnvironmentSourceKinds(ctx context.Context, db attackPathFindingsDB) (map[int32]graph.Kind, error) {
+	var sourceKindIDs []int32
+
+	environments, err := db.GetEnvironments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, environment := range environments {
+		sourceKindIDs = append(sourceKindIDs, environment.SourceKindId)
+	}
+
+	sourceKinds, err := db.GetSourceKindsByIDs(ctx, sourceKindIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		sourceKindsByID  = make(map[int32]graph.Kind, len(sourceKinds))
+		environmentKinds = make(map[int32]graph.Kind, len(environments))
+	)
+
+	for _, sourceKind := range sourceKinds {
+		sourceKindsByID[int32(sourceKind.ID)] = sourceKind.Name
+	}
+
+	for _, environment := range environments {
+		if sourceKind, ok := sourceKindsByID[environment.SourceKindId]; ok {
+			environmentKinds[environment.ID] = sourceKind
+		}
+	}
+
+	return environmentKinds, nil
+}
+
+type findingEvaluator struct {
+	finding           model.SchemaFinding
+	sourceKind        graph.Kind
+	validPrimaryKinds graphschema.ValidPrimaryKinds
+}
+
+// sourceCriteria limits the criteria to the graph data of the finding's environment, if known
+func (s findingEvaluator) sourceCriteria(criteria []graph.Criteria, reference graph.Criteria) graph.Criteria {
+	if s.sourceKind != nil {
+		criteria = append(criteria, query.Kind(reference, s.sourceKind))
+	}
+
+	return query.And(criteria...)
+}
+
+func (s findingEvaluator) relationshipObservations(ctx context.Context, graphDB graph.Database, tierCtx tiering.SearchTierNodesCtx) ([]model.AttackPathFindingObservation, error) {
+	var observations []model.AttackPathFindingObservation
+
+	err := graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		var (
+			triples []graph.RelationshipTripleResult
+			nodeIDs []graph.ID
+		)
+
+		if err := tx.Relationships().Filterf(func() graph.Criteria {
+			return s.sourceCriteria([]graph.Criteria{
+				query.Kind(query.Relationship(), s.finding.Kind),
+				tierCtx.SearchPrimaryTierNodesRelEnd,
+				query.Not(tierCtx.SearchTierNodesRel),
+			}, query.End())
+		}).FetchTriples(func(cursor graph.Cursor[graph.RelationshipTripleResult]) error {
+			for triple := range cursor.Chan() {
+				triples = append(triples, triple)
+				nodeIDs = append(nodeIDs, triple.StartID, triple.EndID)
+			}
+
+			return cursor.Error()
+		}); err != nil || len(triples) == 0 {
+			return err
+		}
+
+		nodes, err := fetchFindingNodes(tx, nodeIDs)
+		if err != nil {
+			return err
+		}
+
+		for _, triple := range triples {
+			start, hasStart := nodes[triple.StartID]
+			end, hasEnd := nodes[triple.EndID]
+
+			if !hasStart || !hasEnd {
+				continue
+			}
+
+			observations = append(observations, model.AttackPathFindingObservation{
+				EnvironmentId: findingEnvironmentID(end),
+				FromPrincipal: findingObjectID(start),
+				ToPrincipal:   findingObjectID(end),
+				PrincipalKind: analysis.GetNodeKindDisplayLabel(s.validPrimaryKinds, start),
+			})
+		}
+
+		return nil
+	})
+
+	return observations, err
+}
+
+func (s findingEvaluator) listObservations(ctx context.Context, graphDB graph.Database) ([]model.AttackPathFindingObservation, error) {
+	var observations []model.AttackPathFindingObservation
+
+	err := graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		return tx.Nodes().Filterf(func() graph.Criteria {
+			return s.sourceCriteria([]graph.Criteria{
+				query.Kind(query.Node(), s.finding.Kind),
+			}, query.Node())
+		}).Fetch(func(cursor graph.Cursor[*graph.Node]) error {
+			for node := range cursor.Chan() {
+				observations = append(observations, model.AttackPathFindingObservation{
+					EnvironmentId: findingEnvironmentID(node),
+					FromPrincipal: findingObjectID(node),
+					PrincipalKind: analysis.GetNodeKindDisplayLabel(s.validPrimaryKinds, node),
+				})
+			}
+
+			return cursor.Error()
+		})
+	})
+
+	return observations, err
+}
+
+func fetchFindingNodes(tx graph.Transaction, nodeIDs []graph.ID) (map[graph.ID]*graph.Node, error) {
+	nodes := make(map[graph.ID]*graph.Node, len(nodeIDs))
+
+	return nodes, tx.Nodes().Filterf(func() graph.Criteria {
+		return query.InIDs(query.NodeID(), nodeIDs...)
+	}).Fetch(func(cursor graph.Cursor[*graph.Node]) error {
+		for node := range cursor.Chan() {
+			nodes[node.ID] = node
+		}
+
+		return cursor.Error()
+	})
+}
+
+// findingObjectID returns the object id of a node, falling back to its graph id for nodes without one
+func findingObjectID(node *graph.Node) string {
+	if objectID, err := node.Properties.Get(common.ObjectID.String()).String(); err == nil && objectID != "" {
+		return objectID
+	}
+
+	return node.ID.String()
+}
+
+// findingEnvironmentID returns the id of the environment a node belongs to. Domains and tenants are their own
+// environment.
+func findingEnvironmentID(node *graph.Node) string {
+	for _, property := range []string{ad.DomainSID.String(), azure.TenantID.String()} {
+		if environmentID, err := node.Properties.Get(property).String(); err == nil && environmentID != "" {
+			return environmentID
+		}
+	}
+
+	if node.Kinds.ContainsOneOf(ad.Domain, azure.Tenant) {
+		if objectID, err := node.Properties.Get(common.ObjectID.String()).String(); err == nil {
+			return objectID
+		}
+	}
+
+	return ""
+}
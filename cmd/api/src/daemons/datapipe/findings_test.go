@@ -0,0 +1,126 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/daemons/datapipe"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	dbmocks "github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	graph_mocks "github.com/specterops/bloodhound/cmd/api/src/vendormocks/dawgs/graph"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRunAttackPathFindings(t *testing.T) {
+	var (
+		ctx         = context.Background()
+		evaluatedAt = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		tiers = []model.AssetGroupTag{
+			{ID: 1, Name: "Tier Zero", Type: model.AssetGroupTagTypeTier, Position: null.Int32From(model.AssetGroupTierZeroPosition)},
+			{ID: 2, Name: "Tier One", Type: model.AssetGroupTagTypeTier, Position: null.Int32From(2), AnalysisEnabled: null.BoolFrom(true)},
+			{ID: 3, Name: "Tier Two", Type: model.AssetGroupTagTypeTier, Position: null.Int32From(3), AnalysisEnabled: null.BoolFrom(false)},
+		}
+		environments = []model.SchemaEnvironment{
+			{Serial: model.Serial{ID: 7}, SourceKindId: 1},
+		}
+		relationshipFinding = model.SchemaFinding{ID: 10, Type: model.SchemaFindingTypeRelationship, EnvironmentId: 7, Name: "T0GenericAll", Kind: graph.StringKind("GenericAll")}
+		listFinding         = model.SchemaFinding{ID: 11, Type: model.SchemaFindingTypeList, EnvironmentId: 7, Name: "Kerberoastable", Kind: graph.StringKind("User")}
+
+		expectSetup = func(mockDB *dbmocks.MockDatabase, findings ...model.SchemaFinding) {
+			mockDB.EXPECT().GetSchemaFindings(ctx, model.Filters{}).Return(findings, nil)
+			mockDB.EXPECT().GetOrderedAssetGroupTagTiers(ctx).Return(tiers, nil)
+			mockDB.EXPECT().GetEnvironments(ctx).Return(environments, nil)
+			mockDB.EXPECT().GetSourceKindsByIDs(ctx, int32(1)).Return([]database.SourceKind{{ID: 1, Name: graph.StringKind("Base")}}, nil)
+			mockDB.EXPECT().GetDisplayNodeGraphKinds(ctx).Return(map[graph.Kind]bool{}, nil)
+		}
+	)
+
+	t.Run("no registered findings", func(t *testing.T) {
+		var (
+			mockCtrl  = gomock.NewController(t)
+			mockDB    = dbmocks.NewMockDatabase(mockCtrl)
+			mockGraph = graph_mocks.NewMockDatabase(mockCtrl)
+		)
+
+		mockDB.EXPECT().GetSchemaFindings(ctx, model.Filters{}).Return(nil, nil)
+
+		require.NoError(t, datapipe.RunAttackPathFindings(ctx, mockDB, mockGraph, true, evaluatedAt))
+	})
+
+	t.Run("relationship findings are saved per analyzed tier", func(t *testing.T) {
+		var (
+			mockCtrl  = gomock.NewController(t)
+			mockDB    = dbmocks.NewMockDatabase(mockCtrl)
+			mockGraph = graph_mocks.NewMockDatabase(mockCtrl)
+		)
+
+		expectSetup(mockDB, relationshipFinding, listFinding)
+
+		mockGraph.EXPECT().ReadTransaction(ctx, gomock.Any()).Return(nil).Times(3)
+		mockDB.EXPECT().SaveAttackPathFindings(ctx, relationshipFinding.ID, 1, gomock.Any(), evaluatedAt).Return(nil)
+		mockDB.EXPECT().SaveAttackPathFindings(ctx, relationshipFinding.ID, 2, gomock.Any(), evaluatedAt).Return(nil)
+		mockDB.EXPECT().SaveAttackPathFindings(ctx, listFinding.ID, model.AssetGroupTierHygienePlaceholderId, gomock.Any(), evaluatedAt).Return(nil)
+
+		require.NoError(t, datapipe.RunAttackPathFindings(ctx, mockDB, mockGraph, true, evaluatedAt))
+	})
+
+	t.Run("only tier zero is analyzed without tiering", func(t *testing.T) {
+		var (
+			mockCtrl  = gomock.NewController(t)
+			mockDB    = dbmocks.NewMockDatabase(mockCtrl)
+			mockGraph = graph_mocks.NewMockDatabase(mockCtrl)
+		)
+
+		expectSetup(mockDB, relationshipFinding)
+
+		mockGraph.EXPECT().ReadTransaction(ctx, gomock.Any()).Return(nil)
+		mockDB.EXPECT().SaveAttackPathFindings(ctx, relationshipFinding.ID, 1, gomock.Any(), evaluatedAt).Return(nil)
+
+		require.NoError(t, datapipe.RunAttackPathFindings(ctx, mockDB, mockGraph, false, evaluatedAt))
+	})
+
+	t.Run("failed evaluations are not saved and do not stop other findings", func(t *testing.T) {
+		var (
+			mockCtrl  = gomock.NewController(t)
+			mockDB    = dbmocks.NewMockDatabase(mockCtrl)
+			mockGraph = graph_mocks.NewMockDatabase(mockCtrl)
+			graphErr  = errors.New("graph unavailable")
+		)
+
+		expectSetup(mockDB, relationshipFinding, listFinding)
+
+		gomock.InOrder(
+			mockGraph.EXPECT().ReadTransaction(ctx, gomock.Any()).Return(graphErr),
+			mockGraph.EXPECT().ReadTransaction(ctx, gomock.Any()).Return(nil).Times(2),
+		)
+		mockDB.EXPECT().SaveAttackPathFindings(ctx, relationshipFinding.ID, 2, gomock.Any(), evaluatedAt).Return(nil)
+		mockDB.EXPECT().SaveAttackPathFindings(ctx, listFinding.ID, model.AssetGroupTierHygienePlaceholderId, gomock.Any(), evaluatedAt).Return(nil)
+
+		err := datapipe.RunAttackPathFindings(ctx, mockDB, mockGraph, true, evaluatedAt)
+		require.ErrorIs(t, err, graphErr)
+		require.ErrorContains(t, err, "T0GenericAll")
+	})
+}
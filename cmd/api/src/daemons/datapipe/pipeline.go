@@ -21,12 +21,17 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/specterops/bloodhound/cmd/api/src/config"
 	"github.com/specterops/bloodhound/cmd/api/src/daemons/changelog"
+	"github.com/specterops/bloodhound/cmd/api/src/daemons/ha"
 	"github.com/specterops/bloodhound/cmd/api/src/database"
 	"github.com/specterops/bloodhound/cmd/api/src/model"
 	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/specterops/bloodhound/cmd/api/src/services/dogtags"
 	"github.com/specterops/bloodhound/cmd/api/src/services/graphify"
 	"github.com/specterops/bloodhound/cmd/api/src/services/job"
 	"github.com/specterops/bloodhound/cmd/api/src/services/upload"
@@ -50,9 +55,17 @@ type BHCEPipeline struct {
 	jobService          job.JobService
 	graphifyService     graphify.GraphifyService
 	changelog           *changelog.Changelog
+	haMutex             ha.HAMutex
+	dogTags             dogtags.Service
+
+	leaderLock    sync.Mutex
+	leaderCtx     context.Context
+	leaderCancel  context.CancelFunc
+	leaderParent  context.Context
+	leaderLockCtx context.Context
 }
 
-func NewPipeline(ctx context.Context, cfg config.Configuration, db database.Database, graphDB graph.Database, cache cache.Cache, ingestSchema upload.IngestSchema, cl *changelog.Changelog) *BHCEPipeline {
+func NewPipeline(ctx context.Context, cfg config.Configuration, db database.Database, graphDB graph.Database, cache cache.Cache, ingestSchema upload.IngestSchema, cl *changelog.Changelog, haMutex ha.HAMutex, dogTags dogtags.Service) *BHCEPipeline {
 	return &BHCEPipeline{
 		db:                  db,
 		graphdb:             graphDB,
@@ -63,6 +76,8 @@ func NewPipeline(ctx context.Context, cfg config.Configuration, db database.Data
 		jobService:          job.NewJobService(ctx, db),
 		graphifyService:     graphify.NewGraphifyService(ctx, db, graphDB, cfg, ingestSchema, cl),
 		changelog:           cl,
+		haMutex:             haMutex,
+		dogTags:             dogTags,
 	}
 }
 
@@ -100,6 +115,75 @@ func (s *BHCEPipeline) DeleteData(ctx context.Context) error {
 	return nil
 }
 
+// Reconcile removes ingested graph data whose lastseen is older than the configured prune TTLs. Each run is audited
+// and analysis is requested afterward whenever anything was removed so that post-processed data is rebuilt.
+func (s *BHCEPipeline) Reconcile(ctx context.Context) error {
+	if !appcfg.GetReconciliationParameter(ctx, s.db) {
+		slog.DebugContext(ctx, "Reconciliation is disabled, skipping stale graph data removal")
+		return nil
+	}
+
+	defer measure.LogAndMeasure(slog.LevelInfo, "Reconcile Graph Data")()
+
+	var (
+		ttl       = appcfg.GetPruneTTLParameters(ctx, s.db)
+		auditData = model.AuditData{
+			"base_ttl":             ttl.BaseTTL.String(),
+			"has_session_edge_ttl": ttl.HasSessionEdgeTTL.String(),
+		}
+	)
+
+	sourceKinds, err := s.db.GetSourceKinds(ctx)
+	if err != nil {
+		return fmt.Errorf("getting source kinds: %w", err)
+	}
+
+	result, reconcileErr := ReconcileGraphData(ctx, s.graphdb, extractKindNames(sourceKinds), ttl, time.Now().UTC())
+	auditData = auditData.MergeLeft(result)
+
+	auditStatus := model.AuditLogStatusSuccess
+	if reconcileErr != nil {
+		auditStatus = model.AuditLogStatusFailure
+	}
+
+	if auditEntry, err := model.NewAuditEntry(model.AuditLogActionReconcileGraphData, auditStatus, auditData); err != nil {
+		slog.ErrorContext(ctx, "Failed to create reconciliation audit entry", attr.Error(err))
+	} else {
+		if reconcileErr != nil {
+			auditEntry.ErrorMsg = reconcileErr.Error()
+		}
+
+		if err := s.db.AppendAuditLog(ctx, auditEntry); err != nil {
+			slog.ErrorContext(ctx, "Failed to write reconciliation audit entry", attr.Error(err))
+		}
+	}
+
+	if reconcileErr != nil {
+		return fmt.Errorf("reconciling graph data: %w", reconcileErr)
+	}
+
+	slog.InfoContext(
+		ctx,
+		"Reconciliation finished",
+		slog.Int("deleted_nodes", result.DeletedNodes),
+		slog.Int("deleted_relationships", result.DeletedRelationships),
+		slog.Int("deleted_has_session_relationships", result.DeletedHasSessionRelationships),
+	)
+
+	if result.Total() > 0 {
+		// Deleted graph elements must be re-submitted on their next ingest rather than deduplicated by the changelog
+		if s.changelog != nil {
+			s.changelog.ClearCache(ctx)
+		}
+
+		if err := s.db.RequestAnalysis(ctx, "reconciliation"); err != nil {
+			return fmt.Errorf("requesting analysis after reconciliation: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func PurgeGraphData(
 	ctx context.Context,
 	deleteRequest model.AnalysisRequest,
@@ -180,10 +264,18 @@ func (s *BHCEPipeline) IngestTasks(ctx context.Context) error {
 // updateJobFunc generates a valid graphify.UpdateJobFunc by injecting the parent context and database interface
 // Only used as a callback, so not exposed
 func updateJobFunc(ctx context.Context, db database.Database) graphify.UpdateJobFunc {
-	return func(jobID int64, fileData []graphify.IngestFileData) {
+	return func(jobID int64, fileData []graphify.IngestFileData, touchedDomains graphify.TouchedDomains, preview *model.IngestPreviewReport) {
 		if job, err := db.GetIngestJob(ctx, jobID); err != nil {
 			slog.ErrorContext(ctx, fmt.Sprintf("Failed to fetch job for ingest task %d: %v", jobID, err))
 		} else {
+			for _, domainSID := range touchedDomains.DomainSIDs {
+				if !slices.Contains(job.DomainSIDs, domainSID) {
+					job.DomainSIDs = append(job.DomainSIDs, domainSID)
+				}
+			}
+
+			job.CrossDomain = job.CrossDomain || touchedDomains.CrossDomain
+
 			for _, file := range fileData {
 				job.TotalFiles += 1
 				completedTask := model.CompletedTask{
@@ -211,20 +303,88 @@ func updateJobFunc(ctx context.Context, db database.Database) graphify.UpdateJob
 			if err = db.UpdateIngestJob(ctx, job); err != nil {
 				slog.ErrorContext(ctx, fmt.Sprintf("Failed to update number of failed files for ingest job ID %d: %v", job.ID, err))
 			}
+
+			if preview != nil {
+				savePreview(ctx, db, job.ID, *preview)
+			}
+		}
+	}
+}
+
+// savePreview adds the preview report of a dry run task to the report of its job
+func savePreview(ctx context.Context, db database.Database, jobID int64, report model.IngestPreviewReport) {
+	if preview, err := db.GetIngestPreview(ctx, jobID); err != nil && !errors.Is(err, database.ErrNotFound) {
+		slog.ErrorContext(ctx, fmt.Sprintf("Failed to fetch preview for ingest job ID %d: %v", jobID, err))
+	} else {
+		if errors.Is(err, database.ErrNotFound) {
+			preview = model.IngestPreview{
+				JobID:  jobID,
+				Report: model.NewIngestPreviewReport(),
+			}
+		}
+
+		preview.Report.Merge(report)
+
+		if err := db.SaveIngestPreview(ctx, preview); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("Failed to save preview for ingest job ID %d: %v", jobID, err))
 		}
 	}
 }
 
-// If the pipeline needs to do anything to the context, this is called before each other pipeline stage
+// If the pipeline needs to do anything to the context, this is called before each other pipeline stage. Only the
+// instance holding the HA lock runs pipeline stages; the returned context is cancelled if that lock is lost or ctx ends.
 func (s *BHCEPipeline) IsPrimary(ctx context.Context, status model.DatapipeStatus) (bool, context.Context) {
-	return true, ctx
+	// Without an HA mutex this is the only instance and therefore always primary
+	if s.haMutex == nil {
+		return true, ctx
+	}
+
+	if lockResult, err := s.haMutex.TryLock(); err != nil {
+		slog.ErrorContext(ctx, "Failed to validate HA election status", slog.String("datapipe_status", string(status)), attr.Error(err))
+		return false, ctx
+	} else if !lockResult.IsPrimary {
+		slog.DebugContext(ctx, "Skipping datapipe stage on non-primary instance", slog.String("datapipe_status", string(status)))
+		return false, ctx
+	} else {
+		return true, s.leaderContext(ctx, lockResult.Context)
+	}
+}
+
+// leaderContext returns a context that ends with either the context of the caller or the context of the HA lock.
+// Stages share the context for as long as both stay the same, so that it is not derived again on every stage.
+func (s *BHCEPipeline) leaderContext(ctx context.Context, lockCtx context.Context) context.Context {
+	s.leaderLock.Lock()
+	defer s.leaderLock.Unlock()
+
+	if s.leaderCtx != nil && s.leaderParent == ctx && s.leaderLockCtx == lockCtx {
+		return s.leaderCtx
+	}
+
+	if s.leaderCancel != nil {
+		s.leaderCancel()
+	}
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(lockCtx, cancel)
+
+	s.leaderCtx = leaderCtx
+	s.leaderParent = ctx
+	s.leaderLockCtx = lockCtx
+	s.leaderCancel = func() {
+		stop()
+		cancel()
+	}
+
+	return leaderCtx
 }
 
 func (s *BHCEPipeline) Analyze(ctx context.Context) error {
 	// If there are completed ingest jobs or if analysis was user-requested, perform analysis.
+	// Analysis requests always rebuild the entire graph; analysis triggered by ingest alone may be scoped to the
+	// domains changed by the ingested jobs
 	if hasJobsWaitingForAnalysis, err := s.jobService.HasIngestJobsWaitingForAnalysis(); err != nil {
 		return fmt.Errorf("looking up jobs for analysis: %v", err)
-	} else if hasJobsWaitingForAnalysis || s.db.HasAnalysisRequest(ctx) {
+	} else if hasAnalysisRequest := s.db.HasAnalysisRequest(ctx); hasJobsWaitingForAnalysis || hasAnalysisRequest {
 		// Ensure that the user-requested analysis switch is deleted. This is done at the beginning of the
 		// function so that any re-analysis requests are caught while analysis is in-progress.
 		if err := s.db.DeleteAnalysisRequest(ctx); err != nil {
@@ -243,7 +403,22 @@ func (s *BHCEPipeline) Analyze(ctx context.Context) error {
 			attr.Scope("summary"),
 		)()
 
-		if err := RunAnalysisOperations(ctx, s.db, s.graphdb, s.cfg); err != nil {
+		scope := FullAnalysisScope()
+		if !hasAnalysisRequest {
+			if jobs, err := s.db.GetIngestJobsWithStatus(ctx, model.JobStatusAnalyzing); err != nil {
+				return fmt.Errorf("looking up jobs for analysis scope: %v", err)
+			} else {
+				scope = NewAnalysisScope(jobs)
+			}
+		}
+
+		if beganScope, err := BeginAnalysisScope(ctx, s.db, scope); err != nil {
+			return fmt.Errorf("beginning analysis: %v", err)
+		} else {
+			scope = beganScope
+		}
+
+		if err := RunScopedAnalysisOperations(ctx, s.db, s.graphdb, s.cfg, s.dogTags, scope); err != nil {
 			if errors.Is(err, ErrAnalysisFailed) {
 				s.jobService.FailAnalyzedIngestJobs()
 			} else if errors.Is(err, ErrAnalysisPartiallyCompleted) {
@@ -252,6 +427,8 @@ func (s *BHCEPipeline) Analyze(ctx context.Context) error {
 			return fmt.Errorf("analysis failure: %v", err)
 		} else if err := s.db.UpdateLastAnalysisCompleteTime(ctx); err != nil {
 			return fmt.Errorf("update last analysis completion time: %v", err)
+		} else if err := s.db.SetFullAnalysisRequired(ctx, false); err != nil {
+			return fmt.Errorf("clearing full analysis requirement: %v", err)
 		} else {
 			s.jobService.CompleteAnalyzedIngestJobs()
 
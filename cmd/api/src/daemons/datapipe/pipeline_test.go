@@ -0,0 +1,78 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/config"
+	"github.com/specterops/bloodhound/cmd/api/src/daemons/datapipe"
+	"github.com/specterops/bloodhound/cmd/api/src/daemons/ha"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/services/dogtags"
+	"github.com/specterops/bloodhound/cmd/api/src/services/upload"
+	"github.com/specterops/bloodhound/packages/go/cache"
+	"github.com/stretchr/testify/require"
+)
+
+type testHAMutex struct {
+	lockCtx context.Context
+}
+
+func (s *testHAMutex) TryLock() (ha.LockResult, error) {
+	return ha.LockResult{Context: s.lockCtx, IsPrimary: true}, nil
+}
+
+func newTestPipeline(haMutex ha.HAMutex) *datapipe.BHCEPipeline {
+	return datapipe.NewPipeline(context.Background(), config.Configuration{}, nil, nil, cache.Cache{}, upload.IngestSchema{}, nil, haMutex, dogtags.NewDefaultService())
+}
+
+func TestBHCEPipeline_IsPrimary(t *testing.T) {
+	t.Run("stages end with the daemon without HA", func(t *testing.T) {
+		var (
+			pipeline    = newTestPipeline(ha.NewDummyHA())
+			ctx, cancel = context.WithCancel(context.Background())
+		)
+
+		active, pipelineCtx := pipeline.IsPrimary(ctx, model.DatapipeStatusIngesting)
+		require.True(t, active)
+		require.NoError(t, pipelineCtx.Err())
+
+		cancel()
+		<-pipelineCtx.Done()
+	})
+
+	t.Run("stages end when the HA lock is lost", func(t *testing.T) {
+		var (
+			lockCtx, loseLock = context.WithCancel(context.Background())
+			pipeline          = newTestPipeline(&testHAMutex{lockCtx: lockCtx})
+			ctx               = context.Background()
+		)
+
+		active, pipelineCtx := pipeline.IsPrimary(ctx, model.DatapipeStatusIngesting)
+		require.True(t, active)
+
+		// Stages of the same leadership share their context
+		_, nextPipelineCtx := pipeline.IsPrimary(ctx, model.DatapipeStatusAnalyzing)
+		require.Equal(t, pipelineCtx, nextPipelineCtx)
+
+		loseLock()
+		<-pipelineCtx.Done()
+		require.NoError(t, ctx.Err())
+	})
+}
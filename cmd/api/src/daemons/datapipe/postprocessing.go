@@ -0,0 +1,49 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/specterops/bloodhound/cmd/api/src/analysis/ad"
+	"github.com/specterops/bloodhound/cmd/api/src/analysis/azure"
+	"github.com/specterops/bloodhound/packages/go/analysis"
+)
+
+// PostProcessingPlan resolves which Active Directory and Azure post-processors run when the named post-processors are
+// disabled
+func PostProcessingPlan(disabledPostProcessors []string) analysis.PostProcessingPlan {
+	return append(ad.PostProcessors.Plan(disabledPostProcessors), azure.PostProcessors.Plan(disabledPostProcessors)...)
+}
+
+// ValidateDisabledPostProcessors ensures that every named post-processor exists and may be disabled
+func ValidateDisabledPostProcessors(disabledPostProcessors []string) error {
+	plan := PostProcessingPlan(nil)
+
+	for _, name := range disabledPostProcessors {
+		if idx := slices.IndexFunc(plan, func(planned analysis.PlannedPostProcessor) bool {
+			return planned.Name == name
+		}); idx < 0 {
+			return fmt.Errorf("unknown post-processor: %s", name)
+		} else if plan[idx].Required {
+			return fmt.Errorf("post-processor %s is required and can not be disabled", name)
+		}
+	}
+
+	return nil
+}
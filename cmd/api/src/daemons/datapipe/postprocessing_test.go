@@ -0,0 +1,43 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe_test
+
+import (
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/analysis/ad"
+	"github.com/specterops/bloodhound/cmd/api/src/analysis/azure"
+	"github.com/specterops/bloodhound/cmd/api/src/daemons/datapipe"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostProcessingPlan(t *testing.T) {
+	plan := datapipe.PostProcessingPlan([]string{ad.PostProcessorADCS, azure.PostProcessorHybrid})
+
+	require.False(t, plan.IsEnabled(ad.PostProcessorADCS))
+	require.False(t, plan.IsEnabled(ad.PostProcessorNTLM))
+	require.False(t, plan.IsEnabled(azure.PostProcessorHybrid))
+	require.True(t, plan.IsEnabled(ad.PostProcessorDomainAssociations))
+	require.True(t, plan.IsEnabled(azure.PostProcessorExecuteCommand))
+}
+
+func TestValidateDisabledPostProcessors(t *testing.T) {
+	require.NoError(t, datapipe.ValidateDisabledPostProcessors(nil))
+	require.NoError(t, datapipe.ValidateDisabledPostProcessors([]string{ad.PostProcessorNTLM, azure.PostProcessorRoleApprovers}))
+	require.ErrorContains(t, datapipe.ValidateDisabledPostProcessors([]string{"ad.unknown"}), "unknown post-processor")
+	require.ErrorContains(t, datapipe.ValidateDisabledPostProcessors([]string{ad.PostProcessorDomainAssociations}), "is required")
+}
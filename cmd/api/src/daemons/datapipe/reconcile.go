@@ -0,0 +1,159 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+)
+
+// ReconciliationResult summarizes the stale graph data removed by a reconciliation run
+type ReconciliationResult struct {
+	DeletedNodes                   int
+	DeletedRelationships           int
+	DeletedHasSessionRelationships int
+}
+
+func (s ReconciliationResult) Total() int {
+	return s.DeletedNodes + s.DeletedRelationships + s.DeletedHasSessionRelationships
+}
+
+func (s ReconciliationResult) AuditData() model.AuditData {
+	return model.AuditData{
+		"deleted_nodes":                     s.DeletedNodes,
+		"deleted_relationships":             s.DeletedRelationships,
+		"deleted_has_session_relationships": s.DeletedHasSessionRelationships,
+	}
+}
+
+// postProcessedRelationshipKinds are rebuilt by every analysis run and are therefore never considered stale
+func postProcessedRelationshipKinds() graph.Kinds {
+	var kinds graph.Kinds
+
+	kinds = append(kinds, ad.PostProcessedRelationships()...)
+	kinds = append(kinds, azure.PostProcessedRelationships()...)
+
+	return kinds
+}
+
+// ReconcileGraphData deletes ingested graph data that collectors have stopped reporting. Nodes belonging to any of
+// the given source kinds and collected relationships between them are deleted once their lastseen property is older
+// than the base TTL. HasSession relationships churn far more than the rest of the graph and are expired on their own,
+// shorter TTL. Graph elements without a lastseen property are left alone.
+func ReconcileGraphData(ctx context.Context, graphDB graph.Database, sourceKinds graph.Kinds, ttl appcfg.PruneTTLParameters, now time.Time) (ReconciliationResult, error) {
+	var (
+		result                    ReconciliationResult
+		baseCutoff                = now.Add(-ttl.BaseTTL)
+		hasSessionCutoff          = now.Add(-ttl.HasSessionEdgeTTL)
+		staleNodeIDs              []graph.ID
+		staleRelationshipIDs      []graph.ID
+		staleHasSessionIDs        []graph.ID
+		excludedRelationshipKinds = append(postProcessedRelationshipKinds(), ad.HasSession)
+	)
+
+	if len(sourceKinds) == 0 {
+		return result, nil
+	}
+
+	slog.InfoContext(
+		ctx,
+		"Reconciling stale graph data",
+		slog.Time("base_cutoff", baseCutoff),
+		slog.Time("has_session_cutoff", hasSessionCutoff),
+	)
+
+	if err := graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		if nodeIDs, err := ops.FetchNodeIDs(tx.Nodes().Filterf(func() graph.Criteria {
+			return query.And(
+				query.KindIn(query.Node(), sourceKinds...),
+				query.Not(query.Kind(query.Node(), common.MigrationData)),
+				query.Exists(query.NodeProperty(common.LastSeen.String())),
+				query.LessThan(query.NodeProperty(common.LastSeen.String()), baseCutoff),
+			)
+		})); err != nil {
+			return fmt.Errorf("fetching stale nodes: %w", err)
+		} else if relationshipIDs, err := ops.FetchRelationshipIDs(tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.KindIn(query.Start(), sourceKinds...),
+				query.Not(query.KindIn(query.Relationship(), excludedRelationshipKinds...)),
+				query.KindIn(query.End(), sourceKinds...),
+				query.Exists(query.RelationshipProperty(common.LastSeen.String())),
+				query.LessThan(query.RelationshipProperty(common.LastSeen.String()), baseCutoff),
+			)
+		})); err != nil {
+			return fmt.Errorf("fetching stale relationships: %w", err)
+		} else if hasSessionIDs, err := ops.FetchRelationshipIDs(tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Kind(query.Relationship(), ad.HasSession),
+				query.Exists(query.RelationshipProperty(common.LastSeen.String())),
+				query.LessThan(query.RelationshipProperty(common.LastSeen.String()), hasSessionCutoff),
+			)
+		})); err != nil {
+			return fmt.Errorf("fetching stale HasSession relationships: %w", err)
+		} else {
+			staleNodeIDs = nodeIDs
+			staleRelationshipIDs = relationshipIDs
+			staleHasSessionIDs = hasSessionIDs
+
+			return nil
+		}
+	}); err != nil {
+		return result, err
+	}
+
+	// Relationships are removed first as deleting a node also removes every relationship attached to it
+	if err := graphDB.BatchOperation(ctx, func(batch graph.Batch) error {
+		for _, relationshipID := range staleHasSessionIDs {
+			if err := batch.DeleteRelationship(relationshipID); err != nil {
+				return err
+			}
+		}
+
+		for _, relationshipID := range staleRelationshipIDs {
+			if err := batch.DeleteRelationship(relationshipID); err != nil {
+				return err
+			}
+		}
+
+		for _, nodeID := range staleNodeIDs {
+			if err := batch.DeleteNode(nodeID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return result, fmt.Errorf("deleting stale graph data: %w", err)
+	}
+
+	result.DeletedNodes = len(staleNodeIDs)
+	result.DeletedRelationships = len(staleRelationshipIDs)
+	result.DeletedHasSessionRelationships = len(staleHasSessionIDs)
+
+	return result, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+//go:build integration
+
+package datapipe_test
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/daemons/datapipe"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/specterops/bloodhound/cmd/api/src/services/graphify"
+	"github.com/specterops/bloodhound/cmd/api/src/services/graphify/endpoint"
+	"github.com/specterops/bloodhound/packages/go/lab/generic"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcileGraphData(t *testing.T) {
+	var (
+		ctx = context.Background()
+		now = time.Now().UTC()
+
+		fixturesPath = path.Join("fixtures", t.Name(), "opengraph")
+
+		testSuite = setupIntegrationTestSuite(t, fixturesPath)
+
+		// Each file is ingested as if it were last collected at the given time
+		files = map[string]time.Time{
+			"stale.json": now.Add(-30 * 24 * time.Hour),
+			"aging.json": now.Add(-5 * 24 * time.Hour),
+			"fresh.json": now,
+		}
+
+		ttl = appcfg.PruneTTLParameters{
+			BaseTTL:           appcfg.DefaultPruneBaseTTL,
+			HasSessionEdgeTTL: appcfg.DefaultPruneHasSessionEdgeTTL,
+		}
+		sourceKinds = graph.Kinds{graph.StringKind("Base"), graph.StringKind("AZBase"), graph.StringKind("GithubBase")}
+	)
+
+	defer teardownIntegrationTestSuite(t, &testSuite)
+
+	for file, ingestTime := range files {
+		ingestContext := graphify.NewIngestContext(ctx, graphify.WithIngestTime(ingestTime), graphify.WithEndpointResolver(endpoint.NewResolver(testSuite.GraphDB)))
+		fileData, err := testSuite.GraphifyService.ProcessIngestFile(ingestContext, model.IngestTask{StoredFileName: path.Join(testSuite.WorkDir, file), FileType: model.FileTypeJson})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(fileData))
+		require.Empty(t, fileData[0].Errors)
+	}
+
+	result, err := datapipe.ReconcileGraphData(ctx, testSuite.GraphDB, sourceKinds, ttl, now)
+	require.NoError(t, err)
+
+	// The stale GenericAll edge is removed along with its nodes; the aging HasSession edge is past its own TTL
+	require.Equal(t, 2, result.DeletedNodes)
+	require.Equal(t, 1, result.DeletedRelationships)
+	require.Equal(t, 1, result.DeletedHasSessionRelationships)
+
+	require.NoError(t, testSuite.GraphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		if relationshipCount, err := tx.Relationships().Count(); err != nil {
+			return err
+		} else {
+			require.Equal(t, int64(1), relationshipCount)
+		}
+
+		return nil
+	}))
+
+	expected, err := generic.LoadGraphFromFile(os.DirFS(path.Join("fixtures", t.Name())), "reconciledExpected.json")
+	require.NoError(t, err)
+	generic.AssertDatabaseGraph(t, ctx, testSuite.GraphDB, &expected)
+
+	// A second pass finds nothing left to reconcile
+	result, err = datapipe.ReconcileGraphData(ctx, testSuite.GraphDB, sourceKinds, ttl, now)
+	require.NoError(t, err)
+	require.Zero(t, result.Total())
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+)
+
+const scheduledAnalysisRequester = "scheduled"
+
+// ScheduledAnalysisData describes the database methods required to evaluate the analysis schedule
+type ScheduledAnalysisData interface {
+	appcfg.ParameterService
+
+	GetDatapipeStatus(ctx context.Context) (model.DatapipeStatusWrapper, error)
+	UpdateLastScheduledAnalysisRunTime(ctx context.Context, runAt time.Time) error
+	HasAnalysisRequest(ctx context.Context) bool
+	RequestAnalysis(ctx context.Context, requester string) error
+}
+
+// RequestScheduledAnalysis evaluates the stored analysis schedule and requests analysis if an occurrence has come due
+// since the last scheduled run. The anchor is used as the last run time when no scheduled run has happened yet.
+//
+// Occurrences missed while the datapipe was busy are collapsed into a single run. An occurrence that comes due while
+// an analysis is already running or requested is recorded as handled and skipped, since that analysis covers it.
+func RequestScheduledAnalysis(ctx context.Context, db ScheduledAnalysisData, anchor time.Time, now time.Time) error {
+	if schedule, err := appcfg.GetScheduledAnalysisParameter(ctx, db); err != nil {
+		return fmt.Errorf("fetching analysis schedule: %w", err)
+	} else if !schedule.Enabled {
+		return nil
+	} else if status, err := db.GetDatapipeStatus(ctx); err != nil {
+		return fmt.Errorf("fetching datapipe status: %w", err)
+	} else {
+		lastRun := status.LastScheduledAnalysisRunAt
+		if lastRun.IsZero() {
+			lastRun = anchor
+		}
+
+		if nextRun, err := schedule.NextRunAfter(lastRun); err != nil {
+			return err
+		} else if nextRun.IsZero() || nextRun.After(now) {
+			return nil
+		} else if err := db.UpdateLastScheduledAnalysisRunTime(ctx, now); err != nil {
+			return fmt.Errorf("updating last scheduled analysis run time: %w", err)
+		} else if status.Status == model.DatapipeStatusAnalyzing || db.HasAnalysisRequest(ctx) {
+			slog.InfoContext(ctx, "Skipping scheduled analysis as analysis is already in progress", slog.Time("scheduled_at", nextRun))
+			return nil
+		} else if err := db.RequestAnalysis(ctx, scheduledAnalysisRequester); err != nil {
+			return fmt.Errorf("requesting scheduled analysis: %w", err)
+		} else {
+			slog.InfoContext(ctx, "Scheduled analysis requested", slog.Time("scheduled_at", nextRun))
+			return nil
+		}
+	}
+}
+
+// NextScheduledAnalysisRun returns the next planned scheduled analysis run, or nil if scheduled analysis is disabled
+func NextScheduledAnalysisRun(schedule appcfg.ScheduledAnalysisParameter, status model.DatapipeStatusWrapper, now time.Time) (*time.Time, error) {
+	lastRun := status.LastScheduledAnalysisRunAt
+	if lastRun.IsZero() {
+		lastRun = now
+	}
+
+	if nextRun, err := schedule.NextRunAfter(lastRun); err != nil {
+		return nil, err
+	} else if nextRun.IsZero() {
+		return nil, nil
+	} else {
+		return &nextRun, nil
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/daemons/datapipe"
+	"github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// dailyAtMidnight runs every day at 00:00 UTC starting on 2025-01-01
+const dailyAtMidnight = "DTSTART:20250101T000000Z\nRRULE:FREQ=DAILY;INTERVAL=1"
+
+func scheduleParameter(t *testing.T, enabled bool, rule string) appcfg.Parameter {
+	value, err := types.NewJSONBObject(appcfg.ScheduledAnalysisParameter{Enabled: enabled, RRule: rule})
+	require.NoError(t, err)
+
+	return appcfg.Parameter{Key: appcfg.ScheduledAnalysis, Value: value}
+}
+
+func TestRequestScheduledAnalysis(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		anchor = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	)
+
+	t.Run("disabled schedule does nothing", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = mocks.NewMockDatabase(mockCtrl)
+		)
+
+		mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.ScheduledAnalysis).Return(scheduleParameter(t, false, ""), nil)
+
+		require.NoError(t, datapipe.RequestScheduledAnalysis(ctx, mockDB, anchor, anchor.Add(48*time.Hour)))
+	})
+
+	t.Run("occurrence not yet due", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = mocks.NewMockDatabase(mockCtrl)
+		)
+
+		mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.ScheduledAnalysis).Return(scheduleParameter(t, true, dailyAtMidnight), nil)
+		mockDB.EXPECT().GetDatapipeStatus(gomock.Any()).Return(model.DatapipeStatusWrapper{Status: model.DatapipeStatusIdle}, nil)
+
+		require.NoError(t, datapipe.RequestScheduledAnalysis(ctx, mockDB, anchor, anchor.Add(time.Hour)))
+	})
+
+	t.Run("due occurrence requests analysis", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = mocks.NewMockDatabase(mockCtrl)
+			now      = anchor.Add(13 * time.Hour)
+		)
+
+		mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.ScheduledAnalysis).Return(scheduleParameter(t, true, dailyAtMidnight), nil)
+		mockDB.EXPECT().GetDatapipeStatus(gomock.Any()).Return(model.DatapipeStatusWrapper{
+			Status:                     model.DatapipeStatusIdle,
+			LastScheduledAnalysisRunAt: anchor,
+		}, nil)
+		mockDB.EXPECT().UpdateLastScheduledAnalysisRunTime(gomock.Any(), now).Return(nil)
+		mockDB.EXPECT().HasAnalysisRequest(gomock.Any()).Return(false)
+		mockDB.EXPECT().RequestAnalysis(gomock.Any(), "scheduled").Return(nil)
+
+		require.NoError(t, datapipe.RequestScheduledAnalysis(ctx, mockDB, time.Time{}, now))
+	})
+
+	t.Run("due occurrence is skipped while analysis is in progress", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = mocks.NewMockDatabase(mockCtrl)
+			now      = anchor.Add(13 * time.Hour)
+		)
+
+		mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.ScheduledAnalysis).Return(scheduleParameter(t, true, dailyAtMidnight), nil)
+		mockDB.EXPECT().GetDatapipeStatus(gomock.Any()).Return(model.DatapipeStatusWrapper{
+			Status: model.DatapipeStatusAnalyzing,
+		}, nil)
+		mockDB.EXPECT().UpdateLastScheduledAnalysisRunTime(gomock.Any(), now).Return(nil)
+
+		require.NoError(t, datapipe.RequestScheduledAnalysis(ctx, mockDB, anchor, now))
+	})
+
+	t.Run("due occurrence is skipped when analysis is already requested", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = mocks.NewMockDatabase(mockCtrl)
+			now      = anchor.Add(13 * time.Hour)
+		)
+
+		mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.ScheduledAnalysis).Return(scheduleParameter(t, true, dailyAtMidnight), nil)
+		mockDB.EXPECT().GetDatapipeStatus(gomock.Any()).Return(model.DatapipeStatusWrapper{Status: model.DatapipeStatusIdle}, nil)
+		mockDB.EXPECT().UpdateLastScheduledAnalysisRunTime(gomock.Any(), now).Return(nil)
+		mockDB.EXPECT().HasAnalysisRequest(gomock.Any()).Return(true)
+
+		require.NoError(t, datapipe.RequestScheduledAnalysis(ctx, mockDB, anchor, now))
+	})
+}
+
+func TestNextScheduledAnalysisRun(t *testing.T) {
+	var (
+		lastRun = time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+		now     = lastRun.Add(6 * time.Hour)
+	)
+
+	t.Run("disabled", func(t *testing.T) {
+		nextRun, err := datapipe.NextScheduledAnalysisRun(appcfg.ScheduledAnalysisParameter{}, model.DatapipeStatusWrapper{}, now)
+		require.NoError(t, err)
+		require.Nil(t, nextRun)
+	})
+
+	t.Run("after last run", func(t *testing.T) {
+		nextRun, err := datapipe.NextScheduledAnalysisRun(
+			appcfg.ScheduledAnalysisParameter{Enabled: true, RRule: dailyAtMidnight},
+			model.DatapipeStatusWrapper{LastScheduledAnalysisRunAt: lastRun},
+			now,
+		)
+		require.NoError(t, err)
+		require.NotNil(t, nextRun)
+		require.Equal(t, lastRun.Add(24*time.Hour), *nextRun)
+	})
+
+	t.Run("never run", func(t *testing.T) {
+		nextRun, err := datapipe.NextScheduledAnalysisRun(
+			appcfg.ScheduledAnalysisParameter{Enabled: true, RRule: dailyAtMidnight},
+			model.DatapipeStatusWrapper{},
+			now,
+		)
+		require.NoError(t, err)
+		require.NotNil(t, nextRun)
+		require.Equal(t, lastRun.Add(24*time.Hour), *nextRun)
+	})
+}
@@ -0,0 +1,199 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/analysis"
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+	"github.com/specterops/bloodhound/packages/go/graphschema"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/query"
+)
+
+// tierViolationSampleLimit bounds the number of violating edges kept for every pair of tiers
+const tierViolationSampleLimit = 10
+
+type tierViolationDB interface {
+	GetOrderedAssetGroupTagTiers(ctx context.Context) ([]model.AssetGroupTag, error)
+	GetDisplayNodeGraphKinds(ctx context.Context) (map[graph.Kind]bool, error)
+	SaveAssetGroupTagViolations(ctx context.Context, violations []model.AssetGroupTagViolation) error
+}
+
+// RunTierViolationAnalysis finds, for every tier within the tier limit, the attack path edges that a principal of a
+// less privileged tier, or of no tier at all, holds over a member of the tier. Principals belonging to several tiers
+// are attributed to the most privileged one. Results replace those of the previous run.
+func RunTierViolationAnalysis(ctx context.Context, db tierViolationDB, graphDB graph.Database, tierLimit int, evaluatedAt time.Time) error {
+	defer measure.ContextMeasureWithThreshold(ctx, slog.LevelInfo, "Tier Violation Analysis")()
+
+	if tiers, err := db.GetOrderedAssetGroupTagTiers(ctx); err != nil {
+		return fmt.Errorf("fetching tiers: %w", err)
+	} else if validPrimaryKinds, err := db.GetDisplayNodeGraphKinds(ctx); err != nil {
+		return fmt.Errorf("fetching display kinds: %w", err)
+	} else {
+		var (
+			violations []model.AssetGroupTagViolation
+			evaluator  = tierViolationEvaluator{
+				tiers:             LimitTiers(tiers, tierLimit),
+				validPrimaryKinds: validPrimaryKinds,
+				evaluatedAt:       evaluatedAt,
+			}
+		)
+
+		if err := graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+			for tierIdx, tier := range evaluator.tiers {
+				if tierViolations, err := evaluator.violations(tx, tierIdx); err != nil {
+					return fmt.Errorf("evaluating tier %d: %w", tier.ID, err)
+				} else {
+					violations = append(violations, tierViolations...)
+				}
+			}
+
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		if err := db.SaveAssetGroupTagViolations(ctx, violations); err != nil {
+			return fmt.Errorf("saving tier violations: %w", err)
+		}
+
+		return nil
+	}
+}
+
+// LimitTiers returns the most privileged tiers allowed by the tier limit. A limit below one does not restrict tiers.
+func LimitTiers(tiers []model.AssetGroupTag, tierLimit int) []model.AssetGroupTag {
+	if tierLimit > 0 && len(tiers) > tierLimit {
+		return tiers[:tierLimit]
+	}
+
+	return tiers
+}
+
+type tierViolationEvaluator struct {
+	tiers             []model.AssetGroupTag
+	validPrimaryKinds graphschema.ValidPrimaryKinds
+	evaluatedAt       time.Time
+}
+
+// sourceTierID returns the id of the most privileged tier below tierIdx that the node belongs to
+func (s tierViolationEvaluator) sourceTierID(node *graph.Node, tierIdx int) int {
+	for _, tier := range s.tiers[tierIdx+1:] {
+		if node.Kinds.ContainsOneOf(tier.ToKind()) {
+			return tier.ID
+		}
+	}
+
+	return model.AssetGroupTagViolationUntieredSourceId
+}
+
+// violations collects the traversable relationships ending at a member of the tier at tierIdx whose start node is not
+// a member of that tier or any more privileged one, grouped by the tier of the start node
+func (s tierViolationEvaluator) violations(tx graph.Transaction, tierIdx int) ([]model.AssetGroupTagViolation, error) {
+	var (
+		tier             = s.tiers[tierIdx]
+		privilegedKinds  = make(graph.Kinds, 0, tierIdx+1)
+		traversableKinds = graph.Kinds(ad.PathfindingRelationships()).Concatenate(azure.PathfindingRelationships())
+		relationships    []*graph.Relationship
+		nodeIDs          []graph.ID
+	)
+
+	for _, privilegedTier := range s.tiers[:tierIdx+1] {
+		privilegedKinds = append(privilegedKinds, privilegedTier.ToKind())
+	}
+
+	if err := tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.KindIn(query.Relationship(), traversableKinds...),
+			query.Kind(query.End(), tier.ToKind()),
+			query.Not(query.KindIn(query.Start(), privilegedKinds...)),
+		)
+	}).Fetch(func(cursor graph.Cursor[*graph.Relationship]) error {
+		for relationship := range cursor.Chan() {
+			relationships = append(relationships, relationship)
+			nodeIDs = append(nodeIDs, relationship.StartID, relationship.EndID)
+		}
+
+		return cursor.Error()
+	}); err != nil || len(relationships) == 0 {
+		return nil, err
+	}
+
+	nodes, err := fetchFindingNodes(tx, nodeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sort so that the sampled edges are stable between runs over the same graph
+	slices.SortFunc(relationships, func(a, b *graph.Relationship) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	var (
+		violations    []model.AssetGroupTagViolation
+		violationIdxs = map[int]int{}
+	)
+
+	for _, relationship := range relationships {
+		start, hasStart := nodes[relationship.StartID]
+		end, hasEnd := nodes[relationship.EndID]
+
+		if !hasStart || !hasEnd {
+			continue
+		}
+
+		sourceTierID := s.sourceTierID(start, tierIdx)
+
+		violationIdx, found := violationIdxs[sourceTierID]
+		if !found {
+			violationIdx = len(violations)
+			violationIdxs[sourceTierID] = violationIdx
+
+			violations = append(violations, model.AssetGroupTagViolation{
+				AssetGroupTagId:       tier.ID,
+				SourceAssetGroupTagId: sourceTierID,
+				SampleEdges:           model.AssetGroupTagViolationEdges{},
+				EvaluatedAt:           s.evaluatedAt,
+			})
+		}
+
+		violation := &violations[violationIdx]
+		violation.ViolationCount++
+
+		if len(violation.SampleEdges) < tierViolationSampleLimit {
+			violation.SampleEdges = append(violation.SampleEdges, model.AssetGroupTagViolationEdge{
+				Kind:          relationship.Kind.String(),
+				FromPrincipal: findingObjectID(start),
+				FromKind:      analysis.GetNodeKindDisplayLabel(s.validPrimaryKinds, start),
+				ToPrincipal:   findingObjectID(end),
+				ToKind:        analysis.GetNodeKindDisplayLabel(s.validPrimaryKinds, end),
+			})
+		}
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/daemons/datapipe"
+	dbmocks "github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	graph_mocks "github.com/specterops/bloodhound/cmd/api/src/vendormocks/dawgs/graph"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRunTierViolationAnalysis(t *testing.T) {
+	var (
+		ctx         = context.Background()
+		evaluatedAt = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		tiers = []model.AssetGroupTag{
+			{ID: 1, Name: "Tier Zero", Type: model.AssetGroupTagTypeTier, Position: null.Int32From(model.AssetGroupTierZeroPosition)},
+			{ID: 2, Name: "Tier One", Type: model.AssetGroupTagTypeTier, Position: null.Int32From(2)},
+			{ID: 3, Name: "Tier Two", Type: model.AssetGroupTagTypeTier, Position: null.Int32From(3)},
+		}
+
+		// expectTierQueries runs the read transaction against a graph without violations and expects one relationship
+		// query per analyzed tier
+		expectTierQueries = func(mockCtrl *gomock.Controller, mockGraph *graph_mocks.MockDatabase, analyzedTiers int) {
+			var (
+				mockTx    = graph_mocks.NewMockTransaction(mockCtrl)
+				mockQuery = graph_mocks.NewMockRelationshipQuery(mockCtrl)
+			)
+
+			mockGraph.EXPECT().ReadTransaction(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, delegate graph.TransactionDelegate, _ ...graph.TransactionOption) error {
+				return delegate(mockTx)
+			})
+			mockTx.EXPECT().Relationships().Return(mockQuery).Times(analyzedTiers)
+			mockQuery.EXPECT().Filterf(gomock.Any()).Return(mockQuery).Times(analyzedTiers)
+			mockQuery.EXPECT().Fetch(gomock.Any()).Return(nil).Times(analyzedTiers)
+		}
+	)
+
+	t.Run("every tier is analyzed without a tier limit", func(t *testing.T) {
+		var (
+			mockCtrl  = gomock.NewController(t)
+			mockDB    = dbmocks.NewMockDatabase(mockCtrl)
+			mockGraph = graph_mocks.NewMockDatabase(mockCtrl)
+		)
+
+		mockDB.EXPECT().GetOrderedAssetGroupTagTiers(ctx).Return(tiers, nil)
+		mockDB.EXPECT().GetDisplayNodeGraphKinds(ctx).Return(map[graph.Kind]bool{}, nil)
+		expectTierQueries(mockCtrl, mockGraph, 3)
+		mockDB.EXPECT().SaveAssetGroupTagViolations(ctx, gomock.Len(0)).Return(nil)
+
+		require.NoError(t, datapipe.RunTierViolationAnalysis(ctx, mockDB, mockGraph, 0, evaluatedAt))
+	})
+
+	t.Run("tiers beyond the tier limit are not analyzed", func(t *testing.T) {
+		var (
+			mockCtrl  = gomock.NewController(t)
+			mockDB    = dbmocks.NewMockDatabase(mockCtrl)
+			mockGraph = graph_mocks.NewMockDatabase(mockCtrl)
+		)
+
+		mockDB.EXPECT().GetOrderedAssetGroupTagTiers(ctx).Return(tiers, nil)
+		mockDB.EXPECT().GetDisplayNodeGraphKinds(ctx).Return(map[graph.Kind]bool{}, nil)
+		expectTierQueries(mockCtrl, mockGraph, 2)
+		mockDB.EXPECT().SaveAssetGroupTagViolations(ctx, gomock.Len(0)).Return(nil)
+
+		require.NoError(t, datapipe.RunTierViolationAnalysis(ctx, mockDB, mockGraph, 2, evaluatedAt))
+	})
+
+	t.Run("results are not replaced when the graph cannot be read", func(t *testing.T) {
+		var (
+			mockCtrl  = gomock.NewController(t)
+			mockDB    = dbmocks.NewMockDatabase(mockCtrl)
+			mockGraph = graph_mocks.NewMockDatabase(mockCtrl)
+			graphErr  = errors.New("graph unavailable")
+		)
+
+		mockDB.EXPECT().GetOrderedAssetGroupTagTiers(ctx).Return(tiers, nil)
+		mockDB.EXPECT().GetDisplayNodeGraphKinds(ctx).Return(map[graph.Kind]bool{}, nil)
+		mockGraph.EXPECT().ReadTransaction(ctx, gomock.Any()).Return(graphErr)
+
+		require.ErrorIs(t, datapipe.RunTierViolationAnalysis(ctx, mockDB, mockGraph, 0, evaluatedAt), graphErr)
+	})
+
+	t.Run("fetching tiers fails", func(t *testing.T) {
+		var (
+			mockCtrl  = gomock.NewController(t)
+			mockDB    = dbmocks.NewMockDatabase(mockCtrl)
+			mockGraph = graph_mocks.NewMockDatabase(mockCtrl)
+			dbErr     = errors.New("db unavailable")
+		)
+
+		mockDB.EXPECT().GetOrderedAssetGroupTagTiers(ctx).Return(nil, dbErr)
+
+		require.ErrorIs(t, datapipe.RunTierViolationAnalysis(ctx, mockDB, mockGraph, 0, evaluatedAt), dbErr)
+	})
+}
@@ -20,20 +20,24 @@ import (
 	"context"
 	"time"
 
+	"github.com/specterops/bloodhound/cmd/api/src/config"
 	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/services/upload"
 )
 
 // Daemon holds data relevant to the data daemon
 type Daemon struct {
 	exitC chan struct{}
 	db    database.Database
+	cfg   config.Configuration
 }
 
 // NewDataPruningDaemon creates a new data pruning daemon
-func NewDataPruningDaemon(db database.Database) *Daemon {
+func NewDataPruningDaemon(db database.Database, cfg config.Configuration) *Daemon {
 	return &Daemon{
 		exitC: make(chan struct{}),
 		db:    db,
+		cfg:   cfg,
 	}
 }
 
@@ -49,9 +53,10 @@ func (s *Daemon) Start(ctx context.Context) {
 	defer close(s.exitC)
 	defer ticker.Stop()
 
-	// prune sessions and collections once when the daemon starts up
+	// prune sessions, collections and abandoned uploads once when the daemon starts up
 	s.db.SweepSessions(ctx)
 	s.db.SweepAssetGroupCollections(ctx)
+	upload.SweepUploadSessions(ctx, s.db, s.cfg.UploadSessionsDirectory())
 
 	// thereafter, prune conditionally once a day
 	for {
@@ -59,6 +64,7 @@ func (s *Daemon) Start(ctx context.Context) {
 		case <-ticker.C:
 			s.db.SweepSessions(ctx)
 			s.db.SweepAssetGroupCollections(ctx)
+			upload.SweepUploadSessions(ctx, s.db, s.cfg.UploadSessionsDirectory())
 
 		case <-s.exitC:
 			return
@@ -18,10 +18,13 @@ package gc
 
 import (
 	"context"
+	"os"
 	"testing"
 	"time"
 
+	"github.com/specterops/bloodhound/cmd/api/src/config"
 	"github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -30,7 +33,7 @@ func TestGC_NewDataPruningDaemon(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 
-	daemon := NewDataPruningDaemon(mocks.NewMockDatabase(mockCtrl))
+	daemon := NewDataPruningDaemon(mocks.NewMockDatabase(mockCtrl), config.Configuration{})
 	require.NotNil(t, daemon)
 }
 
@@ -38,7 +41,7 @@ func TestGC_Name(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 
-	daemon := NewDataPruningDaemon(mocks.NewMockDatabase(mockCtrl))
+	daemon := NewDataPruningDaemon(mocks.NewMockDatabase(mockCtrl), config.Configuration{})
 	require.NotNil(t, daemon)
 
 	result := daemon.Name()
@@ -58,8 +61,13 @@ func TestGC_Start(t *testing.T) {
 	mockDB.EXPECT().SweepAssetGroupCollections(gomock.Any()).Do(func(ctx context.Context) {
 		time.Sleep(1 * time.Millisecond)
 	})
+	mockDB.EXPECT().SweepIngestUploadSessions(gomock.Any()).Return(nil)
+	mockDB.EXPECT().GetAllIngestUploadSessions(gomock.Any()).Return(model.IngestUploadSessions{}, nil)
 
-	daemon := NewDataPruningDaemon(mockDB)
+	cfg := config.Configuration{WorkDir: t.TempDir()}
+	require.NoError(t, os.Mkdir(cfg.UploadSessionsDirectory(), 0755))
+
+	daemon := NewDataPruningDaemon(mockDB, cfg)
 	require.NotNil(t, daemon)
 
 	go func() {
@@ -0,0 +1,195 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+package ha
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
+)
+
+const (
+	DefaultLeaseName     = "datapipe"
+	DefaultLeaseDuration = 30 * time.Second
+
+	leaseReleaseTimeout = 5 * time.Second
+)
+
+// LeaseStore persists a named, expiring lease. AcquireHALease must atomically grant the lease to holderID if it is
+// unheld, expired or already held by holderID, extending its expiry by duration. It reports whether holderID owns
+// the lease after the call.
+type LeaseStore interface {
+	AcquireHALease(ctx context.Context, name string, holderID string, duration time.Duration) (bool, error)
+	ReleaseHALease(ctx context.Context, name string, holderID string) error
+}
+
+type LeaseOptions struct {
+	Name              string
+	HolderID          string
+	LeaseDuration     time.Duration
+	HeartbeatInterval time.Duration
+}
+
+// NewHolderID returns an identifier for this process that is unique across replicas.
+func NewHolderID() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	return fmt.Sprintf("%s-%s", hostname, uuid.Must(uuid.NewV4()).String())
+}
+
+// leaseMutex is an HAMutex backed by a lease held in the application database. The primary instance renews its
+// lease on a heartbeat; if a renewal is refused or the lease runs out before it can be renewed, the context
+// handed out with the lock is cancelled so that any in-flight primary-only work stops.
+type leaseMutex struct {
+	ctx     context.Context
+	store   LeaseStore
+	options LeaseOptions
+
+	mu        sync.Mutex
+	leaderCtx context.Context
+}
+
+// NewLeaseMutex creates an HAMutex that elects a single primary between all instances sharing the same LeaseStore.
+// The lease is released when ctx is cancelled.
+func NewLeaseMutex(ctx context.Context, store LeaseStore, options LeaseOptions) HAMutex {
+	if options.Name == "" {
+		options.Name = DefaultLeaseName
+	}
+
+	if options.HolderID == "" {
+		options.HolderID = NewHolderID()
+	}
+
+	if options.LeaseDuration <= 0 {
+		options.LeaseDuration = DefaultLeaseDuration
+	}
+
+	// The heartbeat must fire at least once before the lease expires or leadership would flap on every renewal
+	if options.HeartbeatInterval <= 0 || options.HeartbeatInterval >= options.LeaseDuration {
+		options.HeartbeatInterval = options.LeaseDuration / 3
+	}
+
+	return &leaseMutex{
+		ctx:     ctx,
+		store:   store,
+		options: options,
+	}
+}
+
+func (s *leaseMutex) TryLock() (LockResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Leadership is held until the heartbeat cancels the leader context
+	if s.leaderCtx != nil && s.leaderCtx.Err() == nil {
+		return LockResult{
+			Context:   s.leaderCtx,
+			IsPrimary: true,
+		}, nil
+	}
+
+	if err := s.ctx.Err(); err != nil {
+		return LockResult{Context: s.ctx}, err
+	}
+
+	if acquired, err := s.store.AcquireHALease(s.ctx, s.options.Name, s.options.HolderID, s.options.LeaseDuration); err != nil {
+		return LockResult{Context: s.ctx}, fmt.Errorf("acquiring lease %s: %w", s.options.Name, err)
+	} else if !acquired {
+		return LockResult{Context: s.ctx}, nil
+	}
+
+	leaderCtx, cancel := context.WithCancel(s.ctx)
+
+	s.leaderCtx = leaderCtx
+
+	slog.InfoContext(s.ctx, "Acquired HA lease, this instance is now primary",
+		slog.String("lease", s.options.Name),
+		slog.String("holder_id", s.options.HolderID),
+	)
+
+	go s.heartbeat(leaderCtx, cancel)
+
+	return LockResult{
+		Context:   leaderCtx,
+		IsPrimary: true,
+	}, nil
+}
+
+// heartbeat renews the lease until leadership is lost or the parent context is cancelled. The leader context is
+// always cancelled and the lease released on return.
+func (s *leaseMutex) heartbeat(ctx context.Context, cancel context.CancelFunc) {
+	var (
+		ticker      = time.NewTicker(s.options.HeartbeatInterval)
+		lastRenewal = time.Now()
+	)
+
+	defer ticker.Stop()
+	defer s.relinquish(ctx, cancel)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			if renewed, err := s.store.AcquireHALease(ctx, s.options.Name, s.options.HolderID, s.options.LeaseDuration); err != nil {
+				// Tolerate transient failures as long as the lease is guaranteed to still be ours by the next heartbeat
+				if time.Since(lastRenewal)+s.options.HeartbeatInterval >= s.options.LeaseDuration {
+					slog.ErrorContext(ctx, "Unable to renew HA lease before expiry, relinquishing primary status",
+						slog.String("lease", s.options.Name),
+						attr.Error(err),
+					)
+					return
+				}
+
+				slog.WarnContext(ctx, "Failed to renew HA lease", slog.String("lease", s.options.Name), attr.Error(err))
+			} else if !renewed {
+				slog.WarnContext(ctx, "HA lease was taken over by another instance, relinquishing primary status",
+					slog.String("lease", s.options.Name),
+				)
+				return
+			} else {
+				lastRenewal = time.Now()
+			}
+		}
+	}
+}
+
+// relinquish cancels the leader context and gives up the lease so that another instance may take over without
+// waiting for it to expire. Releasing a lease held by another instance is a no-op. The mutex is held throughout so
+// that TryLock can not re-acquire the lease between the two steps.
+func (s *leaseMutex) relinquish(ctx context.Context, cancel context.CancelFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cancel()
+
+	releaseCtx, done := context.WithTimeout(context.WithoutCancel(ctx), leaseReleaseTimeout)
+	defer done()
+
+	if err := s.store.ReleaseHALease(releaseCtx, s.options.Name, s.options.HolderID); err != nil {
+		slog.WarnContext(releaseCtx, "Failed to release HA lease", slog.String("lease", s.options.Name), attr.Error(err))
+	}
+}
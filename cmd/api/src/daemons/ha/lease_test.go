@@ -0,0 +1,181 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+package ha_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/daemons/ha"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryLeaseStore is an in-memory LeaseStore that mimics the expiry semantics of the database implementation
+type memoryLeaseStore struct {
+	mu        sync.Mutex
+	holder    string
+	expiresAt time.Time
+	failWith  error
+}
+
+func (s *memoryLeaseStore) AcquireHALease(_ context.Context, _ string, holderID string, duration time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.failWith != nil {
+		return false, s.failWith
+	}
+
+	if now := time.Now(); s.holder == "" || s.holder == holderID || s.expiresAt.Before(now) {
+		s.holder = holderID
+		s.expiresAt = now.Add(duration)
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (s *memoryLeaseStore) ReleaseHALease(_ context.Context, _ string, holderID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.holder == holderID {
+		s.holder = ""
+	}
+
+	return nil
+}
+
+func (s *memoryLeaseStore) steal(holderID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.holder = holderID
+	s.expiresAt = time.Now().Add(time.Hour)
+}
+
+func (s *memoryLeaseStore) currentHolder() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.holder
+}
+
+func testLeaseOptions(holderID string) ha.LeaseOptions {
+	return ha.LeaseOptions{
+		HolderID:          holderID,
+		LeaseDuration:     300 * time.Millisecond,
+		HeartbeatInterval: 20 * time.Millisecond,
+	}
+}
+
+func TestLeaseMutex(t *testing.T) {
+	t.Run("only one instance is primary", func(t *testing.T) {
+		var (
+			ctx, cancel = context.WithCancel(context.Background())
+			store       = &memoryLeaseStore{}
+			first       = ha.NewLeaseMutex(ctx, store, testLeaseOptions("first"))
+			second      = ha.NewLeaseMutex(ctx, store, testLeaseOptions("second"))
+		)
+
+		defer cancel()
+
+		firstResult, err := first.TryLock()
+		require.NoError(t, err)
+		require.True(t, firstResult.IsPrimary)
+
+		secondResult, err := second.TryLock()
+		require.NoError(t, err)
+		require.False(t, secondResult.IsPrimary)
+
+		// Repeated calls while primary return the same leadership context
+		again, err := first.TryLock()
+		require.NoError(t, err)
+		require.True(t, again.IsPrimary)
+		require.Equal(t, firstResult.Context, again.Context)
+	})
+
+	t.Run("losing the lease cancels the primary context", func(t *testing.T) {
+		var (
+			ctx, cancel = context.WithCancel(context.Background())
+			store       = &memoryLeaseStore{}
+			mutex       = ha.NewLeaseMutex(ctx, store, testLeaseOptions("first"))
+		)
+
+		defer cancel()
+
+		result, err := mutex.TryLock()
+		require.NoError(t, err)
+		require.True(t, result.IsPrimary)
+
+		store.steal("second")
+
+		require.Eventually(t, func() bool {
+			return result.Context.Err() != nil
+		}, time.Second, 5*time.Millisecond)
+
+		result, err = mutex.TryLock()
+		require.NoError(t, err)
+		require.False(t, result.IsPrimary)
+	})
+
+	t.Run("renewal failures past lease expiry cancel the primary context", func(t *testing.T) {
+		var (
+			ctx, cancel = context.WithCancel(context.Background())
+			store       = &memoryLeaseStore{}
+			mutex       = ha.NewLeaseMutex(ctx, store, testLeaseOptions("first"))
+		)
+
+		defer cancel()
+
+		result, err := mutex.TryLock()
+		require.NoError(t, err)
+		require.True(t, result.IsPrimary)
+
+		store.mu.Lock()
+		store.failWith = errors.New("connection refused")
+		store.mu.Unlock()
+
+		require.Eventually(t, func() bool {
+			return result.Context.Err() != nil
+		}, time.Second, 5*time.Millisecond)
+
+		_, err = mutex.TryLock()
+		require.Error(t, err)
+	})
+
+	t.Run("shutdown releases the lease", func(t *testing.T) {
+		var (
+			ctx, cancel = context.WithCancel(context.Background())
+			store       = &memoryLeaseStore{}
+			mutex       = ha.NewLeaseMutex(ctx, store, testLeaseOptions("first"))
+		)
+
+		result, err := mutex.TryLock()
+		require.NoError(t, err)
+		require.True(t, result.IsPrimary)
+		require.Equal(t, "first", store.currentHolder())
+
+		cancel()
+
+		require.Eventually(t, func() bool {
+			return store.currentHolder() == ""
+		}, time.Second, 5*time.Millisecond)
+	})
+}
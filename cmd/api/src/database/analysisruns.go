@@ -0,0 +1,159 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"gorm.io/gorm"
+)
+
+const (
+	analysisRunSnapshotsTable = "analysis_run_snapshots"
+
+	// AnalysisRunSnapshotRetention is the number of most recent analysis runs whose snapshots are kept. Older runs
+	// remain listed but can no longer be diffed.
+	AnalysisRunSnapshotRetention = 30
+)
+
+// AnalysisRunData defines the methods required to interact with the analysis_runs, analysis_run_steps and
+// analysis_run_snapshots tables
+type AnalysisRunData interface {
+	CreateAnalysisRun(ctx context.Context, run model.AnalysisRun, steps []model.AnalysisRunStep, snapshot []byte) (model.AnalysisRun, error)
+	GetAnalysisRuns(ctx context.Context, skip, limit int) ([]model.AnalysisRun, int, error)
+	GetAnalysisRun(ctx context.Context, id int64) (model.AnalysisRun, error)
+	GetAnalysisRunSteps(ctx context.Context, id int64) ([]model.AnalysisRunStep, error)
+	GetPreviousAnalysisRun(ctx context.Context, id int64) (model.AnalysisRun, error)
+	GetAnalysisRunSnapshot(ctx context.Context, id int64) ([]byte, error)
+}
+
+// analysisRunSelect selects analysis runs along with whether their snapshot is still retained
+func analysisRunSelect() string {
+	return fmt.Sprintf(
+		"SELECT ar.*, EXISTS (SELECT 1 FROM %s ars WHERE ars.analysis_run_id = ar.id) AS has_snapshot FROM %s ar",
+		analysisRunSnapshotsTable, model.AnalysisRun{}.TableName())
+}
+
+// CreateAnalysisRun records an analysis run along with its steps and encoded snapshot and prunes the snapshots of
+// runs that fall outside of AnalysisRunSnapshotRetention
+func (s *BloodhoundDB) CreateAnalysisRun(ctx context.Context, run model.AnalysisRun, steps []model.AnalysisRunStep, snapshot []byte) (model.AnalysisRun, error) {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if result := tx.Raw(fmt.Sprintf(`
+			INSERT INTO %s (started_at, completed_at, status, post_processed_edge_count, tier_zero_count, tier_zero_path_count)
+			VALUES (?, ?, ?, ?, ?, ?)
+			RETURNING *, true AS has_snapshot`, run.TableName()),
+			run.StartedAt, run.CompletedAt, run.Status, run.PostProcessedEdgeCount, run.TierZeroCount, run.TierZeroPathCount).Scan(&run); result.Error != nil {
+			return CheckError(result)
+		} else if result := tx.Exec(fmt.Sprintf("INSERT INTO %s (analysis_run_id, snapshot) VALUES (?, ?)", analysisRunSnapshotsTable), run.ID, snapshot); result.Error != nil {
+			return CheckError(result)
+		}
+
+		if len(steps) > 0 {
+			for idx := range steps {
+				steps[idx].AnalysisRunId = run.ID
+			}
+
+			if result := tx.Create(&steps); result.Error != nil {
+				return CheckError(result)
+			}
+		}
+
+		return CheckError(tx.Exec(fmt.Sprintf(`
+			DELETE FROM %s WHERE analysis_run_id NOT IN (
+				SELECT id FROM %s ORDER BY id DESC LIMIT ?
+			)`, analysisRunSnapshotsTable, run.TableName()), AnalysisRunSnapshotRetention))
+	})
+
+	return run, err
+}
+
+// GetAnalysisRuns returns analysis runs, most recent first
+func (s *BloodhoundDB) GetAnalysisRuns(ctx context.Context, skip, limit int) ([]model.AnalysisRun, int, error) {
+	var (
+		runs            []model.AnalysisRun
+		rowCount        int64
+		skipLimitString string
+	)
+
+	if limit > 0 {
+		skipLimitString += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	if skip > 0 {
+		skipLimitString += fmt.Sprintf(" OFFSET %d", skip)
+	}
+
+	if result := s.db.WithContext(ctx).Raw(fmt.Sprintf("%s ORDER BY ar.id DESC%s", analysisRunSelect(), skipLimitString)).Find(&runs); result.Error != nil {
+		return nil, 0, CheckError(result)
+	} else if result := s.db.WithContext(ctx).Table(model.AnalysisRun{}.TableName()).Count(&rowCount); result.Error != nil {
+		return nil, 0, CheckError(result)
+	}
+
+	return runs, int(rowCount), nil
+}
+
+func (s *BloodhoundDB) GetAnalysisRun(ctx context.Context, id int64) (model.AnalysisRun, error) {
+	var run model.AnalysisRun
+
+	if result := s.db.WithContext(ctx).Raw(fmt.Sprintf("%s WHERE ar.id = ?", analysisRunSelect()), id).Scan(&run); result.Error != nil {
+		return model.AnalysisRun{}, CheckError(result)
+	} else if result.RowsAffected == 0 {
+		return model.AnalysisRun{}, ErrNotFound
+	}
+
+	return run, nil
+}
+
+// GetAnalysisRunSteps returns the steps of an analysis run in the order they were run
+func (s *BloodhoundDB) GetAnalysisRunSteps(ctx context.Context, id int64) ([]model.AnalysisRunStep, error) {
+	var steps []model.AnalysisRunStep
+
+	if result := s.db.WithContext(ctx).Where("analysis_run_id = ?", id).Order("id ASC").Find(&steps); result.Error != nil {
+		return nil, CheckError(result)
+	}
+
+	return steps, nil
+}
+
+// GetPreviousAnalysisRun returns the run that completed immediately before the given one
+func (s *BloodhoundDB) GetPreviousAnalysisRun(ctx context.Context, id int64) (model.AnalysisRun, error) {
+	var run model.AnalysisRun
+
+	if result := s.db.WithContext(ctx).Raw(fmt.Sprintf("%s WHERE ar.id < ? ORDER BY ar.id DESC LIMIT 1", analysisRunSelect()), id).Scan(&run); result.Error != nil {
+		return model.AnalysisRun{}, CheckError(result)
+	} else if result.RowsAffected == 0 {
+		return model.AnalysisRun{}, ErrNotFound
+	}
+
+	return run, nil
+}
+
+// GetAnalysisRunSnapshot returns the encoded snapshot of an analysis run. ErrNotFound is returned if the run does
+// not exist or its snapshot has been pruned.
+func (s *BloodhoundDB) GetAnalysisRunSnapshot(ctx context.Context, id int64) ([]byte, error) {
+	var snapshot []byte
+
+	if result := s.db.WithContext(ctx).Raw(fmt.Sprintf("SELECT snapshot FROM %s WHERE analysis_run_id = ?", analysisRunSnapshotsTable), id).Scan(&snapshot); result.Error != nil {
+		return nil, CheckError(result)
+	} else if result.RowsAffected == 0 {
+		return nil, ErrNotFound
+	}
+
+	return snapshot, nil
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloodhoundDB_AnalysisRuns(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		testSuite = setupIntegrationTestSuite(t)
+		startedAt = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		runIDs    []int64
+	)
+
+	defer teardownIntegrationTestSuite(t, &testSuite)
+
+	_, err := testSuite.BHDatabase.GetPreviousAnalysisRun(ctx, 1)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	for idx := range database.AnalysisRunSnapshotRetention + 1 {
+		run, err := testSuite.BHDatabase.CreateAnalysisRun(ctx, model.AnalysisRun{
+			StartedAt:              startedAt.Add(time.Duration(idx) * time.Hour),
+			CompletedAt:            startedAt.Add(time.Duration(idx)*time.Hour + time.Minute),
+			Status:                 model.AnalysisRunStatusComplete,
+			PostProcessedEdgeCount: idx,
+			TierZeroCount:          1,
+		}, nil, []byte{byte(idx)})
+		require.NoError(t, err)
+		require.True(t, run.HasSnapshot)
+
+		runIDs = append(runIDs, run.ID)
+	}
+
+	runs, count, err := testSuite.BHDatabase.GetAnalysisRuns(ctx, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, database.AnalysisRunSnapshotRetention+1, count)
+	require.Len(t, runs, 2)
+	require.Equal(t, runIDs[len(runIDs)-1], runs[0].ID)
+	require.Equal(t, database.AnalysisRunSnapshotRetention, runs[0].PostProcessedEdgeCount)
+
+	previous, err := testSuite.BHDatabase.GetPreviousAnalysisRun(ctx, runIDs[len(runIDs)-1])
+	require.NoError(t, err)
+	require.Equal(t, runIDs[len(runIDs)-2], previous.ID)
+
+	snapshot, err := testSuite.BHDatabase.GetAnalysisRunSnapshot(ctx, runIDs[len(runIDs)-1])
+	require.NoError(t, err)
+	require.Equal(t, []byte{byte(database.AnalysisRunSnapshotRetention)}, snapshot)
+
+	// The oldest run is still listed but its snapshot has been pruned
+	oldest, err := testSuite.BHDatabase.GetAnalysisRun(ctx, runIDs[0])
+	require.NoError(t, err)
+	require.False(t, oldest.HasSnapshot)
+
+	_, err = testSuite.BHDatabase.GetAnalysisRunSnapshot(ctx, runIDs[0])
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	_, err = testSuite.BHDatabase.GetAnalysisRun(ctx, runIDs[len(runIDs)-1]+1)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	steps, err := testSuite.BHDatabase.GetAnalysisRunSteps(ctx, runIDs[0])
+	require.NoError(t, err)
+	require.Empty(t, steps)
+}
+
+func TestBloodhoundDB_AnalysisRunSteps(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		testSuite = setupIntegrationTestSuite(t)
+		startedAt = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	defer teardownIntegrationTestSuite(t, &testSuite)
+
+	run, err := testSuite.BHDatabase.CreateAnalysisRun(ctx, model.AnalysisRun{
+		StartedAt:   startedAt,
+		CompletedAt: startedAt.Add(time.Minute),
+		Status:      model.AnalysisRunStatusPartial,
+	}, []model.AnalysisRunStep{
+		{
+			Name:                 "ad_post_processing",
+			Status:               model.AnalysisRunStepStatusSucceeded,
+			StartedAt:            startedAt,
+			DurationMs:           30_000,
+			RelationshipsCreated: model.AnalysisRunRelationshipCounts{"DCSync": 3},
+			RelationshipsDeleted: model.AnalysisRunRelationshipCounts{"DCSync": 2},
+		},
+		{
+			Name:      "azure_post_processing",
+			Status:    model.AnalysisRunStepStatusFailed,
+			Error:     "azure post-processing failed",
+			StartedAt: startedAt.Add(30 * time.Second),
+		},
+	}, []byte{0})
+	require.NoError(t, err)
+	require.Equal(t, model.AnalysisRunStatusPartial, run.Status)
+
+	fetched, err := testSuite.BHDatabase.GetAnalysisRun(ctx, run.ID)
+	require.NoError(t, err)
+	require.Equal(t, model.AnalysisRunStatusPartial, fetched.Status)
+
+	steps, err := testSuite.BHDatabase.GetAnalysisRunSteps(ctx, run.ID)
+	require.NoError(t, err)
+	require.Len(t, steps, 2)
+
+	require.Equal(t, run.ID, steps[0].AnalysisRunId)
+	require.Equal(t, "ad_post_processing", steps[0].Name)
+	require.Equal(t, int64(30_000), steps[0].DurationMs)
+	require.Equal(t, model.AnalysisRunRelationshipCounts{"DCSync": 3}, steps[0].RelationshipsCreated)
+	require.Equal(t, model.AnalysisRunRelationshipCounts{"DCSync": 2}, steps[0].RelationshipsDeleted)
+
+	require.Equal(t, "azure_post_processing", steps[1].Name)
+	require.Equal(t, model.AnalysisRunStepStatusFailed, steps[1].Status)
+	require.Equal(t, "azure post-processing failed", steps[1].Error)
+	require.Empty(t, steps[1].RelationshipsCreated)
+}
@@ -62,6 +62,7 @@ type AssetGroupTagSelectorNodeData interface {
 	InsertSelectorNode(ctx context.Context, assetGroupTagId, selectorId int, nodeId graph.ID, certified model.AssetGroupCertification, certifiedBy null.String, source model.AssetGroupSelectorNodeSource, primaryKind, environmentId, objectId, name string) error
 	UpdateSelectorNodesByNodeId(ctx context.Context, assetGroupTagId, selectorId int, nodeId graph.ID, certified model.AssetGroupCertification, certifiedBy null.String, primaryKind, environmentId, objectId, name string) error
 	UpdateCertificationBySelectorNode(ctx context.Context, input []UpdateCertificationBySelectorNodeInput) error
+	UpdateAssetGroupTagMemberCertification(ctx context.Context, certification model.AssetGroupTagMemberCertification, inputs []UpdateCertificationBySelectorNodeInput) error
 	DeleteSelectorNodesByNodeId(ctx context.Context, selectorId int, nodeId graph.ID) error
 	DeleteSelectorNodesBySelectorIds(ctx context.Context, selectorId ...int) error
 	GetSelectorNodesBySelectorIds(ctx context.Context, selectorIds ...int) ([]model.AssetGroupSelectorNode, error)
@@ -787,6 +788,23 @@ func (s *BloodhoundDB) UpdateCertificationBySelectorNode(ctx context.Context, in
 	})
 }
 
+// UpdateAssetGroupTagMemberCertification applies a reviewer's certification decision to the selector nodes of the
+// affected members within a single audited transaction
+func (s *BloodhoundDB) UpdateAssetGroupTagMemberCertification(ctx context.Context, certification model.AssetGroupTagMemberCertification, inputs []UpdateCertificationBySelectorNodeInput) error {
+	auditEntry := model.AuditEntry{
+		Action: model.AuditLogActionCertifyAssetGroupTagMembers,
+		Model:  &certification,
+	}
+
+	if certification.Certified == model.AssetGroupCertificationRevoked {
+		auditEntry.Action = model.AuditLogActionRevokeAssetGroupTagMembers
+	}
+
+	return s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		return NewBloodhoundDB(tx, s.idResolver, s.config).UpdateCertificationBySelectorNode(ctx, inputs)
+	})
+}
+
 func (s *BloodhoundDB) DeleteSelectorNodesByNodeId(ctx context.Context, selectorId int, nodeId graph.ID) error {
 	return CheckError(s.db.WithContext(ctx).Exec(fmt.Sprintf("DELETE FROM %s WHERE selector_id = ? AND node_id = ?", model.AssetGroupSelectorNode{}.TableName()), selectorId, nodeId))
 }
@@ -922,7 +940,7 @@ func (s *BloodhoundDB) GetAggregatedSelectorNodesCertification(ctx context.Conte
 		sqlFilter.SQLString,
 		skipLimitString)
 
-	if result := s.db.WithContext(ctx).Raw(baseQuery + selectQuery).Find(&nodes); result.Error != nil {
+	if result := s.db.WithContext(ctx).Raw(baseQuery+selectQuery, sqlFilter.Params...).Find(&nodes); result.Error != nil {
 		return nil, 0, result.Error
 	} else {
 		// get a total count on the above query without pagination
@@ -931,7 +949,7 @@ func (s *BloodhoundDB) GetAggregatedSelectorNodesCertification(ctx context.Conte
 			FROM sort_on_created_at %s;`,
 			sqlFilter.SQLString)
 
-		if result := s.db.WithContext(ctx).Raw(baseQuery + countQuery).Scan(&count); result.Error != nil {
+		if result := s.db.WithContext(ctx).Raw(baseQuery+countQuery, sqlFilter.Params...).Scan(&count); result.Error != nil {
 			return nil, 0, result.Error
 		} else {
 			return nodes, count, nil
@@ -1301,6 +1301,58 @@ func TestDatabase_UpdateCertificationBySelectorNode(t *testing.T) {
 	})
 }
 
+func TestDatabase_UpdateAssetGroupTagMemberCertification(t *testing.T) {
+	t.Parallel()
+	suite := setupIntegrationTestSuite(t)
+	defer teardownIntegrationTestSuite(t, &suite)
+
+	var (
+		testCtx         = context.Background()
+		testNodeId      = graph.ID(1)
+		testNote        = null.StringFrom("not a tier zero asset")
+		certifiedBy     = null.StringFrom("reviewer@example.com")
+		auditLogsFilter = model.SQLFilter{SQLString: "action = ?", Params: []any{model.AuditLogActionRevokeAssetGroupTagMembers}}
+	)
+
+	selector, err := suite.BHDatabase.CreateAssetGroupTagSelector(testCtx, 1, model.User{}, "test selector name", "test description", false, true, model.SelectorAutoCertifyMethodDisabled, []model.SelectorSeed{
+		{Type: model.SelectorTypeObjectId, Value: "ObjectID1234"},
+	})
+	require.NoError(t, err)
+
+	err = suite.BHDatabase.InsertSelectorNode(testCtx, 1, selector.ID, testNodeId, model.AssetGroupCertificationPending, null.String{}, model.AssetGroupSelectorNodeSourceSeed, "User", "", "ObjectID1234", "USER@DOMAIN")
+	require.NoError(t, err)
+
+	_, historyCountBefore, err := suite.BHDatabase.GetAssetGroupHistoryRecords(testCtx, model.SQLFilter{}, nil, 0, 0)
+	require.NoError(t, err)
+
+	err = suite.BHDatabase.UpdateAssetGroupTagMemberCertification(testCtx, model.AssetGroupTagMemberCertification{
+		AssetGroupTagId: 1,
+		MemberIds:       []graph.ID{testNodeId},
+		Certified:       model.AssetGroupCertificationRevoked,
+		Note:            testNote,
+	}, []database.UpdateCertificationBySelectorNodeInput{
+		{AssetGroupTagId: 1, SelectorId: selector.ID, CertifiedBy: certifiedBy, CertificationStatus: model.AssetGroupCertificationRevoked, NodeId: testNodeId, NodeName: "USER@DOMAIN", Note: testNote},
+	})
+	require.NoError(t, err)
+
+	// confirm the member was revoked
+	selectorNodes, err := suite.BHDatabase.GetSelectorNodesBySelectorIds(testCtx, selector.ID)
+	require.NoError(t, err)
+	require.Len(t, selectorNodes, 1)
+	require.Equal(t, model.AssetGroupCertificationRevoked, selectorNodes[0].Certified)
+	require.Equal(t, certifiedBy, selectorNodes[0].CertifiedBy)
+
+	// confirm the decision was recorded in the history and the audit log
+	_, historyCountAfter, err := suite.BHDatabase.GetAssetGroupHistoryRecords(testCtx, model.SQLFilter{}, nil, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, historyCountBefore+1, historyCountAfter)
+
+	auditLogs, _, err := suite.BHDatabase.ListAuditLogs(testCtx, time.Now(), time.Now().Add(-time.Hour), 0, 10, "", auditLogsFilter)
+	require.NoError(t, err)
+	require.Len(t, auditLogs, 2)
+	require.ElementsMatch(t, []model.AuditLogEntryStatus{model.AuditLogStatusIntent, model.AuditLogStatusSuccess}, []model.AuditLogEntryStatus{auditLogs[0].Status, auditLogs[1].Status})
+}
+
 func TestDatabase_GetAssetGroupSelectorNodeExpandedOrderedByIdAndPosition(t *testing.T) {
 	t.Parallel()
 	suite := setupIntegrationTestSuite(t)
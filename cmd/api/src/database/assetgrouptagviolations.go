@@ -0,0 +1,57 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"gorm.io/gorm"
+)
+
+// AssetGroupTagViolationData defines the methods required to interact with the asset_group_tag_violations table
+type AssetGroupTagViolationData interface {
+	SaveAssetGroupTagViolations(ctx context.Context, violations []model.AssetGroupTagViolation) error
+	GetAssetGroupTagViolations(ctx context.Context, assetGroupTagId int) ([]model.AssetGroupTagViolation, error)
+}
+
+// SaveAssetGroupTagViolations replaces the results of the previous tier violation analysis. Tier pairs without any
+// violation are not recorded.
+func (s *BloodhoundDB) SaveAssetGroupTagViolations(ctx context.Context, violations []model.AssetGroupTagViolation) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if result := tx.Exec(fmt.Sprintf("DELETE FROM %s", model.AssetGroupTagViolation{}.TableName())); result.Error != nil {
+			return CheckError(result)
+		} else if len(violations) == 0 {
+			return nil
+		}
+
+		return CheckError(tx.Create(&violations))
+	})
+}
+
+// GetAssetGroupTagViolations returns the violations recorded for the tier with the given id, most violated first
+func (s *BloodhoundDB) GetAssetGroupTagViolations(ctx context.Context, assetGroupTagId int) ([]model.AssetGroupTagViolation, error) {
+	var violations []model.AssetGroupTagViolation
+
+	result := s.db.WithContext(ctx).
+		Where("asset_group_tag_id = ?", assetGroupTagId).
+		Order("violation_count DESC, source_asset_group_tag_id ASC").
+		Find(&violations)
+
+	return violations, CheckError(result)
+}
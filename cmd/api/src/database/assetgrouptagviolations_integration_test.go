@@ -0,0 +1,94 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloodhoundDB_AssetGroupTagViolations(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		testSuite = setupIntegrationTestSuite(t)
+		testActor = model.User{Unique: model.Unique{ID: uuid.FromStringOrNil("01234567-9012-4567-9012-456789012345")}}
+
+		firstRun  = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		secondRun = firstRun.Add(24 * time.Hour)
+		samples   = model.AssetGroupTagViolationEdges{
+			{Kind: "GenericAll", FromPrincipal: "S-1-5-21-1-1105", FromKind: "User", ToPrincipal: "S-1-5-21-1-512", ToKind: "Group"},
+		}
+	)
+
+	defer teardownIntegrationTestSuite(t, &testSuite)
+
+	tierZero, err := testSuite.BHDatabase.GetAssetGroupTag(ctx, 1)
+	require.NoError(t, err)
+
+	tierOne, err := testSuite.BHDatabase.CreateAssetGroupTag(ctx, model.AssetGroupTagTypeTier, testActor, "Violations Tier One", "", null.Int32{}, null.Bool{}, null.String{})
+	require.NoError(t, err)
+
+	require.NoError(t, testSuite.BHDatabase.SaveAssetGroupTagViolations(ctx, []model.AssetGroupTagViolation{
+		{AssetGroupTagId: tierZero.ID, SourceAssetGroupTagId: tierOne.ID, ViolationCount: 1, SampleEdges: samples, EvaluatedAt: firstRun},
+		{AssetGroupTagId: tierZero.ID, SourceAssetGroupTagId: model.AssetGroupTagViolationUntieredSourceId, ViolationCount: 3, SampleEdges: samples, EvaluatedAt: firstRun},
+		{AssetGroupTagId: tierOne.ID, SourceAssetGroupTagId: model.AssetGroupTagViolationUntieredSourceId, ViolationCount: 2, EvaluatedAt: firstRun},
+	}))
+
+	// Violations are listed most violated first
+	violations, err := testSuite.BHDatabase.GetAssetGroupTagViolations(ctx, tierZero.ID)
+	require.NoError(t, err)
+	require.Len(t, violations, 2)
+	require.Equal(t, model.AssetGroupTagViolationUntieredSourceId, violations[0].SourceAssetGroupTagId)
+	require.Equal(t, 3, violations[0].ViolationCount)
+	require.Equal(t, samples, violations[0].SampleEdges)
+	require.Equal(t, firstRun, violations[0].EvaluatedAt.UTC())
+
+	violations, err = testSuite.BHDatabase.GetAssetGroupTagViolations(ctx, tierOne.ID)
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	require.Empty(t, violations[0].SampleEdges)
+
+	// A later run replaces every previous result
+	require.NoError(t, testSuite.BHDatabase.SaveAssetGroupTagViolations(ctx, []model.AssetGroupTagViolation{
+		{AssetGroupTagId: tierZero.ID, SourceAssetGroupTagId: tierOne.ID, ViolationCount: 4, SampleEdges: samples, EvaluatedAt: secondRun},
+	}))
+
+	violations, err = testSuite.BHDatabase.GetAssetGroupTagViolations(ctx, tierZero.ID)
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	require.Equal(t, tierOne.ID, violations[0].SourceAssetGroupTagId)
+	require.Equal(t, secondRun, violations[0].EvaluatedAt.UTC())
+
+	violations, err = testSuite.BHDatabase.GetAssetGroupTagViolations(ctx, tierOne.ID)
+	require.NoError(t, err)
+	require.Empty(t, violations)
+
+	// Nothing is recorded when no tier is violated
+	require.NoError(t, testSuite.BHDatabase.SaveAssetGroupTagViolations(ctx, nil))
+
+	violations, err = testSuite.BHDatabase.GetAssetGroupTagViolations(ctx, tierZero.ID)
+	require.NoError(t, err)
+	require.Empty(t, violations)
+}
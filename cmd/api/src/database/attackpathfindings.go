@@ -0,0 +1,211 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"gorm.io/gorm"
+)
+
+// attackPathFindingBatchSize bounds the number of rows written by a single upsert statement
+const attackPathFindingBatchSize = 1000
+
+// AttackPathFindingData defines the methods required to interact with the attack_path_findings table
+type AttackPathFindingData interface {
+	SaveAttackPathFindings(ctx context.Context, schemaFindingId int32, assetGroupTagId int, observations []model.AttackPathFindingObservation, evaluatedAt time.Time) error
+	GetAttackPathFindings(ctx context.Context, sqlFilter model.SQLFilter, sort model.Sort, skip, limit int) ([]model.AttackPathFinding, int, error)
+	GetAttackPathFinding(ctx context.Context, id int64) (model.AttackPathFinding, error)
+	UpdateAttackPathFindingAcceptedRisk(ctx context.Context, id int64, acceptedUntil null.Time, acceptedBy, acceptedReason null.String) (model.AttackPathFinding, error)
+}
+
+// SaveAttackPathFindings records the observations of a schema finding for an asset group tag made by an analysis run.
+// Observations seen before keep their first_seen time, new observations start at evaluatedAt and previously open
+// findings that were not observed again are marked as resolved.
+func (s *BloodhoundDB) SaveAttackPathFindings(ctx context.Context, schemaFindingId int32, assetGroupTagId int, observations []model.AttackPathFindingObservation, evaluatedAt time.Time) error {
+	type observationKey struct {
+		from string
+		to   string
+	}
+
+	var (
+		tableName = model.AttackPathFinding{}.TableName()
+		seen      = make(map[observationKey]struct{}, len(observations))
+		unique    = make([]model.AttackPathFindingObservation, 0, len(observations))
+	)
+
+	// Postgres refuses to upsert the same row twice within a single statement
+	for _, observation := range observations {
+		key := observationKey{from: observation.FromPrincipal, to: observation.ToPrincipal}
+
+		if _, exists := seen[key]; !exists {
+			seen[key] = struct{}{}
+			unique = append(unique, observation)
+		}
+	}
+
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(unique); start += attackPathFindingBatchSize {
+			var (
+				batch  = unique[start:min(start+attackPathFindingBatchSize, len(unique))]
+				values = make([]string, 0, len(batch))
+				params = make([]any, 0, len(batch)*8)
+			)
+
+			for _, observation := range batch {
+				values = append(values, "(?, ?, ?, ?, ?, ?, ?, ?)")
+				params = append(params, schemaFindingId, assetGroupTagId, observation.EnvironmentId, observation.FromPrincipal, observation.ToPrincipal, observation.PrincipalKind, evaluatedAt, evaluatedAt)
+			}
+
+			if result := tx.Exec(fmt.Sprintf(`
+				INSERT INTO %s (schema_finding_id, asset_group_tag_id, environment_id, from_principal, to_principal, principal_kind, first_seen, last_seen)
+				VALUES %s
+				ON CONFLICT (schema_finding_id, asset_group_tag_id, from_principal, to_principal) DO UPDATE SET
+					environment_id = EXCLUDED.environment_id,
+					principal_kind = EXCLUDED.principal_kind,
+					last_seen = EXCLUDED.last_seen,
+					resolved_at = NULL,
+					updated_at = NOW()`,
+				tableName, strings.Join(values, ", ")), params...); result.Error != nil {
+				return CheckError(result)
+			}
+		}
+
+		return CheckError(tx.Exec(fmt.Sprintf(`
+			UPDATE %s SET resolved_at = ?, updated_at = NOW()
+			WHERE schema_finding_id = ? AND asset_group_tag_id = ? AND resolved_at IS NULL AND last_seen < ?`,
+			tableName), evaluatedAt, schemaFindingId, assetGroupTagId, evaluatedAt))
+	})
+}
+
+// attackPathFindingSelect selects attack path findings along with the name of the schema finding they belong to
+func attackPathFindingSelect() string {
+	return fmt.Sprintf("SELECT apf.*, sf.name AS finding_name FROM %s apf JOIN %s sf ON sf.id = apf.schema_finding_id",
+		model.AttackPathFinding{}.TableName(), model.SchemaFinding{}.TableName())
+}
+
+func (s *BloodhoundDB) GetAttackPathFindings(ctx context.Context, sqlFilter model.SQLFilter, sort model.Sort, skip, limit int) ([]model.AttackPathFinding, int, error) {
+	var (
+		findings        []model.AttackPathFinding
+		skipLimitString string
+		rowCount        int
+		sortString      = "ORDER BY id ASC"
+	)
+
+	if sqlFilter.SQLString != "" {
+		sqlFilter.SQLString = " WHERE " + sqlFilter.SQLString
+	}
+
+	if len(sort) > 0 {
+		var sortColumns []string
+		for _, item := range sort {
+			dirString := "ASC"
+			if item.Direction == model.DescendingSortDirection {
+				dirString = "DESC"
+			}
+			sortColumns = append(sortColumns, fmt.Sprintf("%s %s", item.Column, dirString))
+		}
+		sortString = "ORDER BY " + strings.Join(sortColumns, ", ")
+	}
+
+	if limit > 0 {
+		skipLimitString += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	if skip > 0 {
+		skipLimitString += fmt.Sprintf(" OFFSET %d", skip)
+	}
+
+	// The join is wrapped so that filters and sorting apply to the finding columns without qualification
+	sqlStr := fmt.Sprintf(
+		"SELECT * FROM (%s) findings%s %s %s",
+		attackPathFindingSelect(),
+		sqlFilter.SQLString,
+		sortString,
+		skipLimitString)
+
+	if result := s.db.WithContext(ctx).Raw(sqlStr, sqlFilter.Params...).Find(&findings); result.Error != nil {
+		return []model.AttackPathFinding{}, 0, CheckError(result)
+	}
+
+	if limit > 0 || skip > 0 {
+		sqlCountStr := fmt.Sprintf(
+			"SELECT COUNT(*) FROM (%s) findings%s",
+			attackPathFindingSelect(),
+			sqlFilter.SQLString)
+		if result := s.db.WithContext(ctx).Raw(sqlCountStr, sqlFilter.Params...).Scan(&rowCount); result.Error != nil {
+			return []model.AttackPathFinding{}, 0, CheckError(result)
+		}
+	} else {
+		rowCount = len(findings)
+	}
+
+	return findings, rowCount, nil
+}
+
+func (s *BloodhoundDB) GetAttackPathFinding(ctx context.Context, id int64) (model.AttackPathFinding, error) {
+	var finding model.AttackPathFinding
+
+	if result := s.db.WithContext(ctx).Raw(fmt.Sprintf("SELECT * FROM (%s) findings WHERE id = ?", attackPathFindingSelect()), id).Scan(&finding); result.Error != nil {
+		return model.AttackPathFinding{}, CheckError(result)
+	} else if result.RowsAffected == 0 {
+		return model.AttackPathFinding{}, ErrNotFound
+	}
+
+	return finding, nil
+}
+
+// UpdateAttackPathFindingAcceptedRisk accepts the risk of a finding until the given time. An invalid acceptedUntil
+// clears a previous acceptance.
+func (s *BloodhoundDB) UpdateAttackPathFindingAcceptedRisk(ctx context.Context, id int64, acceptedUntil null.Time, acceptedBy, acceptedReason null.String) (model.AttackPathFinding, error) {
+	var (
+		finding    model.AttackPathFinding
+		auditEntry = model.AuditEntry{
+			Action: model.AuditLogActionAcceptRisk,
+			Model:  &finding, // Pointer is required to ensure success log contains updated fields after transaction
+		}
+	)
+
+	if !acceptedUntil.Valid {
+		auditEntry.Action = model.AuditLogActionUnacceptRisk
+		acceptedBy, acceptedReason = null.String{}, null.String{}
+	}
+
+	err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		if result := tx.Raw(fmt.Sprintf(`
+			WITH updated AS (
+				UPDATE %s SET accepted_until = ?, accepted_by = ?, accepted_reason = ?, updated_at = NOW()
+				WHERE id = ?
+				RETURNING *
+			)
+			SELECT updated.*, sf.name AS finding_name FROM updated JOIN %s sf ON sf.id = updated.schema_finding_id`,
+			finding.TableName(), model.SchemaFinding{}.TableName()), acceptedUntil, acceptedBy, acceptedReason, id).Scan(&finding); result.Error != nil {
+			return CheckError(result)
+		} else if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+
+		return nil
+	})
+
+	return finding, err
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloodhoundDB_AttackPathFindings(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		testSuite = setupIntegrationTestSuite(t)
+
+		firstRun  = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		secondRun = firstRun.Add(24 * time.Hour)
+	)
+
+	defer teardownIntegrationTestSuite(t, &testSuite)
+
+	extension, err := testSuite.BHDatabase.CreateGraphSchemaExtension(ctx, "FindingsExt", "Findings", "v1.0.0", "findings_namespace")
+	require.NoError(t, err)
+
+	environment, err := testSuite.BHDatabase.CreateEnvironment(ctx, extension.ID, 1, 1)
+	require.NoError(t, err)
+
+	finding, err := testSuite.BHDatabase.CreateSchemaFinding(ctx, model.SchemaFindingTypeRelationship, extension.ID, 1, environment.ID, "T0Finding", "Tier Zero Finding")
+	require.NoError(t, err)
+
+	// The same observation twice in one run is only recorded once
+	require.NoError(t, testSuite.BHDatabase.SaveAttackPathFindings(ctx, finding.ID, 1, []model.AttackPathFindingObservation{
+		{EnvironmentId: "S-1-5-21-1", FromPrincipal: "S-1-5-21-1-1105", ToPrincipal: "S-1-5-21-1-512", PrincipalKind: "User"},
+		{EnvironmentId: "S-1-5-21-1", FromPrincipal: "S-1-5-21-1-1105", ToPrincipal: "S-1-5-21-1-512", PrincipalKind: "User"},
+		{EnvironmentId: "S-1-5-21-1", FromPrincipal: "S-1-5-21-1-1106", ToPrincipal: "S-1-5-21-1-512", PrincipalKind: "User"},
+	}, firstRun))
+
+	findings, count, err := testSuite.BHDatabase.GetAttackPathFindings(ctx, model.SQLFilter{}, model.Sort{{Column: "from_principal"}}, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	require.Equal(t, "T0Finding", findings[0].FindingName)
+	require.Equal(t, firstRun, findings[0].FirstSeen.UTC())
+
+	// Only one of the observations is seen again
+	require.NoError(t, testSuite.BHDatabase.SaveAttackPathFindings(ctx, finding.ID, 1, []model.AttackPathFindingObservation{
+		{EnvironmentId: "S-1-5-21-1", FromPrincipal: "S-1-5-21-1-1105", ToPrincipal: "S-1-5-21-1-512", PrincipalKind: "User"},
+	}, secondRun))
+
+	findings, _, err = testSuite.BHDatabase.GetAttackPathFindings(ctx, model.SQLFilter{}, model.Sort{{Column: "from_principal"}}, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, findings, 2)
+
+	require.Equal(t, firstRun, findings[0].FirstSeen.UTC())
+	require.Equal(t, secondRun, findings[0].LastSeen.UTC())
+	require.False(t, findings[0].ResolvedAt.Valid)
+
+	require.Equal(t, firstRun, findings[1].LastSeen.UTC())
+	require.True(t, findings[1].ResolvedAt.Valid)
+	require.Equal(t, secondRun, findings[1].ResolvedAt.Time.UTC())
+
+	open, count, err := testSuite.BHDatabase.GetAttackPathFindings(ctx, model.SQLFilter{SQLString: "resolved_at IS NULL AND finding_name = ?", Params: []any{"T0Finding"}}, nil, 0, 1)
+	require.NoError(t, err)
+	require.Len(t, open, 1)
+	require.Equal(t, 1, count)
+
+	// Accepting and clearing the accepted risk
+	acceptedUntil := secondRun.Add(30 * 24 * time.Hour)
+
+	accepted, err := testSuite.BHDatabase.UpdateAttackPathFindingAcceptedRisk(ctx, findings[0].ID, null.TimeFrom(acceptedUntil), null.StringFrom("analyst@example.com"), null.StringFrom("compensating control"))
+	require.NoError(t, err)
+	require.Equal(t, "T0Finding", accepted.FindingName)
+	require.Equal(t, acceptedUntil, accepted.AcceptedUntil.Time.UTC())
+	require.Equal(t, "analyst@example.com", accepted.AcceptedBy.ValueOrZero())
+	require.True(t, accepted.IsAccepted(secondRun))
+
+	cleared, err := testSuite.BHDatabase.UpdateAttackPathFindingAcceptedRisk(ctx, findings[0].ID, null.Time{}, null.StringFrom("analyst@example.com"), null.StringFrom("ignored"))
+	require.NoError(t, err)
+	require.False(t, cleared.AcceptedUntil.Valid)
+	require.False(t, cleared.AcceptedReason.Valid)
+
+	_, err = testSuite.BHDatabase.GetAttackPathFinding(ctx, 1000)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// Findings are removed along with the schema finding they belong to
+	require.NoError(t, testSuite.BHDatabase.DeleteSchemaFinding(ctx, finding.ID))
+
+	findings, _, err = testSuite.BHDatabase.GetAttackPathFindings(ctx, model.SQLFilter{}, nil, 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, findings)
+}
@@ -107,6 +107,80 @@ func (s *BloodhoundDB) GetRole(ctx context.Context, id int32) (model.Role, error
 	return role, CheckError(result)
 }
 
+// CreateRole creates a new custom role and associates it with the permissions of the role template
+// INSERT INTO roles (name, description) VALUES (...)
+func (s *BloodhoundDB) CreateRole(ctx context.Context, role model.Role) (model.Role, error) {
+	auditEntry := model.AuditEntry{
+		Action: model.AuditLogActionCreateRole,
+		Model:  &role,
+	}
+
+	err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		// Permissions are a fixed set; only the roles_permissions associations are created
+		result := tx.WithContext(ctx).Omit("Permissions.*").Create(&role)
+
+		if result.Error != nil && strings.Contains(result.Error.Error(), "duplicate key value violates unique constraint \"roles_name_key\"") {
+			return fmt.Errorf("%w: %v", ErrDuplicateRoleName, result.Error)
+		}
+
+		return CheckError(result)
+	})
+
+	return role, err
+}
+
+// UpdateRole updates the name, description and permissions of a custom role
+// UPDATE roles SET name = ..., description = ... WHERE id = ...
+func (s *BloodhoundDB) UpdateRole(ctx context.Context, role model.Role) error {
+	auditEntry := model.AuditEntry{
+		Action: model.AuditLogActionUpdateRole,
+		Model:  &role,
+	}
+
+	return s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		if err := tx.Model(&role).WithContext(ctx).Omit("Permissions.*").Association("Permissions").Replace(role.Permissions); err != nil {
+			return err
+		}
+
+		result := tx.WithContext(ctx).Omit("Permissions").Save(&role)
+
+		if result.Error != nil && strings.Contains(result.Error.Error(), "duplicate key value violates unique constraint \"roles_name_key\"") {
+			return fmt.Errorf("%w: %v", ErrDuplicateRoleName, result.Error)
+		}
+
+		return CheckError(result)
+	})
+}
+
+// DeleteRole removes a custom role. Roles that are still assigned to a user or used as the default role of an SSO
+// provider's auto provisioning are not deleted and ErrRoleInUse is returned instead.
+// DELETE FROM roles WHERE id = ...
+func (s *BloodhoundDB) DeleteRole(ctx context.Context, role model.Role) error {
+	auditEntry := model.AuditEntry{
+		Action: model.AuditLogActionDeleteRole,
+		Model:  &role,
+	}
+
+	return s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		var references int64
+
+		if result := tx.WithContext(ctx).Raw(`
+			SELECT
+				(SELECT COUNT(*) FROM users_roles WHERE role_id = ?) +
+				(SELECT COUNT(*) FROM sso_providers WHERE (config->'auto_provision'->>'default_role_id')::int = ?)`,
+			role.ID, role.ID,
+		).Scan(&references); result.Error != nil {
+			return CheckError(result)
+		} else if references > 0 {
+			return ErrRoleInUse
+		} else if err := tx.Model(&role).WithContext(ctx).Association("Permissions").Clear(); err != nil {
+			return err
+		}
+
+		return CheckError(tx.WithContext(ctx).Delete(&role))
+	})
+}
+
 // GetAllPermissions retrieves all rows from the Permissions table
 // SELECT * FROM permissions
 func (s *BloodhoundDB) GetAllPermissions(ctx context.Context, order string, filter model.SQLFilter) (model.Permissions, error) {
@@ -155,6 +155,74 @@ func TestDatabase_InitializeRoles(t *testing.T) {
 	}
 }
 
+func TestDatabase_BuiltInRoles(t *testing.T) {
+	_, roles := initAndGetRoles(t)
+
+	for _, role := range roles {
+		_, isTemplate := auth.Roles()[role.Name]
+		require.Equal(t, isTemplate, role.BuiltIn, role.Name)
+	}
+}
+
+func TestDatabase_CreateUpdateDeleteRole(t *testing.T) {
+	var (
+		ctx         = context.Background()
+		dbInst      = integration.SetupDB(t)
+		permissions = auth.Permissions()
+	)
+
+	allPermissions, err := dbInst.GetAllPermissions(ctx, "", model.SQLFilter{})
+	require.NoError(t, err)
+
+	findPermissions := func(templates ...model.Permission) model.Permissions {
+		var found model.Permissions
+
+		for _, permission := range allPermissions {
+			if model.Permissions(templates).Has(permission) {
+				found = append(found, permission)
+			}
+		}
+
+		return found
+	}
+
+	role, err := dbInst.CreateRole(ctx, model.Role{
+		Name:        "Graph Reader",
+		Description: "Can read graph data",
+		Permissions: findPermissions(permissions.GraphDBRead),
+	})
+	require.NoError(t, err)
+	require.NotZero(t, role.ID)
+	require.False(t, role.BuiltIn)
+
+	_, err = dbInst.CreateRole(ctx, model.Role{Name: "Graph Reader"})
+	require.ErrorIs(t, err, database.ErrDuplicateRoleName)
+
+	role.Description = "Can read and write graph data"
+	role.Permissions = findPermissions(permissions.GraphDBRead, permissions.GraphDBWrite)
+	require.NoError(t, dbInst.UpdateRole(ctx, role))
+
+	fetched, err := dbInst.GetRole(ctx, role.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Can read and write graph data", fetched.Description)
+	require.True(t, fetched.Permissions.Equals(model.Permissions{permissions.GraphDBRead, permissions.GraphDBWrite}))
+
+	// Roles assigned to a user are protected from deletion
+	user, err := dbInst.CreateUser(ctx, model.User{
+		Roles:         model.Roles{fetched},
+		EmailAddress:  null.StringFrom(userPrincipal),
+		PrincipalName: userPrincipal,
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, dbInst.DeleteRole(ctx, fetched), database.ErrRoleInUse)
+
+	require.NoError(t, dbInst.DeleteUser(ctx, user))
+	require.NoError(t, dbInst.DeleteRole(ctx, fetched))
+
+	_, err = dbInst.GetRole(ctx, role.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+}
+
 func TestDatabase_CreateGetDeleteUser(t *testing.T) {
 	var (
 		ctx           = context.Background()
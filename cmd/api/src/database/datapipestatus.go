@@ -25,7 +25,9 @@ import (
 
 type DatapipeStatusData interface {
 	UpdateLastAnalysisCompleteTime(ctx context.Context) error
+	UpdateLastScheduledAnalysisRunTime(ctx context.Context, runAt time.Time) error
 	SetDatapipeStatus(ctx context.Context, status model.DatapipeStatus) error
+	SetFullAnalysisRequired(ctx context.Context, required bool) error
 	GetDatapipeStatus(ctx context.Context) (model.DatapipeStatusWrapper, error)
 }
 
@@ -35,15 +37,25 @@ func (s *BloodhoundDB) UpdateLastAnalysisCompleteTime(ctx context.Context) error
 	return s.db.WithContext(ctx).Exec("UPDATE datapipe_status SET updated_at = ?, last_complete_analysis_at = ?", now, now).Error
 }
 
+// This should be called whenever a scheduled analysis occurrence is handled, whether or not it resulted in an analysis run
+func (s *BloodhoundDB) UpdateLastScheduledAnalysisRunTime(ctx context.Context, runAt time.Time) error {
+	return s.db.WithContext(ctx).Exec("UPDATE datapipe_status SET updated_at = ?, last_analysis_run_at = ?", time.Now().UTC(), runAt.UTC()).Error
+}
+
 func (s *BloodhoundDB) SetDatapipeStatus(ctx context.Context, status model.DatapipeStatus) error {
 	now := time.Now().UTC()
 	return s.db.WithContext(ctx).Exec("UPDATE datapipe_status SET status = ?, updated_at = ?;", status, now).Error
 }
 
+// SetFullAnalysisRequired marks whether the next analysis run must rebuild the entire graph
+func (s *BloodhoundDB) SetFullAnalysisRequired(ctx context.Context, required bool) error {
+	return s.db.WithContext(ctx).Exec("UPDATE datapipe_status SET full_analysis_required = ?, updated_at = ?;", required, time.Now().UTC()).Error
+}
+
 func (s *BloodhoundDB) GetDatapipeStatus(ctx context.Context) (model.DatapipeStatusWrapper, error) {
 	var datapipeStatus model.DatapipeStatusWrapper
 
-	tx := s.db.WithContext(ctx).Select("status, updated_at, last_complete_analysis_at, last_analysis_run_at").Table("datapipe_status").First(&datapipeStatus)
+	tx := s.db.WithContext(ctx).Select("status, updated_at, last_complete_analysis_at, last_analysis_run_at, full_analysis_required").Table("datapipe_status").First(&datapipeStatus)
 
 	return datapipeStatus, CheckError(tx)
 }
@@ -45,4 +45,11 @@ func TestDatapipeStatus(t *testing.T) {
 	status, err = db.GetDatapipeStatus(testCtx)
 	require.Nil(t, err)
 	assert.True(t, !status.LastCompleteAnalysisAt.IsZero())
+	assert.False(t, status.FullAnalysisRequired)
+
+	err = db.SetFullAnalysisRequired(testCtx, true)
+	require.Nil(t, err)
+	status, err = db.GetDatapipeStatus(testCtx)
+	require.Nil(t, err)
+	assert.True(t, status.FullAnalysisRequired)
 }
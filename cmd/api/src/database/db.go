@@ -49,7 +49,10 @@ var (
 	ErrDuplicateSSOProviderName    = errors.New("duplicate sso provider name")
 	ErrDuplicateUserPrincipal      = errors.New("duplicate user principal name")
 	ErrDuplicateEmail              = errors.New("duplicate user email address")
+	ErrDuplicateRoleName           = errors.New("duplicate role name")
+	ErrRoleInUse                   = errors.New("role is in use")
 	ErrDuplicateCustomNodeKindName = errors.New("duplicate custom node kind name")
+	ErrDuplicateCostProfileName    = errors.New("duplicate pathfinding cost profile name")
 	ErrDuplicateKindName           = errors.New("duplicate kind name")
 	ErrDuplicateGlyph              = errors.New("duplicate glyph")
 	ErrPositionOutOfRange          = errors.New("position out of range")
@@ -76,6 +79,8 @@ type Database interface {
 	CountAllIngestTasks(ctx context.Context) (int64, error)
 	DeleteIngestTask(ctx context.Context, ingestTask model.IngestTask) error
 	GetIngestTasksForJob(ctx context.Context, jobID int64) (model.IngestTasks, error)
+	GetIngestPreview(ctx context.Context, jobID int64) (model.IngestPreview, error)
+	SaveIngestPreview(ctx context.Context, preview model.IngestPreview) error
 
 	// Asset Groups
 	AgiData
@@ -96,6 +101,9 @@ type Database interface {
 	GetAllRoles(ctx context.Context, order string, filter model.SQLFilter) (model.Roles, error)
 	GetRoles(ctx context.Context, ids []int32) (model.Roles, error)
 	GetRole(ctx context.Context, id int32) (model.Role, error)
+	CreateRole(ctx context.Context, role model.Role) (model.Role, error)
+	UpdateRole(ctx context.Context, role model.Role) error
+	DeleteRole(ctx context.Context, role model.Role) error
 
 	// Permissions
 	GetAllPermissions(ctx context.Context, order string, filter model.SQLFilter) (model.Permissions, error)
@@ -157,6 +165,15 @@ type Database interface {
 	// Datapipe Status
 	DatapipeStatusData
 
+	// Attack Path Findings
+	AttackPathFindingData
+
+	// Analysis Runs
+	AnalysisRunData
+
+	// Asset Group Tag Violations
+	AssetGroupTagViolationData
+
 	// Asset Group Tags
 	AssetGroupHistoryData
 	AssetGroupTagData
@@ -166,6 +183,9 @@ type Database interface {
 	// Custom Node Kinds
 	CustomNodeKindData
 
+	// Pathfinding Cost Profiles
+	PathfindingCostProfileData
+
 	// Source Kinds
 	SourceKindsData
 
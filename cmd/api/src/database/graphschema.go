@@ -99,12 +99,18 @@ type FilterAndPagination struct {
 
 // CreateGraphSchemaExtension creates a new row in the extensions table. A GraphSchemaExtension struct is returned, populated with the value as it stands in the database.
 func (s *BloodhoundDB) CreateGraphSchemaExtension(ctx context.Context, name string, displayName string, version string, namespace string) (model.GraphSchemaExtension, error) {
+	return s.createGraphSchemaExtension(ctx, name, displayName, version, namespace, false)
+}
+
+// createGraphSchemaExtension - creates a new row in the extensions table with the given property validation mode.
+func (s *BloodhoundDB) createGraphSchemaExtension(ctx context.Context, name string, displayName string, version string, namespace string, strictPropertyValidation bool) (model.GraphSchemaExtension, error) {
 	var (
 		extension = model.GraphSchemaExtension{
-			Name:        name,
-			DisplayName: displayName,
-			Version:     version,
-			Namespace:   namespace,
+			Name:                     name,
+			DisplayName:              displayName,
+			Version:                  version,
+			Namespace:                namespace,
+			StrictPropertyValidation: strictPropertyValidation,
 		}
 
 		auditEntry = model.AuditEntry{
@@ -115,11 +121,11 @@ func (s *BloodhoundDB) CreateGraphSchemaExtension(ctx context.Context, name stri
 
 	if err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
 		if result := tx.Raw(fmt.Sprintf(`
-			INSERT INTO %s (name, display_name, version, is_builtin, namespace, created_at, updated_at)
-			VALUES (?, ?, ?, FALSE, ?, NOW(), NOW())
-			RETURNING id, name, display_name, version, is_builtin, namespace, created_at, updated_at, deleted_at`,
+			INSERT INTO %s (name, display_name, version, is_builtin, namespace, strict_property_validation, created_at, updated_at)
+			VALUES (?, ?, ?, FALSE, ?, ?, NOW(), NOW())
+			RETURNING id, name, display_name, version, is_builtin, namespace, strict_property_validation, created_at, updated_at, deleted_at`,
 			extension.TableName()),
-			name, displayName, version, namespace).Scan(&extension); result.Error != nil {
+			name, displayName, version, namespace, strictPropertyValidation).Scan(&extension); result.Error != nil {
 			if strings.Contains(result.Error.Error(), DuplicateKeyValueErrorString) {
 				if strings.Contains(result.Error.Error(), "namespace") {
 					return fmt.Errorf("%w: %v", model.ErrDuplicateGraphSchemaExtensionNamespace, namespace)
@@ -142,7 +148,7 @@ func (s *BloodhoundDB) GetGraphSchemaExtensionById(ctx context.Context, extensio
 	var extension model.GraphSchemaExtension
 
 	if result := s.db.WithContext(ctx).Raw(fmt.Sprintf(`
-		SELECT id, name, display_name, version, is_builtin, namespace, created_at, updated_at, deleted_at
+		SELECT id, name, display_name, version, is_builtin, namespace, strict_property_validation, created_at, updated_at, deleted_at
 		FROM %s WHERE id = ?`,
 		extension.TableName()),
 		extensionId).First(&extension); result.Error != nil {
@@ -164,7 +170,7 @@ func (s *BloodhoundDB) GetGraphSchemaExtensions(ctx context.Context, extensionFi
 		return extensions, 0, err
 	} else {
 
-		sqlStr := fmt.Sprintf(`SELECT id, name, display_name, version, is_builtin, namespace, created_at, updated_at, deleted_at
+		sqlStr := fmt.Sprintf(`SELECT id, name, display_name, version, is_builtin, namespace, strict_property_validation, created_at, updated_at, deleted_at
 								FROM %s %s %s %s`,
 			model.GraphSchemaExtension{}.TableName(),
 			filterAndPagination.WhereClause,
@@ -192,14 +198,14 @@ func (s *BloodhoundDB) GetGraphSchemaExtensions(ctx context.Context, extensionFi
 	}
 }
 
-// UpdateGraphSchemaExtension updates an existing Graph Schema Extension. Only the `name`, `display_name`, and `version` fields are updatable. It returns the updated extension, or an error if the update violates schema constraints or did not succeed.
+// UpdateGraphSchemaExtension updates an existing Graph Schema Extension. Only the `name`, `display_name`, `version`, `namespace` and `strict_property_validation` fields are updatable. It returns the updated extension, or an error if the update violates schema constraints or did not succeed.
 func (s *BloodhoundDB) UpdateGraphSchemaExtension(ctx context.Context, extension model.GraphSchemaExtension) (model.GraphSchemaExtension, error) {
 	if result := s.db.WithContext(ctx).Raw(fmt.Sprintf(`
 		UPDATE %s
-		SET name = ?, display_name = ?, version = ?, namespace = ?, updated_at = NOW()
+		SET name = ?, display_name = ?, version = ?, namespace = ?, strict_property_validation = ?, updated_at = NOW()
 		WHERE id = ?
-		RETURNING id, name, display_name, version, is_builtin, namespace, created_at, updated_at, deleted_at`,
-		extension.TableName()), extension.Name, extension.DisplayName, extension.Version, extension.Namespace, extension.ID).Scan(&extension); result.Error != nil {
+		RETURNING id, name, display_name, version, is_builtin, namespace, strict_property_validation, created_at, updated_at, deleted_at`,
+		extension.TableName()), extension.Name, extension.DisplayName, extension.Version, extension.Namespace, extension.StrictPropertyValidation, extension.ID).Scan(&extension); result.Error != nil {
 		if strings.Contains(result.Error.Error(), DuplicateKeyValueErrorString) {
 			if strings.Contains(result.Error.Error(), "namespace") {
 				return model.GraphSchemaExtension{}, fmt.Errorf("%w: %v", model.ErrDuplicateGraphSchemaExtensionNamespace, extension.Namespace)
@@ -464,14 +470,19 @@ func (s *BloodhoundDB) DeleteGraphSchemaNodeKind(ctx context.Context, schemaNode
 
 // CreateGraphSchemaProperty creates a new row in the schema_properties table. A GraphSchemaProperty struct is returned, populated with the value as it stands in the database.
 func (s *BloodhoundDB) CreateGraphSchemaProperty(ctx context.Context, extensionId int32, name string, displayName string, dataType string, description string) (model.GraphSchemaProperty, error) {
+	return s.createGraphSchemaProperty(ctx, extensionId, name, displayName, dataType, description, false, nil)
+}
+
+// createGraphSchemaProperty - creates a new row in the schema_properties table along with its ingest constraints.
+func (s *BloodhoundDB) createGraphSchemaProperty(ctx context.Context, extensionId int32, name string, displayName string, dataType string, description string, isRequired bool, enumValueList []string) (model.GraphSchemaProperty, error) {
 	var extensionProperty model.GraphSchemaProperty
 
 	if result := s.db.WithContext(ctx).Raw(fmt.Sprintf(`
-			INSERT INTO %s (schema_extension_id, name, display_name, data_type, description)
-			VALUES (?, ?, ?, ?, ?)
-			RETURNING id, schema_extension_id, name, display_name, data_type, description, created_at, updated_at, deleted_at`,
+			INSERT INTO %s (schema_extension_id, name, display_name, data_type, description, is_required, enum_values)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+			RETURNING id, schema_extension_id, name, display_name, data_type, description, is_required, enum_values, created_at, updated_at, deleted_at`,
 		extensionProperty.TableName()),
-		extensionId, name, displayName, dataType, description).Scan(&extensionProperty); result.Error != nil {
+		extensionId, name, displayName, dataType, description, isRequired, enumValues(enumValueList)).Scan(&extensionProperty); result.Error != nil {
 		if strings.Contains(result.Error.Error(), DuplicateKeyValueErrorString) {
 			return model.GraphSchemaProperty{}, fmt.Errorf("%w: %s", model.ErrDuplicateGraphSchemaExtensionPropertyName, name)
 		}
@@ -492,7 +503,7 @@ func (s *BloodhoundDB) GetGraphSchemaProperties(ctx context.Context, filters mod
 	if filterAndPagination, err := parseFiltersAndPagination(filters, sort, skip, limit); err != nil {
 		return schemaProperties, 0, err
 	} else {
-		sqlStr := fmt.Sprintf(`SELECT id, schema_extension_id, name, display_name, data_type, description, created_at, updated_at, deleted_at
+		sqlStr := fmt.Sprintf(`SELECT id, schema_extension_id, name, display_name, data_type, description, is_required, enum_values, created_at, updated_at, deleted_at
 									FROM %s %s %s %s`,
 			model.GraphSchemaProperty{}.TableName(),
 			filterAndPagination.WhereClause,
@@ -521,7 +532,7 @@ func (s *BloodhoundDB) GetGraphSchemaPropertyById(ctx context.Context, extension
 	var extensionProperty model.GraphSchemaProperty
 
 	if result := s.db.WithContext(ctx).Raw(fmt.Sprintf(`
-		SELECT id, schema_extension_id, name, display_name, data_type, description, created_at, updated_at, deleted_at
+		SELECT id, schema_extension_id, name, display_name, data_type, description, is_required, enum_values, created_at, updated_at, deleted_at
 			FROM %s WHERE id = ?`,
 		extensionProperty.TableName()),
 		extensionPropertyId).First(&extensionProperty); result.Error != nil {
@@ -535,10 +546,10 @@ func (s *BloodhoundDB) GetGraphSchemaPropertyById(ctx context.Context, extension
 // error if the target property does not exist or if any of the updates violate the schema constraints.
 func (s *BloodhoundDB) UpdateGraphSchemaProperty(ctx context.Context, property model.GraphSchemaProperty) (model.GraphSchemaProperty, error) {
 	if result := s.db.WithContext(ctx).Raw(fmt.Sprintf(`
-		UPDATE %s SET name = ?, schema_extension_id = ?, display_name = ?, data_type = ?, description = ?, updated_at = NOW() WHERE id = ?
-		RETURNING id, schema_extension_id, name, display_name, data_type, description, created_at, updated_at, deleted_at`,
+		UPDATE %s SET name = ?, schema_extension_id = ?, display_name = ?, data_type = ?, description = ?, is_required = ?, enum_values = ?, updated_at = NOW() WHERE id = ?
+		RETURNING id, schema_extension_id, name, display_name, data_type, description, is_required, enum_values, created_at, updated_at, deleted_at`,
 		property.TableName()),
-		property.Name, property.SchemaExtensionId, property.DisplayName, property.DataType, property.Description, property.ID).Scan(&property); result.Error != nil {
+		property.Name, property.SchemaExtensionId, property.DisplayName, property.DataType, property.Description, property.IsRequired, enumValues(property.EnumValues), property.ID).Scan(&property); result.Error != nil {
 		if strings.Contains(result.Error.Error(), DuplicateKeyValueErrorString) {
 			return model.GraphSchemaProperty{}, fmt.Errorf("%w: %s", model.ErrDuplicateGraphSchemaExtensionPropertyName, property.Name)
 		}
@@ -550,6 +561,15 @@ func (s *BloodhoundDB) UpdateGraphSchemaProperty(ctx context.Context, property m
 	return property, nil
 }
 
+// enumValues - returns an empty array in place of a nil one, since enum_values may not be null.
+func enumValues(values pq.StringArray) pq.StringArray {
+	if values == nil {
+		return pq.StringArray{}
+	}
+
+	return values
+}
+
 // DeleteGraphSchemaProperty - deletes a schema_properties row based on the provided id. It will return an error if that id does not exist.
 func (s *BloodhoundDB) DeleteGraphSchemaProperty(ctx context.Context, propertyID int32) error {
 	var property model.GraphSchemaProperty
@@ -0,0 +1,56 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// AcquireHALease grants or renews the named lease for holderID. The lease is only taken over from another holder once
+// it has expired. Expiry is evaluated against the database clock so that replicas with skewed clocks agree on it.
+func (s *BloodhoundDB) AcquireHALease(ctx context.Context, name string, holderID string, duration time.Duration) (bool, error) {
+	const acquireSQL = `
+		insert into ha_leases (name, holder_id, acquired_at, renewed_at, expires_at)
+		values (@name, @holder_id, now(), now(), now() + make_interval(secs => @seconds))
+		on conflict (name) do update set
+			holder_id = excluded.holder_id,
+			acquired_at = case when ha_leases.holder_id = excluded.holder_id then ha_leases.acquired_at else excluded.acquired_at end,
+			renewed_at = excluded.renewed_at,
+			expires_at = excluded.expires_at
+		where ha_leases.holder_id = excluded.holder_id or ha_leases.expires_at < now()
+		returning holder_id;`
+
+	var holders []string
+
+	result := s.db.WithContext(ctx).Raw(acquireSQL, map[string]any{
+		"name":      name,
+		"holder_id": holderID,
+		"seconds":   duration.Seconds(),
+	}).Scan(&holders)
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return len(holders) == 1 && holders[0] == holderID, nil
+}
+
+// ReleaseHALease gives up the named lease if, and only if, it is currently held by holderID.
+func (s *BloodhoundDB) ReleaseHALease(ctx context.Context, name string, holderID string) error {
+	return s.db.WithContext(ctx).Exec(`delete from ha_leases where name = ? and holder_id = ?;`, name, holderID).Error
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+
+package database_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloodhoundDB_HALease(t *testing.T) {
+	const leaseName = "datapipe"
+
+	var (
+		ctx       = context.Background()
+		testSuite = setupIntegrationTestSuite(t)
+	)
+
+	defer teardownIntegrationTestSuite(t, &testSuite)
+
+	t.Run("only one contender holds the lease", func(t *testing.T) {
+		var (
+			contenders = []string{"replica-a", "replica-b", "replica-c", "replica-d"}
+			acquired   = make([]bool, len(contenders))
+			errs       = make([]error, len(contenders))
+			wg         sync.WaitGroup
+		)
+
+		for idx, holderID := range contenders {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+				acquired[idx], errs[idx] = testSuite.BHDatabase.AcquireHALease(ctx, leaseName, holderID, time.Minute)
+			}()
+		}
+
+		wg.Wait()
+
+		var holders []string
+		for idx, holderID := range contenders {
+			require.NoError(t, errs[idx])
+
+			if acquired[idx] {
+				holders = append(holders, holderID)
+			}
+		}
+
+		require.Len(t, holders, 1)
+
+		// The holder renews the lease while the others are still refused
+		for _, holderID := range contenders {
+			acquired, err := testSuite.BHDatabase.AcquireHALease(ctx, leaseName, holderID, time.Minute)
+			require.NoError(t, err)
+			require.Equal(t, holderID == holders[0], acquired)
+		}
+
+		require.NoError(t, testSuite.BHDatabase.ReleaseHALease(ctx, leaseName, holders[0]))
+	})
+
+	t.Run("releasing a lease held by another replica has no effect", func(t *testing.T) {
+		acquired, err := testSuite.BHDatabase.AcquireHALease(ctx, leaseName, "replica-a", time.Minute)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		require.NoError(t, testSuite.BHDatabase.ReleaseHALease(ctx, leaseName, "replica-b"))
+
+		acquired, err = testSuite.BHDatabase.AcquireHALease(ctx, leaseName, "replica-b", time.Minute)
+		require.NoError(t, err)
+		require.False(t, acquired)
+
+		require.NoError(t, testSuite.BHDatabase.ReleaseHALease(ctx, leaseName, "replica-a"))
+
+		acquired, err = testSuite.BHDatabase.AcquireHALease(ctx, leaseName, "replica-b", time.Minute)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		require.NoError(t, testSuite.BHDatabase.ReleaseHALease(ctx, leaseName, "replica-b"))
+	})
+
+	t.Run("an expired lease is taken over", func(t *testing.T) {
+		acquired, err := testSuite.BHDatabase.AcquireHALease(ctx, leaseName, "replica-a", time.Minute)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		acquired, err = testSuite.BHDatabase.AcquireHALease(ctx, leaseName, "replica-b", time.Minute)
+		require.NoError(t, err)
+		require.False(t, acquired)
+
+		// Simulate replica-a failing to renew the lease in time
+		require.NoError(t, testSuite.DB.Exec(`update ha_leases set expires_at = now() - interval '1 second' where name = ?;`, leaseName).Error)
+
+		acquired, err = testSuite.BHDatabase.AcquireHALease(ctx, leaseName, "replica-b", time.Minute)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		// The previous holder can no longer renew the lease
+		acquired, err = testSuite.BHDatabase.AcquireHALease(ctx, leaseName, "replica-a", time.Minute)
+		require.NoError(t, err)
+		require.False(t, acquired)
+	})
+}
@@ -0,0 +1,33 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+)
+
+func (s *BloodhoundDB) GetIngestPreview(ctx context.Context, jobID int64) (model.IngestPreview, error) {
+	var preview model.IngestPreview
+	return preview, CheckError(s.db.WithContext(ctx).Where("job_id = ?", jobID).First(&preview))
+}
+
+// SaveIngestPreview creates or replaces the preview report of a dry run ingest job
+func (s *BloodhoundDB) SaveIngestPreview(ctx context.Context, preview model.IngestPreview) error {
+	return CheckError(s.db.WithContext(ctx).Save(&preview))
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/ingest"
+	"gorm.io/gorm/clause"
+)
+
+func (s *BloodhoundDB) CreateIngestUploadSession(ctx context.Context, session model.IngestUploadSession) (model.IngestUploadSession, error) {
+	result := s.db.WithContext(ctx).Create(&session)
+	return session, CheckError(result)
+}
+
+func (s *BloodhoundDB) GetIngestUploadSession(ctx context.Context, id int64) (model.IngestUploadSession, error) {
+	var session model.IngestUploadSession
+	return session, CheckError(s.db.WithContext(ctx).First(&session, id))
+}
+
+func (s *BloodhoundDB) GetAllIngestUploadSessions(ctx context.Context) (model.IngestUploadSessions, error) {
+	var sessions model.IngestUploadSessions
+	return sessions, CheckError(s.db.WithContext(ctx).Find(&sessions))
+}
+
+func (s *BloodhoundDB) UpdateIngestUploadSession(ctx context.Context, session model.IngestUploadSession) error {
+	return CheckError(s.db.WithContext(ctx).Save(&session))
+}
+
+// ClaimIngestUploadSession marks the session as being finalized. It fails with ingest.ErrUploadSessionFinalizing if
+// the session is already claimed, unless that claim was made before staleBefore.
+func (s *BloodhoundDB) ClaimIngestUploadSession(ctx context.Context, id int64, staleBefore time.Time) error {
+	var (
+		now    = time.Now().UTC()
+		result = s.db.WithContext(ctx).Exec(
+			"UPDATE ingest_upload_sessions SET finalizing_at = ?, updated_at = ? WHERE id = ? AND (finalizing_at IS NULL OR finalizing_at < ?)",
+			now, now, id, staleBefore.UTC(),
+		)
+	)
+
+	if err := CheckError(result); err != nil {
+		return err
+	} else if result.RowsAffected == 0 {
+		return ingest.ErrUploadSessionFinalizing
+	} else {
+		return nil
+	}
+}
+
+// ReleaseIngestUploadSession clears the claim of a session that could not be finalized so that it can be finalized again
+func (s *BloodhoundDB) ReleaseIngestUploadSession(ctx context.Context, id int64) error {
+	return CheckError(s.db.WithContext(ctx).Exec("UPDATE ingest_upload_sessions SET finalizing_at = NULL, updated_at = ? WHERE id = ?", time.Now().UTC(), id))
+}
+
+// DeleteIngestUploadSession removes the session along with the records of its chunks
+func (s *BloodhoundDB) DeleteIngestUploadSession(ctx context.Context, id int64) error {
+	return CheckError(s.db.WithContext(ctx).Delete(&model.IngestUploadSession{}, id))
+}
+
+// SweepIngestUploadSessions removes sessions that have not received a chunk before they expired
+func (s *BloodhoundDB) SweepIngestUploadSessions(ctx context.Context) error {
+	return CheckError(s.db.WithContext(ctx).Where("expires_at < NOW()").Delete(&model.IngestUploadSession{}))
+}
+
+func (s *BloodhoundDB) GetIngestUploadChunks(ctx context.Context, sessionID int64) (model.IngestUploadChunks, error) {
+	var chunks model.IngestUploadChunks
+	return chunks, CheckError(s.db.WithContext(ctx).Where("session_id = ?", sessionID).Order("chunk_index").Find(&chunks))
+}
+
+// CreateIngestUploadChunk records a received chunk. Receiving a chunk again with the same checksum is a no-op, while
+// receiving it with a different checksum fails with ingest.ErrUploadChunkConflict.
+func (s *BloodhoundDB) CreateIngestUploadChunk(ctx context.Context, chunk model.IngestUploadChunk) (model.IngestUploadChunk, error) {
+	result := s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "session_id"}, {Name: "chunk_index"}},
+		DoUpdates: clause.AssignmentColumns([]string{"updated_at"}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Expr{SQL: "ingest_upload_chunks.sha256 = excluded.sha256"},
+		}},
+	}).Create(&chunk)
+
+	if err := CheckError(result); err != nil {
+		return chunk, err
+	} else if result.RowsAffected == 0 {
+		return chunk, ingest.ErrUploadChunkConflict
+	} else {
+		return chunk, nil
+	}
+}
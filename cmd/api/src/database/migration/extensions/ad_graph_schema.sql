@@ -218,14 +218,19 @@ BEGIN
 	PERFORM genscript_upsert_kind('ADCSESC4');
 	PERFORM genscript_upsert_kind('ADCSESC6a');
 	PERFORM genscript_upsert_kind('ADCSESC6b');
+	PERFORM genscript_upsert_kind('ADCSESC7');
 	PERFORM genscript_upsert_kind('ADCSESC9a');
 	PERFORM genscript_upsert_kind('ADCSESC9b');
 	PERFORM genscript_upsert_kind('ADCSESC10a');
 	PERFORM genscript_upsert_kind('ADCSESC10b');
 	PERFORM genscript_upsert_kind('ADCSESC13');
+	PERFORM genscript_upsert_kind('ADCSESC15');
 	PERFORM genscript_upsert_kind('SyncedToADUser');
+	PERFORM genscript_upsert_kind('SyncedToADGroup');
+	PERFORM genscript_upsert_kind('SyncedToADComputer');
 	PERFORM genscript_upsert_kind('CoerceAndRelayNTLMToSMB');
 	PERFORM genscript_upsert_kind('CoerceAndRelayNTLMToADCS');
+	PERFORM genscript_upsert_kind('CoerceAndRelayNTLMToADCSRPC');
 	PERFORM genscript_upsert_kind('WriteOwnerLimitedRights');
 	PERFORM genscript_upsert_kind('WriteOwnerRaw');
 	PERFORM genscript_upsert_kind('OwnsLimitedRights');
@@ -322,14 +327,19 @@ BEGIN
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'ADCSESC4', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'ADCSESC6a', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'ADCSESC6b', '', true);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'ADCSESC7', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'ADCSESC9a', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'ADCSESC9b', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'ADCSESC10a', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'ADCSESC10b', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'ADCSESC13', '', true);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'ADCSESC15', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'SyncedToADUser', '', true);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'SyncedToADGroup', '', true);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'SyncedToADComputer', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'CoerceAndRelayNTLMToSMB', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'CoerceAndRelayNTLMToADCS', '', true);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'CoerceAndRelayNTLMToADCSRPC', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'WriteOwnerLimitedRights', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'WriteOwnerRaw', '', false);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'OwnsLimitedRights', '', true);
@@ -155,6 +155,9 @@ BEGIN
 	PERFORM genscript_upsert_kind('AZWebApp');
 	PERFORM genscript_upsert_kind('AZLogicApp');
 	PERFORM genscript_upsert_kind('AZAutomationAccount');
+	PERFORM genscript_upsert_kind('AZAdministrativeUnit');
+	PERFORM genscript_upsert_kind('AZStorageAccount');
+	PERFORM genscript_upsert_kind('AZSQLServer');
 
 	-- Insert Relationship Kinds
 	PERFORM genscript_upsert_kind('AZAvereContributor');
@@ -204,8 +207,12 @@ BEGIN
 	PERFORM genscript_upsert_kind('AZMGGrantAppRoles');
 	PERFORM genscript_upsert_kind('AZMGGrantRole');
 	PERFORM genscript_upsert_kind('SyncedToEntraUser');
+	PERFORM genscript_upsert_kind('SyncedToEntraGroup');
+	PERFORM genscript_upsert_kind('SyncedToEntraDevice');
 	PERFORM genscript_upsert_kind('AZRoleEligible');
 	PERFORM genscript_upsert_kind('AZRoleApprover');
+	PERFORM genscript_upsert_kind('AZGetStorageKeys');
+	PERFORM genscript_upsert_kind('AZHasScopedRole');
 
 	PERFORM genscript_upsert_schema_node_kind(extension_id, 'AZBase', 'AZBase', '', false, '', '');
 	PERFORM genscript_upsert_schema_node_kind(extension_id, 'AZVMScaleSet', 'AZVMScaleSet', '', true, 'fa-server', '#007CD0');
@@ -227,6 +234,9 @@ BEGIN
 	PERFORM genscript_upsert_schema_node_kind(extension_id, 'AZWebApp', 'AZWebApp', '', true, 'fa-object-group', '#4696E9');
 	PERFORM genscript_upsert_schema_node_kind(extension_id, 'AZLogicApp', 'AZLogicApp', '', true, 'fa-sitemap', '#9EE047');
 	PERFORM genscript_upsert_schema_node_kind(extension_id, 'AZAutomationAccount', 'AZAutomationAccount', '', true, 'fa-cog', '#F4BA44');
+	PERFORM genscript_upsert_schema_node_kind(extension_id, 'AZAdministrativeUnit', 'AZAdministrativeUnit', '', true, 'fa-layer-group', '#7EB2DD');
+	PERFORM genscript_upsert_schema_node_kind(extension_id, 'AZStorageAccount', 'AZStorageAccount', '', true, 'fa-hard-drive', '#5A9BD5');
+	PERFORM genscript_upsert_schema_node_kind(extension_id, 'AZSQLServer', 'AZSQLServer', '', true, 'fa-database', '#D96C8A');
 
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'AZAvereContributor', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'AZContains', '', true);
@@ -275,8 +285,12 @@ BEGIN
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'AZMGGrantAppRoles', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'AZMGGrantRole', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'SyncedToEntraUser', '', true);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'SyncedToEntraGroup', '', true);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'SyncedToEntraDevice', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'AZRoleEligible', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'AZRoleApprover', '', true);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'AZGetStorageKeys', '', true);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'AZHasScopedRole', '', false);
 
 	PERFORM genscript_upsert_source_kind('AZBase');
 	PERFORM genscript_upsert_kind('AZTenant');
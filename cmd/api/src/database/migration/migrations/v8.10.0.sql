@@ -0,0 +1,207 @@
+-- Copyright 2026 Specter Ops, Inc.
+--
+-- Licensed under the Apache License, Version 2.0
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- Leases used to elect a single primary API instance in high-availability deployments
+CREATE TABLE IF NOT EXISTS ha_leases (
+  name TEXT NOT NULL,
+  holder_id TEXT NOT NULL,
+  acquired_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  renewed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  PRIMARY KEY (name)
+);
+
+-- Domains touched by an ingest job are used to scope AD post-processing. Jobs created before this column existed
+-- carry no domain information and must therefore trigger a full rebuild.
+ALTER TABLE IF EXISTS ingest_jobs
+  ADD COLUMN IF NOT EXISTS domain_sids TEXT[] DEFAULT '{}',
+  ADD COLUMN IF NOT EXISTS cross_domain BOOLEAN NOT NULL DEFAULT false;
+
+UPDATE ingest_jobs SET cross_domain = true WHERE domain_sids IS NULL OR cardinality(domain_sids) = 0;
+
+-- Scoped analysis deletes the post-processed relationships of its domains before rebuilding them. The flag is held
+-- while a scoped run is in progress so that a run that fails or is interrupted is followed by a full rebuild.
+ALTER TABLE IF EXISTS datapipe_status
+  ADD COLUMN IF NOT EXISTS full_analysis_required BOOLEAN NOT NULL DEFAULT false;
+
+-- Attack path findings computed by analysis. Rows are kept once resolved so that findings can be tracked over time;
+-- asset_group_tag_id 0 holds tiering agnostic (hygiene) findings and therefore has no foreign key.
+CREATE TABLE IF NOT EXISTS attack_path_findings (
+  id BIGSERIAL PRIMARY KEY,
+  schema_finding_id INTEGER NOT NULL REFERENCES schema_findings (id) ON DELETE CASCADE,
+  asset_group_tag_id INTEGER NOT NULL DEFAULT 0,
+  environment_id TEXT NOT NULL DEFAULT '',
+  from_principal TEXT NOT NULL,
+  to_principal TEXT NOT NULL DEFAULT '',
+  principal_kind TEXT NOT NULL DEFAULT '',
+  first_seen TIMESTAMP WITH TIME ZONE NOT NULL,
+  last_seen TIMESTAMP WITH TIME ZONE NOT NULL,
+  resolved_at TIMESTAMP WITH TIME ZONE,
+  accepted_until TIMESTAMP WITH TIME ZONE,
+  accepted_by TEXT,
+  accepted_reason TEXT,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  UNIQUE (schema_finding_id, asset_group_tag_id, from_principal, to_principal)
+);
+
+CREATE INDEX IF NOT EXISTS idx_attack_path_findings_asset_group_tag_id ON attack_path_findings (asset_group_tag_id);
+CREATE INDEX IF NOT EXISTS idx_attack_path_findings_environment_id ON attack_path_findings (environment_id);
+CREATE INDEX IF NOT EXISTS idx_attack_path_findings_last_seen ON attack_path_findings (last_seen);
+
+-- Built-in roles are managed by migrations and may not be modified or deleted through the API
+ALTER TABLE IF EXISTS roles
+  ADD COLUMN IF NOT EXISTS built_in BOOLEAN NOT NULL DEFAULT false;
+
+UPDATE roles SET built_in = true WHERE name IN ('Administrator', 'Power User', 'User', 'Read-Only', 'Upload-Only', 'Auditor');
+
+-- OpenGraph property schema constraints checked during ingest
+ALTER TABLE IF EXISTS schema_extensions
+  ADD COLUMN IF NOT EXISTS strict_property_validation BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE IF EXISTS schema_properties
+  ADD COLUMN IF NOT EXISTS is_required BOOLEAN NOT NULL DEFAULT false,
+  ADD COLUMN IF NOT EXISTS enum_values TEXT[] NOT NULL DEFAULT '{}';
+
+-- Analysis runs and the post-processed edges and tier zero membership they produced, used to diff runs. Snapshots are
+-- gzip compressed and only kept for the most recent runs.
+CREATE TABLE IF NOT EXISTS analysis_runs (
+  id BIGSERIAL PRIMARY KEY,
+  started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  completed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  post_processed_edge_count INTEGER NOT NULL DEFAULT 0,
+  tier_zero_count INTEGER NOT NULL DEFAULT 0,
+  tier_zero_path_count INTEGER NOT NULL DEFAULT 0,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS analysis_run_snapshots (
+  analysis_run_id BIGINT PRIMARY KEY REFERENCES analysis_runs (id) ON DELETE CASCADE,
+  snapshot BYTEA NOT NULL
+);
+
+-- Whether an analysis run completed, partially completed or failed, and the duration and relationship counts of each
+-- of its stages
+ALTER TABLE IF EXISTS analysis_runs
+  ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'complete';
+
+CREATE TABLE IF NOT EXISTS analysis_run_steps (
+  id BIGSERIAL PRIMARY KEY,
+  analysis_run_id BIGINT NOT NULL REFERENCES analysis_runs (id) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  status TEXT NOT NULL,
+  error TEXT NOT NULL DEFAULT '',
+  started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  duration_ms BIGINT NOT NULL DEFAULT 0,
+  relationships_created JSONB NOT NULL DEFAULT '{}',
+  relationships_deleted JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX IF NOT EXISTS idx_analysis_run_steps_analysis_run_id ON analysis_run_steps (analysis_run_id);
+
+-- Entra identities matched to their on-prem AD counterparts by hybrid post-processing
+ALTER TABLE IF EXISTS azure_data_quality_stats
+  ADD COLUMN IF NOT EXISTS synced_users bigint DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS synced_groups bigint DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS synced_devices bigint DEFAULT 0;
+
+ALTER TABLE IF EXISTS azure_data_quality_aggregations
+  ADD COLUMN IF NOT EXISTS synced_users bigint DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS synced_groups bigint DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS synced_devices bigint DEFAULT 0;
+
+-- Attack path edges reaching into a privilege zone from a less privileged zone, aggregated per pair of zones by
+-- multi-tier analysis. source_asset_group_tag_id 0 stands for principals outside of every zone and therefore has no
+-- foreign key.
+CREATE TABLE IF NOT EXISTS asset_group_tag_violations (
+  id BIGSERIAL PRIMARY KEY,
+  asset_group_tag_id INTEGER NOT NULL REFERENCES asset_group_tags (id) ON DELETE CASCADE,
+  source_asset_group_tag_id INTEGER NOT NULL DEFAULT 0,
+  violation_count INTEGER NOT NULL DEFAULT 0,
+  sample_edges JSONB NOT NULL DEFAULT '[]',
+  evaluated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  UNIQUE (asset_group_tag_id, source_asset_group_tag_id)
+);
+
+-- Add Post-Processing parameter
+INSERT INTO parameters (key, name, description, value, created_at, updated_at)
+VALUES ('analysis.post_processing',
+        'Post-Processing',
+        'This configuration parameter disables individual post-processors during analysis. Post-processors depending on a disabled post-processor are disabled as well and the edges of disabled post-processors are removed.',
+        '{"disabled_post_processors": []}',
+        current_timestamp,
+        current_timestamp)
+  ON CONFLICT DO NOTHING;
+
+-- Named relationship costs for weighted pathfinding
+CREATE TABLE IF NOT EXISTS pathfinding_cost_profiles (
+  id SERIAL PRIMARY KEY,
+  name TEXT UNIQUE NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  default_cost DOUBLE PRECISION NOT NULL DEFAULT 1,
+  costs JSONB NOT NULL DEFAULT '{}'::jsonb,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Seed a cost profile that favors relationships which are reliable to abuse
+INSERT INTO pathfinding_cost_profiles (name, description, default_cost, costs)
+VALUES ('reliability',
+        'Prefers paths over relationships that are unlikely to change between collection and abuse. Sessions and local privileges cost more than group membership and containment.',
+        1,
+        '{"MemberOf": 0.1, "Contains": 0.1, "HasSession": 5, "CanRDP": 3, "CanPSRemote": 3, "ExecuteDCOM": 3, "AdminTo": 2, "SQLAdmin": 3, "AZMemberOf": 0.1, "AZContains": 0.1}')
+  ON CONFLICT DO NOTHING;
+
+-- Resumable chunked uploads to ingest jobs
+CREATE TABLE IF NOT EXISTS ingest_upload_sessions (
+  id BIGSERIAL PRIMARY KEY,
+  job_id BIGINT NOT NULL REFERENCES ingest_jobs(id) ON DELETE CASCADE,
+  file_name TEXT NOT NULL DEFAULT '',
+  content_type TEXT NOT NULL,
+  total_size BIGINT NOT NULL,
+  chunk_size BIGINT NOT NULL,
+  sha256 TEXT NOT NULL,
+  expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  finalizing_at TIMESTAMP WITH TIME ZONE,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_ingest_upload_sessions_job_id ON ingest_upload_sessions (job_id);
+CREATE INDEX IF NOT EXISTS idx_ingest_upload_sessions_expires_at ON ingest_upload_sessions (expires_at);
+
+CREATE TABLE IF NOT EXISTS ingest_upload_chunks (
+  session_id BIGINT NOT NULL REFERENCES ingest_upload_sessions(id) ON DELETE CASCADE,
+  chunk_index BIGINT NOT NULL,
+  size BIGINT NOT NULL,
+  sha256 TEXT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (session_id, chunk_index)
+);
+
+-- Dry run ingest jobs report what their files would change instead of writing to the graph
+ALTER TABLE IF EXISTS ingest_jobs
+  ADD COLUMN IF NOT EXISTS dry_run BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE IF EXISTS ingest_tasks
+  ADD COLUMN IF NOT EXISTS dry_run BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS ingest_previews (
+  job_id BIGINT PRIMARY KEY REFERENCES ingest_jobs(id) ON DELETE CASCADE,
+  report JSONB NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
@@ -91,6 +91,20 @@ func (mr *MockDatabaseMockRecorder) CancelAllIngestJobs(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAllIngestJobs", reflect.TypeOf((*MockDatabase)(nil).CancelAllIngestJobs), ctx)
 }
 
+// ClaimIngestUploadSession mocks base method.
+func (m *MockDatabase) ClaimIngestUploadSession(ctx context.Context, id int64, staleBefore time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimIngestUploadSession", ctx, id, staleBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClaimIngestUploadSession indicates an expected call of ClaimIngestUploadSession.
+func (mr *MockDatabaseMockRecorder) ClaimIngestUploadSession(ctx, id, staleBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimIngestUploadSession", reflect.TypeOf((*MockDatabase)(nil).ClaimIngestUploadSession), ctx, id, staleBefore)
+}
+
 // Close mocks base method.
 func (m *MockDatabase) Close(ctx context.Context) {
 	m.ctrl.T.Helper()
@@ -148,6 +162,21 @@ func (mr *MockDatabaseMockRecorder) CreateADDataQualityStats(ctx, stats any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateADDataQualityStats", reflect.TypeOf((*MockDatabase)(nil).CreateADDataQualityStats), ctx, stats)
 }
 
+// CreateAnalysisRun mocks base method.
+func (m *MockDatabase) CreateAnalysisRun(ctx context.Context, run model.AnalysisRun, steps []model.AnalysisRunStep, snapshot []byte) (model.AnalysisRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAnalysisRun", ctx, run, steps, snapshot)
+	ret0, _ := ret[0].(model.AnalysisRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAnalysisRun indicates an expected call of CreateAnalysisRun.
+func (mr *MockDatabaseMockRecorder) CreateAnalysisRun(ctx, run, steps, snapshot any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAnalysisRun", reflect.TypeOf((*MockDatabase)(nil).CreateAnalysisRun), ctx, run, steps, snapshot)
+}
+
 // CreateAssetGroup mocks base method.
 func (m *MockDatabase) CreateAssetGroup(ctx context.Context, name, tag string, systemGroup bool) (model.AssetGroup, error) {
 	m.ctrl.T.Helper()
@@ -446,6 +475,36 @@ func (mr *MockDatabaseMockRecorder) CreateIngestTask(ctx, task any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIngestTask", reflect.TypeOf((*MockDatabase)(nil).CreateIngestTask), ctx, task)
 }
 
+// CreateIngestUploadChunk mocks base method.
+func (m *MockDatabase) CreateIngestUploadChunk(ctx context.Context, chunk model.IngestUploadChunk) (model.IngestUploadChunk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIngestUploadChunk", ctx, chunk)
+	ret0, _ := ret[0].(model.IngestUploadChunk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIngestUploadChunk indicates an expected call of CreateIngestUploadChunk.
+func (mr *MockDatabaseMockRecorder) CreateIngestUploadChunk(ctx, chunk any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIngestUploadChunk", reflect.TypeOf((*MockDatabase)(nil).CreateIngestUploadChunk), ctx, chunk)
+}
+
+// CreateIngestUploadSession mocks base method.
+func (m *MockDatabase) CreateIngestUploadSession(ctx context.Context, session model.IngestUploadSession) (model.IngestUploadSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIngestUploadSession", ctx, session)
+	ret0, _ := ret[0].(model.IngestUploadSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIngestUploadSession indicates an expected call of CreateIngestUploadSession.
+func (mr *MockDatabaseMockRecorder) CreateIngestUploadSession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIngestUploadSession", reflect.TypeOf((*MockDatabase)(nil).CreateIngestUploadSession), ctx, session)
+}
+
 // CreateInstallation mocks base method.
 func (m *MockDatabase) CreateInstallation(ctx context.Context) (model.Installation, error) {
 	m.ctrl.T.Helper()
@@ -476,6 +535,21 @@ func (mr *MockDatabaseMockRecorder) CreateOIDCProvider(ctx, name, issuer, client
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOIDCProvider", reflect.TypeOf((*MockDatabase)(nil).CreateOIDCProvider), ctx, name, issuer, clientID, config)
 }
 
+// CreatePathfindingCostProfile mocks base method.
+func (m *MockDatabase) CreatePathfindingCostProfile(ctx context.Context, profile model.PathfindingCostProfile) (model.PathfindingCostProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePathfindingCostProfile", ctx, profile)
+	ret0, _ := ret[0].(model.PathfindingCostProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePathfindingCostProfile indicates an expected call of CreatePathfindingCostProfile.
+func (mr *MockDatabaseMockRecorder) CreatePathfindingCostProfile(ctx, profile any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePathfindingCostProfile", reflect.TypeOf((*MockDatabase)(nil).CreatePathfindingCostProfile), ctx, profile)
+}
+
 // CreatePrincipalKind mocks base method.
 func (m *MockDatabase) CreatePrincipalKind(ctx context.Context, environmentId, principalKind int32) (model.SchemaEnvironmentPrincipalKind, error) {
 	m.ctrl.T.Helper()
@@ -506,6 +580,21 @@ func (mr *MockDatabaseMockRecorder) CreateRemediation(ctx, findingId, shortDescr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRemediation", reflect.TypeOf((*MockDatabase)(nil).CreateRemediation), ctx, findingId, shortDescription, longDescription, shortRemediation, longRemediation)
 }
 
+// CreateRole mocks base method.
+func (m *MockDatabase) CreateRole(ctx context.Context, role model.Role) (model.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRole", ctx, role)
+	ret0, _ := ret[0].(model.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRole indicates an expected call of CreateRole.
+func (mr *MockDatabaseMockRecorder) CreateRole(ctx, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockDatabase)(nil).CreateRole), ctx, role)
+}
+
 // CreateSAMLIdentityProvider mocks base method.
 func (m *MockDatabase) CreateSAMLIdentityProvider(ctx context.Context, samlProvider model.SAMLProvider, config model.SSOProviderConfig) (model.SAMLProvider, error) {
 	m.ctrl.T.Helper()
@@ -968,6 +1057,34 @@ func (mr *MockDatabaseMockRecorder) DeleteIngestTask(ctx, ingestTask any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIngestTask", reflect.TypeOf((*MockDatabase)(nil).DeleteIngestTask), ctx, ingestTask)
 }
 
+// DeleteIngestUploadSession mocks base method.
+func (m *MockDatabase) DeleteIngestUploadSession(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIngestUploadSession", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIngestUploadSession indicates an expected call of DeleteIngestUploadSession.
+func (mr *MockDatabaseMockRecorder) DeleteIngestUploadSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIngestUploadSession", reflect.TypeOf((*MockDatabase)(nil).DeleteIngestUploadSession), ctx, id)
+}
+
+// DeletePathfindingCostProfile mocks base method.
+func (m *MockDatabase) DeletePathfindingCostProfile(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePathfindingCostProfile", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePathfindingCostProfile indicates an expected call of DeletePathfindingCostProfile.
+func (mr *MockDatabaseMockRecorder) DeletePathfindingCostProfile(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePathfindingCostProfile", reflect.TypeOf((*MockDatabase)(nil).DeletePathfindingCostProfile), ctx, name)
+}
+
 // DeletePrincipalKind mocks base method.
 func (m *MockDatabase) DeletePrincipalKind(ctx context.Context, environmentId, principalKind int32) error {
 	m.ctrl.T.Helper()
@@ -996,6 +1113,20 @@ func (mr *MockDatabaseMockRecorder) DeleteRemediation(ctx, findingId any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRemediation", reflect.TypeOf((*MockDatabase)(nil).DeleteRemediation), ctx, findingId)
 }
 
+// DeleteRole mocks base method.
+func (m *MockDatabase) DeleteRole(ctx context.Context, role model.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockDatabaseMockRecorder) DeleteRole(ctx, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockDatabase)(nil).DeleteRole), ctx, role)
+}
+
 // DeleteSSOProvider mocks base method.
 func (m *MockDatabase) DeleteSSOProvider(ctx context.Context, id int) error {
 	m.ctrl.T.Helper()
@@ -1270,6 +1401,21 @@ func (mr *MockDatabaseMockRecorder) GetAllIngestTasks(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllIngestTasks", reflect.TypeOf((*MockDatabase)(nil).GetAllIngestTasks), ctx)
 }
 
+// GetAllIngestUploadSessions mocks base method.
+func (m *MockDatabase) GetAllIngestUploadSessions(ctx context.Context) (model.IngestUploadSessions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllIngestUploadSessions", ctx)
+	ret0, _ := ret[0].(model.IngestUploadSessions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllIngestUploadSessions indicates an expected call of GetAllIngestUploadSessions.
+func (mr *MockDatabaseMockRecorder) GetAllIngestUploadSessions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllIngestUploadSessions", reflect.TypeOf((*MockDatabase)(nil).GetAllIngestUploadSessions), ctx)
+}
+
 // GetAllPermissions mocks base method.
 func (m *MockDatabase) GetAllPermissions(ctx context.Context, order string, filter model.SQLFilter) (model.Permissions, error) {
 	m.ctrl.T.Helper()
@@ -1375,6 +1521,67 @@ func (mr *MockDatabaseMockRecorder) GetAnalysisRequest(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalysisRequest", reflect.TypeOf((*MockDatabase)(nil).GetAnalysisRequest), ctx)
 }
 
+// GetAnalysisRun mocks base method.
+func (m *MockDatabase) GetAnalysisRun(ctx context.Context, id int64) (model.AnalysisRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnalysisRun", ctx, id)
+	ret0, _ := ret[0].(model.AnalysisRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnalysisRun indicates an expected call of GetAnalysisRun.
+func (mr *MockDatabaseMockRecorder) GetAnalysisRun(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalysisRun", reflect.TypeOf((*MockDatabase)(nil).GetAnalysisRun), ctx, id)
+}
+
+// GetAnalysisRunSnapshot mocks base method.
+func (m *MockDatabase) GetAnalysisRunSnapshot(ctx context.Context, id int64) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnalysisRunSnapshot", ctx, id)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnalysisRunSnapshot indicates an expected call of GetAnalysisRunSnapshot.
+func (mr *MockDatabaseMockRecorder) GetAnalysisRunSnapshot(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalysisRunSnapshot", reflect.TypeOf((*MockDatabase)(nil).GetAnalysisRunSnapshot), ctx, id)
+}
+
+// GetAnalysisRunSteps mocks base method.
+func (m *MockDatabase) GetAnalysisRunSteps(ctx context.Context, id int64) ([]model.AnalysisRunStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnalysisRunSteps", ctx, id)
+	ret0, _ := ret[0].([]model.AnalysisRunStep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnalysisRunSteps indicates an expected call of GetAnalysisRunSteps.
+func (mr *MockDatabaseMockRecorder) GetAnalysisRunSteps(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalysisRunSteps", reflect.TypeOf((*MockDatabase)(nil).GetAnalysisRunSteps), ctx, id)
+}
+
+// GetAnalysisRuns mocks base method.
+func (m *MockDatabase) GetAnalysisRuns(ctx context.Context, skip int, limit int) ([]model.AnalysisRun, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnalysisRuns", ctx, skip, limit)
+	ret0, _ := ret[0].([]model.AnalysisRun)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAnalysisRuns indicates an expected call of GetAnalysisRuns.
+func (mr *MockDatabaseMockRecorder) GetAnalysisRuns(ctx, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalysisRuns", reflect.TypeOf((*MockDatabase)(nil).GetAnalysisRuns), ctx, skip, limit)
+}
+
 // GetAssetGroup mocks base method.
 func (m *MockDatabase) GetAssetGroup(ctx context.Context, id int32) (model.AssetGroup, error) {
 	m.ctrl.T.Helper()
@@ -1563,6 +1770,21 @@ func (mr *MockDatabaseMockRecorder) GetAssetGroupTagSelectorsByTagIdFilteredAndP
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetGroupTagSelectorsByTagIdFilteredAndPaginated", reflect.TypeOf((*MockDatabase)(nil).GetAssetGroupTagSelectorsByTagIdFilteredAndPaginated), ctx, assetGroupTagId, selectorSqlFilter, selectorSeedSqlFilter, sort, skip, limit)
 }
 
+// GetAssetGroupTagViolations mocks base method.
+func (m *MockDatabase) GetAssetGroupTagViolations(ctx context.Context, assetGroupTagId int) ([]model.AssetGroupTagViolation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetGroupTagViolations", ctx, assetGroupTagId)
+	ret0, _ := ret[0].([]model.AssetGroupTagViolation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssetGroupTagViolations indicates an expected call of GetAssetGroupTagViolations.
+func (mr *MockDatabaseMockRecorder) GetAssetGroupTagViolations(ctx, assetGroupTagId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetGroupTagViolations", reflect.TypeOf((*MockDatabase)(nil).GetAssetGroupTagViolations), ctx, assetGroupTagId)
+}
+
 // GetAssetGroupTags mocks base method.
 func (m *MockDatabase) GetAssetGroupTags(ctx context.Context, sqlFilter model.SQLFilter) (model.AssetGroupTags, error) {
 	m.ctrl.T.Helper()
@@ -1578,6 +1800,37 @@ func (mr *MockDatabaseMockRecorder) GetAssetGroupTags(ctx, sqlFilter any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetGroupTags", reflect.TypeOf((*MockDatabase)(nil).GetAssetGroupTags), ctx, sqlFilter)
 }
 
+// GetAttackPathFinding mocks base method.
+func (m *MockDatabase) GetAttackPathFinding(ctx context.Context, id int64) (model.AttackPathFinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttackPathFinding", ctx, id)
+	ret0, _ := ret[0].(model.AttackPathFinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttackPathFinding indicates an expected call of GetAttackPathFinding.
+func (mr *MockDatabaseMockRecorder) GetAttackPathFinding(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttackPathFinding", reflect.TypeOf((*MockDatabase)(nil).GetAttackPathFinding), ctx, id)
+}
+
+// GetAttackPathFindings mocks base method.
+func (m *MockDatabase) GetAttackPathFindings(ctx context.Context, sqlFilter model.SQLFilter, sort model.Sort, skip int, limit int) ([]model.AttackPathFinding, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttackPathFindings", ctx, sqlFilter, sort, skip, limit)
+	ret0, _ := ret[0].([]model.AttackPathFinding)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAttackPathFindings indicates an expected call of GetAttackPathFindings.
+func (mr *MockDatabaseMockRecorder) GetAttackPathFindings(ctx, sqlFilter, sort, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttackPathFindings", reflect.TypeOf((*MockDatabase)(nil).GetAttackPathFindings), ctx, sqlFilter, sort, skip, limit)
+}
+
 // GetAuthSecret mocks base method.
 func (m *MockDatabase) GetAuthSecret(ctx context.Context, id int32) (model.AuthSecret, error) {
 	m.ctrl.T.Helper()
@@ -2050,6 +2303,21 @@ func (mr *MockDatabaseMockRecorder) GetIngestJobsWithStatus(ctx, status any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngestJobsWithStatus", reflect.TypeOf((*MockDatabase)(nil).GetIngestJobsWithStatus), ctx, status)
 }
 
+// GetIngestPreview mocks base method.
+func (m *MockDatabase) GetIngestPreview(ctx context.Context, jobID int64) (model.IngestPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIngestPreview", ctx, jobID)
+	ret0, _ := ret[0].(model.IngestPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIngestPreview indicates an expected call of GetIngestPreview.
+func (mr *MockDatabaseMockRecorder) GetIngestPreview(ctx, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngestPreview", reflect.TypeOf((*MockDatabase)(nil).GetIngestPreview), ctx, jobID)
+}
+
 // GetIngestTasksForJob mocks base method.
 func (m *MockDatabase) GetIngestTasksForJob(ctx context.Context, jobID int64) (model.IngestTasks, error) {
 	m.ctrl.T.Helper()
@@ -2065,6 +2333,36 @@ func (mr *MockDatabaseMockRecorder) GetIngestTasksForJob(ctx, jobID any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngestTasksForJob", reflect.TypeOf((*MockDatabase)(nil).GetIngestTasksForJob), ctx, jobID)
 }
 
+// GetIngestUploadChunks mocks base method.
+func (m *MockDatabase) GetIngestUploadChunks(ctx context.Context, sessionID int64) (model.IngestUploadChunks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIngestUploadChunks", ctx, sessionID)
+	ret0, _ := ret[0].(model.IngestUploadChunks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIngestUploadChunks indicates an expected call of GetIngestUploadChunks.
+func (mr *MockDatabaseMockRecorder) GetIngestUploadChunks(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngestUploadChunks", reflect.TypeOf((*MockDatabase)(nil).GetIngestUploadChunks), ctx, sessionID)
+}
+
+// GetIngestUploadSession mocks base method.
+func (m *MockDatabase) GetIngestUploadSession(ctx context.Context, id int64) (model.IngestUploadSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIngestUploadSession", ctx, id)
+	ret0, _ := ret[0].(model.IngestUploadSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIngestUploadSession indicates an expected call of GetIngestUploadSession.
+func (mr *MockDatabaseMockRecorder) GetIngestUploadSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngestUploadSession", reflect.TypeOf((*MockDatabase)(nil).GetIngestUploadSession), ctx, id)
+}
+
 // GetInstallation mocks base method.
 func (m *MockDatabase) GetInstallation(ctx context.Context) (model.Installation, error) {
 	m.ctrl.T.Helper()
@@ -2145,6 +2443,36 @@ func (mr *MockDatabaseMockRecorder) GetOrderedAssetGroupTagTiers(ctx any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderedAssetGroupTagTiers", reflect.TypeOf((*MockDatabase)(nil).GetOrderedAssetGroupTagTiers), ctx)
 }
 
+// GetPathfindingCostProfile mocks base method.
+func (m *MockDatabase) GetPathfindingCostProfile(ctx context.Context, name string) (model.PathfindingCostProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPathfindingCostProfile", ctx, name)
+	ret0, _ := ret[0].(model.PathfindingCostProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPathfindingCostProfile indicates an expected call of GetPathfindingCostProfile.
+func (mr *MockDatabaseMockRecorder) GetPathfindingCostProfile(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPathfindingCostProfile", reflect.TypeOf((*MockDatabase)(nil).GetPathfindingCostProfile), ctx, name)
+}
+
+// GetPathfindingCostProfiles mocks base method.
+func (m *MockDatabase) GetPathfindingCostProfiles(ctx context.Context) ([]model.PathfindingCostProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPathfindingCostProfiles", ctx)
+	ret0, _ := ret[0].([]model.PathfindingCostProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPathfindingCostProfiles indicates an expected call of GetPathfindingCostProfiles.
+func (mr *MockDatabaseMockRecorder) GetPathfindingCostProfiles(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPathfindingCostProfiles", reflect.TypeOf((*MockDatabase)(nil).GetPathfindingCostProfiles), ctx)
+}
+
 // GetPermission mocks base method.
 func (m *MockDatabase) GetPermission(ctx context.Context, id int) (model.Permission, error) {
 	m.ctrl.T.Helper()
@@ -2160,6 +2488,21 @@ func (mr *MockDatabaseMockRecorder) GetPermission(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPermission", reflect.TypeOf((*MockDatabase)(nil).GetPermission), ctx, id)
 }
 
+// GetPreviousAnalysisRun mocks base method.
+func (m *MockDatabase) GetPreviousAnalysisRun(ctx context.Context, id int64) (model.AnalysisRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreviousAnalysisRun", ctx, id)
+	ret0, _ := ret[0].(model.AnalysisRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreviousAnalysisRun indicates an expected call of GetPreviousAnalysisRun.
+func (mr *MockDatabaseMockRecorder) GetPreviousAnalysisRun(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviousAnalysisRun", reflect.TypeOf((*MockDatabase)(nil).GetPreviousAnalysisRun), ctx, id)
+}
+
 // GetPrincipalKindsByEnvironmentId mocks base method.
 func (m *MockDatabase) GetPrincipalKindsByEnvironmentId(ctx context.Context, environmentId int32) (model.SchemaEnvironmentPrincipalKinds, error) {
 	m.ctrl.T.Helper()
@@ -2863,6 +3206,20 @@ func (mr *MockDatabaseMockRecorder) RegisterSourceKind(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSourceKind", reflect.TypeOf((*MockDatabase)(nil).RegisterSourceKind), ctx)
 }
 
+// ReleaseIngestUploadSession mocks base method.
+func (m *MockDatabase) ReleaseIngestUploadSession(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseIngestUploadSession", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseIngestUploadSession indicates an expected call of ReleaseIngestUploadSession.
+func (mr *MockDatabaseMockRecorder) ReleaseIngestUploadSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseIngestUploadSession", reflect.TypeOf((*MockDatabase)(nil).ReleaseIngestUploadSession), ctx, id)
+}
+
 // RequestAnalysis mocks base method.
 func (m *MockDatabase) RequestAnalysis(ctx context.Context, requester string) error {
 	m.ctrl.T.Helper()
@@ -2903,6 +3260,48 @@ func (mr *MockDatabaseMockRecorder) SanitizeUpdateAssetGroupTagRequireCertify(ta
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SanitizeUpdateAssetGroupTagRequireCertify", reflect.TypeOf((*MockDatabase)(nil).SanitizeUpdateAssetGroupTagRequireCertify), tag)
 }
 
+// SaveAssetGroupTagViolations mocks base method.
+func (m *MockDatabase) SaveAssetGroupTagViolations(ctx context.Context, violations []model.AssetGroupTagViolation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAssetGroupTagViolations", ctx, violations)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAssetGroupTagViolations indicates an expected call of SaveAssetGroupTagViolations.
+func (mr *MockDatabaseMockRecorder) SaveAssetGroupTagViolations(ctx, violations any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAssetGroupTagViolations", reflect.TypeOf((*MockDatabase)(nil).SaveAssetGroupTagViolations), ctx, violations)
+}
+
+// SaveAttackPathFindings mocks base method.
+func (m *MockDatabase) SaveAttackPathFindings(ctx context.Context, schemaFindingId int32, assetGroupTagId int, observations []model.AttackPathFindingObservation, evaluatedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAttackPathFindings", ctx, schemaFindingId, assetGroupTagId, observations, evaluatedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAttackPathFindings indicates an expected call of SaveAttackPathFindings.
+func (mr *MockDatabaseMockRecorder) SaveAttackPathFindings(ctx, schemaFindingId, assetGroupTagId, observations, evaluatedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAttackPathFindings", reflect.TypeOf((*MockDatabase)(nil).SaveAttackPathFindings), ctx, schemaFindingId, assetGroupTagId, observations, evaluatedAt)
+}
+
+// SaveIngestPreview mocks base method.
+func (m *MockDatabase) SaveIngestPreview(ctx context.Context, preview model.IngestPreview) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveIngestPreview", ctx, preview)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveIngestPreview indicates an expected call of SaveIngestPreview.
+func (mr *MockDatabaseMockRecorder) SaveIngestPreview(ctx, preview any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveIngestPreview", reflect.TypeOf((*MockDatabase)(nil).SaveIngestPreview), ctx, preview)
+}
+
 // SavedQueryBelongsToUser mocks base method.
 func (m *MockDatabase) SavedQueryBelongsToUser(ctx context.Context, userID uuid.UUID, savedQueryID int64) (bool, error) {
 	m.ctrl.T.Helper()
@@ -2960,6 +3359,20 @@ func (mr *MockDatabaseMockRecorder) SetFlag(ctx, value any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFlag", reflect.TypeOf((*MockDatabase)(nil).SetFlag), ctx, value)
 }
 
+// SetFullAnalysisRequired mocks base method.
+func (m *MockDatabase) SetFullAnalysisRequired(ctx context.Context, required bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFullAnalysisRequired", ctx, required)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFullAnalysisRequired indicates an expected call of SetFullAnalysisRequired.
+func (mr *MockDatabaseMockRecorder) SetFullAnalysisRequired(ctx, required any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFullAnalysisRequired", reflect.TypeOf((*MockDatabase)(nil).SetFullAnalysisRequired), ctx, required)
+}
+
 // SetUserSessionFlag mocks base method.
 func (m *MockDatabase) SetUserSessionFlag(ctx context.Context, userSession *model.UserSession, key model.SessionFlagKey, state bool) error {
 	m.ctrl.T.Helper()
@@ -2986,6 +3399,20 @@ func (mr *MockDatabaseMockRecorder) SweepAssetGroupCollections(ctx any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SweepAssetGroupCollections", reflect.TypeOf((*MockDatabase)(nil).SweepAssetGroupCollections), ctx)
 }
 
+// SweepIngestUploadSessions mocks base method.
+func (m *MockDatabase) SweepIngestUploadSessions(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SweepIngestUploadSessions", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SweepIngestUploadSessions indicates an expected call of SweepIngestUploadSessions.
+func (mr *MockDatabaseMockRecorder) SweepIngestUploadSessions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SweepIngestUploadSessions", reflect.TypeOf((*MockDatabase)(nil).SweepIngestUploadSessions), ctx)
+}
+
 // SweepSessions mocks base method.
 func (m *MockDatabase) SweepSessions(ctx context.Context) {
 	m.ctrl.T.Helper()
@@ -3056,6 +3483,20 @@ func (mr *MockDatabaseMockRecorder) UpdateAssetGroupTag(ctx, user, tag any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssetGroupTag", reflect.TypeOf((*MockDatabase)(nil).UpdateAssetGroupTag), ctx, user, tag)
 }
 
+// UpdateAssetGroupTagMemberCertification mocks base method.
+func (m *MockDatabase) UpdateAssetGroupTagMemberCertification(ctx context.Context, certification model.AssetGroupTagMemberCertification, inputs []database.UpdateCertificationBySelectorNodeInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAssetGroupTagMemberCertification", ctx, certification, inputs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAssetGroupTagMemberCertification indicates an expected call of UpdateAssetGroupTagMemberCertification.
+func (mr *MockDatabaseMockRecorder) UpdateAssetGroupTagMemberCertification(ctx, certification, inputs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssetGroupTagMemberCertification", reflect.TypeOf((*MockDatabase)(nil).UpdateAssetGroupTagMemberCertification), ctx, certification, inputs)
+}
+
 // UpdateAssetGroupTagSelector mocks base method.
 func (m *MockDatabase) UpdateAssetGroupTagSelector(ctx context.Context, actorId, email string, selector model.AssetGroupTagSelector) (model.AssetGroupTagSelector, error) {
 	m.ctrl.T.Helper()
@@ -3071,6 +3512,21 @@ func (mr *MockDatabaseMockRecorder) UpdateAssetGroupTagSelector(ctx, actorId, em
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssetGroupTagSelector", reflect.TypeOf((*MockDatabase)(nil).UpdateAssetGroupTagSelector), ctx, actorId, email, selector)
 }
 
+// UpdateAttackPathFindingAcceptedRisk mocks base method.
+func (m *MockDatabase) UpdateAttackPathFindingAcceptedRisk(ctx context.Context, id int64, acceptedUntil null.Time, acceptedBy null.String, acceptedReason null.String) (model.AttackPathFinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttackPathFindingAcceptedRisk", ctx, id, acceptedUntil, acceptedBy, acceptedReason)
+	ret0, _ := ret[0].(model.AttackPathFinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAttackPathFindingAcceptedRisk indicates an expected call of UpdateAttackPathFindingAcceptedRisk.
+func (mr *MockDatabaseMockRecorder) UpdateAttackPathFindingAcceptedRisk(ctx, id, acceptedUntil, acceptedBy, acceptedReason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttackPathFindingAcceptedRisk", reflect.TypeOf((*MockDatabase)(nil).UpdateAttackPathFindingAcceptedRisk), ctx, id, acceptedUntil, acceptedBy, acceptedReason)
+}
+
 // UpdateAuthSecret mocks base method.
 func (m *MockDatabase) UpdateAuthSecret(ctx context.Context, authSecret model.AuthSecret) error {
 	m.ctrl.T.Helper()
@@ -3217,6 +3673,20 @@ func (mr *MockDatabaseMockRecorder) UpdateIngestJob(ctx, job any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngestJob", reflect.TypeOf((*MockDatabase)(nil).UpdateIngestJob), ctx, job)
 }
 
+// UpdateIngestUploadSession mocks base method.
+func (m *MockDatabase) UpdateIngestUploadSession(ctx context.Context, session model.IngestUploadSession) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIngestUploadSession", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIngestUploadSession indicates an expected call of UpdateIngestUploadSession.
+func (mr *MockDatabaseMockRecorder) UpdateIngestUploadSession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngestUploadSession", reflect.TypeOf((*MockDatabase)(nil).UpdateIngestUploadSession), ctx, session)
+}
+
 // UpdateLastAnalysisCompleteTime mocks base method.
 func (m *MockDatabase) UpdateLastAnalysisCompleteTime(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -3231,6 +3701,20 @@ func (mr *MockDatabaseMockRecorder) UpdateLastAnalysisCompleteTime(ctx any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastAnalysisCompleteTime", reflect.TypeOf((*MockDatabase)(nil).UpdateLastAnalysisCompleteTime), ctx)
 }
 
+// UpdateLastScheduledAnalysisRunTime mocks base method.
+func (m *MockDatabase) UpdateLastScheduledAnalysisRunTime(ctx context.Context, runAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastScheduledAnalysisRunTime", ctx, runAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastScheduledAnalysisRunTime indicates an expected call of UpdateLastScheduledAnalysisRunTime.
+func (mr *MockDatabaseMockRecorder) UpdateLastScheduledAnalysisRunTime(ctx, runAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastScheduledAnalysisRunTime", reflect.TypeOf((*MockDatabase)(nil).UpdateLastScheduledAnalysisRunTime), ctx, runAt)
+}
+
 // UpdateOIDCProvider mocks base method.
 func (m *MockDatabase) UpdateOIDCProvider(ctx context.Context, ssoProvider model.SSOProvider) (model.OIDCProvider, error) {
 	m.ctrl.T.Helper()
@@ -3246,6 +3730,21 @@ func (mr *MockDatabaseMockRecorder) UpdateOIDCProvider(ctx, ssoProvider any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOIDCProvider", reflect.TypeOf((*MockDatabase)(nil).UpdateOIDCProvider), ctx, ssoProvider)
 }
 
+// UpdatePathfindingCostProfile mocks base method.
+func (m *MockDatabase) UpdatePathfindingCostProfile(ctx context.Context, profile model.PathfindingCostProfile) (model.PathfindingCostProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePathfindingCostProfile", ctx, profile)
+	ret0, _ := ret[0].(model.PathfindingCostProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePathfindingCostProfile indicates an expected call of UpdatePathfindingCostProfile.
+func (mr *MockDatabaseMockRecorder) UpdatePathfindingCostProfile(ctx, profile any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePathfindingCostProfile", reflect.TypeOf((*MockDatabase)(nil).UpdatePathfindingCostProfile), ctx, profile)
+}
+
 // UpdateRemediation mocks base method.
 func (m *MockDatabase) UpdateRemediation(ctx context.Context, findingId int32, shortDescription, longDescription, shortRemediation, longRemediation string) (model.Remediation, error) {
 	m.ctrl.T.Helper()
@@ -3261,6 +3760,20 @@ func (mr *MockDatabaseMockRecorder) UpdateRemediation(ctx, findingId, shortDescr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRemediation", reflect.TypeOf((*MockDatabase)(nil).UpdateRemediation), ctx, findingId, shortDescription, longDescription, shortRemediation, longRemediation)
 }
 
+// UpdateRole mocks base method.
+func (m *MockDatabase) UpdateRole(ctx context.Context, role model.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRole", ctx, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRole indicates an expected call of UpdateRole.
+func (mr *MockDatabaseMockRecorder) UpdateRole(ctx, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockDatabase)(nil).UpdateRole), ctx, role)
+}
+
 // UpdateSAMLIdentityProvider mocks base method.
 func (m *MockDatabase) UpdateSAMLIdentityProvider(ctx context.Context, ssoProvider model.SSOProvider) (model.SAMLProvider, error) {
 	m.ctrl.T.Helper()
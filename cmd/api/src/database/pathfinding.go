@@ -0,0 +1,114 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"gorm.io/gorm"
+)
+
+// PathfindingCostProfileData defines the methods required to interact with the pathfinding_cost_profiles table
+type PathfindingCostProfileData interface {
+	GetPathfindingCostProfiles(ctx context.Context) ([]model.PathfindingCostProfile, error)
+	GetPathfindingCostProfile(ctx context.Context, name string) (model.PathfindingCostProfile, error)
+	CreatePathfindingCostProfile(ctx context.Context, profile model.PathfindingCostProfile) (model.PathfindingCostProfile, error)
+	UpdatePathfindingCostProfile(ctx context.Context, profile model.PathfindingCostProfile) (model.PathfindingCostProfile, error)
+	DeletePathfindingCostProfile(ctx context.Context, name string) error
+}
+
+func (s *BloodhoundDB) GetPathfindingCostProfiles(ctx context.Context) ([]model.PathfindingCostProfile, error) {
+	var profiles []model.PathfindingCostProfile
+
+	return profiles, CheckError(s.db.WithContext(ctx).Order("name ASC").Find(&profiles))
+}
+
+func (s *BloodhoundDB) GetPathfindingCostProfile(ctx context.Context, name string) (model.PathfindingCostProfile, error) {
+	var profile model.PathfindingCostProfile
+
+	return profile, CheckError(s.db.WithContext(ctx).Where("name = ?", name).First(&profile))
+}
+
+func (s *BloodhoundDB) CreatePathfindingCostProfile(ctx context.Context, profile model.PathfindingCostProfile) (model.PathfindingCostProfile, error) {
+	var (
+		auditEntry = model.AuditEntry{
+			Action: model.AuditLogActionCreatePathfindingCostProfile,
+			Model:  &profile,
+		}
+	)
+
+	err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		if err := tx.Create(&profile).Error; err != nil {
+			if strings.Contains(err.Error(), "duplicate key value violates unique constraint \"pathfinding_cost_profiles_name_key\"") {
+				return fmt.Errorf("%w: %v", ErrDuplicateCostProfileName, err)
+			}
+
+			return err
+		}
+
+		return nil
+	})
+
+	return profile, err
+}
+
+// UpdatePathfindingCostProfile replaces the description and costs of the cost profile with the given name
+func (s *BloodhoundDB) UpdatePathfindingCostProfile(ctx context.Context, profile model.PathfindingCostProfile) (model.PathfindingCostProfile, error) {
+	var (
+		auditEntry = model.AuditEntry{
+			Action: model.AuditLogActionUpdatePathfindingCostProfile,
+			Model:  &profile,
+		}
+	)
+
+	err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		if result := tx.Raw(fmt.Sprintf("UPDATE %s SET description = ?, default_cost = ?, costs = ?, updated_at = NOW() WHERE name = ? RETURNING *;", profile.TableName()),
+			profile.Description, profile.DefaultCost, profile.Costs, profile.Name).Scan(&profile); result.Error != nil {
+			return CheckError(result)
+		} else if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+
+		return nil
+	})
+
+	return profile, err
+}
+
+func (s *BloodhoundDB) DeletePathfindingCostProfile(ctx context.Context, name string) error {
+	var (
+		profile = model.PathfindingCostProfile{Name: name}
+
+		auditEntry = model.AuditEntry{
+			Action: model.AuditLogActionDeletePathfindingCostProfile,
+			Model:  &profile,
+		}
+	)
+
+	return s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		if result := tx.Raw(fmt.Sprintf("DELETE FROM %s WHERE name = ? RETURNING *;", profile.TableName()), name).Scan(&profile); result.Error != nil {
+			return CheckError(result)
+		} else if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+
+		return nil
+	})
+}
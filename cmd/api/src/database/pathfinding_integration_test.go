@@ -0,0 +1,83 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+
+package database_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloodhoundDB_PathfindingCostProfiles(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		testSuite = setupIntegrationTestSuite(t)
+		profile   = model.PathfindingCostProfile{
+			Name:        "test-profile",
+			Description: "integration test profile",
+			DefaultCost: 2,
+			Costs:       model.PathfindingCosts{ad.MemberOf.String(): 0.5},
+		}
+	)
+
+	defer teardownIntegrationTestSuite(t, &testSuite)
+
+	// The migration seeds a default profile
+	seeded, err := testSuite.BHDatabase.GetPathfindingCostProfile(ctx, "reliability")
+	require.NoError(t, err)
+	require.Equal(t, 0.1, seeded.Cost(ad.MemberOf))
+	require.Equal(t, 1.0, seeded.Cost(ad.GenericAll))
+
+	created, err := testSuite.BHDatabase.CreatePathfindingCostProfile(ctx, profile)
+	require.NoError(t, err)
+	require.NotZero(t, created.ID)
+	require.Equal(t, profile.Costs, created.Costs)
+
+	_, err = testSuite.BHDatabase.CreatePathfindingCostProfile(ctx, profile)
+	require.ErrorIs(t, err, database.ErrDuplicateCostProfileName)
+
+	profiles, err := testSuite.BHDatabase.GetPathfindingCostProfiles(ctx)
+	require.NoError(t, err)
+	require.Len(t, profiles, 2)
+	require.Equal(t, "reliability", profiles[0].Name)
+	require.Equal(t, "test-profile", profiles[1].Name)
+
+	profile.DefaultCost = 3
+	profile.Costs = model.PathfindingCosts{ad.HasSession.String(): 10}
+
+	updated, err := testSuite.BHDatabase.UpdatePathfindingCostProfile(ctx, profile)
+	require.NoError(t, err)
+	require.Equal(t, created.ID, updated.ID)
+	require.Equal(t, 3.0, updated.DefaultCost)
+	require.Equal(t, 3.0, updated.Cost(ad.MemberOf))
+	require.Equal(t, 10.0, updated.Cost(ad.HasSession))
+
+	_, err = testSuite.BHDatabase.UpdatePathfindingCostProfile(ctx, model.PathfindingCostProfile{Name: "missing", DefaultCost: 1})
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	require.NoError(t, testSuite.BHDatabase.DeletePathfindingCostProfile(ctx, profile.Name))
+	require.ErrorIs(t, testSuite.BHDatabase.DeletePathfindingCostProfile(ctx, profile.Name), database.ErrNotFound)
+
+	_, err = testSuite.BHDatabase.GetPathfindingCostProfile(ctx, profile.Name)
+	require.ErrorIs(t, err, database.ErrNotFound)
+}
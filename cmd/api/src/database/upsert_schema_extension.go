@@ -53,8 +53,9 @@ func (s *BloodhoundDB) UpsertOpenGraphExtension(ctx context.Context, graphExtens
 
 	if schemaExists, err = bloodhoundDBTransaction.cleanupExistingExtension(ctx, graphExtensionInput.ExtensionInput.Name); err != nil {
 		return schemaExists, err
-	} else if createdExtension, err = bloodhoundDBTransaction.CreateGraphSchemaExtension(ctx, graphExtensionInput.ExtensionInput.Name,
-		graphExtensionInput.ExtensionInput.DisplayName, graphExtensionInput.ExtensionInput.Version, graphExtensionInput.ExtensionInput.Namespace); err != nil {
+	} else if createdExtension, err = bloodhoundDBTransaction.createGraphSchemaExtension(ctx, graphExtensionInput.ExtensionInput.Name,
+		graphExtensionInput.ExtensionInput.DisplayName, graphExtensionInput.ExtensionInput.Version, graphExtensionInput.ExtensionInput.Namespace,
+		graphExtensionInput.ExtensionInput.StrictPropertyValidation); err != nil {
 		return schemaExists, err
 	} else if createdNodeKinds, err := bloodhoundDBTransaction.insertNodeKinds(ctx, createdExtension.ID,
 		graphExtensionInput.NodeKindsInput); err != nil {
@@ -111,8 +112,8 @@ func (s *BloodhoundDB) insertProperties(ctx context.Context, extensionId int32,
 	)
 
 	for _, property := range newGraphSchemaProperties {
-		if _, err = s.CreateGraphSchemaProperty(ctx, extensionId, property.Name,
-			property.DisplayName, property.DataType, property.Description); err != nil {
+		if _, err = s.createGraphSchemaProperty(ctx, extensionId, property.Name,
+			property.DisplayName, property.DataType, property.Description, property.IsRequired, property.EnumValues); err != nil {
 			return err
 		}
 	}
@@ -37,10 +37,11 @@ func TestBloodhoundDB_UpsertOpenGraphExtension(t *testing.T) {
 	var (
 		testExtensionName = "Test_Extension_Upsert_Test"
 		testExtension     = model.ExtensionInput{
-			Name:        testExtensionName,
-			Version:     "1.0.0",
-			DisplayName: "Test Extension",
-			Namespace:   "Upsert",
+			Name:                     testExtensionName,
+			Version:                  "1.0.0",
+			DisplayName:              "Test Extension",
+			Namespace:                "Upsert",
+			StrictPropertyValidation: true,
 		}
 		newNodeKind1 = model.NodeInput{
 			Name:          "Upsert_New_Test_Node_Kind_1",
@@ -117,6 +118,8 @@ func TestBloodhoundDB_UpsertOpenGraphExtension(t *testing.T) {
 			DisplayName: "Test Property 4",
 			DataType:    "string",
 			Description: "Test Property 4",
+			IsRequired:  true,
+			EnumValues:  []string{"enabled", "disabled"},
 		}
 		newSourceNodeKind = model.NodeInput{
 			Name:          "Upsert_New_Test_Source_Kind",
@@ -1050,6 +1053,7 @@ func getAndCompareGraphExtension(t *testing.T, testContext context.Context, db *
 	require.Equalf(t, want.ExtensionInput.DisplayName, gotGraphExtension.DisplayName, "GraphSchemaExtensionInput - displayname mismatch")
 	require.Equalf(t, want.ExtensionInput.Version, gotGraphExtension.Version, "GraphSchemaExtensionInput - version mismatch")
 	require.Equalf(t, want.ExtensionInput.Namespace, gotGraphExtension.Namespace, "GraphSchemaExtensionInput - namespace mismatch")
+	require.Equalf(t, want.ExtensionInput.StrictPropertyValidation, gotGraphExtension.StrictPropertyValidation, "GraphSchemaExtensionInput - strict_property_validation mismatch")
 
 	var (
 		schemaIdFilter = model.Filter{
@@ -1143,6 +1147,8 @@ func getAndCompareGraphExtension(t *testing.T, testContext context.Context, db *
 		require.Equalf(t, want.PropertiesInput[idx].Description, gotProperty.Description, "PropertyInput - description mismatch")
 		require.Equalf(t, want.PropertiesInput[idx].DataType, gotProperty.DataType, "PropertyInput - DataType mismatch")
 		require.Equalf(t, want.PropertiesInput[idx].DisplayName, gotProperty.DisplayName, "PropertyInput - display_name mismatch")
+		require.Equalf(t, want.PropertiesInput[idx].IsRequired, gotProperty.IsRequired, "PropertyInput - is_required mismatch")
+		require.ElementsMatchf(t, want.PropertiesInput[idx].EnumValues, gotProperty.EnumValues, "PropertyInput - enum_values mismatch")
 	}
 
 	// Test Environments
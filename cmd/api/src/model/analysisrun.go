@@ -0,0 +1,346 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"bytes"
+	"compress/gzip"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// AnalysisRunStatus reflects whether every critical stage of an analysis run succeeded
+type AnalysisRunStatus string
+
+const (
+	AnalysisRunStatusComplete AnalysisRunStatus = "complete"
+	AnalysisRunStatusPartial  AnalysisRunStatus = "partial"
+	AnalysisRunStatusFailed   AnalysisRunStatus = "failed"
+)
+
+// AnalysisRun records a completed analysis run along with the size of the graph state it produced
+type AnalysisRun struct {
+	ID                     int64             `json:"id" gorm:"primaryKey"`
+	StartedAt              time.Time         `json:"started_at"`
+	CompletedAt            time.Time         `json:"completed_at"`
+	Status                 AnalysisRunStatus `json:"status"`
+	PostProcessedEdgeCount int               `json:"post_processed_edge_count"`
+	TierZeroCount          int               `json:"tier_zero_count"`
+	TierZeroPathCount      int               `json:"tier_zero_path_count"`
+	// HasSnapshot is false once the run's snapshot has been pruned, after which it can no longer be diffed
+	HasSnapshot bool      `json:"has_snapshot" gorm:"->"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func (AnalysisRun) TableName() string {
+	return "analysis_runs"
+}
+
+type AnalysisRunStepStatus string
+
+const (
+	AnalysisRunStepStatusSucceeded AnalysisRunStepStatus = "succeeded"
+	AnalysisRunStepStatusFailed    AnalysisRunStepStatus = "failed"
+	AnalysisRunStepStatusSkipped   AnalysisRunStepStatus = "skipped"
+)
+
+// AnalysisRunRelationshipCounts maps a relationship kind to the number of relationships of that kind
+type AnalysisRunRelationshipCounts map[string]int
+
+func (s *AnalysisRunRelationshipCounts) Scan(value any) error {
+	if value == nil {
+		*s = AnalysisRunRelationshipCounts{}
+		return nil
+	}
+
+	if bytes, ok := value.([]byte); !ok {
+		return errors.New("type assertion to []byte failed for AnalysisRunRelationshipCounts")
+	} else {
+		return json.Unmarshal(bytes, s)
+	}
+}
+
+func (s AnalysisRunRelationshipCounts) Value() (driver.Value, error) {
+	if s == nil {
+		return json.Marshal(AnalysisRunRelationshipCounts{})
+	}
+
+	return json.Marshal(map[string]int(s))
+}
+
+// AnalysisRunStep records the duration and outcome of a single stage of an analysis run. Post-processing stages also
+// record the relationships they deleted and created, by kind.
+type AnalysisRunStep struct {
+	ID                   int64                         `json:"-" gorm:"primaryKey"`
+	AnalysisRunId        int64                         `json:"-"`
+	Name                 string                        `json:"name"`
+	Status               AnalysisRunStepStatus         `json:"status"`
+	Error                string                        `json:"error,omitempty"`
+	StartedAt            time.Time                     `json:"started_at"`
+	DurationMs           int64                         `json:"duration_ms"`
+	RelationshipsCreated AnalysisRunRelationshipCounts `json:"relationships_created"`
+	RelationshipsDeleted AnalysisRunRelationshipCounts `json:"relationships_deleted"`
+}
+
+func (AnalysisRunStep) TableName() string {
+	return "analysis_run_steps"
+}
+
+// AnalysisRunEdge identifies a post-processed relationship by the object ids of its endpoints
+type AnalysisRunEdge struct {
+	Kind          string `json:"kind"`
+	EnvironmentId string `json:"environment_id"`
+	Start         string `json:"start"`
+	End           string `json:"end"`
+}
+
+func (s AnalysisRunEdge) compare(other AnalysisRunEdge) int {
+	if result := strings.Compare(s.Kind, other.Kind); result != 0 {
+		return result
+	} else if result := strings.Compare(s.EnvironmentId, other.EnvironmentId); result != 0 {
+		return result
+	} else if result := strings.Compare(s.Start, other.Start); result != 0 {
+		return result
+	}
+
+	return strings.Compare(s.End, other.End)
+}
+
+// AnalysisRunPrincipal identifies a principal by its object id and the environment it belongs to
+type AnalysisRunPrincipal struct {
+	EnvironmentId string `json:"environment_id"`
+	ObjectId      string `json:"object_id"`
+}
+
+func (s AnalysisRunPrincipal) compare(other AnalysisRunPrincipal) int {
+	if result := strings.Compare(s.EnvironmentId, other.EnvironmentId); result != 0 {
+		return result
+	}
+
+	return strings.Compare(s.ObjectId, other.ObjectId)
+}
+
+// AnalysisRunSnapshot is the graph state produced by an analysis run: every post-processed edge, the members of tier
+// zero and the principals outside of tier zero that have an attack path into it
+type AnalysisRunSnapshot struct {
+	Edges         []AnalysisRunEdge      `json:"edges"`
+	TierZero      []AnalysisRunPrincipal `json:"tier_zero"`
+	TierZeroPaths []AnalysisRunPrincipal `json:"tier_zero_paths"`
+	// TierZeroPathsTruncated is set when the walk into tier zero stopped before every principal with a path was found
+	TierZeroPathsTruncated bool `json:"tier_zero_paths_truncated,omitempty"`
+}
+
+// Sort orders the snapshot's contents and removes duplicates. Snapshots must be sorted before they are diffed.
+func (s *AnalysisRunSnapshot) Sort() {
+	slices.SortFunc(s.Edges, AnalysisRunEdge.compare)
+	s.Edges = slices.Compact(s.Edges)
+
+	slices.SortFunc(s.TierZero, AnalysisRunPrincipal.compare)
+	s.TierZero = slices.Compact(s.TierZero)
+
+	slices.SortFunc(s.TierZeroPaths, AnalysisRunPrincipal.compare)
+	s.TierZeroPaths = slices.Compact(s.TierZeroPaths)
+}
+
+// Encode returns the gzip compressed JSON form of the snapshot that is stored alongside its analysis run
+func (s AnalysisRunSnapshot) Encode() ([]byte, error) {
+	var (
+		buffer bytes.Buffer
+		writer = gzip.NewWriter(&buffer)
+	)
+
+	if err := json.NewEncoder(writer).Encode(s); err != nil {
+		return nil, fmt.Errorf("encoding analysis run snapshot: %w", err)
+	} else if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("compressing analysis run snapshot: %w", err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// DecodeAnalysisRunSnapshot reverses AnalysisRunSnapshot.Encode
+func DecodeAnalysisRunSnapshot(data []byte) (AnalysisRunSnapshot, error) {
+	var snapshot AnalysisRunSnapshot
+
+	if reader, err := gzip.NewReader(bytes.NewReader(data)); err != nil {
+		return snapshot, fmt.Errorf("decompressing analysis run snapshot: %w", err)
+	} else {
+		defer reader.Close()
+
+		if err := json.NewDecoder(reader).Decode(&snapshot); err != nil {
+			return snapshot, fmt.Errorf("decoding analysis run snapshot: %w", err)
+		}
+	}
+
+	snapshot.Sort()
+	return snapshot, nil
+}
+
+// AnalysisRunEdgeChanges summarizes the post-processed edges of a single kind and environment that appeared or
+// disappeared between two analysis runs
+type AnalysisRunEdgeChanges struct {
+	Kind          string            `json:"kind"`
+	EnvironmentId string            `json:"environment_id"`
+	AddedCount    int               `json:"added_count"`
+	RemovedCount  int               `json:"removed_count"`
+	Added         []AnalysisRunEdge `json:"added"`
+	Removed       []AnalysisRunEdge `json:"removed"`
+}
+
+// AnalysisRunPrincipalChanges lists the principals that were added to or removed from a set between two runs
+type AnalysisRunPrincipalChanges struct {
+	AddedCount   int                    `json:"added_count"`
+	RemovedCount int                    `json:"removed_count"`
+	Added        []AnalysisRunPrincipal `json:"added"`
+	Removed      []AnalysisRunPrincipal `json:"removed"`
+}
+
+// AnalysisRunDiff describes what changed between a base analysis run and a later one
+type AnalysisRunDiff struct {
+	Edges []AnalysisRunEdgeChanges `json:"edges"`
+	// TierZero lists principals that joined or left tier zero
+	TierZero AnalysisRunPrincipalChanges `json:"tier_zero"`
+	// TierZeroPaths lists principals that gained or lost an attack path into tier zero
+	TierZeroPaths AnalysisRunPrincipalChanges `json:"tier_zero_paths"`
+	// TierZeroPathsTruncated is set when either run only recorded some of the principals with a path into tier zero
+	TierZeroPathsTruncated bool `json:"tier_zero_paths_truncated"`
+}
+
+// DiffAnalysisRunSnapshots compares two sorted snapshots. Counts always cover every change while the listed edges
+// and principals are capped at limit entries per group; a limit of zero or less lists everything. Only changes for
+// which includeEnvironment returns true are reported.
+func DiffAnalysisRunSnapshots(base, target AnalysisRunSnapshot, limit int, includeEnvironment func(environmentId string) bool) AnalysisRunDiff {
+	var (
+		diff = AnalysisRunDiff{
+			Edges: []AnalysisRunEdgeChanges{},
+		}
+		edgeChanges = map[[2]string]*AnalysisRunEdgeChanges{}
+	)
+
+	changesFor := func(edge AnalysisRunEdge) *AnalysisRunEdgeChanges {
+		key := [2]string{edge.Kind, edge.EnvironmentId}
+
+		if changes, found := edgeChanges[key]; found {
+			return changes
+		}
+
+		changes := &AnalysisRunEdgeChanges{
+			Kind:          edge.Kind,
+			EnvironmentId: edge.EnvironmentId,
+			Added:         []AnalysisRunEdge{},
+			Removed:       []AnalysisRunEdge{},
+		}
+
+		edgeChanges[key] = changes
+		return changes
+	}
+
+	diffSorted(base.Edges, target.Edges, AnalysisRunEdge.compare, func(edge AnalysisRunEdge, added bool) {
+		if !includeEnvironment(edge.EnvironmentId) {
+			return
+		}
+
+		changes := changesFor(edge)
+
+		if added {
+			changes.AddedCount++
+			changes.Added = appendWithinLimit(changes.Added, edge, limit)
+		} else {
+			changes.RemovedCount++
+			changes.Removed = appendWithinLimit(changes.Removed, edge, limit)
+		}
+	})
+
+	for _, changes := range edgeChanges {
+		diff.Edges = append(diff.Edges, *changes)
+	}
+
+	slices.SortFunc(diff.Edges, func(a, b AnalysisRunEdgeChanges) int {
+		if result := strings.Compare(a.Kind, b.Kind); result != 0 {
+			return result
+		}
+
+		return strings.Compare(a.EnvironmentId, b.EnvironmentId)
+	})
+
+	diff.TierZero = diffPrincipals(base.TierZero, target.TierZero, limit, includeEnvironment)
+	diff.TierZeroPaths = diffPrincipals(base.TierZeroPaths, target.TierZeroPaths, limit, includeEnvironment)
+	diff.TierZeroPathsTruncated = base.TierZeroPathsTruncated || target.TierZeroPathsTruncated
+
+	return diff
+}
+
+func diffPrincipals(base, target []AnalysisRunPrincipal, limit int, includeEnvironment func(environmentId string) bool) AnalysisRunPrincipalChanges {
+	changes := AnalysisRunPrincipalChanges{
+		Added:   []AnalysisRunPrincipal{},
+		Removed: []AnalysisRunPrincipal{},
+	}
+
+	diffSorted(base, target, AnalysisRunPrincipal.compare, func(principal AnalysisRunPrincipal, added bool) {
+		if !includeEnvironment(principal.EnvironmentId) {
+			return
+		} else if added {
+			changes.AddedCount++
+			changes.Added = appendWithinLimit(changes.Added, principal, limit)
+		} else {
+			changes.RemovedCount++
+			changes.Removed = appendWithinLimit(changes.Removed, principal, limit)
+		}
+	})
+
+	return changes
+}
+
+// diffSorted walks two sorted slices in step and calls delegate for every element found in only one of them
+func diffSorted[T any](base, target []T, compare func(T, T) int, delegate func(element T, added bool)) {
+	var baseIdx, targetIdx int
+
+	for baseIdx < len(base) && targetIdx < len(target) {
+		switch result := compare(base[baseIdx], target[targetIdx]); {
+		case result < 0:
+			delegate(base[baseIdx], false)
+			baseIdx++
+		case result > 0:
+			delegate(target[targetIdx], true)
+			targetIdx++
+		default:
+			baseIdx++
+			targetIdx++
+		}
+	}
+
+	for ; baseIdx < len(base); baseIdx++ {
+		delegate(base[baseIdx], false)
+	}
+
+	for ; targetIdx < len(target); targetIdx++ {
+		delegate(target[targetIdx], true)
+	}
+}
+
+func appendWithinLimit[T any](elements []T, element T, limit int) []T {
+	if limit > 0 && len(elements) >= limit {
+		return elements
+	}
+
+	return append(elements, element)
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalysisRunSnapshot_EncodeDecode(t *testing.T) {
+	snapshot := AnalysisRunSnapshot{
+		Edges: []AnalysisRunEdge{
+			{Kind: "GenericAll", EnvironmentId: "S-1-5-21-1", Start: "B", End: "C"},
+			{Kind: "DCSync", EnvironmentId: "S-1-5-21-1", Start: "A", End: "S-1-5-21-1"},
+			{Kind: "DCSync", EnvironmentId: "S-1-5-21-1", Start: "A", End: "S-1-5-21-1"},
+		},
+		TierZero: []AnalysisRunPrincipal{{EnvironmentId: "S-1-5-21-1", ObjectId: "S-1-5-21-1-512"}},
+	}
+
+	encoded, err := snapshot.Encode()
+	require.NoError(t, err)
+
+	decoded, err := DecodeAnalysisRunSnapshot(encoded)
+	require.NoError(t, err)
+	require.Equal(t, []AnalysisRunEdge{
+		{Kind: "DCSync", EnvironmentId: "S-1-5-21-1", Start: "A", End: "S-1-5-21-1"},
+		{Kind: "GenericAll", EnvironmentId: "S-1-5-21-1", Start: "B", End: "C"},
+	}, decoded.Edges)
+	require.Equal(t, snapshot.TierZero, decoded.TierZero)
+
+	_, err = DecodeAnalysisRunSnapshot([]byte("not gzip"))
+	require.Error(t, err)
+}
+
+func TestDiffAnalysisRunSnapshots(t *testing.T) {
+	var (
+		base = AnalysisRunSnapshot{
+			Edges: []AnalysisRunEdge{
+				{Kind: "DCSync", EnvironmentId: "S-1-5-21-1", Start: "A", End: "S-1-5-21-1"},
+				{Kind: "GenericAll", EnvironmentId: "S-1-5-21-2", Start: "D", End: "E"},
+			},
+			TierZero:      []AnalysisRunPrincipal{{EnvironmentId: "S-1-5-21-1", ObjectId: "T0"}},
+			TierZeroPaths: []AnalysisRunPrincipal{{EnvironmentId: "S-1-5-21-1", ObjectId: "A"}},
+		}
+		target = AnalysisRunSnapshot{
+			Edges: []AnalysisRunEdge{
+				{Kind: "DCSync", EnvironmentId: "S-1-5-21-1", Start: "A", End: "S-1-5-21-1"},
+				{Kind: "DCSync", EnvironmentId: "S-1-5-21-1", Start: "B", End: "S-1-5-21-1"},
+				{Kind: "DCSync", EnvironmentId: "S-1-5-21-1", Start: "C", End: "S-1-5-21-1"},
+			},
+			TierZero: []AnalysisRunPrincipal{{EnvironmentId: "S-1-5-21-1", ObjectId: "T0"}},
+			TierZeroPaths: []AnalysisRunPrincipal{
+				{EnvironmentId: "S-1-5-21-1", ObjectId: "A"},
+				{EnvironmentId: "S-1-5-21-1", ObjectId: "B"},
+				{EnvironmentId: "S-1-5-21-1", ObjectId: "C"},
+			},
+		}
+		allEnvironments = func(string) bool { return true }
+	)
+
+	base.Sort()
+	target.Sort()
+
+	t.Run("changes are grouped by kind and environment", func(t *testing.T) {
+		diff := DiffAnalysisRunSnapshots(base, target, 0, allEnvironments)
+
+		require.Equal(t, []AnalysisRunEdgeChanges{
+			{
+				Kind:          "DCSync",
+				EnvironmentId: "S-1-5-21-1",
+				AddedCount:    2,
+				Added: []AnalysisRunEdge{
+					{Kind: "DCSync", EnvironmentId: "S-1-5-21-1", Start: "B", End: "S-1-5-21-1"},
+					{Kind: "DCSync", EnvironmentId: "S-1-5-21-1", Start: "C", End: "S-1-5-21-1"},
+				},
+				Removed: []AnalysisRunEdge{},
+			},
+			{
+				Kind:          "GenericAll",
+				EnvironmentId: "S-1-5-21-2",
+				RemovedCount:  1,
+				Added:         []AnalysisRunEdge{},
+				Removed:       []AnalysisRunEdge{{Kind: "GenericAll", EnvironmentId: "S-1-5-21-2", Start: "D", End: "E"}},
+			},
+		}, diff.Edges)
+
+		require.Zero(t, diff.TierZero.AddedCount)
+		require.Zero(t, diff.TierZero.RemovedCount)
+		require.Equal(t, 2, diff.TierZeroPaths.AddedCount)
+		require.Equal(t, []AnalysisRunPrincipal{{EnvironmentId: "S-1-5-21-1", ObjectId: "B"}, {EnvironmentId: "S-1-5-21-1", ObjectId: "C"}}, diff.TierZeroPaths.Added)
+	})
+
+	t.Run("listed changes are limited while counts are not", func(t *testing.T) {
+		diff := DiffAnalysisRunSnapshots(base, target, 1, allEnvironments)
+
+		require.Equal(t, 2, diff.Edges[0].AddedCount)
+		require.Len(t, diff.Edges[0].Added, 1)
+		require.Equal(t, 2, diff.TierZeroPaths.AddedCount)
+		require.Len(t, diff.TierZeroPaths.Added, 1)
+	})
+
+	t.Run("changes outside of included environments are omitted", func(t *testing.T) {
+		diff := DiffAnalysisRunSnapshots(base, target, 0, func(environmentId string) bool {
+			return environmentId == "S-1-5-21-2"
+		})
+
+		require.Len(t, diff.Edges, 1)
+		require.Equal(t, "GenericAll", diff.Edges[0].Kind)
+		require.Zero(t, diff.TierZeroPaths.AddedCount)
+	})
+
+	t.Run("reversed runs swap added and removed", func(t *testing.T) {
+		diff := DiffAnalysisRunSnapshots(target, base, 0, allEnvironments)
+
+		require.Equal(t, 2, diff.Edges[0].RemovedCount)
+		require.Equal(t, 1, diff.Edges[1].AddedCount)
+		require.Equal(t, 2, diff.TierZeroPaths.RemovedCount)
+	})
+
+	t.Run("truncated snapshots mark the diff as truncated", func(t *testing.T) {
+		require.False(t, DiffAnalysisRunSnapshots(base, target, 0, allEnvironments).TierZeroPathsTruncated)
+
+		truncated := target
+		truncated.TierZeroPathsTruncated = true
+
+		require.True(t, DiffAnalysisRunSnapshots(base, truncated, 0, allEnvironments).TierZeroPathsTruncated)
+		require.True(t, DiffAnalysisRunSnapshots(truncated, base, 0, allEnvironments).TierZeroPathsTruncated)
+	})
+}
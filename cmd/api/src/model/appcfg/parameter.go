@@ -32,6 +32,7 @@ import (
 	"github.com/specterops/bloodhound/cmd/api/src/utils/validation"
 	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
 	"github.com/specterops/dawgs/drivers/neo4j"
+	"github.com/teambition/rrule-go"
 )
 
 type ParameterKey string
@@ -44,6 +45,7 @@ const (
 	PruneTTL                 ParameterKey = "prune.ttl"
 	ReconciliationKey        ParameterKey = "analysis.reconciliation"
 	ScheduledAnalysis        ParameterKey = "analysis.scheduled"
+	PostProcessingKey        ParameterKey = "analysis.post_processing"
 
 	// The below keys are not intended to be user updatable, so should not be added to IsValidKey
 	TrustedProxiesConfig                ParameterKey = "http.trusted_proxies"
@@ -91,7 +93,7 @@ func (s *Parameter) Map(value any) error {
 
 func (s *Parameter) IsValidKey(parameterKey ParameterKey) bool {
 	switch parameterKey {
-	case PasswordExpirationWindow, Neo4jConfigs, PruneTTL, CitrixRDPSupportKey, ReconciliationKey, ScheduledAnalysis:
+	case PasswordExpirationWindow, Neo4jConfigs, PruneTTL, CitrixRDPSupportKey, ReconciliationKey, ScheduledAnalysis, PostProcessingKey:
 		return true
 	default:
 		return false
@@ -136,6 +138,8 @@ func (s *Parameter) Validate() utils.Errors {
 		v = &TieringParameters{}
 	case ScheduledAnalysis:
 		v = &ScheduledAnalysisParameter{}
+	case PostProcessingKey:
+		v = &PostProcessingParameter{}
 	case TrustedProxiesConfig:
 		v = &TrustedProxiesParameters{}
 	case FedEULACustomTextKey:
@@ -380,6 +384,40 @@ func GetScheduledAnalysisParameter(ctx context.Context, service ParameterService
 	return result, nil
 }
 
+// NextRunAfter returns the first scheduled analysis occurrence strictly after the given time. A zero time is returned
+// when scheduled analysis is disabled or the rule has no further occurrences.
+func (s ScheduledAnalysisParameter) NextRunAfter(after time.Time) (time.Time, error) {
+	if !s.Enabled || s.RRule == "" {
+		return time.Time{}, nil
+	} else if rule, err := rrule.StrToRRule(s.RRule); err != nil {
+		return time.Time{}, fmt.Errorf("parsing scheduled analysis rrule: %w", err)
+	} else {
+		return rule.After(after, false), nil
+	}
+}
+
+// PostProcessingParameter names the post-processors that are switched off. Post-processors depending on them are
+// switched off as well.
+type PostProcessingParameter struct {
+	DisabledPostProcessors []string `json:"disabled_post_processors"`
+}
+
+func GetPostProcessingParameter(ctx context.Context, service ParameterService) PostProcessingParameter {
+	result := PostProcessingParameter{DisabledPostProcessors: []string{}}
+
+	if cfg, err := service.GetConfigurationParameter(ctx, PostProcessingKey); err != nil {
+		slog.WarnContext(ctx, "Failed to fetch post-processing configuration; returning default values")
+	} else if err := cfg.Map(&result); err != nil {
+		slog.WarnContext(ctx, "Invalid post-processing configuration supplied, returning default values.",
+			slog.String("invalid_configuration", err.Error()),
+			slog.String("parameter_key", string(PostProcessingKey)))
+
+		result = PostProcessingParameter{DisabledPostProcessors: []string{}}
+	}
+
+	return result
+}
+
 type TrustedProxiesParameters struct {
 	TrustedProxies int `json:"trusted_proxies,omitempty"`
 }
@@ -43,6 +43,10 @@ func TestParameters_IsValidKey(t *testing.T) {
 	t.Run("should return true for scheduled analysis key", func(t *testing.T) {
 		require.True(t, parameter.IsValidKey(appcfg.ScheduledAnalysis))
 	})
+
+	t.Run("should return true for post-processing key", func(t *testing.T) {
+		require.True(t, parameter.IsValidKey(appcfg.PostProcessingKey))
+	})
 }
 
 func TestParameters_Validate(t *testing.T) {
@@ -248,3 +252,31 @@ func TestParameters_GetScheduledAnalysisParameter(t *testing.T) {
 		require.Equal(t, "", result.RRule)
 	})
 }
+
+func TestParameters_GetPostProcessingParameter(t *testing.T) {
+	t.Run("should return no disabled post-processors by default", func(t *testing.T) {
+		result := appcfg.GetPostProcessingParameter(context.Background(), integration.SetupDB(t))
+		require.Empty(t, result.DisabledPostProcessors)
+	})
+
+	t.Run("should return configured disabled post-processors", func(t *testing.T) {
+		var (
+			db  = integration.SetupDB(t)
+			ctx = context.Background()
+		)
+
+		val, err := types.NewJSONBObject(map[string]any{
+			"disabled_post_processors": []string{"ad.ntlm"},
+		})
+		require.NoError(t, err)
+
+		err = db.SetConfigurationParameter(ctx, appcfg.Parameter{
+			Key:   appcfg.PostProcessingKey,
+			Value: val,
+		})
+		require.NoError(t, err)
+
+		result := appcfg.GetPostProcessingParameter(ctx, db)
+		require.Equal(t, []string{"ad.ntlm"}, result.DisabledPostProcessors)
+	})
+}
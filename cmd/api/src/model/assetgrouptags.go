@@ -197,6 +197,12 @@ func (s AssetGroupTag) IsTierZero() bool {
 	return s.Position.ValueOrZero() == AssetGroupTierZeroPosition
 }
 
+// AcceptsCertification reports whether a selected node with the given certification is a member of the tag. Tags that
+// require certification only accept nodes that were certified, either manually or automatically.
+func (s AssetGroupTag) AcceptsCertification(certified AssetGroupCertification) bool {
+	return !s.RequireCertify.ValueOrZero() || certified > AssetGroupCertificationRevoked
+}
+
 type SelectorSeeds []SelectorSeed
 
 type SelectorSeed struct {
@@ -339,3 +345,20 @@ type AssetGroupSelectorNodeExpanded struct {
 	AssetGroupTagId int `json:"asset_group_tag_id"`
 	Position        int `json:"position"`
 }
+
+// AssetGroupTagMemberCertification is a reviewer's certification decision for a set of members of a tag
+type AssetGroupTagMemberCertification struct {
+	AssetGroupTagId int
+	MemberIds       []graph.ID
+	Certified       AssetGroupCertification
+	Note            null.String
+}
+
+func (s AssetGroupTagMemberCertification) AuditData() AuditData {
+	return AuditData{
+		"asset_group_tag_id": s.AssetGroupTagId,
+		"member_ids":         s.MemberIds,
+		"certified":          s.Certified,
+		"note":               s.Note,
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// AssetGroupTagViolationUntieredSourceId is the source tag id recorded for principals that are not a member of any
+// analyzed tier
+const AssetGroupTagViolationUntieredSourceId = 0
+
+// AssetGroupTagViolationEdge is an attack path edge held by a principal of a less privileged tier over a member of a
+// more privileged one
+type AssetGroupTagViolationEdge struct {
+	Kind          string `json:"kind"`
+	FromPrincipal string `json:"from_principal"`
+	FromKind      string `json:"from_kind"`
+	ToPrincipal   string `json:"to_principal"`
+	ToKind        string `json:"to_kind"`
+}
+
+type AssetGroupTagViolationEdges []AssetGroupTagViolationEdge
+
+func (s *AssetGroupTagViolationEdges) Scan(value any) error {
+	if value == nil {
+		*s = AssetGroupTagViolationEdges{}
+		return nil
+	}
+
+	if bytes, ok := value.([]byte); !ok {
+		return errors.New("type assertion to []byte failed for AssetGroupTagViolationEdges")
+	} else {
+		return json.Unmarshal(bytes, s)
+	}
+}
+
+func (s AssetGroupTagViolationEdges) Value() (driver.Value, error) {
+	if s == nil {
+		return json.Marshal(AssetGroupTagViolationEdges{})
+	}
+
+	return json.Marshal([]AssetGroupTagViolationEdge(s))
+}
+
+// AssetGroupTagViolation counts the attack path edges from the principals of a source tier into the members of a more
+// privileged tier, along with a sample of those edges
+type AssetGroupTagViolation struct {
+	ID                    int64                       `json:"-" gorm:"primaryKey"`
+	AssetGroupTagId       int                         `json:"asset_group_tag_id"`
+	SourceAssetGroupTagId int                         `json:"source_asset_group_tag_id"`
+	ViolationCount        int                         `json:"violation_count"`
+	SampleEdges           AssetGroupTagViolationEdges `json:"sample_edges"`
+	EvaluatedAt           time.Time                   `json:"evaluated_at"`
+}
+
+func (AssetGroupTagViolation) TableName() string {
+	return "asset_group_tag_violations"
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+)
+
+// AttackPathFindingObservation is a single match of a schema finding seen during analysis. Relationship findings
+// identify the principal holding the relationship and the principal it targets, list findings only the former.
+type AttackPathFindingObservation struct {
+	EnvironmentId string
+	FromPrincipal string
+	ToPrincipal   string
+	PrincipalKind string
+}
+
+// AttackPathFinding tracks a schema finding match across analysis runs
+type AttackPathFinding struct {
+	ID              int64       `json:"id" gorm:"primaryKey"`
+	SchemaFindingId int32       `json:"schema_finding_id"`
+	FindingName     string      `json:"finding_name" gorm:"->"`
+	AssetGroupTagId int         `json:"asset_group_tag_id"`
+	EnvironmentId   string      `json:"environment_id"`
+	FromPrincipal   string      `json:"from_principal"`
+	ToPrincipal     string      `json:"to_principal"`
+	PrincipalKind   string      `json:"principal_kind"`
+	FirstSeen       time.Time   `json:"first_seen"`
+	LastSeen        time.Time   `json:"last_seen"`
+	ResolvedAt      null.Time   `json:"resolved_at"`
+	AcceptedUntil   null.Time   `json:"accepted_until"`
+	AcceptedBy      null.String `json:"accepted_by"`
+	AcceptedReason  null.String `json:"accepted_reason"`
+	CreatedAt       time.Time   `json:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at"`
+}
+
+func (AttackPathFinding) TableName() string {
+	return "attack_path_findings"
+}
+
+// IsAccepted returns true if the finding's risk has been accepted and the acceptance has not yet expired
+func (s AttackPathFinding) IsAccepted(now time.Time) bool {
+	return s.AcceptedUntil.Valid && s.AcceptedUntil.Time.After(now)
+}
+
+func (s AttackPathFinding) AuditData() AuditData {
+	return AuditData{
+		"id":                 s.ID,
+		"schema_finding_id":  s.SchemaFindingId,
+		"asset_group_tag_id": s.AssetGroupTagId,
+		"environment_id":     s.EnvironmentId,
+		"from_principal":     s.FromPrincipal,
+		"to_principal":       s.ToPrincipal,
+		"accepted_until":     s.AcceptedUntil,
+		"accepted_reason":    s.AcceptedReason,
+	}
+}
+
+func (s AttackPathFinding) IsSortable(criteria string) bool {
+	switch criteria {
+	case "id", "schema_finding_id", "environment_id", "from_principal", "to_principal", "first_seen", "last_seen", "resolved_at", "accepted_until":
+		return true
+	default:
+		return false
+	}
+}
+
+func (s AttackPathFinding) IsStringColumn(filter string) bool {
+	return filter == "environment_id" || filter == "from_principal" || filter == "to_principal" || filter == "principal_kind"
+}
+
+func (s AttackPathFinding) ValidFilters() map[string][]FilterOperator {
+	return map[string][]FilterOperator{
+		"schema_finding_id": {Equals, NotEquals},
+		"environment_id":    {Equals, NotEquals},
+		"from_principal":    {Equals, NotEquals, ApproximatelyEquals},
+		"to_principal":      {Equals, NotEquals, ApproximatelyEquals},
+		"principal_kind":    {Equals, NotEquals},
+		"first_seen":        {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
+		"last_seen":         {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
+	}
+}
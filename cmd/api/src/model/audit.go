@@ -48,6 +48,10 @@ const (
 	AuditLogActionUpdateUser AuditLogAction = "UpdateUser"
 	AuditLogActionDeleteUser AuditLogAction = "DeleteUser"
 
+	AuditLogActionCreateRole AuditLogAction = "CreateRole"
+	AuditLogActionUpdateRole AuditLogAction = "UpdateRole"
+	AuditLogActionDeleteRole AuditLogAction = "DeleteRole"
+
 	AuditLogActionCreateAssetGroup AuditLogAction = "CreateAssetGroup"
 	AuditLogActionUpdateAssetGroup AuditLogAction = "UpdateAssetGroup"
 	AuditLogActionDeleteAssetGroup AuditLogAction = "DeleteAssetGroup"
@@ -81,6 +85,8 @@ const (
 
 	AuditLogActionDeleteBloodhoundData AuditLogAction = "DeleteBloodhoundData"
 
+	AuditLogActionReconcileGraphData AuditLogAction = "ReconcileGraphData"
+
 	AuditLogActionMutateGraph AuditLogAction = "MutateGraph"
 
 	AuditLogActionUpdateParameter AuditLogAction = "UpdateParameter"
@@ -91,11 +97,17 @@ const (
 	AuditLogActionCreateAssetGroupTagSelector AuditLogAction = "CreateAssetGroupTagSelector"
 	AuditLogActionUpdateAssetGroupTagSelector AuditLogAction = "UpdateAssetGroupTagSelector"
 	AuditLogActionDeleteAssetGroupTagSelector AuditLogAction = "DeleteAssetGroupTagSelector"
+	AuditLogActionCertifyAssetGroupTagMembers AuditLogAction = "CertifyAssetGroupTagMembers"
+	AuditLogActionRevokeAssetGroupTagMembers  AuditLogAction = "RevokeAssetGroupTagMembers"
 
 	AuditLogActionCreateCustomNodeKind AuditLogAction = "CreateCustomNodeKind"
 	AuditLogActionUpdateCustomNodeKind AuditLogAction = "UpdateCustomNodeKind"
 	AuditLogActionDeleteCustomNodeKind AuditLogAction = "DeleteCustomNodeKind"
 
+	AuditLogActionCreatePathfindingCostProfile AuditLogAction = "CreatePathfindingCostProfile"
+	AuditLogActionUpdatePathfindingCostProfile AuditLogAction = "UpdatePathfindingCostProfile"
+	AuditLogActionDeletePathfindingCostProfile AuditLogAction = "DeletePathfindingCostProfile"
+
 	AuditLogActionToggleEarlyAccessFeatureFlag AuditLogAction = "ToggleEarlyAccessFeatureFlag"
 
 	AuditLogActionCreateClient       AuditLogAction = "CreateClient"
@@ -134,6 +134,16 @@ func (s Permissions) Equals(others Permissions) bool {
 	return true
 }
 
+func (s Permissions) Strings() []string {
+	permissions := make([]string, len(s))
+
+	for idx, permission := range s {
+		permissions[idx] = permission.String()
+	}
+
+	return permissions
+}
+
 func (s Permissions) Has(other Permission) bool {
 	for _, permission := range s {
 		if permission.Equals(other) {
@@ -288,6 +298,7 @@ func RoleAssociations() []string {
 type Role struct {
 	Name        string      `json:"name"`
 	Description string      `json:"description"`
+	BuiltIn     bool        `json:"built_in"`
 	Permissions Permissions `json:"permissions" gorm:"many2many:roles_permissions"`
 
 	Serial
@@ -295,8 +306,9 @@ type Role struct {
 
 func (s Role) AuditData() AuditData {
 	return AuditData{
-		"role_id":   s.ID,
-		"role_name": s.Name,
+		"role_id":          s.ID,
+		"role_name":        s.Name,
+		"role_permissions": s.Permissions.Strings(),
 	}
 }
 
@@ -319,6 +331,7 @@ func (s Roles) IsSortable(column string) bool {
 func (s Roles) ValidFilters() map[string][]FilterOperator {
 	return map[string][]FilterOperator{
 		"name":       {Equals, NotEquals},
+		"built_in":   {Equals, NotEquals},
 		"id":         {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
 		"created_at": {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
 		"updated_at": {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
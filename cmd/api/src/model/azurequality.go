@@ -35,6 +35,9 @@ type AzureStatKinds struct {
 	ManagedClusters     int `json:"managed_clusters"`
 	VMScaleSets         int `json:"vm_scale_sets"`
 	WebApps             int `json:"web_apps"`
+	SyncedUsers         int `json:"synced_users"`
+	SyncedGroups        int `json:"synced_groups"`
+	SyncedDevices       int `json:"synced_devices"`
 }
 
 type AzureDataQualityStat struct {
@@ -16,24 +16,32 @@
 
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/specterops/bloodhound/packages/go/analysis"
+)
 
 type DatapipeStatus string
 
 const (
-	DatapipeStatusIdle      DatapipeStatus = "idle"
-	DatapipeStatusIngesting DatapipeStatus = "ingesting"
-	DatapipeStatusAnalyzing DatapipeStatus = "analyzing"
-	DatapipeStatusPurging   DatapipeStatus = "purging"
-	DatapipeStatusPruning   DatapipeStatus = "pruning"
-	DatapipeStatusStarting  DatapipeStatus = "starting"
+	DatapipeStatusIdle        DatapipeStatus = "idle"
+	DatapipeStatusIngesting   DatapipeStatus = "ingesting"
+	DatapipeStatusAnalyzing   DatapipeStatus = "analyzing"
+	DatapipeStatusPurging     DatapipeStatus = "purging"
+	DatapipeStatusPruning     DatapipeStatus = "pruning"
+	DatapipeStatusReconciling DatapipeStatus = "reconciling"
+	DatapipeStatusStarting    DatapipeStatus = "starting"
 )
 
 type DatapipeStatusWrapper struct {
-	Status                     DatapipeStatus `json:"status"`
-	UpdatedAt                  time.Time      `json:"updated_at"`
-	LastCompleteAnalysisAt     time.Time      `json:"last_complete_analysis_at"`
-	LastScheduledAnalysisRunAt time.Time      `json:"last_analysis_run_at" gorm:"column:last_analysis_run_at"`
+	Status                     DatapipeStatus              `json:"status"`
+	UpdatedAt                  time.Time                   `json:"updated_at"`
+	LastCompleteAnalysisAt     time.Time                   `json:"last_complete_analysis_at"`
+	LastScheduledAnalysisRunAt time.Time                   `json:"last_analysis_run_at" gorm:"column:last_analysis_run_at"`
+	NextScheduledAnalysisRunAt *time.Time                  `json:"next_scheduled_analysis_run_at,omitempty" gorm:"-"`
+	FullAnalysisRequired       bool                        `json:"-"`
+	PostProcessing             analysis.PostProcessingPlan `json:"post_processing,omitempty" gorm:"-"`
 }
 
 func (DatapipeStatus) TableName() string {
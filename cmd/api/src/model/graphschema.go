@@ -21,6 +21,7 @@ import (
 	"slices"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/specterops/dawgs/graph"
 )
 
@@ -74,6 +75,10 @@ type GraphSchemaExtension struct {
 	Version     string
 	IsBuiltin   bool
 	Namespace   string
+
+	// StrictPropertyValidation rejects ingested entities with property values that violate the extension's property
+	// schema instead of dropping the offending properties
+	StrictPropertyValidation bool
 }
 
 func (GraphSchemaExtension) TableName() string {
@@ -82,12 +87,13 @@ func (GraphSchemaExtension) TableName() string {
 
 func (s GraphSchemaExtension) AuditData() AuditData {
 	return AuditData{
-		"id":           s.ID,
-		"name":         s.Name,
-		"display_name": s.DisplayName,
-		"version":      s.Version,
-		"is_builtin":   s.IsBuiltin,
-		"namespace":    s.Namespace,
+		"id":                         s.ID,
+		"name":                       s.Name,
+		"display_name":               s.DisplayName,
+		"version":                    s.Version,
+		"is_builtin":                 s.IsBuiltin,
+		"namespace":                  s.Namespace,
+		"strict_property_validation": s.StrictPropertyValidation,
 	}
 }
 
@@ -127,6 +133,8 @@ type GraphSchemaProperty struct {
 	DisplayName       string
 	DataType          string
 	Description       string
+	IsRequired        bool           // indicates whether ingested entities must have this property
+	EnumValues        pq.StringArray `gorm:"type:text[]"` // if not empty, the only values this property may have
 }
 
 func (GraphSchemaProperty) TableName() string {
@@ -322,10 +330,11 @@ type EnvironmentInput struct {
 }
 
 type ExtensionInput struct {
-	Name        string
-	DisplayName string
-	Version     string
-	Namespace   string // the required extension prefix for node and edge kind names
+	Name                     string
+	DisplayName              string
+	Version                  string
+	Namespace                string // the required extension prefix for node and edge kind names
+	StrictPropertyValidation bool   // reject entities with invalid property values instead of dropping the properties
 }
 
 type PropertiesInput []PropertyInput
@@ -334,6 +343,8 @@ type PropertyInput struct {
 	DisplayName string
 	DataType    string
 	Description string
+	IsRequired  bool
+	EnumValues  []string
 }
 
 type NodesInput []NodeInput
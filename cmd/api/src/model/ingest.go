@@ -17,6 +17,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
 )
 
@@ -26,6 +28,7 @@ type IngestTask struct {
 	RequestGUID      string     `json:"request_guid"`
 	JobId            null.Int64 `json:"task_id" gorm:"column:task_id"`
 	FileType         FileType   `json:"file_type"`
+	DryRun           bool       `json:"dry_run"`
 
 	BigSerial
 }
@@ -37,4 +40,57 @@ type FileType int
 const (
 	FileTypeJson FileType = iota
 	FileTypeZip
+	FileTypeNDJSON
+	FileTypeGzip
 )
+
+// IngestUploadSession tracks a file that is uploaded to an ingest job in numbered chunks. Chunks may arrive in any
+// order and more than once; the file is only handed to ingest once every chunk has been received and the assembled
+// file matches the checksum given when the session was created.
+type IngestUploadSession struct {
+	JobID       int64     `json:"file_upload_job_id" gorm:"column:job_id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	TotalSize   int64     `json:"total_size"`
+	ChunkSize   int64     `json:"chunk_size"`
+	SHA256      string    `json:"sha256" gorm:"column:sha256"`
+	ExpiresAt   time.Time `json:"expires_at"`
+
+	// FinalizingAt is set while the chunks of the session are assembled, it is only written by claiming the session
+	FinalizingAt null.Time `json:"finalizing_at" gorm:"->"`
+
+	BigSerial
+}
+
+// ChunkCount returns the number of chunks the file is split into. Every chunk but the last is ChunkSize bytes long.
+func (s IngestUploadSession) ChunkCount() int64 {
+	if s.ChunkSize <= 0 {
+		return 0
+	}
+
+	return (s.TotalSize + s.ChunkSize - 1) / s.ChunkSize
+}
+
+// ChunkLength returns the expected length in bytes of the chunk at the given index
+func (s IngestUploadSession) ChunkLength(index int64) int64 {
+	if index < 0 || index >= s.ChunkCount() {
+		return 0
+	} else if index == s.ChunkCount()-1 {
+		return s.TotalSize - index*s.ChunkSize
+	} else {
+		return s.ChunkSize
+	}
+}
+
+type IngestUploadSessions []IngestUploadSession
+
+type IngestUploadChunk struct {
+	SessionID  int64     `json:"-"`
+	ChunkIndex int64     `json:"chunk_index"`
+	Size       int64     `json:"size"`
+	SHA256     string    `json:"sha256" gorm:"column:sha256"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type IngestUploadChunks []IngestUploadChunk
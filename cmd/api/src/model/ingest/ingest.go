@@ -19,6 +19,7 @@ package ingest
 import (
 	"encoding/json"
 	"errors"
+	"slices"
 
 	"github.com/specterops/bloodhound/packages/go/mediatypes"
 )
@@ -29,7 +30,25 @@ var AllowedZipFileUploadTypes = []string{
 	"application/zip-compressed",   // Not currently available in mediatypes
 }
 
-var AllowedFileUploadTypes = append([]string{mediatypes.ApplicationJson.String()}, AllowedZipFileUploadTypes...)
+var AllowedNDJSONFileUploadTypes = []string{
+	"application/x-ndjson", // Not currently available in mediatypes
+}
+
+// AllowedGzipFileUploadTypes covers both single gzip-compressed files and gzip-compressed tar archives
+var AllowedGzipFileUploadTypes = []string{
+	mediatypes.ApplicationGzip.String(),
+	"application/x-gzip",     // Not currently available in mediatypes
+	"application/x-gtar",     // Not currently available in mediatypes
+	"application/tar+gzip",   // Not currently available in mediatypes
+	"application/x-tar+gzip", // Not currently available in mediatypes
+}
+
+var AllowedFileUploadTypes = slices.Concat(
+	[]string{mediatypes.ApplicationJson.String()},
+	AllowedNDJSONFileUploadTypes,
+	AllowedZipFileUploadTypes,
+	AllowedGzipFileUploadTypes,
+)
 
 type OpengraphMetadata struct {
 	SourceKind string `json:"source_kind"`
@@ -180,7 +199,11 @@ var (
 	ErrInvalidDataTag      = errors.New("invalid data tag found")
 	ErrJSONDecoderInternal = errors.New("json decoder internal error")
 	ErrInvalidZipFile      = errors.New("failed to find zip file header")
+	ErrInvalidGzipFile     = errors.New("failed to find gzip file header")
+	ErrUploadChunkConflict = errors.New("chunk was already received with different content")
 	ErrMixedIngestFormat   = errors.New("request must use either the classic format (meta/data) or the generic format (graph), not both")
 
+	ErrUploadSessionFinalizing = errors.New("upload session is already being finalized")
+
 	ErrOpenGraphMetaTagValidation = errors.New("metadata tag is invalid")
 )
@@ -0,0 +1,178 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+)
+
+// IngestPreviewListLimit caps the number of entries kept in every list of an ingest preview report. The counts of a
+// report always cover every entry, including the ones that were left out of its lists.
+const IngestPreviewListLimit = 1000
+
+// IngestPreviewNode is a node as it is identified by ingest. Identity holds the value of the property the node is
+// matched by, which is its objectid for everything but the DN based relationships of AD collections.
+type IngestPreviewNode struct {
+	Identity string   `json:"identity"`
+	Kinds    []string `json:"kinds"`
+}
+
+type IngestPreviewPropertyChange struct {
+	Name     string `json:"name"`
+	OldValue any    `json:"old_value"`
+	NewValue any    `json:"new_value"`
+}
+
+type IngestPreviewNodeUpdate struct {
+	IngestPreviewNode
+
+	AddedKinds        []string                      `json:"added_kinds"`
+	ChangedProperties []IngestPreviewPropertyChange `json:"changed_properties"`
+}
+
+type IngestPreviewEdge struct {
+	Kind  string `json:"kind"`
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+type IngestPreviewCounts struct {
+	NodesToCreate       int64 `json:"nodes_to_create"`
+	NodesToUpdate       int64 `json:"nodes_to_update"`
+	NodesUnchanged      int64 `json:"nodes_unchanged"`
+	EdgesToAdd          int64 `json:"edges_to_add"`
+	EdgesUnchanged      int64 `json:"edges_unchanged"`
+	UnresolvedEndpoints int64 `json:"unresolved_endpoints"`
+	SchemaWarnings      int64 `json:"schema_warnings"`
+}
+
+// IngestPreviewReport describes what the files of a dry run ingest job would have changed in the graph
+type IngestPreviewReport struct {
+	Counts              IngestPreviewCounts       `json:"counts"`
+	NodesToCreate       []IngestPreviewNode       `json:"nodes_to_create"`
+	NodesToUpdate       []IngestPreviewNodeUpdate `json:"nodes_to_update"`
+	EdgesToAdd          []IngestPreviewEdge       `json:"edges_to_add"`
+	UnresolvedEndpoints []string                  `json:"unresolved_endpoints"`
+	NewKinds            []string                  `json:"new_kinds"`
+	SchemaWarnings      []string                  `json:"schema_warnings"`
+}
+
+func NewIngestPreviewReport() IngestPreviewReport {
+	return IngestPreviewReport{
+		NodesToCreate:       []IngestPreviewNode{},
+		NodesToUpdate:       []IngestPreviewNodeUpdate{},
+		EdgesToAdd:          []IngestPreviewEdge{},
+		UnresolvedEndpoints: []string{},
+		NewKinds:            []string{},
+		SchemaWarnings:      []string{},
+	}
+}
+
+func appendLimited[T any](entries []T, values ...T) []T {
+	if remaining := IngestPreviewListLimit - len(entries); remaining <= 0 {
+		return entries
+	} else if len(values) > remaining {
+		values = values[:remaining]
+	}
+
+	return append(entries, values...)
+}
+
+func (s *IngestPreviewReport) AddNodeToCreate(node IngestPreviewNode) {
+	s.Counts.NodesToCreate++
+	s.NodesToCreate = appendLimited(s.NodesToCreate, node)
+}
+
+func (s *IngestPreviewReport) AddNodeToUpdate(node IngestPreviewNodeUpdate) {
+	s.Counts.NodesToUpdate++
+	s.NodesToUpdate = appendLimited(s.NodesToUpdate, node)
+}
+
+func (s *IngestPreviewReport) AddEdgeToAdd(edge IngestPreviewEdge) {
+	s.Counts.EdgesToAdd++
+	s.EdgesToAdd = appendLimited(s.EdgesToAdd, edge)
+}
+
+func (s *IngestPreviewReport) AddUnresolvedEndpoint(message string) {
+	s.Counts.UnresolvedEndpoints++
+	s.UnresolvedEndpoints = appendLimited(s.UnresolvedEndpoints, message)
+}
+
+func (s *IngestPreviewReport) AddSchemaWarning(message string) {
+	s.Counts.SchemaWarnings++
+	s.SchemaWarnings = appendLimited(s.SchemaWarnings, message)
+}
+
+// AddNewKind records a kind that is not registered yet. New kinds are kept sorted and are never left out of the report.
+func (s *IngestPreviewReport) AddNewKind(kind string) {
+	if index, found := slices.BinarySearch(s.NewKinds, kind); !found {
+		s.NewKinds = slices.Insert(s.NewKinds, index, kind)
+	}
+}
+
+// Merge adds the report of another ingest task of the same job to this report. Every task is previewed against the
+// graph as it was before the job, so an entity that appears in more than one file of a job is counted once per file.
+func (s *IngestPreviewReport) Merge(other IngestPreviewReport) {
+	s.Counts.NodesToCreate += other.Counts.NodesToCreate
+	s.Counts.NodesToUpdate += other.Counts.NodesToUpdate
+	s.Counts.NodesUnchanged += other.Counts.NodesUnchanged
+	s.Counts.EdgesToAdd += other.Counts.EdgesToAdd
+	s.Counts.EdgesUnchanged += other.Counts.EdgesUnchanged
+	s.Counts.UnresolvedEndpoints += other.Counts.UnresolvedEndpoints
+	s.Counts.SchemaWarnings += other.Counts.SchemaWarnings
+
+	s.NodesToCreate = appendLimited(s.NodesToCreate, other.NodesToCreate...)
+	s.NodesToUpdate = appendLimited(s.NodesToUpdate, other.NodesToUpdate...)
+	s.EdgesToAdd = appendLimited(s.EdgesToAdd, other.EdgesToAdd...)
+	s.UnresolvedEndpoints = appendLimited(s.UnresolvedEndpoints, other.UnresolvedEndpoints...)
+	s.SchemaWarnings = appendLimited(s.SchemaWarnings, other.SchemaWarnings...)
+
+	for _, kind := range other.NewKinds {
+		s.AddNewKind(kind)
+	}
+}
+
+// Scan implements the sql.Scanner interface so that GORM can scan the jsonb column into the report
+func (s *IngestPreviewReport) Scan(value any) error {
+	if value == nil {
+		*s = NewIngestPreviewReport()
+		return nil
+	}
+
+	if bytes, ok := value.([]byte); !ok {
+		return errors.New("type assertion to []byte failed for IngestPreviewReport")
+	} else {
+		return json.Unmarshal(bytes, s)
+	}
+}
+
+// Value returns the json-marshaled value of the receiver
+func (s IngestPreviewReport) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
+
+// IngestPreview holds the report of a dry run ingest job, it is built up as the tasks of the job are processed
+type IngestPreview struct {
+	JobID     int64               `json:"file_upload_job_id" gorm:"column:job_id;primaryKey"`
+	Report    IngestPreviewReport `json:"report" gorm:"type:jsonb;column:report"`
+	CreatedAt time.Time           `json:"created_at"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIngestPreviewReport_Merge(t *testing.T) {
+	var (
+		report = NewIngestPreviewReport()
+		other  = NewIngestPreviewReport()
+	)
+
+	report.AddNodeToCreate(IngestPreviewNode{Identity: "A", Kinds: []string{"User"}})
+	report.AddNewKind("Person")
+	report.Counts.NodesUnchanged = 2
+
+	other.AddNodeToCreate(IngestPreviewNode{Identity: "B", Kinds: []string{"Group"}})
+	other.AddEdgeToAdd(IngestPreviewEdge{Kind: "MemberOf", Start: "A", End: "B"})
+	other.AddUnresolvedEndpoint("unable to resolve endpoint: C")
+	other.AddNewKind("Person")
+	other.AddNewKind("Car")
+	other.Counts.EdgesUnchanged = 3
+
+	report.Merge(other)
+
+	require.Equal(t, IngestPreviewCounts{
+		NodesToCreate:       2,
+		NodesUnchanged:      2,
+		EdgesToAdd:          1,
+		EdgesUnchanged:      3,
+		UnresolvedEndpoints: 1,
+	}, report.Counts)
+	require.Equal(t, []IngestPreviewNode{{Identity: "A", Kinds: []string{"User"}}, {Identity: "B", Kinds: []string{"Group"}}}, report.NodesToCreate)
+	require.Equal(t, []IngestPreviewEdge{{Kind: "MemberOf", Start: "A", End: "B"}}, report.EdgesToAdd)
+	require.Equal(t, []string{"unable to resolve endpoint: C"}, report.UnresolvedEndpoints)
+	require.Equal(t, []string{"Car", "Person"}, report.NewKinds)
+}
+
+func TestIngestPreviewReport_ListLimit(t *testing.T) {
+	var (
+		report = NewIngestPreviewReport()
+		other  = NewIngestPreviewReport()
+	)
+
+	for i := 0; i < IngestPreviewListLimit; i++ {
+		report.AddSchemaWarning(fmt.Sprintf("warning %d", i))
+	}
+
+	other.AddSchemaWarning("one too many")
+	report.Merge(other)
+
+	require.Equal(t, int64(IngestPreviewListLimit+1), report.Counts.SchemaWarnings)
+	require.Len(t, report.SchemaWarnings, IngestPreviewListLimit)
+	require.NotContains(t, report.SchemaWarnings, "one too many")
+}
+
+func TestIngestPreviewReport_ScanValue(t *testing.T) {
+	report := NewIngestPreviewReport()
+	report.AddNodeToUpdate(IngestPreviewNodeUpdate{
+		IngestPreviewNode: IngestPreviewNode{Identity: "A", Kinds: []string{"User"}},
+		AddedKinds:        []string{"Person"},
+		ChangedProperties: []IngestPreviewPropertyChange{{Name: "enabled", OldValue: false, NewValue: true}},
+	})
+
+	value, err := report.Value()
+	require.NoError(t, err)
+
+	var scanned IngestPreviewReport
+	require.NoError(t, scanned.Scan(value))
+	require.Equal(t, report, scanned)
+
+	require.NoError(t, scanned.Scan(nil))
+	require.Equal(t, NewIngestPreviewReport(), scanned)
+}
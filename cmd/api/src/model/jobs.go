@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
 	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
 )
 
@@ -38,6 +39,13 @@ type IngestJob struct {
 	FailedFiles        int           `json:"failed_files"`
 	PartialFailedFiles int           `json:"partial_failed_files"`
 
+	// DomainSIDs lists the Active Directory domains whose data was changed by this job
+	DomainSIDs pq.StringArray `json:"domain_sids" gorm:"type:text[]"`
+	// CrossDomain is set when the job changed data spanning domains, or data that could not be attributed to a domain
+	CrossDomain bool `json:"cross_domain"`
+	// DryRun jobs only report what their files would change in the graph, they end in the previewed status
+	DryRun bool `json:"dry_run"`
+
 	BigSerial
 }
 
@@ -123,6 +131,7 @@ const (
 	JobStatusIngesting         JobStatus = 6
 	JobStatusAnalyzing         JobStatus = 7
 	JobStatusPartiallyComplete JobStatus = 8
+	JobStatusPreviewed         JobStatus = 9
 )
 
 func allJobStatuses() []JobStatus {
@@ -137,6 +146,7 @@ func allJobStatuses() []JobStatus {
 		JobStatusIngesting,
 		JobStatusAnalyzing,
 		JobStatusPartiallyComplete,
+		JobStatusPreviewed,
 	}
 }
 
@@ -153,7 +163,7 @@ func ParseJobStatus(jobStatusStr string) (JobStatus, error) {
 }
 
 func GetVisibleJobStatuses() []JobStatus {
-	return []JobStatus{JobStatusComplete, JobStatusCanceled, JobStatusTimedOut, JobStatusFailed, JobStatusIngesting, JobStatusAnalyzing, JobStatusPartiallyComplete}
+	return []JobStatus{JobStatusComplete, JobStatusCanceled, JobStatusTimedOut, JobStatusFailed, JobStatusIngesting, JobStatusAnalyzing, JobStatusPartiallyComplete, JobStatusPreviewed}
 }
 
 func (s JobStatus) String() string {
@@ -185,6 +195,9 @@ func (s JobStatus) String() string {
 	case JobStatusPartiallyComplete:
 		return "PARTIALLYCOMPLETE"
 
+	case JobStatusPreviewed:
+		return "PREVIEWED"
+
 	default:
 		return "INVALIDSTATUS"
 	}
@@ -0,0 +1,108 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/specterops/dawgs/graph"
+)
+
+// PathfindingCosts maps a relationship kind to the cost of traversing a relationship of that kind
+type PathfindingCosts map[string]float64
+
+func (s *PathfindingCosts) Scan(value any) error {
+	if value == nil {
+		*s = PathfindingCosts{}
+		return nil
+	}
+
+	if bytes, ok := value.([]byte); !ok {
+		return errors.New("type assertion to []byte failed for PathfindingCosts")
+	} else {
+		return json.Unmarshal(bytes, s)
+	}
+}
+
+func (s PathfindingCosts) Value() (driver.Value, error) {
+	if s == nil {
+		return json.Marshal(PathfindingCosts{})
+	}
+
+	return json.Marshal(map[string]float64(s))
+}
+
+// PathfindingCostProfile is a named set of relationship costs for weighted pathfinding. Relationship kinds without a
+// cost of their own, including OpenGraph kinds, cost DefaultCost to traverse.
+type PathfindingCostProfile struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	DefaultCost float64          `json:"default_cost"`
+	Costs       PathfindingCosts `json:"costs"`
+
+	Serial
+}
+
+func (PathfindingCostProfile) TableName() string {
+	return "pathfinding_cost_profiles"
+}
+
+func (s PathfindingCostProfile) AuditData() AuditData {
+	return AuditData{
+		"id":           s.ID,
+		"name":         s.Name,
+		"default_cost": s.DefaultCost,
+		"costs":        s.Costs,
+	}
+}
+
+// Cost returns the cost of traversing a relationship of the given kind
+func (s PathfindingCostProfile) Cost(kind graph.Kind) float64 {
+	if cost, found := s.Costs[kind.String()]; found {
+		return cost
+	}
+
+	return s.DefaultCost
+}
+
+// Validate ensures that every cost is a positive, finite number. Cheapest path searches rely on traversal never
+// getting cheaper as a path grows.
+func (s PathfindingCostProfile) Validate() error {
+	if s.Name == "" {
+		return errors.New("cost profile name is required")
+	} else if !isValidPathfindingCost(s.DefaultCost) {
+		return fmt.Errorf("default_cost must be a positive number: %v", s.DefaultCost)
+	}
+
+	for kind, cost := range s.Costs {
+		if kind == "" {
+			return errors.New("costs contains an entry with an empty relationship kind")
+		} else if !isValidPathfindingCost(cost) {
+			return fmt.Errorf("cost of %s must be a positive number: %v", kind, cost)
+		}
+	}
+
+	return nil
+}
+
+func isValidPathfindingCost(cost float64) bool {
+	return cost > 0 && !math.IsInf(cost, 0)
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPathfindingCostProfile_Cost(t *testing.T) {
+	profile := model.PathfindingCostProfile{
+		Name:        "test",
+		DefaultCost: 2,
+		Costs:       model.PathfindingCosts{ad.MemberOf.String(): 0.1},
+	}
+
+	require.Equal(t, 0.1, profile.Cost(ad.MemberOf))
+	require.Equal(t, 2.0, profile.Cost(ad.AdminTo))
+}
+
+func TestPathfindingCostProfile_Validate(t *testing.T) {
+	valid := model.PathfindingCostProfile{Name: "test", DefaultCost: 1, Costs: model.PathfindingCosts{ad.MemberOf.String(): 0.1}}
+	require.NoError(t, valid.Validate())
+
+	for _, profile := range []model.PathfindingCostProfile{
+		{DefaultCost: 1},
+		{Name: "test"},
+		{Name: "test", DefaultCost: -1},
+		{Name: "test", DefaultCost: math.Inf(1)},
+		{Name: "test", DefaultCost: 1, Costs: model.PathfindingCosts{ad.MemberOf.String(): 0}},
+		{Name: "test", DefaultCost: 1, Costs: model.PathfindingCosts{ad.MemberOf.String(): math.NaN()}},
+		{Name: "test", DefaultCost: 1, Costs: model.PathfindingCosts{"": 1}},
+	} {
+		require.Error(t, profile.Validate())
+	}
+}
+
+func TestPathfindingCosts_Scan(t *testing.T) {
+	var costs model.PathfindingCosts
+
+	require.NoError(t, costs.Scan([]byte(`{"MemberOf": 0.5}`)))
+	require.Equal(t, model.PathfindingCosts{"MemberOf": 0.5}, costs)
+
+	value, err := model.PathfindingCosts(nil).Value()
+	require.NoError(t, err)
+	require.Equal(t, []byte("{}"), value)
+}
@@ -0,0 +1,408 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package queries
+
+import (
+	"cmp"
+	"container/heap"
+	"context"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/analysis"
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/query"
+)
+
+// WeightedPath is a loop-free path along with the summed cost of traversing its relationships
+type WeightedPath struct {
+	Path graph.Path
+	Cost float64
+}
+
+// weightedStep is a relationship leading out of a node along with the node it leads to and the cost of traversing it
+type weightedStep struct {
+	relationship *graph.Relationship
+	node         *graph.Node
+	cost         float64
+}
+
+// outboundSteps returns the traversable relationships leading out of the given node
+type outboundSteps func(ctx context.Context, node graph.ID) ([]weightedStep, error)
+
+// fetchOutboundSteps expands nodes with relationship queries so that searches run the same way against every graph
+// backend. Steps are ordered by relationship ID to keep results stable between searches.
+func fetchOutboundSteps(tx graph.Transaction, filter graph.Criteria, costProfile model.PathfindingCostProfile) outboundSteps {
+	return func(ctx context.Context, node graph.ID) ([]weightedStep, error) {
+		var (
+			steps    []weightedStep
+			criteria = []graph.Criteria{
+				query.Equals(query.StartID(), node),
+			}
+		)
+
+		if filter != nil {
+			criteria = append(criteria, filter)
+		}
+
+		if err := tx.Relationships().Filter(query.And(criteria...)).FetchDirection(graph.DirectionInbound, func(cursor graph.Cursor[graph.DirectionalResult]) error {
+			for next := range cursor.Chan() {
+				steps = append(steps, weightedStep{
+					relationship: next.Relationship,
+					node:         next.Node,
+					cost:         costProfile.Cost(next.Relationship.Kind),
+				})
+			}
+
+			return cursor.Error()
+		}); err != nil {
+			return nil, err
+		}
+
+		slices.SortFunc(steps, func(a, b weightedStep) int {
+			return cmp.Compare(a.relationship.ID, b.relationship.ID)
+		})
+
+		return steps, nil
+	}
+}
+
+// cachedOutboundSteps remembers the steps out of every node expanded. The searches that make up a k cheapest paths
+// query revisit the same nodes many times.
+func cachedOutboundSteps(fetch outboundSteps) outboundSteps {
+	expanded := map[graph.ID][]weightedStep{}
+
+	return func(ctx context.Context, node graph.ID) ([]weightedStep, error) {
+		if steps, cached := expanded[node]; cached {
+			return steps, nil
+		} else if steps, err := fetch(ctx, node); err != nil {
+			return nil, err
+		} else {
+			expanded[node] = steps
+			return steps, nil
+		}
+	}
+}
+
+type weightedPath struct {
+	nodes         []*graph.Node
+	relationships []*graph.Relationship
+	costs         []float64
+	cost          float64
+}
+
+// key identifies a path by the relationships it traverses
+func (s weightedPath) key() string {
+	ids := make([]string, len(s.relationships))
+
+	for idx, relationship := range s.relationships {
+		ids[idx] = strconv.FormatUint(relationship.ID.Uint64(), 10)
+	}
+
+	return strings.Join(ids, ",")
+}
+
+// root returns the path up to the node at the given index
+func (s weightedPath) root(nodeIdx int) weightedPath {
+	root := weightedPath{
+		nodes:         slices.Clone(s.nodes[:nodeIdx+1]),
+		relationships: slices.Clone(s.relationships[:nodeIdx]),
+		costs:         slices.Clone(s.costs[:nodeIdx]),
+	}
+
+	for _, cost := range root.costs {
+		root.cost += cost
+	}
+
+	return root
+}
+
+// join appends a path starting at the last node of this path
+func (s weightedPath) join(other weightedPath) weightedPath {
+	return weightedPath{
+		nodes:         append(slices.Clone(s.nodes), other.nodes[1:]...),
+		relationships: append(slices.Clone(s.relationships), other.relationships...),
+		costs:         append(slices.Clone(s.costs), other.costs...),
+		cost:          s.cost + other.cost,
+	}
+}
+
+// sharesRoot reports whether both paths traverse the same relationships up to the node at the given index
+func (s weightedPath) sharesRoot(other weightedPath, nodeIdx int) bool {
+	if len(s.relationships) <= nodeIdx || len(other.relationships) < nodeIdx {
+		return false
+	}
+
+	for idx := 0; idx < nodeIdx; idx++ {
+		if s.relationships[idx].ID != other.relationships[idx].ID {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s weightedPath) toWeightedPath() WeightedPath {
+	return WeightedPath{
+		Path: graph.Path{
+			Nodes: s.nodes,
+			Edges: s.relationships,
+		},
+		Cost: s.cost,
+	}
+}
+
+// compareWeightedPaths orders paths by cost, then by length and finally by the relationships traversed so that paths
+// of equal cost are returned in a stable order
+func compareWeightedPaths(a, b weightedPath) int {
+	if byCost := cmp.Compare(a.cost, b.cost); byCost != 0 {
+		return byCost
+	} else if byLength := cmp.Compare(len(a.relationships), len(b.relationships)); byLength != 0 {
+		return byLength
+	} else {
+		return strings.Compare(a.key(), b.key())
+	}
+}
+
+type weightedQueueEntry struct {
+	node *graph.Node
+	cost float64
+}
+
+// weightedQueue is a min-heap of nodes ordered by the cost of reaching them
+type weightedQueue []weightedQueueEntry
+
+func (s weightedQueue) Len() int {
+	return len(s)
+}
+
+func (s weightedQueue) Less(i, j int) bool {
+	if s[i].cost != s[j].cost {
+		return s[i].cost < s[j].cost
+	}
+
+	return s[i].node.ID < s[j].node.ID
+}
+
+func (s weightedQueue) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s *weightedQueue) Push(entry any) {
+	*s = append(*s, entry.(weightedQueueEntry))
+}
+
+func (s *weightedQueue) Pop() any {
+	var (
+		entries = *s
+		last    = entries[len(entries)-1]
+	)
+
+	*s = entries[:len(entries)-1]
+	return last
+}
+
+type weightedPredecessor struct {
+	node *graph.Node
+	step weightedStep
+}
+
+// cheapestPath runs Dijkstra's algorithm from start to end without traversing the excluded nodes and relationships
+func cheapestPath(ctx context.Context, next outboundSteps, start *graph.Node, end graph.ID, excludedNodes, excludedRelationships map[graph.ID]struct{}) (weightedPath, bool, error) {
+	var (
+		costs        = map[graph.ID]float64{start.ID: 0}
+		predecessors = map[graph.ID]weightedPredecessor{}
+		settled      = map[graph.ID]struct{}{}
+		queue        = &weightedQueue{{node: start}}
+	)
+
+	for queue.Len() > 0 {
+		if err := ctx.Err(); err != nil {
+			return weightedPath{}, false, err
+		}
+
+		current := heap.Pop(queue).(weightedQueueEntry)
+
+		if _, isSettled := settled[current.node.ID]; isSettled {
+			continue
+		} else {
+			settled[current.node.ID] = struct{}{}
+		}
+
+		if current.node.ID == end {
+			return buildWeightedPath(current.node, predecessors), true, nil
+		}
+
+		steps, err := next(ctx, current.node.ID)
+		if err != nil {
+			return weightedPath{}, false, err
+		}
+
+		for _, step := range steps {
+			if _, isExcluded := excludedRelationships[step.relationship.ID]; isExcluded {
+				continue
+			} else if _, isExcluded := excludedNodes[step.node.ID]; isExcluded {
+				continue
+			} else if _, isSettled := settled[step.node.ID]; isSettled {
+				continue
+			}
+
+			cost := current.cost + step.cost
+
+			if knownCost, isKnown := costs[step.node.ID]; !isKnown || cost < knownCost {
+				costs[step.node.ID] = cost
+				predecessors[step.node.ID] = weightedPredecessor{
+					node: current.node,
+					step: step,
+				}
+
+				heap.Push(queue, weightedQueueEntry{
+					node: step.node,
+					cost: cost,
+				})
+			}
+		}
+	}
+
+	return weightedPath{}, false, nil
+}
+
+func buildWeightedPath(end *graph.Node, predecessors map[graph.ID]weightedPredecessor) weightedPath {
+	path := weightedPath{
+		nodes: []*graph.Node{end},
+	}
+
+	for cursor := end.ID; ; {
+		predecessor, hasPredecessor := predecessors[cursor]
+
+		if !hasPredecessor {
+			break
+		}
+
+		path.nodes = append(path.nodes, predecessor.node)
+		path.relationships = append(path.relationships, predecessor.step.relationship)
+		path.costs = append(path.costs, predecessor.step.cost)
+		path.cost += predecessor.step.cost
+
+		cursor = predecessor.node.ID
+	}
+
+	slices.Reverse(path.nodes)
+	slices.Reverse(path.relationships)
+	slices.Reverse(path.costs)
+
+	return path
+}
+
+// cheapestPaths returns up to limit loop-free paths from start to end, cheapest first, using Yen's algorithm
+func cheapestPaths(ctx context.Context, next outboundSteps, start, end *graph.Node, limit int) ([]weightedPath, error) {
+	if first, found, err := cheapestPath(ctx, next, start, end.ID, nil, nil); err != nil {
+		return nil, err
+	} else if !found || len(first.relationships) == 0 {
+		return nil, nil
+	} else {
+		var (
+			accepted   = []weightedPath{first}
+			candidates []weightedPath
+			seen       = map[string]struct{}{first.key(): {}}
+		)
+
+		for len(accepted) < limit {
+			previous := accepted[len(accepted)-1]
+
+			// Branch off of every node along the previous path, excluding the relationships already taken from that
+			// node by accepted paths with the same root and the root's nodes so that candidates remain loop-free
+			for spurIdx := 0; spurIdx < len(previous.relationships); spurIdx++ {
+				var (
+					excludedNodes         = map[graph.ID]struct{}{}
+					excludedRelationships = map[graph.ID]struct{}{}
+				)
+
+				for _, path := range accepted {
+					if path.sharesRoot(previous, spurIdx) {
+						excludedRelationships[path.relationships[spurIdx].ID] = struct{}{}
+					}
+				}
+
+				for _, node := range previous.nodes[:spurIdx] {
+					excludedNodes[node.ID] = struct{}{}
+				}
+
+				if spurPath, found, err := cheapestPath(ctx, next, previous.nodes[spurIdx], end.ID, excludedNodes, excludedRelationships); err != nil {
+					return nil, err
+				} else if found {
+					candidate := previous.root(spurIdx).join(spurPath)
+
+					if _, isSeen := seen[candidate.key()]; !isSeen {
+						seen[candidate.key()] = struct{}{}
+						candidates = append(candidates, candidate)
+					}
+				}
+			}
+
+			if len(candidates) == 0 {
+				break
+			}
+
+			slices.SortFunc(candidates, compareWeightedPaths)
+
+			accepted = append(accepted, candidates[0])
+			candidates = candidates[1:]
+		}
+
+		return accepted, nil
+	}
+}
+
+// GetCheapestPaths returns up to limit loop-free paths between two nodes, cheapest first, where the cost of a path is
+// the sum of the costs the profile assigns to the kinds of its relationships
+func (s *GraphQuery) GetCheapestPaths(ctx context.Context, startNodeID string, endNodeID string, filter graph.Criteria, costProfile model.PathfindingCostProfile, limit int, includeOpenGraph bool) ([]WeightedPath, error) {
+	defer measure.ContextMeasureWithThreshold(ctx, slog.LevelInfo, "GetCheapestPaths")()
+
+	var (
+		nodeFetcher = analysis.FetchNodeByObjectID
+		paths       []WeightedPath
+	)
+
+	if includeOpenGraph {
+		nodeFetcher = analysis.FetchNodeByObjectIDIncludeOpenGraph
+	}
+
+	err := s.Graph.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		if startNode, err := nodeFetcher(tx, startNodeID); err != nil {
+			return err
+		} else if endNode, err := nodeFetcher(tx, endNodeID); err != nil {
+			return err
+		} else if found, err := cheapestPaths(ctx, cachedOutboundSteps(fetchOutboundSteps(tx, filter, costProfile)), startNode, endNode, limit); err != nil {
+			return err
+		} else {
+			paths = make([]WeightedPath, len(found))
+
+			for idx, path := range found {
+				paths[idx] = path.toWeightedPath()
+			}
+
+			return nil
+		}
+	})
+
+	return paths, err
+}
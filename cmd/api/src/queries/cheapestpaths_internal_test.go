@@ -0,0 +1,226 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package queries
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/require"
+)
+
+type testWeightedGraph struct {
+	nodes    map[graph.ID]*graph.Node
+	steps    map[graph.ID][]weightedStep
+	nextID   graph.ID
+	expanded int
+}
+
+func newTestWeightedGraph() *testWeightedGraph {
+	return &testWeightedGraph{
+		nodes: map[graph.ID]*graph.Node{},
+		steps: map[graph.ID][]weightedStep{},
+	}
+}
+
+func (s *testWeightedGraph) node(id graph.ID) *graph.Node {
+	if node, found := s.nodes[id]; found {
+		return node
+	}
+
+	node := graph.NewNode(id, graph.NewProperties(), ad.Entity)
+	s.nodes[id] = node
+
+	return node
+}
+
+func (s *testWeightedGraph) link(start, end graph.ID, kind graph.Kind, cost float64) {
+	s.nextID++
+
+	s.steps[start] = append(s.steps[start], weightedStep{
+		relationship: graph.NewRelationship(s.nextID, start, end, graph.NewProperties(), kind),
+		node:         s.node(end),
+		cost:         cost,
+	})
+}
+
+func (s *testWeightedGraph) outbound(_ context.Context, node graph.ID) ([]weightedStep, error) {
+	s.expanded++
+	return s.steps[node], nil
+}
+
+func pathNodeIDs(path weightedPath) []graph.ID {
+	ids := make([]graph.ID, len(path.nodes))
+
+	for idx, node := range path.nodes {
+		ids[idx] = node.ID
+	}
+
+	return ids
+}
+
+func TestCheapestPaths(t *testing.T) {
+	//   1 --MemberOf(1)--> 2 --AdminTo(1)--> 4
+	//   1 --HasSession(5)--> 4
+	//   1 --MemberOf(1)--> 3 --AdminTo(2)--> 4
+	//   2 --MemberOf(1)--> 3
+	testGraph := newTestWeightedGraph()
+	testGraph.link(1, 2, ad.MemberOf, 1)
+	testGraph.link(2, 4, ad.AdminTo, 1)
+	testGraph.link(1, 4, ad.HasSession, 5)
+	testGraph.link(1, 3, ad.MemberOf, 1)
+	testGraph.link(3, 4, ad.AdminTo, 2)
+	testGraph.link(2, 3, ad.MemberOf, 1)
+
+	t.Run("returns paths cheapest first", func(t *testing.T) {
+		paths, err := cheapestPaths(context.Background(), testGraph.outbound, testGraph.node(1), testGraph.node(4), 10)
+		require.NoError(t, err)
+		require.Len(t, paths, 4)
+
+		require.Equal(t, []graph.ID{1, 2, 4}, pathNodeIDs(paths[0]))
+		require.Equal(t, 2.0, paths[0].cost)
+		require.Equal(t, []graph.ID{1, 3, 4}, pathNodeIDs(paths[1]))
+		require.Equal(t, 3.0, paths[1].cost)
+		require.Equal(t, []graph.ID{1, 2, 3, 4}, pathNodeIDs(paths[2]))
+		require.Equal(t, 4.0, paths[2].cost)
+		require.Equal(t, []graph.ID{1, 4}, pathNodeIDs(paths[3]))
+		require.Equal(t, 5.0, paths[3].cost)
+	})
+
+	t.Run("limits the number of paths", func(t *testing.T) {
+		paths, err := cheapestPaths(context.Background(), testGraph.outbound, testGraph.node(1), testGraph.node(4), 2)
+		require.NoError(t, err)
+		require.Len(t, paths, 2)
+	})
+
+	t.Run("returns nothing when the end is unreachable", func(t *testing.T) {
+		paths, err := cheapestPaths(context.Background(), testGraph.outbound, testGraph.node(4), testGraph.node(1), 3)
+		require.NoError(t, err)
+		require.Empty(t, paths)
+	})
+
+	t.Run("returns nothing when start and end are the same node", func(t *testing.T) {
+		paths, err := cheapestPaths(context.Background(), testGraph.outbound, testGraph.node(1), testGraph.node(1), 3)
+		require.NoError(t, err)
+		require.Empty(t, paths)
+	})
+}
+
+func TestCheapestPaths_Loops(t *testing.T) {
+	// 2 and 3 link back to 1, neither cycle may appear in a path
+	testGraph := newTestWeightedGraph()
+	testGraph.link(1, 2, ad.MemberOf, 1)
+	testGraph.link(2, 1, ad.MemberOf, 1)
+	testGraph.link(2, 3, ad.GenericAll, 1)
+	testGraph.link(3, 1, ad.GenericAll, 1)
+	testGraph.link(3, 4, ad.AdminTo, 1)
+	testGraph.link(1, 3, ad.GenericWrite, 4)
+
+	paths, err := cheapestPaths(context.Background(), testGraph.outbound, testGraph.node(1), testGraph.node(4), 10)
+	require.NoError(t, err)
+	require.Len(t, paths, 2)
+
+	for _, path := range paths {
+		seen := map[graph.ID]struct{}{}
+
+		for _, id := range pathNodeIDs(path) {
+			require.NotContains(t, seen, id)
+			seen[id] = struct{}{}
+		}
+	}
+}
+
+func TestCheapestPaths_ParallelRelationships(t *testing.T) {
+	// Relationships of different kinds between the same nodes are distinct paths
+	testGraph := newTestWeightedGraph()
+	testGraph.link(1, 2, ad.GenericAll, 1)
+	testGraph.link(1, 2, ad.GenericWrite, 2)
+	testGraph.link(1, 2, ad.WriteDACL, 3)
+
+	paths, err := cheapestPaths(context.Background(), testGraph.outbound, testGraph.node(1), testGraph.node(2), 10)
+	require.NoError(t, err)
+	require.Len(t, paths, 3)
+	require.Equal(t, ad.GenericAll, paths[0].relationships[0].Kind)
+	require.Equal(t, ad.GenericWrite, paths[1].relationships[0].Kind)
+	require.Equal(t, ad.WriteDACL, paths[2].relationships[0].Kind)
+}
+
+func TestCheapestPaths_Deterministic(t *testing.T) {
+	// Four paths of equal cost must come back in the same order every time
+	testGraph := newTestWeightedGraph()
+
+	for _, middle := range []graph.ID{5, 3, 4, 2} {
+		testGraph.link(1, middle, ad.MemberOf, 1)
+		testGraph.link(middle, 6, ad.AdminTo, 1)
+	}
+
+	expected, err := cheapestPaths(context.Background(), testGraph.outbound, testGraph.node(1), testGraph.node(6), 4)
+	require.NoError(t, err)
+	require.Len(t, expected, 4)
+
+	for range 10 {
+		paths, err := cheapestPaths(context.Background(), testGraph.outbound, testGraph.node(1), testGraph.node(6), 4)
+		require.NoError(t, err)
+
+		for idx := range expected {
+			require.Equal(t, expected[idx].key(), paths[idx].key())
+		}
+	}
+}
+
+func TestCheapestPaths_Errors(t *testing.T) {
+	t.Run("stops when the context is done", func(t *testing.T) {
+		testGraph := newTestWeightedGraph()
+		testGraph.link(1, 2, ad.MemberOf, 1)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := cheapestPaths(ctx, testGraph.outbound, testGraph.node(1), testGraph.node(2), 3)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("returns expansion errors", func(t *testing.T) {
+		var (
+			expectedErr = errors.New("expansion failed")
+			failing     = func(context.Context, graph.ID) ([]weightedStep, error) {
+				return nil, expectedErr
+			}
+		)
+
+		_, err := cheapestPaths(context.Background(), failing, graph.NewNode(1, graph.NewProperties()), graph.NewNode(2, graph.NewProperties()), 3)
+		require.ErrorIs(t, err, expectedErr)
+	})
+}
+
+func TestCachedOutboundSteps(t *testing.T) {
+	testGraph := newTestWeightedGraph()
+	testGraph.link(1, 2, ad.MemberOf, 1)
+
+	cached := cachedOutboundSteps(testGraph.outbound)
+
+	for range 3 {
+		steps, err := cached(context.Background(), 1)
+		require.NoError(t, err)
+		require.Len(t, steps, 1)
+	}
+
+	require.Equal(t, 1, testGraph.expanded)
+}
@@ -73,6 +73,7 @@ var (
 	ErrUnsupportedDataType   = errors.New("unsupported result type for this query")
 	ErrGraphUnsupported      = errors.New("type 'graph' is not supported for this endpoint")
 	ErrCypherQueryTooComplex = errors.New("cypher query is too complex and is likely to result in poor or unstable database performance")
+	ErrCypherStreamMutation  = errors.New("graph mutations can not be streamed")
 )
 
 type EntityQueryParameters struct {
@@ -138,6 +139,7 @@ type Graph interface {
 	GetAssetGroupNodes(ctx context.Context, assetGroupTag string, isSystemGroup bool) (graph.NodeSet, error)
 	GetAllShortestPaths(ctx context.Context, startNodeID string, endNodeID string, filter graph.Criteria) (graph.PathSet, error)
 	GetAllShortestPathsWithOpenGraph(ctx context.Context, startNodeID string, endNodeID string, filter graph.Criteria) (graph.PathSet, error)
+	GetCheapestPaths(ctx context.Context, startNodeID string, endNodeID string, filter graph.Criteria, costProfile model.PathfindingCostProfile, limit int, includeOpenGraph bool) ([]WeightedPath, error)
 	SearchNodesByNameOrObjectId(ctx context.Context, primaryNodeKinds graphschema.ValidPrimaryKinds, customNodeKindMap model.CustomNodeKindMap, etacAllowedList []string, nodeKinds graph.Kinds, nameOrObjectIdQuery string, skip int, limit int) ([]model.SearchResult, error)
 	SearchByNameOrObjectID(ctx context.Context, includeOpenGraphNodes bool, searchValue string, searchType string) (graph.NodeSet, error)
 	GetADEntityQueryResult(ctx context.Context, primaryNodeKinds graphschema.ValidPrimaryKinds, customNodeKinds model.CustomNodeKindMap, params EntityQueryParameters, cacheEnabled bool) (any, int, error)
@@ -155,6 +157,7 @@ type Graph interface {
 	BatchNodeUpdate(ctx context.Context, nodeUpdate graph.NodeUpdate) error
 	RawCypherQuery(ctx context.Context, validPrimaryKinds graphschema.ValidPrimaryKinds, pQuery PreparedQuery, includeProperties bool) (model.UnifiedGraph, error)
 	PrepareCypherQuery(rawCypher string, queryComplexityLimit int64) (PreparedQuery, error)
+	StreamCypherQuery(ctx context.Context, pQuery PreparedQuery, delegate func(result graph.Result) error) error
 	UpdateSelectorTags(ctx context.Context, db database.AgiData, selectors model.UpdatedAssetGroupSelectors) error
 	FetchNodeByGraphId(ctx context.Context, id graph.ID) (*graph.Node, error)
 }
@@ -563,6 +566,58 @@ func (s *GraphQuery) RawCypherQuery(ctx context.Context, validPrimaryKinds graph
 	return graphResponse, err
 }
 
+// StreamCypherQuery executes the given PreparedQuery in a read transaction and hands the open result to the delegate so
+// that rows can be consumed as they arrive rather than being collected in memory. Prepared queries that mutate the
+// graph are rejected with ErrCypherStreamMutation.
+func (s *GraphQuery) StreamCypherQuery(ctx context.Context, pQuery PreparedQuery, delegate func(result graph.Result) error) error {
+	if pQuery.HasMutation {
+		return ErrCypherStreamMutation
+	}
+
+	slog.InfoContext(
+		ctx,
+		"Preparing user cypher query stream",
+		slog.String("query", pQuery.StrippedQuery),
+		slog.Int64("fitness", pQuery.complexity.RelativeFitness),
+	)
+
+	start := time.Now()
+
+	err := s.Graph.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		result := tx.Query(pQuery.query, map[string]any{})
+		defer result.Close()
+
+		if err := result.Error(); err != nil {
+			return err
+		}
+
+		return delegate(result)
+	})
+
+	slog.InfoContext(
+		ctx,
+		"Executed user cypher query stream",
+		slog.String("query", pQuery.StrippedQuery),
+		slog.Int64("fitness", pQuery.complexity.RelativeFitness),
+		slog.Duration("elapsed", time.Since(start)),
+	)
+
+	if err != nil {
+		if util.IsNeoTimeoutError(err) {
+			slog.ErrorContext(
+				ctx,
+				"Neo4j timed out while streaming cypher query",
+				slog.String("query", pQuery.StrippedQuery),
+				slog.Int64("fitness", pQuery.complexity.RelativeFitness),
+			)
+		} else {
+			slog.WarnContext(ctx, "StreamCypherQuery failed", attr.Error(err))
+		}
+	}
+
+	return err
+}
+
 func applyTimeoutReduction(queryWeight int64, availableRuntime time.Duration) (time.Duration, int64) {
 	// The weight of the query is divided by 5 to get a runtime reduction factor, in a way that:
 	// weights of 4 or less get the full runtime duration
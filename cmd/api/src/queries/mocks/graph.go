@@ -242,6 +242,21 @@ func (mr *MockGraphMockRecorder) GetAssetGroupNodes(ctx, assetGroupTag, isSystem
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetGroupNodes", reflect.TypeOf((*MockGraph)(nil).GetAssetGroupNodes), ctx, assetGroupTag, isSystemGroup)
 }
 
+// GetCheapestPaths mocks base method.
+func (m *MockGraph) GetCheapestPaths(ctx context.Context, startNodeID, endNodeID string, filter graph.Criteria, costProfile model.PathfindingCostProfile, limit int, includeOpenGraph bool) ([]queries.WeightedPath, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCheapestPaths", ctx, startNodeID, endNodeID, filter, costProfile, limit, includeOpenGraph)
+	ret0, _ := ret[0].([]queries.WeightedPath)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCheapestPaths indicates an expected call of GetCheapestPaths.
+func (mr *MockGraphMockRecorder) GetCheapestPaths(ctx, startNodeID, endNodeID, filter, costProfile, limit, includeOpenGraph any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheapestPaths", reflect.TypeOf((*MockGraph)(nil).GetCheapestPaths), ctx, startNodeID, endNodeID, filter, costProfile, limit, includeOpenGraph)
+}
+
 // GetEntityByObjectId mocks base method.
 func (m *MockGraph) GetEntityByObjectId(ctx context.Context, objectID string, kinds ...graph.Kind) (*graph.Node, error) {
 	m.ctrl.T.Helper()
@@ -406,6 +421,20 @@ func (mr *MockGraphMockRecorder) SearchNodesByNameOrObjectId(ctx, primaryNodeKin
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchNodesByNameOrObjectId", reflect.TypeOf((*MockGraph)(nil).SearchNodesByNameOrObjectId), ctx, primaryNodeKinds, customNodeKindMap, etacAllowedList, nodeKinds, nameOrObjectIdQuery, skip, limit)
 }
 
+// StreamCypherQuery mocks base method.
+func (m *MockGraph) StreamCypherQuery(ctx context.Context, pQuery queries.PreparedQuery, delegate func(graph.Result) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamCypherQuery", ctx, pQuery, delegate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamCypherQuery indicates an expected call of StreamCypherQuery.
+func (mr *MockGraphMockRecorder) StreamCypherQuery(ctx, pQuery, delegate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamCypherQuery", reflect.TypeOf((*MockGraph)(nil).StreamCypherQuery), ctx, pQuery, delegate)
+}
+
 // UpdateSelectorTags mocks base method.
 func (m *MockGraph) UpdateSelectorTags(ctx context.Context, db database.AgiData, selectors model.UpdatedAssetGroupSelectors) error {
 	m.ctrl.T.Helper()
@@ -22,6 +22,7 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/specterops/bloodhound/cmd/api/src/api"
 	"github.com/specterops/bloodhound/cmd/api/src/api/registration"
 	"github.com/specterops/bloodhound/cmd/api/src/api/router"
@@ -34,11 +35,13 @@ import (
 	"github.com/specterops/bloodhound/cmd/api/src/daemons/changelog"
 	"github.com/specterops/bloodhound/cmd/api/src/daemons/datapipe"
 	"github.com/specterops/bloodhound/cmd/api/src/daemons/gc"
+	"github.com/specterops/bloodhound/cmd/api/src/daemons/ha"
 	"github.com/specterops/bloodhound/cmd/api/src/database"
 	"github.com/specterops/bloodhound/cmd/api/src/migrations"
 	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
 	"github.com/specterops/bloodhound/cmd/api/src/queries"
 	"github.com/specterops/bloodhound/cmd/api/src/services/dogtags"
+	"github.com/specterops/bloodhound/cmd/api/src/services/graphify"
 	"github.com/specterops/bloodhound/cmd/api/src/services/opengraphschema"
 	"github.com/specterops/bloodhound/cmd/api/src/services/upload"
 	"github.com/specterops/bloodhound/packages/go/cache"
@@ -78,6 +81,28 @@ func PreMigrationDaemons(ctx context.Context, cfg config.Configuration, connecti
 	}, nil
 }
 
+// NewHAMutex returns the HA mutex used to elect the instance that runs the datapipe and changelog. Deployments
+// without HA enabled are a single instance and always primary.
+func NewHAMutex(ctx context.Context, cfg config.Configuration, leaseStore ha.LeaseStore) ha.HAMutex {
+	if !cfg.HA.Enabled {
+		return ha.NewDummyHA()
+	}
+
+	options := ha.LeaseOptions{
+		Name:              ha.DefaultLeaseName,
+		HolderID:          ha.NewHolderID(),
+		LeaseDuration:     time.Duration(cfg.HA.LeaseDuration) * time.Second,
+		HeartbeatInterval: time.Duration(cfg.HA.HeartbeatInterval) * time.Second,
+	}
+
+	slog.InfoContext(ctx, "High availability enabled",
+		slog.String("holder_id", options.HolderID),
+		slog.Duration("lease_duration", options.LeaseDuration),
+	)
+
+	return ha.NewLeaseMutex(ctx, leaseStore, options)
+}
+
 func Entrypoint(ctx context.Context, cfg config.Configuration, connections bootstrap.DatabaseConnections[*database.BloodhoundDB, *graph.DatabaseSwitch]) ([]daemons.Daemon, error) {
 
 	dogtagsService := dogtags.NewDefaultService()
@@ -91,6 +116,15 @@ func Entrypoint(ctx context.Context, cfg config.Configuration, connections boots
 		slog.String("namespace", "dogtags"),
 		slog.Any("flags", flags))
 
+	// Analysis metrics are served by the tools API through the default Prometheus registry
+	if err := datapipe.InitializeAnalysisMetrics(prometheus.DefaultRegisterer); err != nil {
+		slog.WarnContext(ctx, fmt.Sprintf("failed to register analysis metrics: %v", err))
+	}
+
+	if err := graphify.InitializeIngestMetrics(prometheus.DefaultRegisterer); err != nil {
+		slog.WarnContext(ctx, fmt.Sprintf("failed to register ingest metrics: %v", err))
+	}
+
 	if !cfg.DisableMigrations {
 		if err := bootstrap.MigrateDB(ctx, cfg, connections.RDMS, config.NewDefaultAdminConfiguration); err != nil {
 			return nil, fmt.Errorf("rdms migration error: %w", err)
@@ -133,8 +167,9 @@ func Entrypoint(ctx context.Context, cfg config.Configuration, connections boots
 		startDelay := 0 * time.Second
 
 		var (
-			cl                     = changelog.NewChangelog(connections.Graph, connections.RDMS, changelog.DefaultOptions())
-			pipeline               = datapipe.NewPipeline(ctx, cfg, connections.RDMS, connections.Graph, graphQueryCache, ingestSchema, cl)
+			haMutex                = NewHAMutex(ctx, cfg, connections.RDMS)
+			cl                     = changelog.NewChangelogWithHA(connections.Graph, connections.RDMS, changelog.DefaultOptions(), haMutex)
+			pipeline               = datapipe.NewPipeline(ctx, cfg, connections.RDMS, connections.Graph, graphQueryCache, ingestSchema, cl, haMutex, dogtagsService)
 			graphQuery             = queries.NewGraphQuery(connections.Graph, graphQueryCache, cfg)
 			authorizer             = auth.NewAuthorizer(connections.RDMS)
 			datapipeDaemon         = datapipe.NewDaemon(pipeline, startDelay, time.Duration(cfg.DatapipeInterval)*time.Second, connections.RDMS)
@@ -158,7 +193,7 @@ func Entrypoint(ctx context.Context, cfg config.Configuration, connections boots
 
 		return []daemons.Daemon{
 			bhapi.NewDaemon(cfg, routerInst.Handler()),
-			gc.NewDataPruningDaemon(connections.RDMS),
+			gc.NewDataPruningDaemon(connections.RDMS, cfg),
 			cl,
 			datapipeDaemon,
 		}, nil
@@ -25,6 +25,7 @@ import (
 
 	"github.com/specterops/bloodhound/cmd/api/src/config"
 	"github.com/specterops/bloodhound/cmd/api/src/daemons/datapipe"
+	"github.com/specterops/bloodhound/cmd/api/src/services/dogtags"
 	"github.com/specterops/bloodhound/packages/go/lab/generic"
 	"github.com/stretchr/testify/require"
 )
@@ -47,7 +48,7 @@ func TestVersion5Analysis(t *testing.T) {
 	err = generic.WriteGraphToDatabase(testSuite.GraphDB, &expected)
 	require.NoError(t, err)
 
-	err = datapipe.RunAnalysisOperations(ctx, testSuite.BHDatabase, testSuite.GraphDB, config.Configuration{})
+	err = datapipe.RunAnalysisOperations(ctx, testSuite.BHDatabase, testSuite.GraphDB, config.Configuration{}, dogtags.NewDefaultService())
 	require.NoError(t, err)
 
 	expected, err = generic.LoadGraphFromFile(os.DirFS(analysisFilePath), "analyzed.json")
@@ -74,7 +75,7 @@ func TestVersion6ADCSAnalysis(t *testing.T) {
 	err = generic.WriteGraphToDatabase(testSuite.GraphDB, &expected)
 	require.NoError(t, err)
 
-	err = datapipe.RunAnalysisOperations(ctx, testSuite.BHDatabase, testSuite.GraphDB, config.Configuration{})
+	err = datapipe.RunAnalysisOperations(ctx, testSuite.BHDatabase, testSuite.GraphDB, config.Configuration{}, dogtags.NewDefaultService())
 	require.NoError(t, err)
 
 	expected, err = generic.LoadGraphFromFile(os.DirFS(analysisFilePath), "analyzed.json")
@@ -101,7 +102,7 @@ func TestVersion6AllAnalysis(t *testing.T) {
 	err = generic.WriteGraphToDatabase(testSuite.GraphDB, &expected)
 	require.NoError(t, err)
 
-	err = datapipe.RunAnalysisOperations(ctx, testSuite.BHDatabase, testSuite.GraphDB, config.Configuration{})
+	err = datapipe.RunAnalysisOperations(ctx, testSuite.BHDatabase, testSuite.GraphDB, config.Configuration{}, dogtags.NewDefaultService())
 	require.NoError(t, err)
 
 	expected, err = generic.LoadGraphFromFile(os.DirFS(analysisFilePath), "analyzed.json")
@@ -128,7 +129,7 @@ func TestVersion6Analysis(t *testing.T) {
 	err = generic.WriteGraphToDatabase(testSuite.GraphDB, &expected)
 	require.NoError(t, err)
 
-	err = datapipe.RunAnalysisOperations(ctx, testSuite.BHDatabase, testSuite.GraphDB, config.Configuration{})
+	err = datapipe.RunAnalysisOperations(ctx, testSuite.BHDatabase, testSuite.GraphDB, config.Configuration{}, dogtags.NewDefaultService())
 	require.NoError(t, err)
 
 	expected, err = generic.LoadGraphFromFile(os.DirFS(analysisFilePath), "analyzed.json")
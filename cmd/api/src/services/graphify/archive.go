@@ -0,0 +1,328 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graphify
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
+	"github.com/specterops/bloodhound/packages/go/bomenc"
+	"github.com/specterops/bloodhound/packages/go/errorlist"
+)
+
+var (
+	ErrArchiveSizeLimitExceeded = errors.New("archive exceeds the maximum extracted size")
+	ErrUnsafeArchiveMember      = errors.New("archive member path escapes the archive")
+	ErrUnsupportedArchiveMember = errors.New("archive member is not a regular file")
+)
+
+const (
+	tarBlockSize = 512
+
+	// tarMagicOffset is where both POSIX ustar and GNU tar headers store their "ustar" magic
+	tarMagicOffset = 257
+)
+
+var tarMagic = []byte("ustar")
+
+// archiveBudget tracks how many bytes have been extracted from a single archive. A limit of zero disables the check.
+type archiveBudget struct {
+	limit int64
+	used  int64
+}
+
+func (s *archiveBudget) exceeded() bool {
+	return s.limit > 0 && s.used > s.limit
+}
+
+// reader wraps the given reader so that every byte read from it counts against the budget
+func (s *archiveBudget) reader(reader io.Reader) io.Reader {
+	return &budgetReader{
+		reader: reader,
+		budget: s,
+	}
+}
+
+type budgetReader struct {
+	reader io.Reader
+	budget *archiveBudget
+}
+
+func (s *budgetReader) Read(p []byte) (int, error) {
+	if s.budget.exceeded() {
+		return 0, ErrArchiveSizeLimitExceeded
+	}
+
+	n, err := s.reader.Read(p)
+	s.budget.used += int64(n)
+
+	if s.budget.exceeded() {
+		return n, ErrArchiveSizeLimitExceeded
+	}
+
+	return n, err
+}
+
+// checkArchiveMemberName rejects member names that are absolute or that would resolve outside of the archive root
+func checkArchiveMemberName(name string) error {
+	if !filepath.IsLocal(filepath.FromSlash(name)) {
+		return fmt.Errorf("%w: %s", ErrUnsafeArchiveMember, name)
+	}
+
+	return nil
+}
+
+func failedArchiveMember(name, parentFile string, err error) IngestFileData {
+	return IngestFileData{
+		Name:       name,
+		ParentFile: parentFile,
+		Errors:     []string{err.Error()},
+	}
+}
+
+func (s *GraphifyService) newArchiveBudget() *archiveBudget {
+	return &archiveBudget{
+		limit: s.cfg.IngestArchiveSizeLimit,
+	}
+}
+
+func (s *GraphifyService) removeArchive(archive io.Closer, path string) {
+	if err := archive.Close(); err != nil {
+		slog.ErrorContext(
+			s.ctx,
+			"Error closing archive",
+			slog.String("path", path),
+			attr.Error(err),
+		)
+	}
+	if err := os.Remove(path); err != nil {
+		slog.ErrorContext(
+			s.ctx,
+			"Error deleting archive",
+			slog.String("path", path),
+			attr.Error(err),
+		)
+	}
+}
+
+// extractZipFiles extracts every regular file of a zip archive to its own temporary file. Members that fail to
+// extract are returned with their errors so that they can be reported next to the members that succeeded.
+func (s *GraphifyService) extractZipFiles(path string, providedFileName string) ([]IngestFileData, error) {
+	archive, err := zip.OpenReader(path)
+	if err != nil {
+		return []IngestFileData{}, err
+	}
+
+	defer s.removeArchive(archive, path)
+
+	var (
+		errs     = errorlist.NewBuilder()
+		fileData = make([]IngestFileData, 0)
+		budget   = s.newArchiveBudget()
+	)
+
+	for _, f := range archive.File {
+		// skip directories
+		if f.FileInfo().IsDir() {
+			continue
+		}
+
+		if err := checkArchiveMemberName(f.Name); err != nil {
+			fileData = append(fileData, failedArchiveMember(f.Name, providedFileName, err))
+			errs.Add(err)
+		} else if !f.Mode().IsRegular() {
+			err := fmt.Errorf("%w: %s", ErrUnsupportedArchiveMember, f.Name)
+
+			fileData = append(fileData, failedArchiveMember(f.Name, providedFileName, err))
+			errs.Add(err)
+		} else if fileName, err := s.extractZipMember(f, budget); err != nil {
+			fileData = append(fileData, failedArchiveMember(f.Name, providedFileName, err))
+			errs.Add(err)
+
+			// Nothing after this member can be extracted once the archive is over its size limit
+			if errors.Is(err, ErrArchiveSizeLimitExceeded) {
+				break
+			}
+		} else {
+			fileData = append(fileData, IngestFileData{
+				Name:       f.Name,
+				ParentFile: providedFileName,
+				Path:       fileName,
+			})
+		}
+	}
+
+	return fileData, errs.Build()
+}
+
+func (s *GraphifyService) extractZipMember(f *zip.File, budget *archiveBudget) (string, error) {
+	srcFile, err := f.Open()
+	if err != nil {
+		return "", err
+	}
+	defer srcFile.Close()
+
+	return s.extractToTempFile(budget.reader(srcFile))
+}
+
+// extractGzipFiles handles both gzip compressed tar archives and single gzip compressed files. Which of the two the
+// upload contains is decided by looking for a tar header at the start of the decompressed stream.
+func (s *GraphifyService) extractGzipFiles(path string, providedFileName string) ([]IngestFileData, error) {
+	archive, err := os.Open(path)
+	if err != nil {
+		return []IngestFileData{}, err
+	}
+
+	defer s.removeArchive(archive, path)
+
+	gzipReader, err := gzip.NewReader(archive)
+	if err != nil {
+		return []IngestFileData{}, err
+	}
+	defer gzipReader.Close()
+
+	var (
+		budget   = s.newArchiveBudget()
+		contents = bufio.NewReaderSize(gzipReader, tarBlockSize)
+	)
+
+	if isTarStream(contents) {
+		return s.extractTarFiles(tar.NewReader(contents), providedFileName, budget)
+	}
+
+	name := gzipMemberName(gzipReader.Header, providedFileName)
+	if fileName, err := s.extractToTempFile(budget.reader(contents)); err != nil {
+		return []IngestFileData{failedArchiveMember(name, providedFileName, err)}, err
+	} else {
+		return []IngestFileData{
+			{
+				Name:       name,
+				ParentFile: providedFileName,
+				Path:       fileName,
+			},
+		}, nil
+	}
+}
+
+func (s *GraphifyService) extractTarFiles(tarReader *tar.Reader, providedFileName string, budget *archiveBudget) ([]IngestFileData, error) {
+	var (
+		errs     = errorlist.NewBuilder()
+		fileData = make([]IngestFileData, 0)
+	)
+
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			// A corrupt stream cannot be read past this point, keep what was extracted so far
+			errs.Add(err)
+			break
+		}
+
+		// skip directories and the pax global headers written by tools like git archive
+		if header.Typeflag == tar.TypeDir || header.Typeflag == tar.TypeXGlobalHeader {
+			continue
+		}
+
+		if err := checkArchiveMemberName(header.Name); err != nil {
+			fileData = append(fileData, failedArchiveMember(header.Name, providedFileName, err))
+			errs.Add(err)
+		} else if header.Typeflag != tar.TypeReg {
+			// Links are rejected rather than followed so that a member can never point outside of the archive
+			err := fmt.Errorf("%w: %s", ErrUnsupportedArchiveMember, header.Name)
+
+			fileData = append(fileData, failedArchiveMember(header.Name, providedFileName, err))
+			errs.Add(err)
+		} else if fileName, err := s.extractToTempFile(budget.reader(tarReader)); err != nil {
+			fileData = append(fileData, failedArchiveMember(header.Name, providedFileName, err))
+			errs.Add(err)
+
+			if errors.Is(err, ErrArchiveSizeLimitExceeded) {
+				break
+			}
+		} else {
+			fileData = append(fileData, IngestFileData{
+				Name:       header.Name,
+				ParentFile: providedFileName,
+				Path:       fileName,
+			})
+		}
+	}
+
+	return fileData, errs.Build()
+}
+
+// isTarStream peeks at the first block of the stream without consuming it
+func isTarStream(reader *bufio.Reader) bool {
+	if header, err := reader.Peek(tarBlockSize); err != nil {
+		return false
+	} else {
+		return bytes.HasPrefix(header[tarMagicOffset:], tarMagic)
+	}
+}
+
+// gzipMemberName prefers the original file name stored in the gzip header and falls back to the uploaded file name
+// without its .gz extension
+func gzipMemberName(header gzip.Header, providedFileName string) string {
+	if header.Name != "" {
+		return filepath.Base(filepath.FromSlash(header.Name))
+	}
+
+	return strings.TrimSuffix(providedFileName, ".gz")
+}
+
+// extractToTempFile normalizes a single artifact of an archive to UTF-8 and writes it out to a temporary file
+func (s *GraphifyService) extractToTempFile(src io.Reader) (string, error) {
+	tempFile, err := os.CreateTemp(s.cfg.TempDirectory(), "bh")
+	if err != nil {
+		return "", err
+	}
+
+	success := false
+	defer func() {
+		// Always close the tempFile, but...
+		tempFile.Close()
+		if !success {
+			// ... only delete if it wasn't successful. Otherwise we leave it around to be processed
+			os.Remove(tempFile.Name())
+		}
+	}()
+
+	// this creates a normalized file to feed to the copy
+	if normFile, err := bomenc.NormalizeToUTF8(src); err != nil {
+		return "", err
+		// and this is what actually copies it to disk
+	} else if _, err := io.Copy(tempFile, normFile); err != nil {
+		return "", err
+	} else {
+		// let the deferred method above know we shouldn't delete it and return the filename
+		success = true
+		return tempFile.Name(), nil
+	}
+}
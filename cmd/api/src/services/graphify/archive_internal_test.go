@@ -0,0 +1,270 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graphify
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/config"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/errorlist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const archiveTestContent = `{"meta": {"type": "domains", "version": 6, "count": 0}, "data": []}`
+
+func newArchiveTestService(t *testing.T, sizeLimit int64) *GraphifyService {
+	t.Helper()
+
+	workDir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(workDir, "tmp"), 0755))
+
+	return &GraphifyService{
+		ctx: context.Background(),
+		cfg: config.Configuration{
+			WorkDir:                workDir,
+			IngestArchiveSizeLimit: sizeLimit,
+		},
+	}
+}
+
+func writeArchiveTestFile(t *testing.T, data []byte) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "upload")
+	require.NoError(t, os.WriteFile(path, data, 0644))
+
+	return path
+}
+
+// requireArchiveError asserts that one of the per-member errors collected while extracting an archive matches target
+func requireArchiveError(t *testing.T, err error, target error) {
+	t.Helper()
+
+	var errs errorlist.Error
+	require.ErrorAs(t, err, &errs)
+
+	for _, memberErr := range errs.Errors {
+		if errors.Is(memberErr, target) {
+			return
+		}
+	}
+
+	require.Failf(t, "missing archive error", "expected %v in %v", target, err)
+}
+
+type tarTestEntry struct {
+	header  tar.Header
+	content string
+}
+
+func buildTarGz(t *testing.T, entries ...tarTestEntry) []byte {
+	t.Helper()
+
+	var (
+		buffer     bytes.Buffer
+		gzipWriter = gzip.NewWriter(&buffer)
+		tarWriter  = tar.NewWriter(gzipWriter)
+	)
+
+	for _, entry := range entries {
+		header := entry.header
+		header.Size = int64(len(entry.content))
+
+		require.NoError(t, tarWriter.WriteHeader(&header))
+		_, err := tarWriter.Write([]byte(entry.content))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+
+	return buffer.Bytes()
+}
+
+func buildZip(t *testing.T, names ...string) []byte {
+	t.Helper()
+
+	var (
+		buffer    bytes.Buffer
+		zipWriter = zip.NewWriter(&buffer)
+	)
+
+	for _, name := range names {
+		writer, err := zipWriter.Create(name)
+		require.NoError(t, err)
+
+		_, err = writer.Write([]byte(archiveTestContent))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, zipWriter.Close())
+
+	return buffer.Bytes()
+}
+
+func TestExtractIngestFiles_TarGz(t *testing.T) {
+	var (
+		service = newArchiveTestService(t, 0)
+		archive = buildTarGz(t,
+			tarTestEntry{header: tar.Header{Name: "collection/", Typeflag: tar.TypeDir, Mode: 0755}},
+			tarTestEntry{header: tar.Header{Name: "collection/domains.json", Typeflag: tar.TypeReg, Mode: 0644}, content: archiveTestContent},
+			tarTestEntry{header: tar.Header{Name: "collection/users.json", Typeflag: tar.TypeReg, Mode: 0644}, content: archiveTestContent},
+			tarTestEntry{header: tar.Header{Name: "../escape.json", Typeflag: tar.TypeReg, Mode: 0644}, content: archiveTestContent},
+			tarTestEntry{header: tar.Header{Name: "/etc/absolute.json", Typeflag: tar.TypeReg, Mode: 0644}, content: archiveTestContent},
+			tarTestEntry{header: tar.Header{Name: "collection/link.json", Typeflag: tar.TypeSymlink, Linkname: "/etc/passwd"}},
+		)
+		path = writeArchiveTestFile(t, archive)
+	)
+
+	fileData, err := service.extractIngestFiles(path, "collection.tar.gz", model.FileTypeGzip)
+	requireArchiveError(t, err, ErrUnsafeArchiveMember)
+	requireArchiveError(t, err, ErrUnsupportedArchiveMember)
+	require.Len(t, fileData, 5)
+
+	for _, data := range fileData[:2] {
+		assert.Equal(t, "collection.tar.gz", data.ParentFile)
+		assert.Empty(t, data.Errors)
+
+		content, err := os.ReadFile(data.Path)
+		require.NoError(t, err)
+		assert.Equal(t, archiveTestContent, string(content))
+	}
+
+	assert.Equal(t, "collection/domains.json", fileData[0].Name)
+	assert.Equal(t, "collection/users.json", fileData[1].Name)
+
+	for _, data := range fileData[2:] {
+		assert.Equal(t, "collection.tar.gz", data.ParentFile)
+		assert.Empty(t, data.Path)
+		assert.Len(t, data.Errors, 1)
+	}
+
+	assert.NoFileExists(t, path)
+}
+
+func TestExtractIngestFiles_Gzip(t *testing.T) {
+	t.Run("name from gzip header", func(t *testing.T) {
+		var (
+			service    = newArchiveTestService(t, 0)
+			buffer     bytes.Buffer
+			gzipWriter = gzip.NewWriter(&buffer)
+		)
+
+		gzipWriter.Name = "computers.json"
+		_, err := gzipWriter.Write([]byte(archiveTestContent))
+		require.NoError(t, err)
+		require.NoError(t, gzipWriter.Close())
+
+		fileData, err := service.extractIngestFiles(writeArchiveTestFile(t, buffer.Bytes()), "upload.gz", model.FileTypeGzip)
+		require.NoError(t, err)
+		require.Len(t, fileData, 1)
+		assert.Equal(t, "computers.json", fileData[0].Name)
+		assert.Equal(t, "upload.gz", fileData[0].ParentFile)
+
+		content, err := os.ReadFile(fileData[0].Path)
+		require.NoError(t, err)
+		assert.Equal(t, archiveTestContent, string(content))
+	})
+
+	t.Run("name from uploaded file name", func(t *testing.T) {
+		var (
+			service    = newArchiveTestService(t, 0)
+			buffer     bytes.Buffer
+			gzipWriter = gzip.NewWriter(&buffer)
+		)
+
+		_, err := gzipWriter.Write([]byte(archiveTestContent))
+		require.NoError(t, err)
+		require.NoError(t, gzipWriter.Close())
+
+		fileData, err := service.extractIngestFiles(writeArchiveTestFile(t, buffer.Bytes()), "users.json.gz", model.FileTypeGzip)
+		require.NoError(t, err)
+		require.Len(t, fileData, 1)
+		assert.Equal(t, "users.json", fileData[0].Name)
+	})
+
+	t.Run("corrupt gzip fails the whole archive", func(t *testing.T) {
+		service := newArchiveTestService(t, 0)
+
+		fileData, err := service.extractIngestFiles(writeArchiveTestFile(t, []byte("not gzip")), "users.json.gz", model.FileTypeGzip)
+		require.Error(t, err)
+		assert.Empty(t, fileData)
+	})
+}
+
+func TestExtractIngestFiles_SizeLimit(t *testing.T) {
+	t.Run("tar.gz", func(t *testing.T) {
+		var (
+			service = newArchiveTestService(t, int64(len(archiveTestContent))*2)
+			archive = buildTarGz(t,
+				tarTestEntry{header: tar.Header{Name: "domains.json", Typeflag: tar.TypeReg, Mode: 0644}, content: archiveTestContent},
+				tarTestEntry{header: tar.Header{Name: "users.json", Typeflag: tar.TypeReg, Mode: 0644}, content: archiveTestContent},
+				tarTestEntry{header: tar.Header{Name: "groups.json", Typeflag: tar.TypeReg, Mode: 0644}, content: archiveTestContent},
+			)
+		)
+
+		fileData, err := service.extractIngestFiles(writeArchiveTestFile(t, archive), "collection.tar.gz", model.FileTypeGzip)
+		requireArchiveError(t, err, ErrArchiveSizeLimitExceeded)
+		require.Len(t, fileData, 3)
+		assert.FileExists(t, fileData[0].Path)
+		assert.FileExists(t, fileData[1].Path)
+		assert.Empty(t, fileData[2].Path)
+		assert.NotEmpty(t, fileData[2].Errors)
+	})
+
+	t.Run("zip", func(t *testing.T) {
+		var (
+			service = newArchiveTestService(t, int64(len(archiveTestContent))+1)
+			archive = buildZip(t, "domains.json", "users.json", "groups.json")
+		)
+
+		fileData, err := service.extractIngestFiles(writeArchiveTestFile(t, archive), "collection.zip", model.FileTypeZip)
+		requireArchiveError(t, err, ErrArchiveSizeLimitExceeded)
+		require.Len(t, fileData, 2)
+		assert.NotEmpty(t, fileData[0].Path)
+		assert.Empty(t, fileData[1].Path)
+		assert.NotEmpty(t, fileData[1].Errors)
+	})
+}
+
+func TestExtractIngestFiles_ZipPathTraversal(t *testing.T) {
+	var (
+		service = newArchiveTestService(t, 0)
+		archive = buildZip(t, "domains.json", "../../escape.json")
+	)
+
+	fileData, err := service.extractIngestFiles(writeArchiveTestFile(t, archive), "collection.zip", model.FileTypeZip)
+	requireArchiveError(t, err, ErrUnsafeArchiveMember)
+	require.Len(t, fileData, 2)
+
+	assert.Equal(t, "domains.json", fileData[0].Name)
+	assert.NotEmpty(t, fileData[0].Path)
+
+	assert.Equal(t, "../../escape.json", fileData[1].Name)
+	assert.Empty(t, fileData[1].Path)
+	assert.Len(t, fileData[1].Errors, 1)
+}
@@ -36,6 +36,14 @@ const (
 	ExtractError                  = "failed to extract owner id/type from directory object: %v"
 	PrincipalTypeServicePrincipal = "ServicePrincipal"
 	PrincipalTypeUser             = "User"
+
+	// Administrative units, storage accounts and SQL servers are not part of the AzureHound kind enums
+	KindAZAdministrativeUnit           enums.Kind = "AZAdministrativeUnit"
+	KindAZAdministrativeUnitMember     enums.Kind = "AZAdministrativeUnitMember"
+	KindAZStorageAccount               enums.Kind = "AZStorageAccount"
+	KindAZStorageAccountRoleAssignment enums.Kind = "AZStorageAccountRoleAssignment"
+	KindAZSQLServer                    enums.Kind = "AZSQLServer"
+	KindAZSQLServerRoleAssignment      enums.Kind = "AZSQLServerRoleAssignment"
 )
 
 func getKindConverter(kind enums.Kind) func(json.RawMessage, *ConvertedAzureData, time.Time) {
@@ -46,6 +54,10 @@ func getKindConverter(kind enums.Kind) func(json.RawMessage, *ConvertedAzureData
 		return convertAzureAppOwner
 	case enums.KindAZAppRoleAssignment:
 		return convertAzureAppRoleAssignment
+	case KindAZAdministrativeUnit:
+		return convertAzureAdministrativeUnit
+	case KindAZAdministrativeUnitMember:
+		return convertAzureAdministrativeUnitMember
 	case enums.KindAZDevice:
 		return convertAzureDevice
 	case enums.KindAZFunctionApp:
@@ -140,6 +152,14 @@ func getKindConverter(kind enums.Kind) func(json.RawMessage, *ConvertedAzureData
 		return convertAzureAutomationAccount
 	case enums.KindAZAutomationAccountRoleAssignment:
 		return convertAzureAutomationAccountRoleAssignment
+	case KindAZStorageAccount:
+		return convertAzureStorageAccount
+	case KindAZStorageAccountRoleAssignment:
+		return convertAzureStorageAccountRoleAssignment
+	case KindAZSQLServer:
+		return convertAzureSQLServer
+	case KindAZSQLServerRoleAssignment:
+		return convertAzureSQLServerRoleAssignment
 	case enums.KindAZRoleManagementPolicyAssignment:
 		return convertAzureRoleManagementPolicyAssignment
 	case enums.KindAZRoleEligibilityScheduleInstance:
@@ -218,12 +238,37 @@ func convertAzureAppRoleAssignment(raw json.RawMessage, converted *ConvertedAzur
 	}
 }
 
+func convertAzureAdministrativeUnit(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
+	var data ein.AzureAdministrativeUnit
+	if err := json.Unmarshal(raw, &data); err != nil {
+		slog.Error(fmt.Sprintf(SerialError, "azure administrative unit", err))
+	} else {
+		node, rel := ein.ConvertAzureAdministrativeUnit(data, ingestTime)
+		converted.NodeProps = append(converted.NodeProps, node)
+		converted.RelProps = append(converted.RelProps, rel)
+	}
+}
+
+func convertAzureAdministrativeUnitMember(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
+	var data ein.AzureAdministrativeUnitMembers
+	if err := json.Unmarshal(raw, &data); err != nil {
+		slog.Error(fmt.Sprintf(SerialError, "azure administrative unit members", err))
+	} else {
+		converted.RelProps = append(converted.RelProps, ein.ConvertAzureAdministrativeUnitMembersToRels(data)...)
+	}
+}
+
 func convertAzureDevice(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
-	var data models.Device
+	var (
+		data       models.Device
+		onPremises ein.AzureDeviceOnPremises
+	)
 	if err := json.Unmarshal(raw, &data); err != nil {
 		slog.Error(fmt.Sprintf(SerialError, "azure device", err))
+	} else if err := json.Unmarshal(raw, &onPremises); err != nil {
+		slog.Error(fmt.Sprintf(SerialError, "azure device on-premises properties", err))
 	} else {
-		converted.NodeProps = append(converted.NodeProps, ein.ConvertAZDeviceToNode(data, ingestTime))
+		converted.NodeProps = append(converted.NodeProps, ein.ConvertAZDeviceToNode(data, onPremises, ingestTime))
 		converted.RelProps = append(converted.RelProps, ein.ConvertAZDeviceRelationships(data)...)
 	}
 }
@@ -691,6 +736,48 @@ func convertAzureAutomationAccountRoleAssignment(raw json.RawMessage, converted
 	}
 }
 
+func convertAzureStorageAccount(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
+	var data ein.AzureStorageAccount
+	if err := json.Unmarshal(raw, &data); err != nil {
+		slog.Error(fmt.Sprintf(SerialError, "azure storage account", err))
+	} else {
+		node, relationships := ein.ConvertAzureStorageAccount(data, ingestTime)
+		converted.NodeProps = append(converted.NodeProps, node)
+		converted.RelProps = append(converted.RelProps, relationships...)
+	}
+}
+
+func convertAzureStorageAccountRoleAssignment(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
+	var data models.AzureRoleAssignments
+
+	if err := json.Unmarshal(raw, &data); err != nil {
+		slog.Error(fmt.Sprintf(SerialError, "azure storage account role assignments", err))
+	} else {
+		converted.RelProps = append(converted.RelProps, ein.ConvertAzureStorageAccountRoleAssignment(data)...)
+	}
+}
+
+func convertAzureSQLServer(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
+	var data ein.AzureSQLServer
+	if err := json.Unmarshal(raw, &data); err != nil {
+		slog.Error(fmt.Sprintf(SerialError, "azure sql server", err))
+	} else {
+		node, relationships := ein.ConvertAzureSQLServer(data, ingestTime)
+		converted.NodeProps = append(converted.NodeProps, node)
+		converted.RelProps = append(converted.RelProps, relationships...)
+	}
+}
+
+func convertAzureSQLServerRoleAssignment(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
+	var data models.AzureRoleAssignments
+
+	if err := json.Unmarshal(raw, &data); err != nil {
+		slog.Error(fmt.Sprintf(SerialError, "azure sql server role assignments", err))
+	} else {
+		converted.RelProps = append(converted.RelProps, ein.ConvertAzureSQLServerRoleAssignment(data)...)
+	}
+}
+
 // convertAzureRoleManagementPolicyAssignment implements function signature required in getKindConverter
 func convertAzureRoleManagementPolicyAssignment(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
 	var data models.RoleManagementPolicyAssignment
@@ -17,10 +17,15 @@
 package graphify
 
 import (
+	"slices"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	adAnalysis "github.com/specterops/bloodhound/packages/go/analysis/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
 	"github.com/specterops/dawgs/graph"
 )
 
@@ -36,14 +41,14 @@ func InitializeIngestMetrics(registerer prometheus.Registerer) error {
 			Name: "bhe_ingest_throughput",
 			Help: "Ingestion throughput in entities per second",
 		},
-		[]string{"entity_type", "stage"}, // "nodes" or "relationships", "processed" or "written"
+		[]string{"worker", "entity_type", "stage"}, // ingest worker, "nodes" or "relationships", "processed" or "written"
 	)
 
 	return registerer.Register(ingestThroughputGauge)
 }
 
-// PublishIngestThroughput publishes ingestion throughput metrics to Prometheus
-func PublishIngestThroughput(nodesProcessed, relsProcessed, nodesWritten, relsWritten int64, duration time.Duration) {
+// PublishIngestThroughput publishes the ingestion throughput of an ingest worker to Prometheus
+func PublishIngestThroughput(worker string, nodesProcessed, relsProcessed, nodesWritten, relsWritten int64, duration time.Duration) {
 	if ingestThroughputGauge == nil || duration.Seconds() <= 0 {
 		return
 	}
@@ -55,10 +60,10 @@ func PublishIngestThroughput(nodesProcessed, relsProcessed, nodesWritten, relsWr
 	relsWrittenPerSec := float64(relsWritten) / duration.Seconds()
 
 	// Update gauges immediately
-	ingestThroughputGauge.WithLabelValues("nodes", "processed").Set(nodesProcessedPerSec)
-	ingestThroughputGauge.WithLabelValues("relationships", "processed").Set(relsProcessedPerSec)
-	ingestThroughputGauge.WithLabelValues("nodes", "written").Set(nodesWrittenPerSec)
-	ingestThroughputGauge.WithLabelValues("relationships", "written").Set(relsWrittenPerSec)
+	ingestThroughputGauge.WithLabelValues(worker, "nodes", "processed").Set(nodesProcessedPerSec)
+	ingestThroughputGauge.WithLabelValues(worker, "relationships", "processed").Set(relsProcessedPerSec)
+	ingestThroughputGauge.WithLabelValues(worker, "nodes", "written").Set(nodesWrittenPerSec)
+	ingestThroughputGauge.WithLabelValues(worker, "relationships", "written").Set(relsWrittenPerSec)
 }
 
 // IngestStats tracks the number of nodes and relationships processed during ingestion
@@ -71,6 +76,18 @@ type IngestStats struct {
 	// Entities actually written to database (subset of processed)
 	NodesWritten         atomic.Int64
 	RelationshipsWritten atomic.Int64
+
+	// Active Directory domains that written entities belong to
+	domainsLock sync.Mutex
+	domainSIDs  map[string]struct{}
+	crossDomain bool
+}
+
+// TouchedDomains describes the Active Directory domains whose data was written during ingest
+type TouchedDomains struct {
+	DomainSIDs []string
+	// CrossDomain is set when written data spans domains or could not be attributed to a domain
+	CrossDomain bool
 }
 
 func (s *IngestStats) Reset() {
@@ -78,6 +95,94 @@ func (s *IngestStats) Reset() {
 	s.RelationshipsProcessed.Store(0)
 	s.NodesWritten.Store(0)
 	s.RelationshipsWritten.Store(0)
+
+	s.domainsLock.Lock()
+	defer s.domainsLock.Unlock()
+
+	s.domainSIDs = nil
+	s.crossDomain = false
+}
+
+// TouchedDomains returns the Active Directory domains written to since the stats were last reset
+func (s *IngestStats) TouchedDomains() TouchedDomains {
+	s.domainsLock.Lock()
+	defer s.domainsLock.Unlock()
+
+	touched := TouchedDomains{
+		DomainSIDs:  make([]string, 0, len(s.domainSIDs)),
+		CrossDomain: s.crossDomain,
+	}
+
+	for domainSID := range s.domainSIDs {
+		touched.DomainSIDs = append(touched.DomainSIDs, domainSID)
+	}
+
+	slices.Sort(touched.DomainSIDs)
+	return touched
+}
+
+func (s *IngestStats) addDomains(crossDomain bool, domainSIDs ...string) {
+	s.domainsLock.Lock()
+	defer s.domainsLock.Unlock()
+
+	if s.domainSIDs == nil {
+		s.domainSIDs = make(map[string]struct{})
+	}
+
+	for _, domainSID := range domainSIDs {
+		s.domainSIDs[domainSID] = struct{}{}
+	}
+
+	s.crossDomain = s.crossDomain || crossDomain
+}
+
+func isADNode(identityKind graph.Kind, node *graph.Node) bool {
+	return identityKind == ad.Entity || (node != nil && node.Kinds.ContainsOneOf(ad.Entity))
+}
+
+// nodeDomainSID resolves the domain of a node from its domainsid property, falling back to its object identifier
+func nodeDomainSID(node *graph.Node) (string, bool) {
+	if node == nil {
+		return "", false
+	} else if domainSID, err := node.Properties.Get(ad.DomainSID.String()).String(); err == nil && domainSID != "" {
+		return domainSID, true
+	} else if objectID, err := node.Properties.Get(common.ObjectID.String()).String(); err == nil {
+		return adAnalysis.DomainSIDFromObjectID(objectID)
+	}
+
+	return "", false
+}
+
+func (s *IngestStats) recordNodeDomain(update graph.NodeUpdate) {
+	if !isADNode(update.IdentityKind, update.Node) {
+		return
+	}
+
+	if domainSID, ok := nodeDomainSID(update.Node); ok {
+		s.addDomains(false, domainSID)
+	} else {
+		s.addDomains(true)
+	}
+}
+
+// recordRelationshipDomains attributes a relationship to the domains of its endpoints. Endpoints that can not be
+// attributed, such as GUID identified OUs and GPOs, are ignored as their nodes carry their own domain.
+func (s *IngestStats) recordRelationshipDomains(update graph.RelationshipUpdate) {
+	if !isADNode(update.StartIdentityKind, update.Start) && !isADNode(update.EndIdentityKind, update.End) {
+		return
+	}
+
+	startDomainSID, hasStartDomain := nodeDomainSID(update.Start)
+	endDomainSID, hasEndDomain := nodeDomainSID(update.End)
+
+	switch {
+	case hasStartDomain && hasEndDomain:
+		s.addDomains(startDomainSID != endDomainSID, startDomainSID, endDomainSID)
+	case hasStartDomain:
+		s.addDomains(false, startDomainSID)
+	case hasEndDomain:
+		s.addDomains(false, endDomainSID)
+	}
 }
 
 func (s *IngestStats) GetCounts() (nodesProcessed, relsProcessed, nodesWritten, relsWritten int64) {
@@ -105,6 +210,7 @@ func (s *countingBatchUpdater) UpdateNodeBy(update graph.NodeUpdate) error {
 	}
 	// Track database writes
 	s.stats.NodesWritten.Add(1)
+	s.stats.recordNodeDomain(update)
 	return nil
 }
 
@@ -114,6 +220,7 @@ func (s *countingBatchUpdater) UpdateRelationshipBy(update graph.RelationshipUpd
 	}
 	// Track database writes
 	s.stats.RelationshipsWritten.Add(1)
+	s.stats.recordRelationshipDomains(update)
 	return nil
 }
 
@@ -0,0 +1,113 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graphify
+
+import (
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/services/graphify/mocks"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	domainASID = "S-1-5-21-1000000000-1000000000-1000000000"
+	domainBSID = "S-1-5-21-2000000000-2000000000-2000000000"
+)
+
+func adRelationshipUpdate(startObjectID, endObjectID string) graph.RelationshipUpdate {
+	return graph.RelationshipUpdate{
+		Relationship:      graph.PrepareRelationship(graph.NewProperties(), ad.MemberOf),
+		Start:             graph.PrepareNode(graph.NewProperties().Set(common.ObjectID.String(), startObjectID), ad.User),
+		StartIdentityKind: ad.Entity,
+		End:               graph.PrepareNode(graph.NewProperties().Set(common.ObjectID.String(), endObjectID), ad.Group),
+		EndIdentityKind:   ad.Entity,
+	}
+}
+
+func TestCountingBatchUpdaterTouchedDomains(t *testing.T) {
+	t.Run("domains are recorded from written AD entities", func(t *testing.T) {
+		var (
+			ctrl             = gomock.NewController(t)
+			mockBatchUpdater = mocks.NewMockBatchUpdater(ctrl)
+			stats            = &IngestStats{}
+			batch            = NewCountingBatchUpdater(mockBatchUpdater, stats)
+
+			userUpdate = graph.NodeUpdate{
+				Node:         graph.PrepareNode(graph.NewProperties().Set(common.ObjectID.String(), domainASID+"-1105").Set(ad.DomainSID.String(), domainASID), ad.User),
+				IdentityKind: ad.Entity,
+			}
+			ouUpdate = graph.NodeUpdate{
+				Node:         graph.PrepareNode(graph.NewProperties().Set(common.ObjectID.String(), "0E1B3B4A-7C4B-4B2E-9E3A-2B5B1C1D1E1F").Set(ad.DomainSID.String(), domainBSID), ad.OU),
+				IdentityKind: ad.Entity,
+			}
+			azureUpdate = graph.NodeUpdate{
+				Node:         graph.PrepareNode(graph.NewProperties().Set(common.ObjectID.String(), "tenant"), azure.Tenant),
+				IdentityKind: azure.Entity,
+			}
+		)
+
+		mockBatchUpdater.EXPECT().UpdateNodeBy(gomock.Any()).Return(nil).Times(3)
+		mockBatchUpdater.EXPECT().UpdateRelationshipBy(gomock.Any()).Return(nil).Times(1)
+
+		require.NoError(t, batch.UpdateNodeBy(userUpdate))
+		require.NoError(t, batch.UpdateNodeBy(ouUpdate))
+		require.NoError(t, batch.UpdateNodeBy(azureUpdate))
+		require.NoError(t, batch.UpdateRelationshipBy(adRelationshipUpdate(domainASID+"-1105", domainASID+"-513")))
+
+		require.Equal(t, TouchedDomains{DomainSIDs: []string{domainASID, domainBSID}}, stats.TouchedDomains())
+	})
+
+	t.Run("relationships spanning domains are cross-domain", func(t *testing.T) {
+		var (
+			ctrl             = gomock.NewController(t)
+			mockBatchUpdater = mocks.NewMockBatchUpdater(ctrl)
+			stats            = &IngestStats{}
+			batch            = NewCountingBatchUpdater(mockBatchUpdater, stats)
+		)
+
+		mockBatchUpdater.EXPECT().UpdateRelationshipBy(gomock.Any()).Return(nil).Times(1)
+
+		require.NoError(t, batch.UpdateRelationshipBy(adRelationshipUpdate(domainASID+"-1105", domainBSID+"-513")))
+		require.Equal(t, TouchedDomains{DomainSIDs: []string{domainASID, domainBSID}, CrossDomain: true}, stats.TouchedDomains())
+
+		stats.Reset()
+		require.Equal(t, TouchedDomains{DomainSIDs: []string{}}, stats.TouchedDomains())
+	})
+
+	t.Run("AD nodes without a domain are cross-domain", func(t *testing.T) {
+		var (
+			ctrl             = gomock.NewController(t)
+			mockBatchUpdater = mocks.NewMockBatchUpdater(ctrl)
+			stats            = &IngestStats{}
+			batch            = NewCountingBatchUpdater(mockBatchUpdater, stats)
+		)
+
+		mockBatchUpdater.EXPECT().UpdateNodeBy(gomock.Any()).Return(nil).Times(1)
+
+		require.NoError(t, batch.UpdateNodeBy(graph.NodeUpdate{
+			Node:         graph.PrepareNode(graph.NewProperties().Set(common.ObjectID.String(), "TESTLAB.LOCAL-S-1-5-32-544"), ad.Group),
+			IdentityKind: ad.Entity,
+		}))
+
+		require.True(t, stats.TouchedDomains().CrossDomain)
+	})
+}
@@ -22,7 +22,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/specterops/bloodhound/cmd/api/src/services/graphify/propertyschema"
 	"github.com/specterops/bloodhound/packages/go/ein"
+	"github.com/specterops/bloodhound/packages/go/errorlist"
 	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
 	"github.com/specterops/bloodhound/packages/go/graphschema/common"
 	"github.com/specterops/dawgs/graph"
@@ -95,6 +97,52 @@ func ConvertGenericEdge(entity ein.GenericEdge, converted *ConvertedData) error
 	return nil
 }
 
+// validatedGenericNodeConvertor validates the properties of each node against the property schema of the extension
+// owning its kinds before converting it
+func validatedGenericNodeConvertor(propertySchemas *propertyschema.Registry) ConversionFunc[ein.GenericNode] {
+	return func(entity ein.GenericNode, converted *ConvertedData) error {
+		return convertValidatedProperties(propertySchemas, propertyschema.EntityNode, fmt.Sprintf("node %s", entity.ID), entity.Kinds, entity.Properties, func(properties map[string]any) error {
+			entity.Properties = properties
+			return ConvertGenericNode(entity, converted)
+		})
+	}
+}
+
+// validatedGenericEdgeConvertor validates the properties of each edge against the property schema of the extension
+// owning its kind before converting it
+func validatedGenericEdgeConvertor(propertySchemas *propertyschema.Registry) ConversionFunc[ein.GenericEdge] {
+	return func(entity ein.GenericEdge, converted *ConvertedData) error {
+		entityName := fmt.Sprintf("edge %s from %s to %s", entity.Kind, entity.Start.Value, entity.End.Value)
+
+		return convertValidatedProperties(propertySchemas, propertyschema.EntityRelationship, entityName, []string{entity.Kind}, entity.Properties, func(properties map[string]any) error {
+			entity.Properties = properties
+			return ConvertGenericEdge(entity, converted)
+		})
+	}
+}
+
+// convertValidatedProperties runs convert with the validated properties of an entity. Violations are returned as a
+// propertyschema.ViolationError, and convert is skipped if they reject the entity.
+func convertValidatedProperties(propertySchemas *propertyschema.Registry, entity propertyschema.Entity, entityName string, kinds []string, properties map[string]any, convert func(properties map[string]any) error) error {
+	validated, result := propertySchemas.Validate(entity, kinds, properties)
+	if len(result.Violations) == 0 {
+		return convert(validated)
+	}
+
+	violationErr := propertyschema.ViolationError{
+		Entity: entityName,
+		Result: result,
+	}
+
+	if result.Rejected() {
+		return violationErr
+	} else if err := convert(validated); err != nil {
+		return errorlist.Error{Errors: []error{violationErr, err}}
+	}
+
+	return violationErr
+}
+
 func convertComputerData(computer ein.Computer, converted *ConvertedData, ingestTime time.Time) {
 	baseNodeProp := ein.ConvertComputerToNode(computer, ingestTime)
 	converted.RelProps = append(converted.RelProps, ein.ParseACEData(baseNodeProp, computer.Aces, computer.ObjectIdentifier, ad.Computer)...)
@@ -17,7 +17,6 @@
 package graphify
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -29,6 +28,13 @@ import (
 	"github.com/specterops/dawgs/graph"
 )
 
+// IngestDecoder is the part of json.Decoder used to stream the entities of an ingest payload. It lets payloads
+// that are not a single JSON document, like NDJSON, share the decoders below.
+type IngestDecoder interface {
+	More() bool
+	Decode(v any) error
+}
+
 type ConversionFuncWithTime[T any] func(decoded T, converted *ConvertedData, ingestTime time.Time)
 
 // ConversionFunc is a function that transforms a decoded JSON object (of type T)
@@ -37,7 +43,7 @@ type ConversionFuncWithTime[T any] func(decoded T, converted *ConvertedData, ing
 // T represents a specific ingest type (e.g., User, Computer, Group, etc.).
 type ConversionFunc[T any] func(decoded T, converted *ConvertedData) error
 
-func decodeBasicData[T any](batch *IngestContext, decoder *json.Decoder, conversionFunc ConversionFuncWithTime[T]) error {
+func decodeBasicData[T any](batch *IngestContext, decoder IngestDecoder, conversionFunc ConversionFuncWithTime[T]) error {
 	var (
 		count         = 0
 		convertedData ConvertedData
@@ -77,7 +83,7 @@ func decodeBasicData[T any](batch *IngestContext, decoder *json.Decoder, convers
 	return errs.Build()
 }
 
-func DecodeGenericData[T any](batch *IngestContext, decoder *json.Decoder, sourceKind graph.Kind, conversionFunc ConversionFunc[T]) error {
+func DecodeGenericData[T any](batch *IngestContext, decoder IngestDecoder, sourceKind graph.Kind, conversionFunc ConversionFunc[T]) error {
 	var (
 		count         = 0
 		convertedData ConvertedData
@@ -119,7 +125,7 @@ func DecodeGenericData[T any](batch *IngestContext, decoder *json.Decoder, sourc
 	return errs.Build()
 }
 
-func decodeGroupData(batch *IngestContext, decoder *json.Decoder) error {
+func decodeGroupData(batch *IngestContext, decoder IngestDecoder) error {
 
 	var (
 		convertedData = ConvertedGroupData{}
@@ -158,7 +164,7 @@ func decodeGroupData(batch *IngestContext, decoder *json.Decoder) error {
 	return errs.Build()
 }
 
-func decodeSessionData(batch *IngestContext, decoder *json.Decoder) error {
+func decodeSessionData(batch *IngestContext, decoder IngestDecoder) error {
 	var (
 		convertedData = ConvertedSessionData{}
 		count         = 0
@@ -195,7 +201,7 @@ func decodeSessionData(batch *IngestContext, decoder *json.Decoder) error {
 	return errs.Build()
 }
 
-func decodeAzureData(batch *IngestContext, decoder *json.Decoder) error {
+func decodeAzureData(batch *IngestContext, decoder IngestDecoder) error {
 	var (
 		convertedData = ConvertedAzureData{}
 		count         = 0
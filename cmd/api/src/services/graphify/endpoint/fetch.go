@@ -268,9 +268,13 @@ func resolveIngestibleEndpoint(tx graph.Transaction, ingestEntry ein.IngestibleE
 // collects the resolved relationships in a separate goroutine, and waits for completion.
 // It returns the fully resolved list of relationships and any aggregated errors encountered
 // during the worker execution. This function logs its duration and operation details to the context logger.
+// Concurrent calls sharing a resolver wait for each other, as a resolver runs a single resolution at a time.
 func ResolveAll(ctx context.Context, endpointResolver *Resolver, ingestEntries []ein.IngestibleRelationship) ([]ein.IngestibleRelationship, error) {
 	defer measure.ContextLogAndMeasure(ctx, slog.LevelInfo, "ResolveAll")()
 
+	endpointResolver.resolveLock.Lock()
+	defer endpointResolver.resolveLock.Unlock()
+
 	// Start a new parallel resolution
 	endpointResolver.Start(ctx, analysis.MaximumDatabaseParallelWorkers)
 
@@ -40,6 +40,9 @@ type Resolver struct {
 	started          bool
 	workerErrors     *errorlist.ErrorBuilder
 	stateLock        sync.Mutex
+
+	// resolveLock serializes ResolveAll calls of concurrent ingest workers sharing this resolver and its cache
+	resolveLock sync.Mutex
 }
 
 // NewResolver initializes a new Resolver instance with the provided database connection
@@ -30,6 +30,7 @@ import (
 	"github.com/specterops/bloodhound/cmd/api/src/model"
 	"github.com/specterops/bloodhound/cmd/api/src/model/ingest"
 	"github.com/specterops/bloodhound/cmd/api/src/services/graphify/endpoint"
+	"github.com/specterops/bloodhound/cmd/api/src/services/graphify/propertyschema"
 	"github.com/specterops/bloodhound/cmd/api/src/services/upload"
 	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
 	"github.com/specterops/bloodhound/packages/go/ein"
@@ -49,7 +50,7 @@ const (
 type registrationFn func(kind graph.Kind) error
 
 type ReadOptions struct {
-	FileType           model.FileType // JSON or ZIP
+	FileType           model.FileType // JSON, NDJSON or ZIP
 	IngestSchema       upload.IngestSchema
 	RegisterSourceKind registrationFn
 }
@@ -70,6 +71,11 @@ type IngestContext struct {
 	EndpointResolver *endpoint.Resolver
 	// RetainIngestedFiles determines if the service should clean up working files after ingest
 	RetainIngestedFiles bool
+	// PropertySchemas holds the property schemas of registered extensions that OpenGraph node and edge properties
+	// are validated against. Properties are not validated if nil.
+	PropertySchemas *propertyschema.Registry
+	// Preview is set for dry runs, which record the changes ingest would make instead of writing to the graph
+	Preview *IngestPreview
 }
 
 func NewIngestContext(ctx context.Context, opts ...IngestOption) *IngestContext {
@@ -117,6 +123,18 @@ func WithEndpointResolver(resolver *endpoint.Resolver) IngestOption {
 	}
 }
 
+func WithPropertySchemas(propertySchemas *propertyschema.Registry) IngestOption {
+	return func(s *IngestContext) {
+		s.PropertySchemas = propertySchemas
+	}
+}
+
+func WithPreview(preview *IngestPreview) IngestOption {
+	return func(s *IngestContext) {
+		s.Preview = preview
+	}
+}
+
 func WithBatchUpdater(batchUpdater BatchUpdater) IngestOption {
 	return func(s *IngestContext) {
 		s.Batch = batchUpdater
@@ -132,6 +150,10 @@ func (s *IngestContext) HasChangelog() bool {
 	return s.Manager != nil
 }
 
+func (s *IngestContext) IsDryRun() bool {
+	return s.Preview != nil
+}
+
 // ChangeManager represents the ingestion-facing API for the changelog daemon.
 //
 // It provides three responsibilities:
@@ -179,11 +201,13 @@ func ReadFileForIngest(batch *IngestContext, reader io.ReadSeeker, options ReadO
 
 	// TODO: Should this be moved into the upload service. The comment here is helpful, but more
 	// discovery required.
-	// if filetype == ZIP, we need to validate against jsonschema because
+	// if filetype == ZIP or GZIP, we need to validate against jsonschema because
 	// the archive bypassed validation controls at file upload time, as opposed to JSON files,
 	// which were validated at file upload time
-	if options.FileType == model.FileTypeZip {
+	if options.FileType == model.FileTypeZip || options.FileType == model.FileTypeGzip {
 		shouldValidateGraph = true
+	} else if options.FileType == model.FileTypeNDJSON {
+		return ReadNDJSONForIngest(batch, reader, options)
 	}
 
 	if meta, err := upload.ParseAndValidatePayload(reader, options.IngestSchema, shouldValidateGraph, shouldValidateGraph); err != nil {
@@ -210,7 +234,11 @@ func IngestWrapper(batch *IngestContext, reader io.ReadSeeker, meta ingest.Origi
 
 	// Basic handler
 	if handler, ok := basicHandlers[meta.Type]; ok {
-		return handler(batch, reader, meta)
+		if decoder, err := getDefaultDecoder(reader); err != nil {
+			return err
+		} else {
+			return handler(batch, decoder, meta)
+		}
 	}
 
 	return fmt.Errorf("no handler for ingest data type: %v", meta.Type)
@@ -288,8 +316,9 @@ func IngestAzureData(batch *IngestContext, converted ConvertedAzureData) error {
 	return errs.Build()
 }
 
-// basicIngestHandler defines the function signature for all ingest paths except for the OpenGraph
-type basicIngestHandler func(batch *IngestContext, reader io.ReadSeeker, meta ingest.OriginalMetadata) error
+// basicIngestHandler defines the function signature for all ingest paths except for the OpenGraph. The decoder is
+// positioned at the first entity of the payload's data.
+type basicIngestHandler func(batch *IngestContext, decoder IngestDecoder, meta ingest.OriginalMetadata) error
 
 // sourceKindIngestHandler defines the function signature for ingest handlers that require
 // additional logic — specifically, registration of a sourceKind before decoding data.
@@ -297,45 +326,27 @@ type basicIngestHandler func(batch *IngestContext, reader io.ReadSeeker, meta in
 type sourceKindIngestHandler func(batch *IngestContext, reader io.ReadSeeker, meta ingest.OriginalMetadata, register registrationFn) error
 
 func defaultBasicHandler[T any](conversionFunc ConversionFuncWithTime[T]) basicIngestHandler {
-	return func(batch *IngestContext, reader io.ReadSeeker, meta ingest.OriginalMetadata) error {
-		decoder, err := getDefaultDecoder(reader)
-		if err != nil {
-			return err
-		}
+	return func(batch *IngestContext, decoder IngestDecoder, meta ingest.OriginalMetadata) error {
 		return decodeBasicData(batch, decoder, conversionFunc)
 	}
 }
 
 var basicHandlers = map[ingest.DataType]basicIngestHandler{
-	ingest.DataTypeComputer: func(batch *IngestContext, reader io.ReadSeeker, meta ingest.OriginalMetadata) error {
-		if decoder, err := getDefaultDecoder(reader); err != nil {
-			return err
-		} else if meta.Version >= 5 {
+	ingest.DataTypeComputer: func(batch *IngestContext, decoder IngestDecoder, meta ingest.OriginalMetadata) error {
+		if meta.Version >= 5 {
 			return decodeBasicData(batch, decoder, convertComputerData)
 		} else {
 			return nil
 		}
 	},
-	ingest.DataTypeGroup: func(batch *IngestContext, reader io.ReadSeeker, meta ingest.OriginalMetadata) error {
-		if decoder, err := getDefaultDecoder(reader); err != nil {
-			return err
-		} else {
-			return decodeGroupData(batch, decoder)
-		}
+	ingest.DataTypeGroup: func(batch *IngestContext, decoder IngestDecoder, meta ingest.OriginalMetadata) error {
+		return decodeGroupData(batch, decoder)
 	},
-	ingest.DataTypeSession: func(batch *IngestContext, reader io.ReadSeeker, meta ingest.OriginalMetadata) error {
-		if decoder, err := getDefaultDecoder(reader); err != nil {
-			return err
-		} else {
-			return decodeSessionData(batch, decoder)
-		}
+	ingest.DataTypeSession: func(batch *IngestContext, decoder IngestDecoder, meta ingest.OriginalMetadata) error {
+		return decodeSessionData(batch, decoder)
 	},
-	ingest.DataTypeAzure: func(batch *IngestContext, reader io.ReadSeeker, meta ingest.OriginalMetadata) error {
-		if decoder, err := getDefaultDecoder(reader); err != nil {
-			return err
-		} else {
-			return decodeAzureData(batch, decoder)
-		}
+	ingest.DataTypeAzure: func(batch *IngestContext, decoder IngestDecoder, meta ingest.OriginalMetadata) error {
+		return decodeAzureData(batch, decoder)
 	},
 	ingest.DataTypeUser:           defaultBasicHandler(convertUserData),
 	ingest.DataTypeDomain:         defaultBasicHandler(convertDomainData),
@@ -352,7 +363,10 @@ var basicHandlers = map[ingest.DataType]basicIngestHandler{
 
 var sourceKindHandlers = map[ingest.DataType]sourceKindIngestHandler{
 	ingest.DataTypeOpenGraph: func(batch *IngestContext, reader io.ReadSeeker, meta ingest.OriginalMetadata, registerSourceKind registrationFn) error {
-		sourceKind := graph.EmptyKind
+		var (
+			sourceKind = graph.EmptyKind
+			errs       = errorlist.NewBuilder()
+		)
 
 		// decode metadata, if present
 		if decoder, err := CreateIngestDecoder(reader, "metadata", 1); err != nil {
@@ -378,8 +392,15 @@ var sourceKindHandlers = map[ingest.DataType]sourceKindIngestHandler{
 				return err
 			}
 			slog.Debug("No nodes found in opengraph payload; continuing to edges")
-		} else if err := DecodeGenericData(batch, decoder, sourceKind, ConvertGenericNode); err != nil {
-			return err
+		} else if err := DecodeGenericData(batch, decoder, sourceKind, validatedGenericNodeConvertor(batch.PropertySchemas)); err != nil {
+			var conversionErrs errorlist.Error
+
+			// Errors for individual nodes should not prevent edges from being ingested
+			if !errors.As(err, &conversionErrs) {
+				return err
+			}
+
+			errs.Add(err)
 		}
 
 		// decode edges, if present
@@ -388,11 +409,11 @@ var sourceKindHandlers = map[ingest.DataType]sourceKindIngestHandler{
 				return err
 			}
 			slog.Debug("No edges found in opengraph payload")
-		} else {
-			return DecodeGenericData(batch, decoder, sourceKind, ConvertGenericEdge)
+		} else if err := DecodeGenericData(batch, decoder, sourceKind, validatedGenericEdgeConvertor(batch.PropertySchemas)); err != nil {
+			errs.Add(err)
 		}
 
-		return nil
+		return errs.Build()
 	},
 }
 
@@ -120,3 +120,43 @@ func Test_ReadFileForIngest(t *testing.T) {
 		})
 	})
 }
+
+// verifies that NDJSON payloads are decoded through the same convertors as JSON payloads and that bad lines are skipped
+// and reported instead of failing the whole file
+func Test_ReadFileForIngest_NDJSON(t *testing.T) {
+	var (
+		ingestSchema, _ = upload.LoadIngestSchema()
+		payload         = bytes.NewReader([]byte(`{"metadata": {"source_kind": "NDJSONBase"}}
+{"start": {"value": "1234"}, "end": {"value": "5678"}, "kind": "RelA"}
+{"id": "1234", "kinds": ["kindA"], "properties": {"hello": "world"}}
+{"id": 5678
+{"id": "5678", "kinds": ["kindB"]}
+`))
+		readOptions = graphify.ReadOptions{
+			IngestSchema:       ingestSchema,
+			FileType:           model.FileTypeNDJSON,
+			RegisterSourceKind: func(k graph.Kind) error { return nil }, // stub this out
+		}
+	)
+
+	testContext := integration.NewGraphTestContext(t, graphschema.DefaultGraphSchema())
+
+	testContext.BatchTest(func(harness integration.HarnessDetails, batch graph.Batch) {
+		ingestContext := graphify.NewIngestContext(testContext.Context(), graphify.WithBatchUpdater(batch), graphify.WithEndpointResolver(endpoint.NewResolver(testContext.Graph.Database)))
+
+		err := graphify.ReadFileForIngest(ingestContext, payload, readOptions)
+
+		var lineErr upload.LineError
+		require.ErrorAs(t, err, &lineErr)
+		require.Equal(t, 4, lineErr.Line)
+	}, func(details integration.HarnessDetails, tx graph.Transaction) {
+		numNodes, err := tx.Nodes().Filter(query.Kind(query.Node(), graph.StringKind("NDJSONBase"))).Count()
+		require.Nil(t, err)
+		require.Equal(t, int64(2), numNodes)
+
+		// the edge precedes its nodes in the payload but is still ingested after them
+		numRelationships, err := tx.Relationships().Filter(query.Kind(query.Relationship(), graph.StringKind("RelA"))).Count()
+		require.Nil(t, err)
+		require.Equal(t, int64(1), numRelationships)
+	})
+}
@@ -0,0 +1,198 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graphify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model/ingest"
+	"github.com/specterops/bloodhound/cmd/api/src/services/upload"
+	"github.com/specterops/bloodhound/packages/go/errorlist"
+	"github.com/specterops/dawgs/graph"
+)
+
+// ndjsonLineFilter decides whether a line is decoded. Lines that return an error are skipped and reported as
+// warnings, lines that return false are skipped silently.
+type ndjsonLineFilter func(line upload.NDJSONLine) (bool, error)
+
+// ndjsonDecoder implements IngestDecoder over the lines of an NDJSON payload. Lines that can not be decoded are
+// skipped and collected as upload.LineError values instead of failing the decode.
+type ndjsonDecoder struct {
+	lines    *upload.NDJSONReader
+	filter   ndjsonLineFilter
+	next     *upload.NDJSONLine
+	err      error
+	warnings *errorlist.ErrorBuilder
+}
+
+func newNDJSONDecoder(lines *upload.NDJSONReader, filter ndjsonLineFilter) *ndjsonDecoder {
+	return &ndjsonDecoder{
+		lines:    lines,
+		filter:   filter,
+		warnings: errorlist.NewBuilder(),
+	}
+}
+
+// More reports whether there is another line to decode. Read errors are surfaced by the next call to Decode.
+func (s *ndjsonDecoder) More() bool {
+	for s.next == nil && s.err == nil {
+		if line, err := s.lines.Next(); err != nil {
+			s.err = err
+		} else if s.filter == nil {
+			s.next = &line
+		} else if include, err := s.filter(line); err != nil {
+			s.warnings.Add(err)
+		} else if include {
+			s.next = &line
+		}
+	}
+
+	return s.next != nil
+}
+
+func (s *ndjsonDecoder) Decode(v any) error {
+	for s.More() {
+		line := s.next
+		s.next = nil
+
+		if err := json.Unmarshal(line.Data, v); err != nil {
+			s.warnings.Add(upload.LineError{Line: line.Number, Err: err})
+
+			// Unmarshal may have partially populated v before failing; reset it for the next line
+			reflect.ValueOf(v).Elem().SetZero()
+			continue
+		}
+
+		return nil
+	}
+
+	return s.err
+}
+
+// Warnings returns the lines that were skipped, or nil if every line was decoded
+func (s *ndjsonDecoder) Warnings() error {
+	return s.warnings.Build()
+}
+
+// ReadNDJSONForIngest ingests a newline-delimited JSON payload. The first line carries the payload metadata and
+// every following line a single node, edge or collector object, which are decoded with the same convertors as
+// their JSON counterparts.
+//
+// Lines that fail to decode or, for OpenGraph payloads, fail schema validation are skipped and returned as
+// upload.LineError warnings alongside any other ingest errors.
+func ReadNDJSONForIngest(batch *IngestContext, reader io.ReadSeeker, options ReadOptions) error {
+	if _, err := reader.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("error seeking to start of file: %w", err)
+	}
+
+	lines := upload.NewNDJSONReader(reader)
+
+	if metaLine, err := lines.Next(); errors.Is(err, io.EOF) {
+		return ingest.ErrNoTagFound
+	} else if err != nil {
+		return err
+	} else if meta, graphMetadata, err := upload.ParseNDJSONMeta(metaLine, options.IngestSchema); err != nil {
+		return err
+	} else if meta.Type == ingest.DataTypeOpenGraph {
+		return ingestOpenGraphNDJSON(batch, reader, graphMetadata, options)
+	} else if handler, ok := basicHandlers[meta.Type]; !ok {
+		return fmt.Errorf("no handler for ingest data type: %v", meta.Type)
+	} else {
+		var (
+			decoder = newNDJSONDecoder(lines, nil)
+			errs    = errorlist.NewBuilder()
+		)
+
+		errs.Add(handler(batch, decoder, meta))
+		errs.Add(decoder.Warnings())
+
+		return errs.Build()
+	}
+}
+
+// ingestOpenGraphNDJSON makes two passes over an OpenGraph NDJSON payload so that, as with JSON payloads, every node
+// is ingested before the edges that may reference it.
+func ingestOpenGraphNDJSON(batch *IngestContext, reader io.ReadSeeker, graphMetadata ingest.OpengraphMetadata, options ReadOptions) error {
+	var (
+		sourceKind = graph.EmptyKind
+		errs       = errorlist.NewBuilder()
+	)
+
+	if graphMetadata.SourceKind != "" {
+		if options.RegisterSourceKind == nil {
+			return fmt.Errorf("missing source kind registration function for data type: %v", ingest.DataTypeOpenGraph)
+		}
+
+		sourceKind = graph.StringKind(graphMetadata.SourceKind)
+		if err := options.RegisterSourceKind(sourceKind); err != nil {
+			return fmt.Errorf("failed to register sourceKind: %w", err)
+		}
+	}
+
+	// Both passes validate every line, so bad lines are only reported by the node pass
+	nodeDecoder, err := seekNDJSONData(reader, func(line upload.NDJSONLine) (bool, error) {
+		isEdge, err := upload.ValidateNDJSONGraphLine(line, options.IngestSchema)
+		return !isEdge, err
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := DecodeGenericData(batch, nodeDecoder, sourceKind, validatedGenericNodeConvertor(batch.PropertySchemas)); err != nil {
+		var conversionErrs errorlist.Error
+
+		// Errors for individual nodes should not prevent edges from being ingested
+		if !errors.As(err, &conversionErrs) {
+			return err
+		}
+
+		errs.Add(err)
+	}
+
+	errs.Add(nodeDecoder.Warnings())
+
+	edgeDecoder, err := seekNDJSONData(reader, func(line upload.NDJSONLine) (bool, error) {
+		isEdge, err := upload.ValidateNDJSONGraphLine(line, options.IngestSchema)
+		return isEdge && err == nil, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	errs.Add(DecodeGenericData(batch, edgeDecoder, sourceKind, validatedGenericEdgeConvertor(batch.PropertySchemas)))
+	errs.Add(edgeDecoder.Warnings())
+
+	return errs.Build()
+}
+
+// seekNDJSONData rewinds the payload and returns a decoder positioned after its meta line
+func seekNDJSONData(reader io.ReadSeeker, filter ndjsonLineFilter) (*ndjsonDecoder, error) {
+	if _, err := reader.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("error seeking to start of file: %w", err)
+	}
+
+	lines := upload.NewNDJSONReader(reader)
+	if _, err := lines.Next(); err != nil {
+		return nil, fmt.Errorf("error reading meta line: %w", err)
+	}
+
+	return newNDJSONDecoder(lines, filter), nil
+}
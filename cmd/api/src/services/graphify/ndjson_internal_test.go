@@ -0,0 +1,107 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graphify
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/services/upload"
+	"github.com/specterops/bloodhound/packages/go/errorlist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ndjsonTestEntity struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func decodeAllNDJSON(t *testing.T, decoder *ndjsonDecoder) []ndjsonTestEntity {
+	t.Helper()
+
+	var entities []ndjsonTestEntity
+	for decoder.More() {
+		var entity ndjsonTestEntity
+		if err := decoder.Decode(&entity); errors.Is(err, io.EOF) {
+			break
+		} else {
+			require.Nil(t, err)
+		}
+
+		entities = append(entities, entity)
+	}
+
+	return entities
+}
+
+func TestNDJSONDecoder(t *testing.T) {
+	t.Run("bad lines are skipped and reported", func(t *testing.T) {
+		var (
+			lines   = upload.NewNDJSONReader(strings.NewReader("{\"name\": \"a\", \"count\": 1}\n{\"name\": \"b\", \"count\": \"two\"}\n\n{\"name\": \n{\"name\": \"c\"}\n"))
+			decoder = newNDJSONDecoder(lines, nil)
+		)
+
+		// the partially decoded second line must not leak into the third entity
+		assert.Equal(t, []ndjsonTestEntity{{Name: "a", Count: 1}, {Name: "c"}}, decodeAllNDJSON(t, decoder))
+
+		var warnings errorlist.Error
+		require.ErrorAs(t, decoder.Warnings(), &warnings)
+		require.Len(t, warnings.Errors, 2)
+
+		var lineErr upload.LineError
+		require.ErrorAs(t, warnings.Errors[0], &lineErr)
+		assert.Equal(t, 2, lineErr.Line)
+		require.ErrorAs(t, warnings.Errors[1], &lineErr)
+		assert.Equal(t, 4, lineErr.Line)
+	})
+
+	t.Run("trailing bad lines end the decode", func(t *testing.T) {
+		var (
+			lines   = upload.NewNDJSONReader(strings.NewReader("{\"name\": \"a\"}\nnot json\n"))
+			decoder = newNDJSONDecoder(lines, nil)
+		)
+
+		assert.Equal(t, []ndjsonTestEntity{{Name: "a"}}, decodeAllNDJSON(t, decoder))
+		assert.False(t, decoder.More())
+		assert.Error(t, decoder.Warnings())
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		var (
+			lines   = upload.NewNDJSONReader(strings.NewReader("{\"name\": \"a\"}\n{\"name\": \"skip\"}\n{\"name\": \"reject\"}\n{\"name\": \"b\"}\n"))
+			decoder = newNDJSONDecoder(lines, func(line upload.NDJSONLine) (bool, error) {
+				if strings.Contains(string(line.Data), "reject") {
+					return false, upload.LineError{Line: line.Number, Err: errors.New("rejected")}
+				}
+				return !strings.Contains(string(line.Data), "skip"), nil
+			})
+		)
+
+		assert.Equal(t, []ndjsonTestEntity{{Name: "a"}, {Name: "b"}}, decodeAllNDJSON(t, decoder))
+		assert.EqualError(t, decoder.Warnings(), "line 3: rejected")
+	})
+
+	t.Run("no warnings", func(t *testing.T) {
+		decoder := newNDJSONDecoder(upload.NewNDJSONReader(strings.NewReader("{\"name\": \"a\"}")), nil)
+
+		assert.Equal(t, []ndjsonTestEntity{{Name: "a"}}, decodeAllNDJSON(t, decoder))
+		assert.Nil(t, decoder.Warnings())
+	})
+}
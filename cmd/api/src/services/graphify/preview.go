@@ -0,0 +1,304 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graphify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/query"
+)
+
+// IngestPreview collects the changes a dry run ingest task would make to the graph. Entities are compared against the
+// graph as it was before the task, entities that appear more than once in a task are only recorded the first time.
+type IngestPreview struct {
+	lock       sync.Mutex
+	report     model.IngestPreviewReport
+	knownKinds map[graph.Kind]struct{}
+	seenNodes  map[string]struct{}
+	seenEdges  map[string]struct{}
+}
+
+// NewIngestPreview creates an empty preview. Kinds of ingested entities that are not in knownKinds are reported as new.
+func NewIngestPreview(knownKinds graph.Kinds) *IngestPreview {
+	preview := &IngestPreview{
+		report:     model.NewIngestPreviewReport(),
+		knownKinds: make(map[graph.Kind]struct{}, len(knownKinds)),
+		seenNodes:  map[string]struct{}{},
+		seenEdges:  map[string]struct{}{},
+	}
+
+	for _, kind := range knownKinds {
+		preview.knownKinds[kind] = struct{}{}
+	}
+
+	return preview
+}
+
+// Report returns a copy of the changes recorded so far
+func (s *IngestPreview) Report() model.IngestPreviewReport {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	report := model.NewIngestPreviewReport()
+	report.Merge(s.report)
+
+	return report
+}
+
+// RegisterSourceKind is the registrationFn of dry runs, source kinds are reported instead of being registered
+func (s *IngestPreview) RegisterSourceKind(kind graph.Kind) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.recordKinds(kind)
+	return nil
+}
+
+func (s *IngestPreview) addUnresolvedEndpoint(message string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.report.AddUnresolvedEndpoint(message)
+}
+
+func (s *IngestPreview) addSchemaWarning(message string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.report.AddSchemaWarning(message)
+}
+
+// recordKinds must be called with the lock held
+func (s *IngestPreview) recordKinds(kinds ...graph.Kind) {
+	for _, kind := range kinds {
+		if kind == graph.EmptyKind {
+			continue
+		} else if _, known := s.knownKinds[kind]; !known {
+			s.knownKinds[kind] = struct{}{}
+			s.report.AddNewKind(kind.String())
+		}
+	}
+}
+
+func previewIdentity(node *graph.Node, identityProperties []string) string {
+	values := make([]string, 0, len(identityProperties))
+
+	for _, identityProperty := range identityProperties {
+		values = append(values, fmt.Sprint(node.Properties.Get(identityProperty).Any()))
+	}
+
+	return strings.Join(values, ",")
+}
+
+func previewNode(node *graph.Node, identityProperties []string) model.IngestPreviewNode {
+	return model.IngestPreviewNode{
+		Identity: previewIdentity(node, identityProperties),
+		Kinds:    node.Kinds.Strings(),
+	}
+}
+
+// fetchExistingNode looks a node up the same way a batch upsert matches it
+func fetchExistingNode(tx graph.Transaction, identityKind graph.Kind, identityProperties []string, node *graph.Node) (*graph.Node, error) {
+	criteria := make([]graph.Criteria, 0, len(identityProperties)+1)
+
+	if identityKind != graph.EmptyKind {
+		criteria = append(criteria, query.Kind(query.Node(), identityKind))
+	}
+
+	for _, identityProperty := range identityProperties {
+		criteria = append(criteria, query.Equals(query.NodeProperty(identityProperty), node.Properties.Get(identityProperty).Any()))
+	}
+
+	if existing, err := tx.Nodes().Filter(query.And(criteria...)).First(); graph.IsErrNotFound(err) {
+		return nil, nil
+	} else {
+		return existing, err
+	}
+}
+
+// propertyValuesEqual compares property values by their JSON encoding, the graph does not preserve the numeric types
+// of ingested values
+func propertyValuesEqual(a, b any) bool {
+	if encodedA, err := json.Marshal(a); err != nil {
+		return false
+	} else if encodedB, err := json.Marshal(b); err != nil {
+		return false
+	} else {
+		return bytes.Equal(encodedA, encodedB)
+	}
+}
+
+func diffNode(existing, update *graph.Node) ([]string, []model.IngestPreviewPropertyChange) {
+	var (
+		addedKinds        []string
+		changedProperties []model.IngestPreviewPropertyChange
+	)
+
+	for _, kind := range update.Kinds {
+		if !existing.Kinds.ContainsOneOf(kind) {
+			addedKinds = append(addedKinds, kind.String())
+		}
+	}
+
+	for name, newValue := range update.Properties.Map {
+		// Every ingest moves lastseen forward, it is not a change to the data
+		if name == common.LastSeen.String() {
+			continue
+		}
+
+		if oldValue, found := existing.Properties.Map[name]; !found || !propertyValuesEqual(oldValue, newValue) {
+			changedProperties = append(changedProperties, model.IngestPreviewPropertyChange{
+				Name:     name,
+				OldValue: oldValue,
+				NewValue: newValue,
+			})
+		}
+	}
+
+	return addedKinds, changedProperties
+}
+
+// previewBatchUpdater is the BatchUpdater of dry runs. Updates are compared against the graph through a read
+// transaction and recorded in the preview, nothing is written.
+type previewBatchUpdater struct {
+	tx      graph.Transaction
+	preview *IngestPreview
+}
+
+// NewPreviewBatchUpdater creates a BatchUpdater that records the updates submitted to it in the preview
+func NewPreviewBatchUpdater(tx graph.Transaction, preview *IngestPreview) BatchUpdater {
+	return &previewBatchUpdater{
+		tx:      tx,
+		preview: preview,
+	}
+}
+
+// previewNodeUpsert records the node of an upsert and returns the graph ID of the node if it is already in the graph.
+// Relationship endpoints are only recorded when they would be created, as the upsert only moves their lastseen forward.
+func (s *previewBatchUpdater) previewNodeUpsert(identityKind graph.Kind, identityProperties []string, node *graph.Node, isEndpoint bool) (graph.ID, bool, error) {
+	existing, err := fetchExistingNode(s.tx, identityKind, identityProperties, node)
+	if err != nil {
+		return 0, false, err
+	}
+
+	s.preview.lock.Lock()
+	defer s.preview.lock.Unlock()
+
+	var (
+		key         = identityKind.String() + "|" + previewIdentity(node, identityProperties)
+		_, seen     = s.preview.seenNodes[key]
+		recordNodes = !seen && (existing == nil || !isEndpoint)
+	)
+
+	s.preview.recordKinds(node.Kinds...)
+
+	if recordNodes {
+		// Endpoints of existing nodes stay unmarked so that the node itself is still compared if it is ingested later
+		s.preview.seenNodes[key] = struct{}{}
+
+		if existing == nil {
+			s.preview.report.AddNodeToCreate(previewNode(node, identityProperties))
+		} else if addedKinds, changedProperties := diffNode(existing, node); len(addedKinds) > 0 || len(changedProperties) > 0 {
+			s.preview.report.AddNodeToUpdate(model.IngestPreviewNodeUpdate{
+				IngestPreviewNode: previewNode(existing, identityProperties),
+				AddedKinds:        addedKinds,
+				ChangedProperties: changedProperties,
+			})
+		} else {
+			s.preview.report.Counts.NodesUnchanged++
+		}
+	}
+
+	if existing == nil {
+		return 0, false, nil
+	}
+
+	return existing.ID, true, nil
+}
+
+func (s *previewBatchUpdater) UpdateNodeBy(update graph.NodeUpdate) error {
+	_, _, err := s.previewNodeUpsert(update.IdentityKind, update.IdentityProperties, update.Node, false)
+	return err
+}
+
+func (s *previewBatchUpdater) UpdateRelationshipBy(update graph.RelationshipUpdate) error {
+	startID, startExists, err := s.previewNodeUpsert(update.StartIdentityKind, update.StartIdentityProperties, update.Start, true)
+	if err != nil {
+		return err
+	}
+
+	endID, endExists, err := s.previewNodeUpsert(update.EndIdentityKind, update.EndIdentityProperties, update.End, true)
+	if err != nil {
+		return err
+	}
+
+	exists := false
+	if startExists && endExists {
+		if count, err := s.tx.Relationships().Filter(query.And(
+			query.Equals(query.StartID(), startID),
+			query.Equals(query.EndID(), endID),
+			query.Kind(query.Relationship(), update.Relationship.Kind),
+		)).Count(); err != nil {
+			return err
+		} else {
+			exists = count > 0
+		}
+	}
+
+	s.preview.lock.Lock()
+	defer s.preview.lock.Unlock()
+
+	var (
+		edge = model.IngestPreviewEdge{
+			Kind:  update.Relationship.Kind.String(),
+			Start: previewIdentity(update.Start, update.StartIdentityProperties),
+			End:   previewIdentity(update.End, update.EndIdentityProperties),
+		}
+		key = edge.Kind + "|" + edge.Start + "|" + edge.End
+	)
+
+	s.preview.recordKinds(update.Relationship.Kind)
+
+	if _, seen := s.preview.seenEdges[key]; seen {
+		return nil
+	}
+
+	s.preview.seenEdges[key] = struct{}{}
+
+	if exists {
+		s.preview.report.Counts.EdgesUnchanged++
+	} else {
+		s.preview.report.AddEdgeToAdd(edge)
+	}
+
+	return nil
+}
+
+func (s *previewBatchUpdater) Nodes() graph.NodeQuery {
+	return s.tx.Nodes()
+}
+
+func (s *previewBatchUpdater) Relationships() graph.RelationshipQuery {
+	return s.tx.Relationships()
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+//go:build integration
+
+package graphify
+
+import (
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/services/graphify/endpoint"
+	"github.com/specterops/bloodhound/cmd/api/src/test/integration"
+	"github.com/specterops/bloodhound/packages/go/ein"
+	"github.com/specterops/bloodhound/packages/go/graphschema"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/query"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PreviewBatchUpdater(t *testing.T) {
+	testContext := integration.NewGraphTestContext(t, graphschema.DefaultGraphSchema())
+
+	testContext.DatabaseTestWithSetup(
+		func(harness *integration.HarnessDetails) error {
+			harness.IngestRelationships.Setup(testContext)
+			return nil
+		},
+		func(harness integration.HarnessDetails, db graph.Database) {
+			knownKinds, err := db.FetchKinds(testContext.Context())
+			require.NoError(t, err)
+
+			var (
+				preview   = NewIngestPreview(knownKinds)
+				computer  = graph.StringKind("Computer")
+				relatedTo = graph.StringKind("preview_related_to")
+				nodes     = []ein.IngestibleNode{
+					{ObjectID: "1234", Labels: graph.Kinds{computer}, PropertyMap: map[string]any{common.Name.String(): "computer a"}},
+					{ObjectID: "5678", Labels: graph.Kinds{computer}, PropertyMap: map[string]any{common.Enabled.String(): true}},
+					{ObjectID: "9999", Labels: graph.Kinds{computer}, PropertyMap: map[string]any{}},
+				}
+				relationships = []ein.IngestibleRelationship{
+					ein.NewIngestibleRelationship(ein.IngestibleEndpoint{Value: "1234"}, ein.IngestibleEndpoint{Value: "5678"}, ein.IngestibleRel{RelType: graph.StringKind("existing_edge_kind")}),
+					ein.NewIngestibleRelationship(ein.IngestibleEndpoint{Value: "1234"}, ein.IngestibleEndpoint{Value: "5678"}, ein.IngestibleRel{RelType: relatedTo}),
+					ein.NewIngestibleRelationship(ein.IngestibleEndpoint{Value: "1234"}, ein.IngestibleEndpoint{Value: "5678"}, ein.IngestibleRel{RelType: relatedTo}),
+				}
+			)
+
+			err = db.ReadTransaction(testContext.Context(), func(tx graph.Transaction) error {
+				ingestContext := NewIngestContext(testContext.Context(), WithEndpointResolver(endpoint.NewResolver(db)), WithPreview(preview))
+				ingestContext.BindBatchUpdater(NewPreviewBatchUpdater(tx, preview))
+
+				require.NoError(t, IngestNodes(ingestContext, graph.EmptyKind, nodes))
+				require.NoError(t, IngestRelationships(ingestContext, graph.EmptyKind, relationships))
+				return nil
+			})
+			require.NoError(t, err)
+
+			report := preview.Report()
+
+			require.Equal(t, int64(1), report.Counts.NodesToCreate)
+			require.Equal(t, "9999", report.NodesToCreate[0].Identity)
+
+			require.Equal(t, int64(1), report.Counts.NodesToUpdate)
+			require.Equal(t, "5678", report.NodesToUpdate[0].Identity)
+			require.Len(t, report.NodesToUpdate[0].ChangedProperties, 1)
+			require.Equal(t, common.Enabled.String(), report.NodesToUpdate[0].ChangedProperties[0].Name)
+			require.Nil(t, report.NodesToUpdate[0].ChangedProperties[0].OldValue)
+			require.Equal(t, true, report.NodesToUpdate[0].ChangedProperties[0].NewValue)
+
+			require.Equal(t, int64(1), report.Counts.NodesUnchanged)
+
+			// The duplicate relationship is only reported once
+			require.Equal(t, int64(1), report.Counts.EdgesToAdd)
+			require.Equal(t, relatedTo.String(), report.EdgesToAdd[0].Kind)
+			require.Equal(t, int64(1), report.Counts.EdgesUnchanged)
+
+			require.Equal(t, []string{relatedTo.String()}, report.NewKinds)
+
+			// Nothing previewed may have been written
+			err = db.ReadTransaction(testContext.Context(), func(tx graph.Transaction) error {
+				if count, err := tx.Nodes().Filter(query.Equals(query.NodeProperty(common.ObjectID.String()), "9999")).Count(); err != nil {
+					return err
+				} else {
+					require.Zero(t, count)
+				}
+
+				if count, err := tx.Relationships().Filter(query.Kind(query.Relationship(), relatedTo)).Count(); err != nil {
+					return err
+				} else {
+					require.Zero(t, count)
+				}
+
+				return nil
+			})
+			require.NoError(t, err)
+		})
+}
@@ -0,0 +1,290 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package propertyschema checks OpenGraph node and edge properties against the property definitions registered by
+// the schema extension that owns the entity's kind. It is shared by ingest and by chow so both apply the same rules.
+package propertyschema
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	DataTypeString  = "string"
+	DataTypeInteger = "integer"
+	DataTypeNumber  = "number"
+	DataTypeBoolean = "boolean"
+	DataTypeArray   = "array"
+)
+
+// IsSupportedDataType returns true if values of the given data type can be checked. Properties without a data type
+// are not type checked.
+func IsSupportedDataType(dataType string) bool {
+	switch dataType {
+	case "", DataTypeString, DataTypeInteger, DataTypeNumber, DataTypeBoolean, DataTypeArray:
+		return true
+	default:
+		return false
+	}
+}
+
+// Entity identifies whether validated properties belong to a node or a relationship
+type Entity string
+
+const (
+	EntityNode         Entity = "node"
+	EntityRelationship Entity = "relationship"
+)
+
+// Action describes what was done with an entity or property that violated its schema
+type Action string
+
+const (
+	// ActionCoerced means the value was safely converted to the declared data type and kept
+	ActionCoerced Action = "coerced"
+	// ActionDropped means the property was removed and the rest of the entity was kept
+	ActionDropped Action = "dropped"
+	// ActionAccepted means the violation was reported but the entity was kept as is
+	ActionAccepted Action = "accepted"
+	// ActionRejected means the entity was not ingested
+	ActionRejected Action = "rejected"
+)
+
+type Violation struct {
+	Property string
+	Reason   string
+	Action   Action
+}
+
+func (s Violation) String() string {
+	return fmt.Sprintf("property %s %s (%s)", s.Property, s.Reason, s.Action)
+}
+
+// Result is the outcome of validating the properties of a single entity
+type Result struct {
+	Extension  string
+	Violations []Violation
+}
+
+// Rejected returns true if the entity should not be ingested
+func (s Result) Rejected() bool {
+	for _, violation := range s.Violations {
+		if violation.Action == ActionRejected {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ViolationError reports the property violations found on a single entity. Ingest reports rejected entities as
+// errors and every other violation as a warning.
+type ViolationError struct {
+	Entity string
+	Result Result
+}
+
+func (s ViolationError) Error() string {
+	violations := make([]string, len(s.Result.Violations))
+
+	for idx, violation := range s.Result.Violations {
+		violations[idx] = violation.String()
+	}
+
+	return fmt.Sprintf("%s violates the property schema of extension %s: %s", s.Entity, s.Result.Extension, strings.Join(violations, ", "))
+}
+
+type Property struct {
+	Name       string
+	DataType   string
+	IsRequired bool
+	EnumValues []string
+}
+
+// Schema holds the property definitions of a single schema extension. Strict schemas reject entities with values
+// that can not be coerced, while lenient schemas drop the offending property and keep the entity.
+type Schema struct {
+	Extension  string
+	Strict     bool
+	Properties []Property
+}
+
+// Validate checks the given properties of an entity against the schema. It returns the properties to ingest, which is
+// a copy of the given map with coerced values replaced and invalid properties removed, along with the violations
+// found. Properties the schema does not define are passed through unchecked.
+//
+// Extension properties are not bound to specific kinds and describe the extension's nodes, so required properties are
+// only enforced on nodes. Relationships are checked against the data type and enum values of the properties they set.
+func (s Schema) Validate(entity Entity, properties map[string]any) (map[string]any, Result) {
+	var (
+		validated = make(map[string]any, len(properties))
+		result    = Result{Extension: s.Extension}
+	)
+
+	for key, value := range properties {
+		validated[key] = value
+	}
+
+	for _, property := range s.Properties {
+		value, found := validated[property.Name]
+
+		if !found || value == nil {
+			if property.IsRequired && entity == EntityNode {
+				result.Violations = append(result.Violations, Violation{
+					Property: property.Name,
+					Reason:   "is required but missing",
+					Action:   s.invalidAction(ActionAccepted),
+				})
+			}
+
+			continue
+		}
+
+		coerced, changed, err := coerce(property.DataType, value)
+		if err != nil {
+			result.Violations = append(result.Violations, Violation{
+				Property: property.Name,
+				Reason:   err.Error(),
+				Action:   s.invalidAction(ActionDropped),
+			})
+
+			delete(validated, property.Name)
+			continue
+		} else if changed {
+			result.Violations = append(result.Violations, Violation{
+				Property: property.Name,
+				Reason:   fmt.Sprintf("was converted from %T to %s", value, property.DataType),
+				Action:   ActionCoerced,
+			})
+
+			validated[property.Name] = coerced
+		}
+
+		if invalidValue, ok := findInvalidEnumValue(property.EnumValues, coerced); !ok {
+			result.Violations = append(result.Violations, Violation{
+				Property: property.Name,
+				Reason:   fmt.Sprintf("value %v is not one of %s", invalidValue, strings.Join(property.EnumValues, ", ")),
+				Action:   s.invalidAction(ActionDropped),
+			})
+
+			delete(validated, property.Name)
+		}
+	}
+
+	return validated, result
+}
+
+// invalidAction returns the action taken for a value that can not be ingested as is
+func (s Schema) invalidAction(lenientAction Action) Action {
+	if s.Strict {
+		return ActionRejected
+	}
+
+	return lenientAction
+}
+
+// coerce converts the value to the given data type where that can be done without losing information. The returned
+// bool is true if the value was changed.
+func coerce(dataType string, value any) (any, bool, error) {
+	switch dataType {
+	case DataTypeString:
+		switch typed := value.(type) {
+		case string:
+			return typed, false, nil
+		case bool, float64, int, int64:
+			return fmt.Sprint(typed), true, nil
+		}
+
+	case DataTypeInteger:
+		switch typed := value.(type) {
+		case int, int64:
+			return typed, false, nil
+		case float64:
+			if typed == math.Trunc(typed) {
+				return typed, false, nil
+			}
+		case string:
+			if parsed, err := strconv.ParseInt(strings.TrimSpace(typed), 10, 64); err == nil {
+				return parsed, true, nil
+			}
+		}
+
+	case DataTypeNumber:
+		switch typed := value.(type) {
+		case float64, int, int64:
+			return typed, false, nil
+		case string:
+			if parsed, err := strconv.ParseFloat(strings.TrimSpace(typed), 64); err == nil && !math.IsNaN(parsed) && !math.IsInf(parsed, 0) {
+				return parsed, true, nil
+			}
+		}
+
+	case DataTypeBoolean:
+		switch typed := value.(type) {
+		case bool:
+			return typed, false, nil
+		case string:
+			if strings.EqualFold(typed, "true") {
+				return true, true, nil
+			} else if strings.EqualFold(typed, "false") {
+				return false, true, nil
+			}
+		}
+
+	case DataTypeArray:
+		if typed, ok := value.([]any); ok {
+			for _, element := range typed {
+				switch element.(type) {
+				case []any, map[string]any:
+					return nil, false, fmt.Errorf("must be an array of primitive values")
+				}
+			}
+
+			return typed, false, nil
+		}
+
+	default:
+		// Data types that can not be checked are passed through as is
+		return value, false, nil
+	}
+
+	return nil, false, fmt.Errorf("expected %s but got %T", dataType, value)
+}
+
+// findInvalidEnumValue returns the first value, or element of an array value, that is not one of the allowed enum
+// values. The returned bool is false if such a value was found.
+func findInvalidEnumValue(enumValues []string, value any) (any, bool) {
+	if len(enumValues) == 0 {
+		return nil, true
+	}
+
+	values, isArray := value.([]any)
+	if !isArray {
+		values = []any{value}
+	}
+
+	for _, element := range values {
+		if !slices.Contains(enumValues, fmt.Sprint(element)) {
+			return element, false
+		}
+	}
+
+	return nil, true
+}
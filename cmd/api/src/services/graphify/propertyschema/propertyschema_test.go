@@ -0,0 +1,206 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package propertyschema_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/services/graphify/propertyschema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchema_Validate(t *testing.T) {
+	schema := propertyschema.Schema{
+		Extension: "TestExtension",
+		Properties: []propertyschema.Property{
+			{Name: "enabled", DataType: propertyschema.DataTypeBoolean},
+			{Name: "count", DataType: propertyschema.DataTypeInteger},
+			{Name: "score", DataType: propertyschema.DataTypeNumber},
+			{Name: "label", DataType: propertyschema.DataTypeString},
+			{Name: "tags", DataType: propertyschema.DataTypeArray, EnumValues: []string{"a", "b"}},
+			{Name: "state", DataType: propertyschema.DataTypeString, EnumValues: []string{"on", "off"}},
+			{Name: "owner", DataType: propertyschema.DataTypeString, IsRequired: true},
+		},
+	}
+
+	t.Run("valid properties are kept unchanged", func(t *testing.T) {
+		properties := map[string]any{
+			"enabled": true,
+			"count":   float64(3),
+			"score":   1.5,
+			"label":   "name",
+			"tags":    []any{"a"},
+			"state":   "on",
+			"owner":   "someone",
+			"other":   map[string]any{"unchecked": true},
+		}
+
+		validated, result := schema.Validate(propertyschema.EntityNode, properties)
+		require.Empty(t, result.Violations)
+		require.Equal(t, properties, validated)
+	})
+
+	t.Run("safe values are coerced", func(t *testing.T) {
+		validated, result := schema.Validate(propertyschema.EntityNode, map[string]any{
+			"enabled": "TRUE",
+			"count":   "42",
+			"score":   "0.25",
+			"label":   float64(7),
+			"owner":   "someone",
+		})
+
+		require.False(t, result.Rejected())
+		require.Len(t, result.Violations, 4)
+		for _, violation := range result.Violations {
+			require.Equal(t, propertyschema.ActionCoerced, violation.Action)
+		}
+
+		require.Equal(t, true, validated["enabled"])
+		require.Equal(t, int64(42), validated["count"])
+		require.Equal(t, 0.25, validated["score"])
+		require.Equal(t, "7", validated["label"])
+	})
+
+	t.Run("invalid values are dropped by lenient schemas", func(t *testing.T) {
+		properties := map[string]any{
+			"enabled": "yes",
+			"count":   1.5,
+			"tags":    []any{"a", "c"},
+			"state":   "unknown",
+		}
+
+		validated, result := schema.Validate(propertyschema.EntityNode, properties)
+		require.False(t, result.Rejected())
+		require.Len(t, result.Violations, 5)
+
+		for _, violation := range result.Violations {
+			if violation.Property == "owner" {
+				require.Equal(t, propertyschema.ActionAccepted, violation.Action)
+			} else {
+				require.Equal(t, propertyschema.ActionDropped, violation.Action)
+			}
+		}
+
+		require.Empty(t, validated)
+		require.Equal(t, "yes", properties["enabled"], "input properties must not be modified")
+	})
+
+	t.Run("invalid values reject the entity for strict schemas", func(t *testing.T) {
+		strictSchema := schema
+		strictSchema.Strict = true
+
+		_, result := strictSchema.Validate(propertyschema.EntityNode, map[string]any{"enabled": "yes", "owner": "someone"})
+		require.True(t, result.Rejected())
+
+		_, result = strictSchema.Validate(propertyschema.EntityNode, map[string]any{"enabled": false})
+		require.True(t, result.Rejected())
+		require.Equal(t, "owner", result.Violations[0].Property)
+
+		_, result = strictSchema.Validate(propertyschema.EntityNode, map[string]any{"enabled": "false", "owner": "someone"})
+		require.False(t, result.Rejected())
+		require.Equal(t, propertyschema.ActionCoerced, result.Violations[0].Action)
+	})
+
+	t.Run("required properties are only enforced on nodes", func(t *testing.T) {
+		strictSchema := schema
+		strictSchema.Strict = true
+
+		validated, result := strictSchema.Validate(propertyschema.EntityRelationship, map[string]any{"enabled": false})
+		require.Empty(t, result.Violations)
+		require.Equal(t, map[string]any{"enabled": false}, validated)
+
+		_, result = strictSchema.Validate(propertyschema.EntityRelationship, map[string]any{"enabled": "yes"})
+		require.True(t, result.Rejected())
+		require.Len(t, result.Violations, 1)
+		require.Equal(t, "enabled", result.Violations[0].Property)
+	})
+}
+
+func TestRegistry_Validate(t *testing.T) {
+	var (
+		extensions = model.GraphSchemaExtensions{
+			{Serial: model.Serial{ID: 1}, Name: "Lenient"},
+			{Serial: model.Serial{ID: 2}, Name: "Strict", StrictPropertyValidation: true},
+		}
+		properties = model.GraphSchemaProperties{
+			{SchemaExtensionId: 1, Name: "enabled", DataType: propertyschema.DataTypeBoolean},
+			{SchemaExtensionId: 2, Name: "enabled", DataType: propertyschema.DataTypeBoolean},
+		}
+		nodeKinds = model.GraphSchemaNodeKinds{
+			{SchemaExtensionId: 1, Name: "LE_User"},
+			{SchemaExtensionId: 2, Name: "ST_User"},
+		}
+		relationshipKinds = model.GraphSchemaRelationshipKinds{
+			{SchemaExtensionId: 2, Name: "ST_MemberOf"},
+		}
+
+		registry = propertyschema.NewRegistryFromExtensions(extensions, properties, nodeKinds, relationshipKinds)
+		invalid  = map[string]any{"enabled": "yes"}
+	)
+
+	validated, result := registry.Validate(propertyschema.EntityNode, []string{"Unknown", "LE_User"}, invalid)
+	require.Equal(t, "Lenient", result.Extension)
+	require.False(t, result.Rejected())
+	require.Empty(t, validated)
+
+	_, result = registry.Validate(propertyschema.EntityRelationship, []string{"ST_MemberOf"}, invalid)
+	require.Equal(t, "Strict", result.Extension)
+	require.True(t, result.Rejected())
+
+	validated, result = registry.Validate(propertyschema.EntityNode, []string{"Unknown"}, invalid)
+	require.Empty(t, result.Violations)
+	require.Equal(t, invalid, validated)
+
+	validated, result = registry.Validate(propertyschema.EntityRelationship, []string{"ST_User"}, invalid)
+	require.Empty(t, result.Violations, "node kinds must not be matched for relationships")
+	require.Equal(t, invalid, validated)
+
+	var nilRegistry *propertyschema.Registry
+	validated, result = nilRegistry.Validate(propertyschema.EntityNode, []string{"LE_User"}, invalid)
+	require.Empty(t, result.Violations)
+	require.Equal(t, invalid, validated)
+}
+
+func TestLoadExtensionDefinition(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		registry, err := propertyschema.LoadExtensionDefinition(strings.NewReader(`{
+			"schema": {"name": "TestExtension", "namespace": "TE", "strict_property_validation": true},
+			"node_kinds": [{"name": "TE_User"}],
+			"relationship_kinds": [{"name": "TE_MemberOf"}],
+			"properties": [{"name": "state", "data_type": "string", "is_required": true, "enum_values": ["on", "off"]}]
+		}`))
+		require.NoError(t, err)
+
+		schema, found := registry.Lookup(propertyschema.EntityRelationship, "TE_MemberOf")
+		require.True(t, found)
+		require.True(t, schema.Strict)
+		require.Equal(t, []propertyschema.Property{{Name: "state", DataType: "string", IsRequired: true, EnumValues: []string{"on", "off"}}}, schema.Properties)
+
+		_, found = registry.Lookup(propertyschema.EntityNode, "TE_User")
+		require.True(t, found)
+
+		_, found = registry.Lookup(propertyschema.EntityNode, "TE_MemberOf")
+		require.False(t, found)
+	})
+
+	t.Run("unsupported data type", func(t *testing.T) {
+		_, err := propertyschema.LoadExtensionDefinition(strings.NewReader(`{"properties": [{"name": "state", "data_type": "datetime"}]}`))
+		require.ErrorContains(t, err, "unsupported data type datetime")
+	})
+}
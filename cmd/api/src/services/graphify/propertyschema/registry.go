@@ -0,0 +1,186 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package propertyschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+)
+
+// Registry maps node kinds and relationship kinds to the property schema of the extension that owns them. Node and
+// relationship kinds are kept apart so that a kind is only ever validated as the entity its extension declared it
+// for. A nil Registry is valid and performs no validation.
+type Registry struct {
+	schemas map[Entity]map[string]*Schema
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		schemas: map[Entity]map[string]*Schema{
+			EntityNode:         make(map[string]*Schema),
+			EntityRelationship: make(map[string]*Schema),
+		},
+	}
+}
+
+// Register associates the schema with each of the given node or relationship kind names
+func (s *Registry) Register(schema Schema, entity Entity, kinds ...string) {
+	registered := &schema
+
+	for _, kind := range kinds {
+		s.schemas[entity][kind] = registered
+	}
+}
+
+// Lookup returns the schema owning the first of the given node or relationship kinds that has one registered
+func (s *Registry) Lookup(entity Entity, kinds ...string) (Schema, bool) {
+	if s == nil {
+		return Schema{}, false
+	}
+
+	for _, kind := range kinds {
+		if schema, found := s.schemas[entity][kind]; found {
+			return *schema, true
+		}
+	}
+
+	return Schema{}, false
+}
+
+// Validate checks the properties of a node or relationship against the schema of the extension owning its kinds.
+// Properties of entities whose kinds are not owned by any extension, or whose extension defines no properties, are
+// returned unchanged.
+func (s *Registry) Validate(entity Entity, kinds []string, properties map[string]any) (map[string]any, Result) {
+	if schema, found := s.Lookup(entity, kinds...); !found || len(schema.Properties) == 0 {
+		return properties, Result{}
+	} else {
+		return schema.Validate(entity, properties)
+	}
+}
+
+// NewRegistryFromExtensions builds a registry from the schema extensions, properties and kinds stored in the database
+func NewRegistryFromExtensions(extensions model.GraphSchemaExtensions, properties model.GraphSchemaProperties, nodeKinds model.GraphSchemaNodeKinds, relationshipKinds model.GraphSchemaRelationshipKinds) *Registry {
+	var (
+		registry              = NewRegistry()
+		schemas               = make(map[int32]Schema, len(extensions))
+		nodeKindNames         = make(map[int32][]string, len(extensions))
+		relationshipKindNames = make(map[int32][]string, len(extensions))
+	)
+
+	for _, extension := range extensions {
+		schemas[extension.ID] = Schema{
+			Extension: extension.Name,
+			Strict:    extension.StrictPropertyValidation,
+		}
+	}
+
+	for _, property := range properties {
+		if schema, found := schemas[property.SchemaExtensionId]; found {
+			schema.Properties = append(schema.Properties, Property{
+				Name:       property.Name,
+				DataType:   property.DataType,
+				IsRequired: property.IsRequired,
+				EnumValues: property.EnumValues,
+			})
+
+			schemas[property.SchemaExtensionId] = schema
+		}
+	}
+
+	for _, nodeKind := range nodeKinds {
+		nodeKindNames[nodeKind.SchemaExtensionId] = append(nodeKindNames[nodeKind.SchemaExtensionId], nodeKind.Name)
+	}
+
+	for _, relationshipKind := range relationshipKinds {
+		relationshipKindNames[relationshipKind.SchemaExtensionId] = append(relationshipKindNames[relationshipKind.SchemaExtensionId], relationshipKind.Name)
+	}
+
+	for extensionID, schema := range schemas {
+		registry.Register(schema, EntityNode, nodeKindNames[extensionID]...)
+		registry.Register(schema, EntityRelationship, relationshipKindNames[extensionID]...)
+	}
+
+	return registry
+}
+
+// ExtensionDefinition is the subset of an OpenGraph extension definition file needed to validate properties offline
+type ExtensionDefinition struct {
+	Schema struct {
+		Name                     string `json:"name"`
+		StrictPropertyValidation bool   `json:"strict_property_validation"`
+	} `json:"schema"`
+	NodeKinds []struct {
+		Name string `json:"name"`
+	} `json:"node_kinds"`
+	RelationshipKinds []struct {
+		Name string `json:"name"`
+	} `json:"relationship_kinds"`
+	Properties []struct {
+		Name       string   `json:"name"`
+		DataType   string   `json:"data_type"`
+		IsRequired bool     `json:"is_required"`
+		EnumValues []string `json:"enum_values"`
+	} `json:"properties"`
+}
+
+// LoadExtensionDefinition reads an OpenGraph extension definition file and returns a registry for its kinds
+func LoadExtensionDefinition(reader io.Reader) (*Registry, error) {
+	var (
+		definition        ExtensionDefinition
+		nodeKinds         []string
+		relationshipKinds []string
+	)
+
+	if err := json.NewDecoder(reader).Decode(&definition); err != nil {
+		return nil, fmt.Errorf("unable to decode extension definition: %w", err)
+	}
+
+	schema := Schema{
+		Extension: definition.Schema.Name,
+		Strict:    definition.Schema.StrictPropertyValidation,
+	}
+
+	for _, property := range definition.Properties {
+		if !IsSupportedDataType(property.DataType) {
+			return nil, fmt.Errorf("property %s has unsupported data type %s", property.Name, property.DataType)
+		}
+
+		schema.Properties = append(schema.Properties, Property{
+			Name:       property.Name,
+			DataType:   property.DataType,
+			IsRequired: property.IsRequired,
+			EnumValues: property.EnumValues,
+		})
+	}
+
+	for _, nodeKind := range definition.NodeKinds {
+		nodeKinds = append(nodeKinds, nodeKind.Name)
+	}
+
+	for _, relationshipKind := range definition.RelationshipKinds {
+		relationshipKinds = append(relationshipKinds, relationshipKind.Name)
+	}
+
+	registry := NewRegistry()
+	registry.Register(schema, EntityNode, nodeKinds...)
+	registry.Register(schema, EntityRelationship, relationshipKinds...)
+
+	return registry, nil
+}
@@ -35,6 +35,12 @@ type GraphifyData interface {
 	DeleteIngestTask(ctx context.Context, ingestTask model.IngestTask) error
 	GetFlagByKey(context.Context, string) (appcfg.FeatureFlag, error)
 
+	// Extension property schemas used to validate OpenGraph properties
+	GetGraphSchemaExtensions(ctx context.Context, extensionFilters model.Filters, sort model.Sort, skip, limit int) (model.GraphSchemaExtensions, int, error)
+	GetGraphSchemaProperties(ctx context.Context, filters model.Filters, sort model.Sort, skip, limit int) (model.GraphSchemaProperties, int, error)
+	GetGraphSchemaNodeKinds(ctx context.Context, nodeKindFilters model.Filters, sort model.Sort, skip, limit int) (model.GraphSchemaNodeKinds, int, error)
+	GetGraphSchemaRelationshipKinds(ctx context.Context, filters model.Filters, sort model.Sort, skip, limit int) (model.GraphSchemaRelationshipKinds, int, error)
+
 	RegisterSourceKind(context.Context) func(sourceKind graph.Kind) error
 }
 
@@ -17,30 +17,34 @@
 package graphify
 
 import (
-	"archive/zip"
 	"context"
 	"errors"
-	"io"
+	"fmt"
 	"io/fs"
 	"log/slog"
 	"os"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/specterops/bloodhound/cmd/api/src/model"
 	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
 	"github.com/specterops/bloodhound/cmd/api/src/services/graphify/endpoint"
+	"github.com/specterops/bloodhound/cmd/api/src/services/graphify/propertyschema"
+	"github.com/specterops/bloodhound/cmd/api/src/services/upload"
 	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
 	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
-	"github.com/specterops/bloodhound/packages/go/bomenc"
 	"github.com/specterops/bloodhound/packages/go/errorlist"
 	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/util/channels"
 )
 
 // UpdateJobFunc is passed to the graphify service to let it tell us about the tasks as they are processed
 //
 // The datapipe doesn't know or care about tasks, and the graphify service doesn't know or care about jobs.
-// Instead, this func is provided as an abstraction for graphify.
-type UpdateJobFunc func(jobId int64, fileData []IngestFileData)
+// Instead, this func is provided as an abstraction for graphify. The preview report is only set for dry run tasks.
+// Tasks of different jobs are processed in parallel, so the func may be called concurrently, but never for the same job.
+type UpdateJobFunc func(jobId int64, fileData []IngestFileData, touchedDomains TouchedDomains, preview *model.IngestPreviewReport)
 
 // clearFileTask removes a generic ingest task for ingested data.
 func (s *GraphifyService) clearFileTask(ingestTask model.IngestTask) {
@@ -57,11 +61,12 @@ type IngestFileData struct {
 	UserDataErrs []string
 }
 
-// extractIngestFiles will take a path and extract zips if necessary, returning the paths for files to process
-// along with any errors and the number of failed files (in the case of a zip archive)
+// extractIngestFiles will take a path and extract archives if necessary, returning the paths for files to process
+// along with any errors. Archive members that could not be extracted are returned with their errors set.
 func (s *GraphifyService) extractIngestFiles(path string, providedFileName string, fileType model.FileType) ([]IngestFileData, error) {
-	if fileType == model.FileTypeJson {
-		// If this isn't a zip file, just return a slice with the path in it and let stuff process as normal
+	switch fileType {
+	case model.FileTypeJson, model.FileTypeNDJSON:
+		// If this isn't an archive, just return a slice with the path in it and let stuff process as normal
 		return []IngestFileData{
 			{
 				Name:   providedFileName,
@@ -69,128 +74,76 @@ func (s *GraphifyService) extractIngestFiles(path string, providedFileName strin
 				Errors: []string{},
 			},
 		}, nil
-	} else if archive, err := zip.OpenReader(path); err != nil {
-		return []IngestFileData{}, err
-	} else {
-		var (
-			errs     = errorlist.NewBuilder()
-			fileData = make([]IngestFileData, 0)
-		)
-
-		defer func() {
-			if err := archive.Close(); err != nil {
-				slog.ErrorContext(
-					s.ctx,
-					"Error closing archive",
-					slog.String("path", path),
-					attr.Error(err),
-				)
-			}
-			if err := os.Remove(path); err != nil {
-				slog.ErrorContext(
-					s.ctx,
-					"Error deleting archive",
-					slog.String("path", path),
-					attr.Error(err),
-				)
-			}
-		}()
-
-		for _, f := range archive.File {
-			// skip directories
-			if f.FileInfo().IsDir() {
-				continue
-			}
-
-			fileName, err := s.extractToTempFile(f)
-			if err != nil {
-				fileData = append(fileData, IngestFileData{
-					Name:       f.Name,
-					ParentFile: providedFileName,
-					Errors:     []string{err.Error()},
-				})
-
-				errs.Add(err)
-			} else {
-				fileData = append(fileData, IngestFileData{
-					Name:       f.Name,
-					ParentFile: providedFileName,
-					Path:       fileName,
-				})
-			}
-		}
-
-		return fileData, errs.Build()
-	}
-}
-
-func (s *GraphifyService) extractToTempFile(f *zip.File) (string, error) {
-	// Given a single artifact in an archive, extract it out to a temporary file
-	tempFile, err := os.CreateTemp(s.cfg.TempDirectory(), "bh")
-	if err != nil {
-		return "", err
-	}
-
-	success := false
-	defer func() {
-		// Always close the tempFile, but...
-		tempFile.Close()
-		if !success {
-			// ... only delete if it wasn't successful. Otherwise we leave it around to be processed
-			os.Remove(tempFile.Name())
-		}
-	}()
-
-	srcFile, err := f.Open()
-	if err != nil {
-		return "", err
-	}
-	defer srcFile.Close()
-
-	// this creates a normalized file to feed to the copy
-	if normFile, err := bomenc.NormalizeToUTF8(srcFile); err != nil {
-		return "", err
-		// and this is what actually copies it to disk
-	} else if _, err := io.Copy(tempFile, normFile); err != nil {
-		return "", err
-	} else {
-		// let the deferred method above know we shouldn't delete it and return the filename
-		success = true
-		return tempFile.Name(), nil
+	case model.FileTypeGzip:
+		return s.extractGzipFiles(path, providedFileName)
+	default:
+		return s.extractZipFiles(path, providedFileName)
 	}
 }
 
 // ProcessIngestFile reads the files at the path supplied, and returns the total number of files in the
 // archive, the number of files that failed to ingest as JSON, and an error
 func (s *GraphifyService) ProcessIngestFile(ic *IngestContext, task model.IngestTask) ([]IngestFileData, error) {
-	// Try to pre-process the file. If any of them fail, stop processing and return the error
-	if fileData, err := s.extractIngestFiles(task.StoredFileName, task.OriginalFileName, task.FileType); err != nil {
+	// Try to pre-process the file. If nothing could be extracted, stop processing and return the error
+	if fileData, err := s.extractIngestFiles(task.StoredFileName, task.OriginalFileName, task.FileType); err != nil && len(fileData) == 0 {
 		return []IngestFileData{}, err
 	} else {
 		errs := errorlist.NewBuilder()
 
-		return fileData, s.graphdb.BatchOperation(ic.Ctx, func(batch graph.Batch) error {
+		// Archive members that failed to extract already carry their errors, the rest of the archive is still ingested
+		errs.Add(err)
+
+		ingestFiles := func(batch BatchUpdater) error {
 			// bind batch to ingest context now that its in scope.
 			ic.BindBatchUpdater(batch)
 			for i, data := range fileData {
+				if data.Path == "" {
+					continue
+				}
+
 				readOpts := ReadOptions{
 					IngestSchema:       s.schema,
 					FileType:           task.FileType,
 					RegisterSourceKind: s.RegisterSourceKind(s.ctx),
 				}
 
+				if ic.IsDryRun() {
+					readOpts.RegisterSourceKind = ic.Preview.RegisterSourceKind
+				}
+
 				if err := processSingleFile(ic.Ctx, data, ic, readOpts); err != nil {
 					var (
 						graphifyError errorlist.Error
 						resolutionErr endpoint.ResolutionError
+						violationErr  propertyschema.ViolationError
+						lineErr       upload.LineError
 					)
 
 					if errors.As(err, &graphifyError) {
 						for _, graphifyErr := range graphifyError.Errors {
 							if ok := errors.As(graphifyErr, &resolutionErr); ok {
 								fileData[i].UserDataErrs = append(fileData[i].UserDataErrs, resolutionErr.Error())
+
+								if ic.IsDryRun() {
+									ic.Preview.addUnresolvedEndpoint(resolutionErr.Error())
+								}
+							} else if ok := errors.As(graphifyErr, &violationErr); ok && !violationErr.Result.Rejected() {
+								// Entities that were still ingested are reported as warnings, rejected ones as errors
+								fileData[i].UserDataErrs = append(fileData[i].UserDataErrs, violationErr.Error())
+
+								if ic.IsDryRun() {
+									ic.Preview.addSchemaWarning(violationErr.Error())
+								}
+							} else if ok := errors.As(graphifyErr, &lineErr); ok {
+								// Skipped NDJSON lines did not stop the rest of the file from being ingested
+								fileData[i].UserDataErrs = append(fileData[i].UserDataErrs, lineErr.Error())
 							} else {
 								fileData[i].Errors = append(fileData[i].Errors, graphifyErr.Error())
+
+								// Previews also list the entities the property schemas would have rejected
+								if ic.IsDryRun() && errors.As(graphifyErr, &violationErr) {
+									ic.Preview.addSchemaWarning(violationErr.Error())
+								}
 							}
 						}
 					} else {
@@ -203,14 +156,26 @@ func (s *GraphifyService) ProcessIngestFile(ic *IngestContext, task model.Ingest
 			}
 
 			return errs.Build()
+		}
+
+		if ic.IsDryRun() {
+			// Dry runs compare the files against the graph without writing to it
+			return fileData, s.graphdb.ReadTransaction(ic.Ctx, func(tx graph.Transaction) error {
+				return ingestFiles(NewPreviewBatchUpdater(tx, ic.Preview))
+			})
+		}
+
+		return fileData, s.graphdb.BatchOperation(ic.Ctx, func(batch graph.Batch) error {
+			return ingestFiles(batch)
 		})
 	}
 }
 
-func (s *GraphifyService) NewIngestContext(ctx context.Context, ingestTime time.Time, useChangelog bool) *IngestContext {
+func (s *GraphifyService) NewIngestContext(ctx context.Context, ingestTime time.Time, useChangelog bool, propertySchemas *propertyschema.Registry) *IngestContext {
 	opts := []IngestOption{
 		WithIngestTime(ingestTime),
 		WithEndpointResolver(s.endpointResolver),
+		WithPropertySchemas(propertySchemas),
 	}
 
 	if useChangelog {
@@ -270,6 +235,9 @@ func (s *GraphifyService) getAllTasks() model.IngestTasks {
 	return tasks
 }
 
+// ProcessTasks ingests all queued tasks using up to the configured number of ingest workers. Each upload job is
+// handled by a single worker, which processes the tasks of the job in the order they were queued. The batches of
+// different jobs commit concurrently, the workers share the changelog through pendingChanges.
 func (s *GraphifyService) ProcessTasks(updateJob UpdateJobFunc) {
 	tasks := s.getAllTasks()
 	if len(tasks) == 0 {
@@ -300,37 +268,49 @@ func (s *GraphifyService) ProcessTasks(updateJob UpdateJobFunc) {
 		flagChangeLogEnabled = changelogFF.Enabled
 	}
 
-	for _, task := range tasks {
-		ingestCtx := s.NewIngestContext(s.ctx, time.Now().UTC(), flagChangeLogEnabled)
-		fileData, err := s.ProcessIngestFile(ingestCtx, task)
+	// Load extension property schemas once per run. Without them OpenGraph properties are ingested unchecked.
+	propertySchemas, err := s.loadPropertySchemas()
+	if err != nil {
+		slog.WarnContext(s.ctx, "Loading extension property schemas failed; OpenGraph properties will not be validated", attr.Error(err))
+	}
 
-		switch {
-		case errors.Is(err, fs.ErrNotExist):
-			slog.WarnContext(s.ctx,
-				"Ingest file missing",
-				slog.Int64("task_id", task.ID),
-				slog.String("file", task.OriginalFileName),
-				attr.Error(err),
-			)
-		case err != nil:
-			slog.ErrorContext(s.ctx,
-				"Ingest task failed",
-				slog.Int64("task_id", task.ID),
-				slog.String("file", task.OriginalFileName),
-				attr.Error(err),
-			)
-		default:
-			slog.InfoContext(s.ctx,
-				"Ingest task processed",
-				slog.Int64("task_id", task.ID),
-				slog.String("file", task.OriginalFileName),
-			)
-		}
+	var (
+		taskGroups = partitionTasksByJob(tasks)
+		numWorkers = ingestWorkerCount(s.cfg.IngestWorkers, len(taskGroups))
+		jobC       = make(chan model.IngestTasks)
+		pending    = newPendingChanges()
+		workerWG   sync.WaitGroup
+	)
+
+	// Jobs are spread over the workers, while the tasks of a job are processed in order by a single worker
+	for workerID := 0; workerID < numWorkers; workerID++ {
+		workerWG.Add(1)
+
+		go func() {
+			defer workerWG.Done()
+
+			for jobTasks := range jobC {
+				for _, task := range jobTasks {
+					// Tasks left unprocessed on shutdown stay queued for the next run
+					if s.ctx.Err() != nil {
+						break
+					}
+
+					s.processTask(workerID, task, flagChangeLogEnabled, propertySchemas, pending, updateJob)
+				}
+			}
+		}()
+	}
 
-		updateJob(task.JobId.ValueOrZero(), fileData)
-		s.clearFileTask(task)
+	for _, jobTasks := range taskGroups {
+		if !channels.Submit(s.ctx, jobC, jobTasks) {
+			break
+		}
 	}
 
+	close(jobC)
+	workerWG.Wait()
+
 	slog.InfoContext(s.ctx,
 		"Ingest run finished",
 		slog.Duration("duration", time.Since(start)),
@@ -342,6 +322,91 @@ func (s *GraphifyService) ProcessTasks(updateJob UpdateJobFunc) {
 	}
 }
 
+// processTask ingests a single task and reports its results to the job of the task
+func (s *GraphifyService) processTask(workerID int, task model.IngestTask, flagChangeLogEnabled bool, propertySchemas *propertyschema.Registry, pending *pendingChanges, updateJob UpdateJobFunc) {
+	var (
+		start = time.Now()
+		// Dry runs must not feed the changelog, its cache would otherwise treat the previewed entities as ingested
+		useChangelog = flagChangeLogEnabled && !task.DryRun
+		ingestCtx    = s.NewIngestContext(s.ctx, start.UTC(), useChangelog, propertySchemas)
+	)
+
+	if task.DryRun {
+		if knownKinds, err := s.graphdb.FetchKinds(s.ctx); err != nil {
+			// The task is left in place to be previewed on the next run
+			slog.ErrorContext(s.ctx, "Fetching graph kinds for ingest preview failed", slog.Int64("task_id", task.ID), attr.Error(err))
+			return
+		} else {
+			ingestCtx.Preview = NewIngestPreview(knownKinds)
+		}
+	}
+
+	if useChangelog {
+		changeManager := pending.track(s.changeManager)
+		ingestCtx.Manager = changeManager
+
+		// The batch of the task has been committed once ProcessIngestFile returns
+		defer changeManager.done()
+	}
+
+	fileData, err := s.ProcessIngestFile(ingestCtx, task)
+
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		slog.WarnContext(s.ctx,
+			"Ingest file missing",
+			slog.Int64("task_id", task.ID),
+			slog.Int("worker", workerID),
+			slog.String("file", task.OriginalFileName),
+			attr.Error(err),
+		)
+	case err != nil:
+		slog.ErrorContext(s.ctx,
+			"Ingest task failed",
+			slog.Int64("task_id", task.ID),
+			slog.Int("worker", workerID),
+			slog.String("file", task.OriginalFileName),
+			attr.Error(err),
+		)
+	default:
+		slog.InfoContext(s.ctx,
+			"Ingest task processed",
+			slog.Int64("task_id", task.ID),
+			slog.Int("worker", workerID),
+			slog.String("file", task.OriginalFileName),
+		)
+	}
+
+	if !ingestCtx.IsDryRun() {
+		nodesProcessed, relsProcessed, nodesWritten, relsWritten := ingestCtx.Stats.GetCounts()
+		PublishIngestThroughput(strconv.Itoa(workerID), nodesProcessed, relsProcessed, nodesWritten, relsWritten, time.Since(start))
+	}
+
+	var preview *model.IngestPreviewReport
+	if ingestCtx.IsDryRun() {
+		report := ingestCtx.Preview.Report()
+		preview = &report
+	}
+
+	updateJob(task.JobId.ValueOrZero(), fileData, ingestCtx.Stats.TouchedDomains(), preview)
+	s.clearFileTask(task)
+}
+
+// loadPropertySchemas builds the registry of property schemas of all registered schema extensions
+func (s *GraphifyService) loadPropertySchemas() (*propertyschema.Registry, error) {
+	if extensions, _, err := s.db.GetGraphSchemaExtensions(s.ctx, model.Filters{}, model.Sort{}, 0, 0); err != nil {
+		return nil, fmt.Errorf("fetching extensions: %w", err)
+	} else if properties, _, err := s.db.GetGraphSchemaProperties(s.ctx, model.Filters{}, model.Sort{}, 0, 0); err != nil {
+		return nil, fmt.Errorf("fetching properties: %w", err)
+	} else if nodeKinds, _, err := s.db.GetGraphSchemaNodeKinds(s.ctx, model.Filters{}, model.Sort{}, 0, 0); err != nil {
+		return nil, fmt.Errorf("fetching node kinds: %w", err)
+	} else if relationshipKinds, _, err := s.db.GetGraphSchemaRelationshipKinds(s.ctx, model.Filters{}, model.Sort{}, 0, 0); err != nil {
+		return nil, fmt.Errorf("fetching relationship kinds: %w", err)
+	} else {
+		return propertyschema.NewRegistryFromExtensions(extensions, properties, nodeKinds, relationshipKinds), nil
+	}
+}
+
 // RegisterSourceKind - returns a function that will register a source kind and then refresh the in-memory DAWGS kind map
 func (s *GraphifyService) RegisterSourceKind(ctx context.Context) func(kind graph.Kind) error {
 	return func(kind graph.Kind) error {
@@ -355,3 +355,39 @@ func TestVersion6IngestZIP(t *testing.T) {
 	require.NoError(t, err)
 	generic.AssertDatabaseGraph(t, ctx, testSuite.GraphDB, &expected)
 }
+
+func TestVersion6IngestTarGz(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		fixturesPath = path.Join("fixtures", "Version6TarGz", "raw")
+
+		testSuite = setupIntegrationTestSuite(t, fixturesPath)
+
+		files = []string{
+			path.Join(testSuite.WorkDir, "archive.tar.gz"),
+		}
+	)
+
+	defer teardownIntegrationTestSuite(t, &testSuite)
+
+	for _, file := range files {
+		ingestContext := graphify.NewIngestContext(ctx, graphify.WithIngestTime(time.Now()))
+		fileData, err := testSuite.GraphifyService.ProcessIngestFile(ingestContext, model.IngestTask{StoredFileName: file, FileType: model.FileTypeGzip})
+		require.NoError(t, err)
+
+		failed := 0
+		for _, data := range fileData {
+			if len(data.Errors) > 0 {
+				failed++
+			}
+		}
+
+		require.Zero(t, failed)
+		require.Equal(t, 8, len(fileData))
+	}
+
+	expected, err := generic.LoadGraphFromFile(os.DirFS(path.Join("fixtures", "Version6TarGz", "ingest")), "ingested.json")
+	require.NoError(t, err)
+	generic.AssertDatabaseGraph(t, ctx, testSuite.GraphDB, &expected)
+}
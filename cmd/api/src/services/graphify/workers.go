@@ -0,0 +1,188 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graphify
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+
+	"github.com/specterops/bloodhound/cmd/api/src/daemons/changelog"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+)
+
+// partitionTasksByJob groups ingest tasks by their upload job. Tasks within a group are ordered by ID, which is the
+// order they were uploaded in, and groups are ordered by the ID of their first task.
+func partitionTasksByJob(tasks model.IngestTasks) []model.IngestTasks {
+	var (
+		sorted    = slices.Clone(tasks)
+		groups    []model.IngestTasks
+		jobGroups = make(map[int64]int)
+	)
+
+	slices.SortFunc(sorted, func(a, b model.IngestTask) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	for _, task := range sorted {
+		jobID := task.JobId.ValueOrZero()
+
+		if groupIdx, found := jobGroups[jobID]; found {
+			groups[groupIdx] = append(groups[groupIdx], task)
+		} else {
+			jobGroups[jobID] = len(groups)
+			groups = append(groups, model.IngestTasks{task})
+		}
+	}
+
+	return groups
+}
+
+// ingestWorkerCount returns the number of workers needed to ingest the given number of jobs
+func ingestWorkerCount(configured, numJobs int) int {
+	return max(1, min(configured, numJobs))
+}
+
+// identityLockStripes is the number of locks that resolution of changelog entities is spread over
+const identityLockStripes = 64
+
+// pendingChanges tracks the entities that ingest workers resolved as new or modified but have not committed yet. It
+// is shared by all workers of an ingest run, each task gets its own view of it through track.
+//
+// The changelog cache records an entity as soon as it is resolved, well before the batch writing it commits. A
+// worker that resolves the same entity in the meantime finds it cached and would hand it to the changelog, which
+// only refreshes the lastseen property of the entity and, if the entity does not exist yet, creates it without
+// its kinds and properties. Such an entity is written by the batch of every task that resolves it instead, and
+// the batches of different jobs upsert it concurrently.
+//
+// Resolution of an entity is serialized across workers by the lock of its identity, so that checking whether the
+// entity is pending elsewhere, resolving it against the cache and marking it pending happen as one step. Entities
+// are spread over a fixed number of locks, entities sharing a lock are serialized as well.
+type pendingChanges struct {
+	identityLocks [identityLockStripes]sync.Mutex
+
+	lock    sync.Mutex
+	changes map[uint64]int
+}
+
+func newPendingChanges() *pendingChanges {
+	return &pendingChanges{
+		changes: make(map[uint64]int),
+	}
+}
+
+// lockIdentity locks resolution of the entity with the given identity key and returns the func unlocking it
+func (s *pendingChanges) lockIdentity(identityKey uint64) func() {
+	identityLock := &s.identityLocks[identityKey%identityLockStripes]
+	identityLock.Lock()
+
+	return identityLock.Unlock
+}
+
+// acquire marks the entity as pending for one more task
+func (s *pendingChanges) acquire(identityKey uint64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.changes[identityKey] += 1
+}
+
+// count returns the number of tasks the entity is pending for
+func (s *pendingChanges) count(identityKey uint64) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.changes[identityKey]
+}
+
+// release removes the pending mark of one task from the entity
+func (s *pendingChanges) release(identityKey uint64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.changes[identityKey] <= 1 {
+		delete(s.changes, identityKey)
+	} else {
+		s.changes[identityKey] -= 1
+	}
+}
+
+// track returns the ChangeManager of a single ingest task
+func (s *pendingChanges) track(changeManager ChangeManager) *taskChangeManager {
+	return &taskChangeManager{
+		ChangeManager: changeManager,
+		pending:       s,
+		acquired:      make(map[uint64]struct{}),
+	}
+}
+
+// taskChangeManager is the ChangeManager of a single ingest task. It wraps the ChangeManager shared by all workers
+// and remembers the entities the task marked as pending, which are released by done once the batch of the task has
+// been committed.
+//
+// Entities that are pending for the task of another worker are written by this task as well, even if the changelog
+// cache already holds them. Entities that are only pending for this task are resolved as usual.
+type taskChangeManager struct {
+	ChangeManager
+
+	lock     sync.Mutex
+	pending  *pendingChanges
+	acquired map[uint64]struct{}
+}
+
+func (s *taskChangeManager) ResolveChange(change changelog.Change) (bool, error) {
+	identityKey := change.IdentityKey()
+
+	unlockIdentity := s.pending.lockIdentity(identityKey)
+	defer unlockIdentity()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, ownChange := s.acquired[identityKey]
+
+	// One of the pending marks belongs to this task
+	pendingElsewhere := s.pending.count(identityKey)
+	if ownChange {
+		pendingElsewhere -= 1
+	}
+
+	if shouldSubmit, err := s.ChangeManager.ResolveChange(change); err != nil {
+		return false, err
+	} else if !shouldSubmit && pendingElsewhere == 0 {
+		return false, nil
+	}
+
+	if !ownChange {
+		s.pending.acquire(identityKey)
+		s.acquired[identityKey] = struct{}{}
+	}
+
+	return true, nil
+}
+
+// done releases the entities of the task once its batch has been committed
+func (s *taskChangeManager) done() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for identityKey := range s.acquired {
+		s.pending.release(identityKey)
+	}
+
+	clear(s.acquired)
+}
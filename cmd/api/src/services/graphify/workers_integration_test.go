@@ -0,0 +1,172 @@
+// Copyright 2025 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+//go:build slow_integration
+
+package graphify_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/config"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/services/graphify"
+	"github.com/specterops/bloodhound/cmd/api/src/services/upload"
+	"github.com/specterops/bloodhound/packages/go/lab/generic"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessTasks_WorkerCount(t *testing.T) {
+	var (
+		fixturesPath = path.Join("fixtures", "Version5JSON", "raw")
+		files        = []string{
+			"computers.json",
+			"containers.json",
+			"domains.json",
+			"gpos.json",
+			"groups.json",
+			"ous.json",
+			"sessions.json",
+			"users.json",
+		}
+	)
+
+	expected, err := generic.LoadGraphFromFile(os.DirFS(path.Join("fixtures", "Version5JSON", "ingest")), "ingested.json")
+	require.NoError(t, err)
+
+	for _, numWorkers := range []int{1, 4} {
+		t.Run(fmt.Sprintf("%d workers", numWorkers), func(t *testing.T) {
+			testSuite := setupIntegrationTestSuite(t, fixturesPath)
+			defer teardownIntegrationTestSuite(t, &testSuite)
+
+			ingestSchema, err := upload.LoadIngestSchema()
+			require.NoError(t, err)
+
+			cfg := config.Configuration{
+				WorkDir:       testSuite.WorkDir,
+				IngestWorkers: numWorkers,
+			}
+
+			// Spread the files over three upload jobs so that the workers ingest them in parallel
+			for idx, file := range files {
+				_, err := testSuite.BHDatabase.CreateIngestTask(testSuite.Context, model.IngestTask{
+					StoredFileName:   path.Join(testSuite.WorkDir, file),
+					OriginalFileName: file,
+					JobId:            null.Int64From(int64(idx%3 + 1)),
+					FileType:         model.FileTypeJson,
+				})
+				require.NoError(t, err)
+			}
+
+			var (
+				service     = graphify.NewGraphifyService(testSuite.Context, testSuite.BHDatabase, testSuite.GraphDB, cfg, ingestSchema, nil)
+				updatedJobs = make(chan int64, len(files))
+			)
+
+			service.ProcessTasks(func(jobID int64, fileData []graphify.IngestFileData, _ graphify.TouchedDomains, _ *model.IngestPreviewReport) {
+				for _, data := range fileData {
+					assert.Empty(t, data.Errors)
+				}
+
+				updatedJobs <- jobID
+			})
+
+			require.Len(t, updatedJobs, len(files))
+
+			remainingTasks, err := testSuite.BHDatabase.GetAllIngestTasks(testSuite.Context)
+			require.NoError(t, err)
+			require.Empty(t, remainingTasks)
+
+			generic.AssertDatabaseGraph(t, testSuite.Context, testSuite.GraphDB, &expected)
+		})
+	}
+}
+
+func TestProcessTasks_OverlappingJobs(t *testing.T) {
+	var (
+		fixturesPath = path.Join("fixtures", "Version5JSON", "raw")
+		files        = []string{
+			"computers.json",
+			"containers.json",
+			"domains.json",
+			"gpos.json",
+			"groups.json",
+			"ous.json",
+			"sessions.json",
+			"users.json",
+		}
+		testSuite = setupIntegrationTestSuite(t, fixturesPath)
+	)
+
+	defer teardownIntegrationTestSuite(t, &testSuite)
+
+	expected, err := generic.LoadGraphFromFile(os.DirFS(path.Join("fixtures", "Version5JSON", "ingest")), "ingested.json")
+	require.NoError(t, err)
+
+	ingestSchema, err := upload.LoadIngestSchema()
+	require.NoError(t, err)
+
+	cfg := config.Configuration{
+		WorkDir:       testSuite.WorkDir,
+		IngestWorkers: 2,
+	}
+
+	// Upload the same files in two jobs, ingested files are removed so each job gets its own copy
+	for _, jobID := range []int64{1, 2} {
+		for _, file := range files {
+			content, err := os.ReadFile(path.Join(testSuite.WorkDir, file))
+			require.NoError(t, err)
+
+			storedFileName := path.Join(testSuite.WorkDir, fmt.Sprintf("job-%d-%s", jobID, file))
+			require.NoError(t, os.WriteFile(storedFileName, content, 0644))
+
+			_, err = testSuite.BHDatabase.CreateIngestTask(testSuite.Context, model.IngestTask{
+				StoredFileName:   storedFileName,
+				OriginalFileName: file,
+				JobId:            null.Int64From(jobID),
+				FileType:         model.FileTypeJson,
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	service := graphify.NewGraphifyService(testSuite.Context, testSuite.BHDatabase, testSuite.GraphDB, cfg, ingestSchema, nil)
+
+	service.ProcessTasks(func(_ int64, fileData []graphify.IngestFileData, _ graphify.TouchedDomains, _ *model.IngestPreviewReport) {
+		for _, data := range fileData {
+			assert.Empty(t, data.Errors)
+		}
+	})
+
+	remainingTasks, err := testSuite.BHDatabase.GetAllIngestTasks(testSuite.Context)
+	require.NoError(t, err)
+	require.Empty(t, remainingTasks)
+
+	// Entities present in both jobs are only created once
+	require.NoError(t, testSuite.GraphDB.ReadTransaction(testSuite.Context, func(tx graph.Transaction) error {
+		numNodes, err := tx.Nodes().Count()
+		require.NoError(t, err)
+		require.Equal(t, int64(len(expected.Nodes)), numNodes)
+		return nil
+	}))
+
+	generic.AssertDatabaseGraph(t, testSuite.Context, testSuite.GraphDB, &expected)
+}
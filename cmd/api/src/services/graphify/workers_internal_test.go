@@ -0,0 +1,185 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graphify
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/daemons/changelog"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/services/graphify/mocks"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func testIngestTask(id, jobID int64) model.IngestTask {
+	return model.IngestTask{
+		JobId:     null.Int64From(jobID),
+		BigSerial: model.BigSerial{ID: id},
+	}
+}
+
+func TestPartitionTasksByJob(t *testing.T) {
+	groups := partitionTasksByJob(model.IngestTasks{
+		testIngestTask(4, 2),
+		testIngestTask(1, 1),
+		testIngestTask(5, 3),
+		testIngestTask(3, 1),
+		testIngestTask(2, 2),
+	})
+
+	require.Equal(t, []model.IngestTasks{
+		{testIngestTask(1, 1), testIngestTask(3, 1)},
+		{testIngestTask(2, 2), testIngestTask(4, 2)},
+		{testIngestTask(5, 3)},
+	}, groups)
+
+	require.Empty(t, partitionTasksByJob(nil))
+}
+
+func TestIngestWorkerCount(t *testing.T) {
+	require.Equal(t, 1, ingestWorkerCount(0, 3))
+	require.Equal(t, 1, ingestWorkerCount(-2, 3))
+	require.Equal(t, 2, ingestWorkerCount(2, 3))
+	require.Equal(t, 3, ingestWorkerCount(8, 3))
+	require.Equal(t, 1, ingestWorkerCount(4, 0))
+}
+
+func TestTaskChangeManager(t *testing.T) {
+	var (
+		ctrl          = gomock.NewController(t)
+		changeManager = mocks.NewMockChangeManager(ctrl)
+		pending       = newPendingChanges()
+		change        = changelog.NewNodeChange("1234", graph.Kinds{ad.User}, graph.NewProperties())
+	)
+
+	t.Run("cached changes are resolved as usual", func(t *testing.T) {
+		taskA := pending.track(changeManager)
+
+		changeManager.EXPECT().ResolveChange(change).Return(false, nil)
+
+		shouldSubmit, err := taskA.ResolveChange(change)
+		require.NoError(t, err)
+		require.False(t, shouldSubmit)
+		require.Zero(t, pending.count(change.IdentityKey()))
+	})
+
+	t.Run("changes pending for another task are written", func(t *testing.T) {
+		var (
+			taskA = pending.track(changeManager)
+			taskB = pending.track(changeManager)
+		)
+
+		changeManager.EXPECT().ResolveChange(change).Return(true, nil)
+
+		shouldSubmit, err := taskA.ResolveChange(change)
+		require.NoError(t, err)
+		require.True(t, shouldSubmit)
+
+		// Changes already pending for the task itself are resolved as usual
+		changeManager.EXPECT().ResolveChange(change).Return(false, nil)
+
+		shouldSubmit, err = taskA.ResolveChange(change)
+		require.NoError(t, err)
+		require.False(t, shouldSubmit)
+
+		// The change is cached, but the batch of task A has not been committed yet
+		changeManager.EXPECT().ResolveChange(change).Return(false, nil)
+
+		shouldSubmit, err = taskB.ResolveChange(change)
+		require.NoError(t, err)
+		require.True(t, shouldSubmit)
+		require.Equal(t, 2, pending.count(change.IdentityKey()))
+
+		taskA.done()
+		taskB.done()
+		require.Zero(t, pending.count(change.IdentityKey()))
+
+		// Once committed, the change is cached and no longer pending
+		changeManager.EXPECT().ResolveChange(change).Return(false, nil)
+
+		shouldSubmit, err = taskB.ResolveChange(change)
+		require.NoError(t, err)
+		require.False(t, shouldSubmit)
+	})
+
+	t.Run("resolution of an entity is serialized across tasks", func(t *testing.T) {
+		const numTasks = 8
+
+		var (
+			resolving  atomic.Int32
+			overlapped atomic.Bool
+			cached     atomic.Bool
+			tasks      = make([]*taskChangeManager, numTasks)
+			results    = make([]bool, numTasks)
+			tasksWG    sync.WaitGroup
+		)
+
+		// The first resolution misses the cache, every later one finds the entity cached
+		changeManager.EXPECT().ResolveChange(change).DoAndReturn(func(changelog.Change) (bool, error) {
+			if resolving.Add(1) > 1 {
+				overlapped.Store(true)
+			}
+
+			defer resolving.Add(-1)
+
+			time.Sleep(time.Millisecond)
+			return !cached.Swap(true), nil
+		}).Times(numTasks)
+
+		for idx := range tasks {
+			tasks[idx] = pending.track(changeManager)
+		}
+
+		for idx, task := range tasks {
+			tasksWG.Add(1)
+
+			go func() {
+				defer tasksWG.Done()
+
+				shouldSubmit, err := task.ResolveChange(change)
+				assert.NoError(t, err)
+
+				results[idx] = shouldSubmit
+			}()
+		}
+
+		tasksWG.Wait()
+
+		require.False(t, overlapped.Load())
+
+		// None of the batches has been committed, so every task writes the entity
+		for _, shouldSubmit := range results {
+			require.True(t, shouldSubmit)
+		}
+
+		require.Equal(t, numTasks, pending.count(change.IdentityKey()))
+
+		for _, task := range tasks {
+			task.done()
+		}
+
+		require.Zero(t, pending.count(change.IdentityKey()))
+	})
+}
@@ -158,7 +158,8 @@ func (s *JobService) CompleteAnalyzedIngestJobs() {
 	}
 }
 
-// ProcessFinishedIngestJobs transitions all jobs in an ingesting state to an analyzing state, if there are no further tasks associated with the job in question
+// ProcessFinishedIngestJobs transitions all jobs in an ingesting state to an analyzing state, if there are no further tasks associated with the job in question.
+// Dry run jobs did not change the graph and end in the previewed state instead.
 func (s *JobService) ProcessFinishedIngestJobs() {
 	// Because our database interfaces do not yet accept contexts this is a best-effort check to ensure that we do not
 	// commit state transitions when shutting down.
@@ -173,7 +174,17 @@ func (s *JobService) ProcessFinishedIngestJobs() {
 			if remainingIngestTasks, err := s.db.GetIngestTasksForJob(s.ctx, job.ID); err != nil {
 				slog.ErrorContext(s.ctx, fmt.Sprintf("Failed looking up remaining ingest tasks for ingest job %d: %v", job.ID, err))
 			} else if len(remainingIngestTasks) == 0 {
-				if err := updateIngestJobStatus(s.ctx, s.db, job, model.JobStatusAnalyzing, "Analyzing"); err != nil {
+				var (
+					status  = model.JobStatusAnalyzing
+					message = "Analyzing"
+				)
+
+				if job.DryRun {
+					status = model.JobStatusPreviewed
+					message = "Previewed"
+				}
+
+				if err := updateIngestJobStatus(s.ctx, s.db, job, status, message); err != nil {
 					slog.ErrorContext(s.ctx, fmt.Sprintf("Error updating ingest job %d: %v", job.ID, err))
 				}
 			}
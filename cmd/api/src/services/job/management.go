@@ -36,13 +36,14 @@ func GetAllIngestJobs(ctx context.Context, db JobData, skip int, limit int, orde
 	return db.GetAllIngestJobs(ctx, skip, limit, order, filter)
 }
 
-func StartIngestJob(ctx context.Context, db JobData, user model.User) (model.IngestJob, error) {
+func StartIngestJob(ctx context.Context, db JobData, user model.User, dryRun bool) (model.IngestJob, error) {
 	job := model.IngestJob{
 		UserID:     uuid.NullUUID{UUID: user.ID, Valid: true},
 		User:       user,
 		Status:     model.JobStatusRunning,
 		StartTime:  time.Now().UTC(),
 		LastIngest: time.Now().UTC(),
+		DryRun:     dryRun,
 	}
 	return db.CreateIngestJob(ctx, job)
 }
@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/services/graphify/propertyschema"
 	"github.com/specterops/dawgs/graph"
 )
 
@@ -53,7 +54,8 @@ func (s *OpenGraphSchemaService) UpsertOpenGraphExtension(ctx context.Context, o
 // It ensures there's no duplicate kinds, environments or findings. Also ensures the extension's
 // namespace MUST be prefixed to all name fields except for an environment's source_kind.
 //
-// Properties: Not currently ingested by the API so there's little validation.
+// Properties must have a data type that can be checked during ingest, and only string or array properties may
+// declare enum values.
 func validateGraphExtension(graphExtension model.GraphExtensionInput) error {
 	var (
 		nodeKinds         = make(map[string]any, 0)
@@ -105,6 +107,12 @@ func validateGraphExtension(graphExtension model.GraphExtensionInput) error {
 		if _, ok := properties[property.Name]; ok {
 			return fmt.Errorf("duplicate graph properties: %s", property.Name)
 		}
+		if !propertyschema.IsSupportedDataType(property.DataType) {
+			return fmt.Errorf("graph schema property %s has unsupported data type %s", property.Name, property.DataType)
+		}
+		if len(property.EnumValues) > 0 && property.DataType != propertyschema.DataTypeString && property.DataType != propertyschema.DataTypeArray {
+			return fmt.Errorf("graph schema property %s can only declare enum values with a string or array data type", property.Name)
+		}
 		properties[property.Name] = struct{}{}
 	}
 	for _, environment := range graphExtension.EnvironmentsInput {
@@ -295,6 +295,55 @@ func Test_validateGraphExtension(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("duplicate graph properties: property 1"),
 		},
+		{
+			name: "fail - unsupported property data type",
+			args: args{
+				graphExtension: model.GraphExtensionInput{
+					ExtensionInput: model.ExtensionInput{
+						Name:      "Test extension",
+						Version:   "1.0.0",
+						Namespace: "AD",
+					},
+					NodeKindsInput: model.NodesInput{
+						{
+							Name: "AD_node kind 1",
+						},
+					},
+					PropertiesInput: model.PropertiesInput{
+						{
+							Name:     "property 1",
+							DataType: "datetime",
+						},
+					},
+				},
+			},
+			wantErr: fmt.Errorf("graph schema property property 1 has unsupported data type datetime"),
+		},
+		{
+			name: "fail - enum values on a non string property",
+			args: args{
+				graphExtension: model.GraphExtensionInput{
+					ExtensionInput: model.ExtensionInput{
+						Name:      "Test extension",
+						Version:   "1.0.0",
+						Namespace: "AD",
+					},
+					NodeKindsInput: model.NodesInput{
+						{
+							Name: "AD_node kind 1",
+						},
+					},
+					PropertiesInput: model.PropertiesInput{
+						{
+							Name:       "property 1",
+							DataType:   "boolean",
+							EnumValues: []string{"true"},
+						},
+					},
+				},
+			},
+			wantErr: fmt.Errorf("graph schema property property 1 can only declare enum values with a string or array data type"),
+		},
 		{
 			name: "fail - duplicate kinds - same edge and node kind",
 			args: args{
@@ -17,6 +17,8 @@
 package upload
 
 import (
+	"bytes"
+	"errors"
 	"io"
 
 	"github.com/specterops/bloodhound/cmd/api/src/model/ingest"
@@ -36,6 +38,31 @@ func WriteAndValidateZip(src io.Reader, dst io.Writer) (ingest.OriginalMetadata,
 	return ingest.OriginalMetadata{}, ValidateZipFile(tr)
 }
 
+// GzipMagicBytes are the ID1, ID2 and CM (deflate) bytes every gzip member starts with, see RFC 1952
+var GzipMagicBytes = []byte{0x1f, 0x8b, 0x08}
+
+// WriteAndValidateGzip implements FileValidator for gzip-compressed ingest files, including tar.gz archives.
+// Only the gzip header is checked here; the compressed contents are validated when they are extracted for ingest.
+func WriteAndValidateGzip(src io.Reader, dst io.Writer) (ingest.OriginalMetadata, error) {
+	tr := io.TeeReader(src, dst)
+	return ingest.OriginalMetadata{}, ValidateGzipFile(tr)
+}
+
+// ValidateGzipFile checks that the stream starts with a gzip header and then drains it
+func ValidateGzipFile(reader io.Reader) error {
+	header := make([]byte, len(GzipMagicBytes))
+	if _, err := io.ReadFull(reader, header); errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return ingest.ErrInvalidGzipFile
+	} else if err != nil {
+		return err
+	} else if !bytes.Equal(header, GzipMagicBytes) {
+		return ingest.ErrInvalidGzipFile
+	}
+
+	_, err := io.Copy(io.Discard, reader)
+	return err
+}
+
 // IngestValidator encapsulates precompiled JSON schemas used to validate
 // graph ingest payloads, including node and edge definitions.
 //
@@ -66,3 +93,21 @@ func (s *IngestValidator) WriteAndValidateJSON(src io.Reader, dst io.Writer) (in
 
 	return metatag, err
 }
+
+// WriteAndValidateNDJSON implements FileValidator for newline-delimited JSON ingest files.
+// Each line is validated as it is streamed to disk, see ValidateNDJSON.
+func (s *IngestValidator) WriteAndValidateNDJSON(src io.Reader, dst io.Writer) (ingest.OriginalMetadata, error) {
+	normalizedContent, err := bomenc.NormalizeToUTF8(src)
+	if err != nil {
+		return ingest.OriginalMetadata{}, err
+	}
+	tr := io.TeeReader(normalizedContent, dst)
+	metatag, err := ValidateNDJSON(tr, s.IngestSchema)
+	if err != nil {
+		return metatag, err
+	}
+
+	// Drain the reader regardless so dst always holds the whole payload
+	_, err = io.Copy(io.Discard, tr)
+	return metatag, err
+}
@@ -28,6 +28,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/specterops/bloodhound/cmd/api/src/model"
 	gomock "go.uber.org/mock/gomock"
@@ -71,6 +72,20 @@ func (mr *MockUploadDataMockRecorder) CancelAllIngestJobs(ctx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAllIngestJobs", reflect.TypeOf((*MockUploadData)(nil).CancelAllIngestJobs), ctx)
 }
 
+// ClaimIngestUploadSession mocks base method.
+func (m *MockUploadData) ClaimIngestUploadSession(ctx context.Context, id int64, staleBefore time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimIngestUploadSession", ctx, id, staleBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClaimIngestUploadSession indicates an expected call of ClaimIngestUploadSession.
+func (mr *MockUploadDataMockRecorder) ClaimIngestUploadSession(ctx, id, staleBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimIngestUploadSession", reflect.TypeOf((*MockUploadData)(nil).ClaimIngestUploadSession), ctx, id, staleBefore)
+}
+
 // CreateCompletedTask mocks base method.
 func (m *MockUploadData) CreateCompletedTask(ctx context.Context, task model.CompletedTask) (model.CompletedTask, error) {
 	m.ctrl.T.Helper()
@@ -132,6 +147,36 @@ func (mr *MockUploadDataMockRecorder) CreateIngestTask(ctx, task any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIngestTask", reflect.TypeOf((*MockUploadData)(nil).CreateIngestTask), ctx, task)
 }
 
+// CreateIngestUploadChunk mocks base method.
+func (m *MockUploadData) CreateIngestUploadChunk(ctx context.Context, chunk model.IngestUploadChunk) (model.IngestUploadChunk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIngestUploadChunk", ctx, chunk)
+	ret0, _ := ret[0].(model.IngestUploadChunk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIngestUploadChunk indicates an expected call of CreateIngestUploadChunk.
+func (mr *MockUploadDataMockRecorder) CreateIngestUploadChunk(ctx, chunk any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIngestUploadChunk", reflect.TypeOf((*MockUploadData)(nil).CreateIngestUploadChunk), ctx, chunk)
+}
+
+// CreateIngestUploadSession mocks base method.
+func (m *MockUploadData) CreateIngestUploadSession(ctx context.Context, session model.IngestUploadSession) (model.IngestUploadSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIngestUploadSession", ctx, session)
+	ret0, _ := ret[0].(model.IngestUploadSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIngestUploadSession indicates an expected call of CreateIngestUploadSession.
+func (mr *MockUploadDataMockRecorder) CreateIngestUploadSession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIngestUploadSession", reflect.TypeOf((*MockUploadData)(nil).CreateIngestUploadSession), ctx, session)
+}
+
 // DeleteAllIngestJobs mocks base method.
 func (m *MockUploadData) DeleteAllIngestJobs(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -160,6 +205,20 @@ func (mr *MockUploadDataMockRecorder) DeleteAllIngestTasks(ctx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllIngestTasks", reflect.TypeOf((*MockUploadData)(nil).DeleteAllIngestTasks), ctx)
 }
 
+// DeleteIngestUploadSession mocks base method.
+func (m *MockUploadData) DeleteIngestUploadSession(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIngestUploadSession", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIngestUploadSession indicates an expected call of DeleteIngestUploadSession.
+func (mr *MockUploadDataMockRecorder) DeleteIngestUploadSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIngestUploadSession", reflect.TypeOf((*MockUploadData)(nil).DeleteIngestUploadSession), ctx, id)
+}
+
 // GetAllIngestJobs mocks base method.
 func (m *MockUploadData) GetAllIngestJobs(ctx context.Context, skip, limit int, order string, filter model.SQLFilter) ([]model.IngestJob, int, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +235,21 @@ func (mr *MockUploadDataMockRecorder) GetAllIngestJobs(ctx, skip, limit, order,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllIngestJobs", reflect.TypeOf((*MockUploadData)(nil).GetAllIngestJobs), ctx, skip, limit, order, filter)
 }
 
+// GetAllIngestUploadSessions mocks base method.
+func (m *MockUploadData) GetAllIngestUploadSessions(ctx context.Context) (model.IngestUploadSessions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllIngestUploadSessions", ctx)
+	ret0, _ := ret[0].(model.IngestUploadSessions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllIngestUploadSessions indicates an expected call of GetAllIngestUploadSessions.
+func (mr *MockUploadDataMockRecorder) GetAllIngestUploadSessions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllIngestUploadSessions", reflect.TypeOf((*MockUploadData)(nil).GetAllIngestUploadSessions), ctx)
+}
+
 // GetCompletedTasks mocks base method.
 func (m *MockUploadData) GetCompletedTasks(ctx context.Context, ingestJobId int64) ([]model.CompletedTask, error) {
 	m.ctrl.T.Helper()
@@ -221,6 +295,64 @@ func (mr *MockUploadDataMockRecorder) GetIngestJobsWithStatus(ctx, status any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngestJobsWithStatus", reflect.TypeOf((*MockUploadData)(nil).GetIngestJobsWithStatus), ctx, status)
 }
 
+// GetIngestUploadChunks mocks base method.
+func (m *MockUploadData) GetIngestUploadChunks(ctx context.Context, sessionID int64) (model.IngestUploadChunks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIngestUploadChunks", ctx, sessionID)
+	ret0, _ := ret[0].(model.IngestUploadChunks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIngestUploadChunks indicates an expected call of GetIngestUploadChunks.
+func (mr *MockUploadDataMockRecorder) GetIngestUploadChunks(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngestUploadChunks", reflect.TypeOf((*MockUploadData)(nil).GetIngestUploadChunks), ctx, sessionID)
+}
+
+// GetIngestUploadSession mocks base method.
+func (m *MockUploadData) GetIngestUploadSession(ctx context.Context, id int64) (model.IngestUploadSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIngestUploadSession", ctx, id)
+	ret0, _ := ret[0].(model.IngestUploadSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIngestUploadSession indicates an expected call of GetIngestUploadSession.
+func (mr *MockUploadDataMockRecorder) GetIngestUploadSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngestUploadSession", reflect.TypeOf((*MockUploadData)(nil).GetIngestUploadSession), ctx, id)
+}
+
+// ReleaseIngestUploadSession mocks base method.
+func (m *MockUploadData) ReleaseIngestUploadSession(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseIngestUploadSession", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseIngestUploadSession indicates an expected call of ReleaseIngestUploadSession.
+func (mr *MockUploadDataMockRecorder) ReleaseIngestUploadSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseIngestUploadSession", reflect.TypeOf((*MockUploadData)(nil).ReleaseIngestUploadSession), ctx, id)
+}
+
+// SweepIngestUploadSessions mocks base method.
+func (m *MockUploadData) SweepIngestUploadSessions(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SweepIngestUploadSessions", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SweepIngestUploadSessions indicates an expected call of SweepIngestUploadSessions.
+func (mr *MockUploadDataMockRecorder) SweepIngestUploadSessions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SweepIngestUploadSessions", reflect.TypeOf((*MockUploadData)(nil).SweepIngestUploadSessions), ctx)
+}
+
 // UpdateIngestJob mocks base method.
 func (m *MockUploadData) UpdateIngestJob(ctx context.Context, job model.IngestJob) error {
 	m.ctrl.T.Helper()
@@ -234,3 +366,17 @@ func (mr *MockUploadDataMockRecorder) UpdateIngestJob(ctx, job any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngestJob", reflect.TypeOf((*MockUploadData)(nil).UpdateIngestJob), ctx, job)
 }
+
+// UpdateIngestUploadSession mocks base method.
+func (m *MockUploadData) UpdateIngestUploadSession(ctx context.Context, session model.IngestUploadSession) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIngestUploadSession", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIngestUploadSession indicates an expected call of UpdateIngestUploadSession.
+func (mr *MockUploadDataMockRecorder) UpdateIngestUploadSession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngestUploadSession", reflect.TypeOf((*MockUploadData)(nil).UpdateIngestUploadSession), ctx, session)
+}
@@ -0,0 +1,238 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package upload
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model/ingest"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
+)
+
+// NDJSONLine is a single non-blank line of a newline-delimited JSON payload
+type NDJSONLine struct {
+	// Number is the 1-based line number within the payload
+	Number int
+	Data   []byte
+}
+
+// LineError describes a line of an NDJSON payload that could not be validated or decoded. Ingest skips these lines
+// and reports them as warnings instead of failing the file.
+type LineError struct {
+	Line int
+	Err  error
+}
+
+func (s LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", s.Line, s.Err)
+}
+
+func (s LineError) Unwrap() error {
+	return s.Err
+}
+
+// NDJSONReader reads the lines of a newline-delimited JSON payload, skipping blank lines. Lines are not limited
+// in length.
+type NDJSONReader struct {
+	reader *bufio.Reader
+	line   int
+}
+
+func NewNDJSONReader(reader io.Reader) *NDJSONReader {
+	return &NDJSONReader{
+		reader: bufio.NewReader(reader),
+	}
+}
+
+// Next returns the next non-blank line or io.EOF once the payload is exhausted
+func (s *NDJSONReader) Next() (NDJSONLine, error) {
+	for {
+		data, err := s.reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return NDJSONLine{}, err
+		}
+
+		if len(data) > 0 {
+			s.line++
+
+			if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 {
+				return NDJSONLine{Number: s.line, Data: trimmed}, nil
+			}
+		}
+
+		if err != nil {
+			return NDJSONLine{}, err
+		}
+	}
+}
+
+// ndjsonMetaLine is the first line of an NDJSON payload. Collector payloads carry a "meta" object and OpenGraph
+// payloads carry a "metadata" object, mirroring the top level tags of their JSON counterparts.
+type ndjsonMetaLine struct {
+	Meta     json.RawMessage `json:"meta"`
+	Metadata json.RawMessage `json:"metadata"`
+}
+
+// ParseNDJSONMeta parses the meta line of an NDJSON payload. The returned OpengraphMetadata is only populated for
+// OpenGraph payloads.
+func ParseNDJSONMeta(line NDJSONLine, schema IngestSchema) (ingest.OriginalMetadata, ingest.OpengraphMetadata, error) {
+	var metaLine ndjsonMetaLine
+
+	if err := json.Unmarshal(line.Data, &metaLine); err != nil {
+		return ingest.OriginalMetadata{}, ingest.OpengraphMetadata{}, LineError{Line: line.Number, Err: fmt.Errorf("%w: %v", ErrInvalidJSON, err)}
+	} else if metaLine.Meta != nil && metaLine.Metadata != nil {
+		return ingest.OriginalMetadata{}, ingest.OpengraphMetadata{}, LineError{Line: line.Number, Err: ingest.ErrMixedIngestFormat}
+	} else if metaLine.Meta != nil {
+		var meta ingest.OriginalMetadata
+
+		if err := json.Unmarshal(metaLine.Meta, &meta); err != nil {
+			slog.Warn("Found invalid NDJSON meta line", slog.Int("line", line.Number), attr.Error(err))
+			return ingest.OriginalMetadata{}, ingest.OpengraphMetadata{}, LineError{Line: line.Number, Err: ingest.ErrMetaTagNotFound}
+		} else if !meta.Type.IsValidOriginalType() || meta.Type == ingest.DataTypeOpenGraph {
+			return ingest.OriginalMetadata{}, ingest.OpengraphMetadata{}, LineError{Line: line.Number, Err: ingest.ErrMetaTagNotFound}
+		}
+
+		return meta, ingest.OpengraphMetadata{}, nil
+	} else if metaLine.Metadata != nil {
+		var (
+			item          map[string]any
+			graphMetadata ingest.OpengraphMetadata
+		)
+
+		if err := json.Unmarshal(metaLine.Metadata, &item); err != nil {
+			return ingest.OriginalMetadata{}, ingest.OpengraphMetadata{}, LineError{Line: line.Number, Err: fmt.Errorf("error decoding metadata tag: %w", err)}
+		} else if err := schema.MetaSchema.Validate(item); err != nil {
+			return ingest.OriginalMetadata{}, ingest.OpengraphMetadata{}, LineError{Line: line.Number, Err: fmt.Errorf("error validating metadata tag: %w", err)}
+		} else if err := json.Unmarshal(metaLine.Metadata, &graphMetadata); err != nil {
+			return ingest.OriginalMetadata{}, ingest.OpengraphMetadata{}, LineError{Line: line.Number, Err: fmt.Errorf("error decoding metadata tag: %w", err)}
+		}
+
+		return ingest.OriginalMetadata{Type: ingest.DataTypeOpenGraph}, graphMetadata, nil
+	}
+
+	return ingest.OriginalMetadata{}, ingest.OpengraphMetadata{}, LineError{Line: line.Number, Err: ingest.ErrMetaTagNotFound}
+}
+
+// ValidateNDJSONGraphLine validates a single OpenGraph node or edge line against the ingest schemas. Lines with a
+// "start" or "end" key are treated as edges, every other line as a node.
+func ValidateNDJSONGraphLine(line NDJSONLine, schema IngestSchema) (bool, error) {
+	var item map[string]any
+
+	if err := json.Unmarshal(line.Data, &item); err != nil {
+		return false, LineError{Line: line.Number, Err: fmt.Errorf("syntax error: %w", err)}
+	}
+
+	_, hasStart := item["start"]
+	_, hasEnd := item["end"]
+
+	if isEdge := hasStart || hasEnd; isEdge {
+		if err := schema.EdgeSchema.Validate(item); err != nil {
+			return true, LineError{Line: line.Number, Err: errors.New(formatSchemaViolations("edge", err))}
+		}
+
+		return true, nil
+	} else if err := schema.NodeSchema.Validate(item); err != nil {
+		return false, LineError{Line: line.Number, Err: errors.New(formatSchemaViolations("node", err))}
+	}
+
+	return false, nil
+}
+
+// validateNDJSONDataLine checks that a collector data line is a JSON object. Collector payloads have no JSON schema,
+// so their contents are checked by the convertors at ingest time.
+func validateNDJSONDataLine(line NDJSONLine) error {
+	var item map[string]any
+
+	if err := json.Unmarshal(line.Data, &item); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return LineError{Line: line.Number, Err: fmt.Errorf("type mismatch: %w", err)}
+		}
+
+		return LineError{Line: line.Number, Err: fmt.Errorf("syntax error: %w", err)}
+	}
+
+	return nil
+}
+
+// ValidateNDJSON validates a newline-delimited JSON payload line by line. The first line must carry the payload
+// metadata, and every following line must be a single node, edge or collector object.
+//
+// Unlike JSON payloads, a bad line does not reject the payload on its own: it is skipped at ingest time and reported
+// as a warning. The payload is only rejected with a ValidationReport if the meta line is invalid, the stream can not
+// be read or the number of bad lines reaches the error limit.
+func ValidateNDJSON(reader io.Reader, schema IngestSchema) (ingest.OriginalMetadata, error) {
+	var (
+		v = &validator{
+			nodeSchema: schema.NodeSchema,
+			edgeSchema: schema.EdgeSchema,
+			metaSchema: schema.MetaSchema,
+			maxErrors:  15,
+		}
+		lines = NewNDJSONReader(reader)
+	)
+
+	metaLine, err := lines.Next()
+	if errors.Is(err, io.EOF) {
+		v.reportCritical(0, "payload is empty. the first line must contain a meta or metadata object")
+		return ingest.OriginalMetadata{}, v.report()
+	} else if err != nil {
+		return ingest.OriginalMetadata{}, err
+	}
+
+	meta, _, err := ParseNDJSONMeta(metaLine, schema)
+	if err != nil {
+		v.reportLineCritical(metaLine.Number, err.Error())
+		return ingest.OriginalMetadata{}, v.report()
+	}
+
+	for {
+		line, err := lines.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			v.reportCritical(0, fmt.Sprintf("error reading line: %v", err))
+			return meta, v.report()
+		}
+
+		if meta.Type == ingest.DataTypeOpenGraph {
+			_, err = ValidateNDJSONGraphLine(line, schema)
+		} else {
+			err = validateNDJSONDataLine(line)
+		}
+
+		if err != nil {
+			v.reportLineValidation(line.Number, err.Error())
+
+			if len(v.validationErrors) >= v.maxErrors {
+				return meta, v.report()
+			}
+		}
+	}
+
+	if len(v.validationErrors) > 0 {
+		slog.Warn("NDJSON payload contains invalid lines that will be skipped during ingest", slog.Int("invalid_lines", len(v.validationErrors)))
+	}
+
+	return meta, nil
+}
@@ -0,0 +1,170 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package upload
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model/ingest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNDJSONReader_Next(t *testing.T) {
+	reader := NewNDJSONReader(strings.NewReader("{\"a\": 1}\n\n  \r\n{\"b\": 2}\r\n{\"c\": 3}"))
+
+	line, err := reader.Next()
+	require.Nil(t, err)
+	assert.Equal(t, NDJSONLine{Number: 1, Data: []byte(`{"a": 1}`)}, line)
+
+	line, err = reader.Next()
+	require.Nil(t, err)
+	assert.Equal(t, NDJSONLine{Number: 4, Data: []byte(`{"b": 2}`)}, line)
+
+	// the last line does not need a trailing newline
+	line, err = reader.Next()
+	require.Nil(t, err)
+	assert.Equal(t, NDJSONLine{Number: 5, Data: []byte(`{"c": 3}`)}, line)
+
+	_, err = reader.Next()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestParseNDJSONMeta(t *testing.T) {
+	ingestSchema, err := LoadIngestSchema()
+	require.Nil(t, err)
+
+	t.Run("collector meta line", func(t *testing.T) {
+		meta, graphMetadata, err := ParseNDJSONMeta(NDJSONLine{Number: 1, Data: []byte(`{"meta": {"type": "users", "version": 6}}`)}, ingestSchema)
+		require.Nil(t, err)
+		assert.Equal(t, ingest.OriginalMetadata{Type: ingest.DataTypeUser, Version: 6}, meta)
+		assert.Equal(t, ingest.OpengraphMetadata{}, graphMetadata)
+	})
+
+	t.Run("opengraph metadata line", func(t *testing.T) {
+		meta, graphMetadata, err := ParseNDJSONMeta(NDJSONLine{Number: 1, Data: []byte(`{"metadata": {"source_kind": "GithubBase"}}`)}, ingestSchema)
+		require.Nil(t, err)
+		assert.Equal(t, ingest.DataTypeOpenGraph, meta.Type)
+		assert.Equal(t, "GithubBase", graphMetadata.SourceKind)
+	})
+
+	for _, testCase := range []struct {
+		name     string
+		line     string
+		expected error
+	}{
+		{name: "invalid json", line: `{"meta": `, expected: ErrInvalidJSON},
+		{name: "unknown type", line: `{"meta": {"type": "things"}}`, expected: ingest.ErrMetaTagNotFound},
+		{name: "opengraph type in meta", line: `{"meta": {"type": "opengraph"}}`, expected: ingest.ErrMetaTagNotFound},
+		{name: "no meta", line: `{"id": "1234", "kinds": ["a"]}`, expected: ingest.ErrMetaTagNotFound},
+		{name: "mixed", line: `{"meta": {"type": "users"}, "metadata": {}}`, expected: ingest.ErrMixedIngestFormat},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, _, err := ParseNDJSONMeta(NDJSONLine{Number: 3, Data: []byte(testCase.line)}, ingestSchema)
+
+			var lineErr LineError
+			require.ErrorAs(t, err, &lineErr)
+			assert.Equal(t, 3, lineErr.Line)
+			assert.ErrorIs(t, err, testCase.expected)
+		})
+	}
+}
+
+func TestValidateNDJSON(t *testing.T) {
+	ingestSchema, err := LoadIngestSchema()
+	require.Nil(t, err)
+
+	t.Run("valid collector payload", func(t *testing.T) {
+		meta, err := ValidateNDJSON(strings.NewReader("{\"meta\": {\"type\": \"domains\", \"version\": 6}}\n{\"ObjectIdentifier\": \"S-1-5-21-1\"}\n{\"ObjectIdentifier\": \"S-1-5-21-2\"}\n"), ingestSchema)
+		require.Nil(t, err)
+		assert.Equal(t, ingest.DataTypeDomain, meta.Type)
+	})
+
+	t.Run("valid opengraph payload", func(t *testing.T) {
+		meta, err := ValidateNDJSON(strings.NewReader("{\"metadata\": {}}\n{\"id\": \"1\", \"kinds\": [\"a\"]}\n{\"start\": {\"value\": \"1\"}, \"end\": {\"value\": \"1\"}, \"kind\": \"b\"}\n"), ingestSchema)
+		require.Nil(t, err)
+		assert.Equal(t, ingest.DataTypeOpenGraph, meta.Type)
+	})
+
+	t.Run("bad lines below the error limit are accepted", func(t *testing.T) {
+		_, err := ValidateNDJSON(strings.NewReader("{\"metadata\": {}}\n{\"id\": 1}\n{\"id\": \n{\"id\": \"1\", \"kinds\": [\"a\"]}\n"), ingestSchema)
+		assert.Nil(t, err)
+	})
+
+	t.Run("empty payload", func(t *testing.T) {
+		_, err := ValidateNDJSON(strings.NewReader("\n\n"), ingestSchema)
+
+		var report ValidationReport
+		require.True(t, errors.As(err, &report))
+		require.Len(t, report.CriticalErrors, 1)
+	})
+
+	t.Run("invalid meta line", func(t *testing.T) {
+		_, err := ValidateNDJSON(strings.NewReader("\n{\"id\": \"1\", \"kinds\": [\"a\"]}\n"), ingestSchema)
+
+		var report ValidationReport
+		require.True(t, errors.As(err, &report))
+		require.Len(t, report.CriticalErrors, 1)
+		assert.Equal(t, 2, report.CriticalErrors[0].Line)
+		assert.Contains(t, report.CriticalErrors[0].Message, "line 2")
+	})
+
+	t.Run("too many bad lines", func(t *testing.T) {
+		payload := "{\"metadata\": {}}\n" + strings.Repeat("{\"id\": \"1\"}\n", 20)
+
+		_, err := ValidateNDJSON(strings.NewReader(payload), ingestSchema)
+
+		var report ValidationReport
+		require.True(t, errors.As(err, &report))
+		require.Len(t, report.ValidationErrors, 15)
+		for idx, validationErr := range report.ValidationErrors {
+			assert.Equal(t, idx+2, validationErr.Line)
+			assert.Contains(t, validationErr.Message, "node schema validation failed")
+		}
+	})
+
+	t.Run("collector lines must be objects", func(t *testing.T) {
+		payload := "{\"meta\": {\"type\": \"users\", \"version\": 6}}\n" + strings.Repeat("[1, 2]\n", 15)
+
+		_, err := ValidateNDJSON(strings.NewReader(payload), ingestSchema)
+
+		var report ValidationReport
+		require.True(t, errors.As(err, &report))
+		require.Len(t, report.ValidationErrors, 15)
+		assert.Contains(t, report.ValidationErrors[0].Message, "line 2: type mismatch")
+	})
+}
+
+func TestWriteAndValidateNDJSON(t *testing.T) {
+	ingestSchema, err := LoadIngestSchema()
+	require.Nil(t, err)
+
+	var (
+		validator = NewIngestValidator(ingestSchema)
+		payload   = "{\"meta\": {\"type\": \"domains\", \"version\": 6}}\n{\"ObjectIdentifier\": \"S-1-5-21-1\"}\n"
+		writer    = bytes.Buffer{}
+	)
+
+	meta, err := validator.WriteAndValidateNDJSON(strings.NewReader(string(append([]byte{0xEF, 0xBB, 0xBF}, payload...))), &writer)
+	require.Nil(t, err)
+	assert.Equal(t, ingest.DataTypeDomain, meta.Type)
+	assert.Equal(t, payload, writer.String())
+}
@@ -19,6 +19,7 @@ package upload
 
 import (
 	"context"
+	"time"
 
 	"github.com/specterops/bloodhound/cmd/api/src/model"
 )
@@ -42,4 +43,16 @@ type UploadData interface {
 	// Completed task handlers - distinctly different from task handlers
 	CreateCompletedTask(ctx context.Context, task model.CompletedTask) (model.CompletedTask, error)
 	GetCompletedTasks(ctx context.Context, ingestJobId int64) ([]model.CompletedTask, error)
+
+	// Upload session handlers for resumable chunked uploads
+	CreateIngestUploadSession(ctx context.Context, session model.IngestUploadSession) (model.IngestUploadSession, error)
+	GetIngestUploadSession(ctx context.Context, id int64) (model.IngestUploadSession, error)
+	GetAllIngestUploadSessions(ctx context.Context) (model.IngestUploadSessions, error)
+	UpdateIngestUploadSession(ctx context.Context, session model.IngestUploadSession) error
+	ClaimIngestUploadSession(ctx context.Context, id int64, staleBefore time.Time) error
+	ReleaseIngestUploadSession(ctx context.Context, id int64) error
+	DeleteIngestUploadSession(ctx context.Context, id int64) error
+	SweepIngestUploadSessions(ctx context.Context) error
+	GetIngestUploadChunks(ctx context.Context, sessionID int64) (model.IngestUploadChunks, error)
+	CreateIngestUploadChunk(ctx context.Context, chunk model.IngestUploadChunk) (model.IngestUploadChunk, error)
 }
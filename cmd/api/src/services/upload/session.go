@@ -0,0 +1,441 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package upload
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/ingest"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
+	"github.com/specterops/bloodhound/packages/go/headers"
+)
+
+const (
+	// UploadSessionTimeout is how long a session is kept around after it was created or last received a chunk
+	UploadSessionTimeout = 24 * time.Hour
+	// UploadSessionFinalizeTimeout is how long a finalize request holds its claim on a session before another request
+	// may take over, in case the server finalizing the session stopped before releasing it
+	UploadSessionFinalizeTimeout = time.Hour
+
+	MaxUploadChunkSize  = 256 * 1024 * 1024
+	MaxUploadChunkCount = 10_000
+)
+
+var (
+	ErrUploadSessionContentType = errors.New("content_type is not an accepted file upload type")
+	ErrUploadSessionTotalSize   = errors.New("total_size must be greater than zero")
+	ErrUploadSessionChunkSize   = fmt.Errorf("chunk_size must be greater than zero and at most %d bytes", MaxUploadChunkSize)
+	ErrUploadSessionChunkCount  = fmt.Errorf("total_size and chunk_size must not split the file into more than %d chunks", MaxUploadChunkCount)
+	ErrUploadSessionChecksum    = errors.New("sha256 must be a hex encoded SHA-256 digest")
+	ErrUploadSessionIncomplete  = errors.New("upload session is missing chunks")
+	ErrUploadSessionMismatch    = errors.New("assembled file does not match the sha256 of the upload session")
+	ErrUploadChunkOutOfRange    = errors.New("chunk index is out of range")
+	ErrUploadChunkLength        = errors.New("chunk length does not match the upload session")
+	ErrUploadChunkChecksum      = errors.New("chunk does not match its sha256")
+)
+
+type UploadSessionParams struct {
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	TotalSize   int64  `json:"total_size"`
+	ChunkSize   int64  `json:"chunk_size"`
+	SHA256      string `json:"sha256"`
+}
+
+func (s UploadSessionParams) Validate() error {
+	if mediaType, _, err := mime.ParseMediaType(s.ContentType); err != nil || !slices.Contains(ingest.AllowedFileUploadTypes, mediaType) {
+		return ErrUploadSessionContentType
+	} else if s.TotalSize <= 0 {
+		return ErrUploadSessionTotalSize
+	} else if s.ChunkSize <= 0 || s.ChunkSize > MaxUploadChunkSize {
+		return ErrUploadSessionChunkSize
+	} else if (s.TotalSize+s.ChunkSize-1)/s.ChunkSize > MaxUploadChunkCount {
+		return ErrUploadSessionChunkCount
+	} else if !isSHA256Digest(s.SHA256) {
+		return ErrUploadSessionChecksum
+	}
+
+	return nil
+}
+
+func isSHA256Digest(value string) bool {
+	decoded, err := hex.DecodeString(value)
+	return err == nil && len(decoded) == sha256.Size
+}
+
+// ByteRange is a range of bytes of the uploaded file, the end is inclusive just like in an HTTP Content-Range
+type ByteRange struct {
+	Start int64 `json:"start"`
+	End   int64 `json:"end"`
+}
+
+// UploadSessionStatus describes which parts of the file an upload session has received so that a client can resume
+// the upload after losing its connection
+type UploadSessionStatus struct {
+	model.IngestUploadSession
+
+	ChunkCount     int64       `json:"chunk_count"`
+	ReceivedBytes  int64       `json:"received_bytes"`
+	ReceivedRanges []ByteRange `json:"received_ranges"`
+	MissingChunks  []int64     `json:"missing_chunks"`
+}
+
+func NewUploadSessionStatus(session model.IngestUploadSession, chunks model.IngestUploadChunks) UploadSessionStatus {
+	var (
+		received = make([]bool, session.ChunkCount())
+		status   = UploadSessionStatus{
+			IngestUploadSession: session,
+			ChunkCount:          session.ChunkCount(),
+			ReceivedRanges:      []ByteRange{},
+			MissingChunks:       []int64{},
+		}
+	)
+
+	for _, chunk := range chunks {
+		if chunk.ChunkIndex >= 0 && chunk.ChunkIndex < status.ChunkCount {
+			received[chunk.ChunkIndex] = true
+		}
+	}
+
+	for index, ok := range received {
+		var (
+			chunkIndex = int64(index)
+			start      = chunkIndex * session.ChunkSize
+			end        = start + session.ChunkLength(chunkIndex) - 1
+		)
+
+		if !ok {
+			status.MissingChunks = append(status.MissingChunks, chunkIndex)
+			continue
+		}
+
+		status.ReceivedBytes += session.ChunkLength(chunkIndex)
+
+		// Merge ranges of consecutive chunks
+		if last := len(status.ReceivedRanges) - 1; last >= 0 && status.ReceivedRanges[last].End+1 == start {
+			status.ReceivedRanges[last].End = end
+		} else {
+			status.ReceivedRanges = append(status.ReceivedRanges, ByteRange{Start: start, End: end})
+		}
+	}
+
+	return status
+}
+
+// UploadSessionDirectory returns the directory within root that holds the chunks of the given session
+func UploadSessionDirectory(root string, sessionID int64) string {
+	return filepath.Join(root, strconv.FormatInt(sessionID, 10))
+}
+
+// uploadChunkPath returns the path of a received chunk. Chunk files are named after their checksum as well, a chunk
+// received again with different content therefore never replaces the file of the chunk that was recorded.
+func uploadChunkPath(directory string, index int64, checksum string) string {
+	return filepath.Join(directory, "chunk-"+strconv.FormatInt(index, 10)+"-"+checksum)
+}
+
+func CreateUploadSession(ctx context.Context, db UploadData, root string, jobID int64, params UploadSessionParams) (model.IngestUploadSession, error) {
+	if err := params.Validate(); err != nil {
+		return model.IngestUploadSession{}, err
+	}
+
+	session, err := db.CreateIngestUploadSession(ctx, model.IngestUploadSession{
+		JobID:       jobID,
+		FileName:    params.FileName,
+		ContentType: params.ContentType,
+		TotalSize:   params.TotalSize,
+		ChunkSize:   params.ChunkSize,
+		SHA256:      strings.ToLower(params.SHA256),
+		ExpiresAt:   time.Now().UTC().Add(UploadSessionTimeout),
+	})
+	if err != nil {
+		return model.IngestUploadSession{}, err
+	}
+
+	if err := os.Mkdir(UploadSessionDirectory(root, session.ID), 0755); err != nil {
+		if deleteErr := db.DeleteIngestUploadSession(ctx, session.ID); deleteErr != nil {
+			slog.ErrorContext(ctx, "Error removing upload session", slog.Int64("upload_session_id", session.ID), attr.Error(deleteErr))
+		}
+
+		return model.IngestUploadSession{}, fmt.Errorf("error creating upload session directory: %w", err)
+	}
+
+	return session, nil
+}
+
+// SaveUploadChunk writes a single chunk of an upload session to disk after checking its length and checksum. Uploading
+// a chunk that was already received is a no-op as long as its content did not change.
+func SaveUploadChunk(ctx context.Context, db UploadData, root string, session model.IngestUploadSession, index int64, checksum string, data io.Reader) (model.IngestUploadChunk, error) {
+	if index < 0 || index >= session.ChunkCount() {
+		return model.IngestUploadChunk{}, ErrUploadChunkOutOfRange
+	}
+
+	var (
+		directory      = UploadSessionDirectory(root, session.ID)
+		expectedLength = session.ChunkLength(index)
+		hash           = sha256.New()
+	)
+
+	// The chunk is written next to its final location first so that a partially received chunk never replaces one
+	// that was already received in full
+	partFile, err := os.CreateTemp(directory, "chunk-*.part")
+	if err != nil {
+		return model.IngestUploadChunk{}, fmt.Errorf("error creating chunk file: %w", err)
+	}
+
+	kept := false
+	defer func() {
+		if !kept {
+			os.Remove(partFile.Name())
+		}
+	}()
+
+	written, err := io.Copy(io.MultiWriter(partFile, hash), io.LimitReader(data, expectedLength+1))
+	if closeErr := partFile.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return model.IngestUploadChunk{}, fmt.Errorf("error writing chunk file: %w", err)
+	} else if written != expectedLength {
+		return model.IngestUploadChunk{}, fmt.Errorf("%w: expected %d bytes", ErrUploadChunkLength, expectedLength)
+	}
+
+	digest := hex.EncodeToString(hash.Sum(nil))
+	if !strings.EqualFold(digest, checksum) {
+		return model.IngestUploadChunk{}, ErrUploadChunkChecksum
+	}
+
+	// The chunk file is moved into place before the chunk is recorded, so that every recorded chunk has its file. A
+	// file that is already in place belongs to a chunk received before and is kept if recording the chunk fails.
+	chunkPath := uploadChunkPath(directory, index, digest)
+	_, statErr := os.Stat(chunkPath)
+	alreadyStored := statErr == nil
+
+	if err := os.Rename(partFile.Name(), chunkPath); err != nil {
+		return model.IngestUploadChunk{}, fmt.Errorf("error storing chunk file: %w", err)
+	}
+
+	kept = true
+
+	if chunk, err := db.CreateIngestUploadChunk(ctx, model.IngestUploadChunk{
+		SessionID:  session.ID,
+		ChunkIndex: index,
+		Size:       written,
+		SHA256:     digest,
+	}); err != nil {
+		if !alreadyStored {
+			if err := os.Remove(chunkPath); err != nil {
+				slog.ErrorContext(ctx, "Error deleting unrecorded chunk file", slog.String("path", chunkPath), attr.Error(err))
+			}
+		}
+
+		return model.IngestUploadChunk{}, err
+	} else {
+		session.ExpiresAt = time.Now().UTC().Add(UploadSessionTimeout)
+		return chunk, db.UpdateIngestUploadSession(ctx, session)
+	}
+}
+
+// FinalizeUploadSession assembles the chunks of a complete upload session and passes the file through the same
+// validation as a file uploaded in a single request. Only one request at a time may finalize a session, others fail
+// with ingest.ErrUploadSessionFinalizing.
+//
+// The session is removed once the file was assembled, or once the assembled file turned out not to match its checksum
+// or failed validation. A session that is still missing chunks or could not be assembled because of a file system
+// error is kept so that the client can resume the upload or finalize it again.
+func FinalizeUploadSession(ctx context.Context, db UploadData, root string, location string, session model.IngestUploadSession, validator IngestValidator) (IngestTaskParams, error) {
+	chunks, err := db.GetIngestUploadChunks(ctx, session.ID)
+	if err != nil {
+		return IngestTaskParams{}, err
+	} else if status := NewUploadSessionStatus(session, chunks); len(status.MissingChunks) > 0 {
+		return IngestTaskParams{}, fmt.Errorf("%w: %d of %d chunks have not been received", ErrUploadSessionIncomplete, len(status.MissingChunks), status.ChunkCount)
+	}
+
+	if err := db.ClaimIngestUploadSession(ctx, session.ID, time.Now().UTC().Add(-UploadSessionFinalizeTimeout)); err != nil {
+		return IngestTaskParams{}, err
+	}
+
+	params, err := assembleUploadSession(ctx, root, location, session, chunks, validator)
+
+	var pathErr *fs.PathError
+	if err != nil && errors.As(err, &pathErr) {
+		// Reading the chunks or writing the assembled file failed, the chunks themselves may still be fine
+		if err := db.ReleaseIngestUploadSession(ctx, session.ID); err != nil {
+			slog.ErrorContext(ctx, "Error releasing upload session", slog.Int64("upload_session_id", session.ID), attr.Error(err))
+		}
+	} else if err := RemoveUploadSession(ctx, db, root, session.ID); err != nil {
+		slog.ErrorContext(ctx, "Error removing finalized upload session", slog.Int64("upload_session_id", session.ID), attr.Error(err))
+	}
+
+	return params, err
+}
+
+// assembleUploadSession writes the chunks of a session to a single validated ingest file and checks it against the
+// checksum of the session
+func assembleUploadSession(ctx context.Context, root string, location string, session model.IngestUploadSession, chunks model.IngestUploadChunks, validator IngestValidator) (IngestTaskParams, error) {
+	var (
+		hash   = sha256.New()
+		header = http.Header{}
+		reader = &chunkReader{
+			directory: UploadSessionDirectory(root, session.ID),
+			chunks:    slices.Clone(chunks),
+		}
+	)
+
+	defer reader.Close()
+	header.Set(headers.ContentType.String(), session.ContentType)
+
+	slices.SortFunc(reader.chunks, func(a, b model.IngestUploadChunk) int {
+		return cmp.Compare(a.ChunkIndex, b.ChunkIndex)
+	})
+
+	if params, err := saveIngestFile(location, header, io.TeeReader(reader, hash), validator); err != nil {
+		return IngestTaskParams{}, err
+	} else if _, err := io.Copy(hash, reader); err != nil {
+		// Validators may stop reading early, the checksum still has to cover every chunk
+		if err := os.Remove(params.Filename); err != nil {
+			slog.ErrorContext(ctx, "Error deleting assembled upload", slog.String("path", params.Filename), attr.Error(err))
+		}
+
+		return IngestTaskParams{}, fmt.Errorf("error reading upload chunks: %w", err)
+	} else if hex.EncodeToString(hash.Sum(nil)) != session.SHA256 {
+		if err := os.Remove(params.Filename); err != nil {
+			slog.ErrorContext(ctx, "Error deleting assembled upload", slog.String("path", params.Filename), attr.Error(err))
+		}
+
+		return IngestTaskParams{}, ErrUploadSessionMismatch
+	} else {
+		return params, nil
+	}
+}
+
+// RemoveUploadSession deletes an upload session along with its chunks
+func RemoveUploadSession(ctx context.Context, db UploadData, root string, sessionID int64) error {
+	if err := db.DeleteIngestUploadSession(ctx, sessionID); err != nil {
+		return err
+	}
+
+	return os.RemoveAll(UploadSessionDirectory(root, sessionID))
+}
+
+// SweepUploadSessions removes expired upload sessions as well as chunk directories that no longer belong to a session
+func SweepUploadSessions(ctx context.Context, db UploadData, root string) {
+	// List the directories before looking up the sessions, a session created in between must not lose its directory
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		// Expired sessions are still removed from the database, only the directory cleanup is skipped
+		slog.ErrorContext(ctx, "Error listing upload session directories", slog.String("path", root), attr.Error(err))
+	}
+
+	if err := db.SweepIngestUploadSessions(ctx); err != nil {
+		slog.ErrorContext(ctx, "Error removing expired upload sessions", attr.Error(err))
+		return
+	}
+
+	sessions, err := db.GetAllIngestUploadSessions(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing upload sessions", attr.Error(err))
+		return
+	}
+
+	activeSessions := make(map[string]struct{}, len(sessions))
+	for _, session := range sessions {
+		activeSessions[strconv.FormatInt(session.ID, 10)] = struct{}{}
+	}
+
+	for _, entry := range entries {
+		if _, active := activeSessions[entry.Name()]; active {
+			continue
+		}
+
+		path := filepath.Join(root, entry.Name())
+		if err := os.RemoveAll(path); err != nil {
+			slog.ErrorContext(ctx, "Error removing abandoned upload session", slog.String("path", path), attr.Error(err))
+		} else {
+			slog.InfoContext(ctx, "Removed abandoned upload session", slog.String("path", path))
+		}
+	}
+}
+
+// chunkReader reads the given chunks of an upload session in order, holding at most one chunk file open at a time
+type chunkReader struct {
+	directory string
+	chunks    model.IngestUploadChunks
+	next      int
+	current   *os.File
+}
+
+func (s *chunkReader) Read(p []byte) (int, error) {
+	for {
+		if s.current == nil {
+			if s.next >= len(s.chunks) {
+				return 0, io.EOF
+			}
+
+			chunk := s.chunks[s.next]
+			file, err := os.Open(uploadChunkPath(s.directory, chunk.ChunkIndex, chunk.SHA256))
+			if err != nil {
+				return 0, err
+			}
+
+			s.current = file
+			s.next++
+		}
+
+		n, err := s.current.Read(p)
+		if errors.Is(err, io.EOF) {
+			s.current.Close()
+			s.current = nil
+
+			if n == 0 {
+				continue
+			}
+
+			return n, nil
+		}
+
+		return n, err
+	}
+}
+
+func (s *chunkReader) Close() error {
+	if s.current != nil {
+		err := s.current.Close()
+		s.current = nil
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,407 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package upload
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/ingest"
+	"github.com/specterops/bloodhound/cmd/api/src/services/upload/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func sha256Hex(data []byte) string {
+	digest := sha256.Sum256(data)
+	return hex.EncodeToString(digest[:])
+}
+
+func gzipPayload(t *testing.T) []byte {
+	var (
+		compressed = bytes.Buffer{}
+		gzipWriter = gzip.NewWriter(&compressed)
+	)
+
+	_, err := gzipWriter.Write([]byte(`{"meta": {"type": "domains", "version": 6, "count": 0}, "data": []}`))
+	require.Nil(t, err)
+	require.Nil(t, gzipWriter.Close())
+
+	return compressed.Bytes()
+}
+
+// newTestUploadSession stores every chunk of data, except for the skipped ones, in the session directory as if it
+// had been received through SaveUploadChunk
+func newTestUploadSession(t *testing.T, root string, data []byte, chunkSize int64, skip ...int64) (model.IngestUploadSession, model.IngestUploadChunks) {
+	var (
+		session = model.IngestUploadSession{
+			JobID:       1,
+			FileName:    "collection.json.gz",
+			ContentType: "application/gzip",
+			TotalSize:   int64(len(data)),
+			ChunkSize:   chunkSize,
+			SHA256:      sha256Hex(data),
+			ExpiresAt:   time.Now().Add(time.Hour),
+		}
+		chunks model.IngestUploadChunks
+	)
+
+	session.ID = 7
+	directory := UploadSessionDirectory(root, session.ID)
+	require.Nil(t, os.Mkdir(directory, 0755))
+
+	for index := range session.ChunkCount() {
+		if slices.Contains(skip, index) {
+			continue
+		}
+
+		start := index * chunkSize
+		content := data[start : start+session.ChunkLength(index)]
+
+		require.Nil(t, os.WriteFile(uploadChunkPath(directory, index, sha256Hex(content)), content, 0644))
+		chunks = append(chunks, model.IngestUploadChunk{SessionID: session.ID, ChunkIndex: index, Size: int64(len(content)), SHA256: sha256Hex(content)})
+	}
+
+	return session, chunks
+}
+
+func TestUploadSessionParams_Validate(t *testing.T) {
+	valid := UploadSessionParams{
+		FileName:    "collection.zip",
+		ContentType: "application/zip",
+		TotalSize:   1024,
+		ChunkSize:   512,
+		SHA256:      sha256Hex([]byte("collection")),
+	}
+
+	assert.Nil(t, valid.Validate())
+
+	for name, testCase := range map[string]struct {
+		modify   func(params *UploadSessionParams)
+		expected error
+	}{
+		"unsupported content type": {func(params *UploadSessionParams) { params.ContentType = "text/plain" }, ErrUploadSessionContentType},
+		"empty file":               {func(params *UploadSessionParams) { params.TotalSize = 0 }, ErrUploadSessionTotalSize},
+		"empty chunks":             {func(params *UploadSessionParams) { params.ChunkSize = 0 }, ErrUploadSessionChunkSize},
+		"oversized chunks":         {func(params *UploadSessionParams) { params.ChunkSize = MaxUploadChunkSize + 1 }, ErrUploadSessionChunkSize},
+		"too many chunks":          {func(params *UploadSessionParams) { params.ChunkSize, params.TotalSize = 1, MaxUploadChunkCount+1 }, ErrUploadSessionChunkCount},
+		"malformed checksum":       {func(params *UploadSessionParams) { params.SHA256 = "abc" }, ErrUploadSessionChecksum},
+	} {
+		t.Run(name, func(t *testing.T) {
+			params := valid
+			testCase.modify(&params)
+
+			assert.ErrorIs(t, params.Validate(), testCase.expected)
+		})
+	}
+}
+
+func TestNewUploadSessionStatus(t *testing.T) {
+	session := model.IngestUploadSession{TotalSize: 10, ChunkSize: 3}
+
+	status := NewUploadSessionStatus(session, model.IngestUploadChunks{{ChunkIndex: 0}, {ChunkIndex: 1}, {ChunkIndex: 3}})
+
+	assert.Equal(t, int64(4), status.ChunkCount)
+	assert.Equal(t, int64(7), status.ReceivedBytes)
+	assert.Equal(t, []ByteRange{{Start: 0, End: 5}, {Start: 9, End: 9}}, status.ReceivedRanges)
+	assert.Equal(t, []int64{2}, status.MissingChunks)
+
+	empty := NewUploadSessionStatus(session, nil)
+	assert.Equal(t, []ByteRange{}, empty.ReceivedRanges)
+	assert.Equal(t, []int64{0, 1, 2, 3}, empty.MissingChunks)
+}
+
+func TestSaveUploadChunk(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		root       = t.TempDir()
+		session, _ = newTestUploadSession(t, root, []byte("0123456789"), 4, 0, 1, 2)
+		directory  = UploadSessionDirectory(root, session.ID)
+	)
+
+	t.Run("chunk is stored", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = mocks.NewMockUploadData(mockCtrl)
+		)
+
+		mockDB.EXPECT().CreateIngestUploadChunk(gomock.Any(), model.IngestUploadChunk{SessionID: session.ID, ChunkIndex: 1, Size: 4, SHA256: sha256Hex([]byte("4567"))}).
+			DoAndReturn(func(_ context.Context, chunk model.IngestUploadChunk) (model.IngestUploadChunk, error) {
+				return chunk, nil
+			})
+		mockDB.EXPECT().UpdateIngestUploadSession(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, updated model.IngestUploadSession) error {
+			assert.True(t, updated.ExpiresAt.After(session.ExpiresAt))
+			return nil
+		})
+
+		chunk, err := SaveUploadChunk(ctx, mockDB, root, session, 1, strings.ToUpper(sha256Hex([]byte("4567"))), strings.NewReader("4567"))
+		require.Nil(t, err)
+		assert.Equal(t, int64(4), chunk.Size)
+
+		content, err := os.ReadFile(uploadChunkPath(directory, 1, sha256Hex([]byte("4567"))))
+		require.Nil(t, err)
+		assert.Equal(t, "4567", string(content))
+	})
+
+	t.Run("last chunk may be short", func(t *testing.T) {
+		var (
+			mockCtrl = gomock.NewController(t)
+			mockDB   = mocks.NewMockUploadData(mockCtrl)
+		)
+
+		mockDB.EXPECT().CreateIngestUploadChunk(gomock.Any(), gomock.Any()).Return(model.IngestUploadChunk{}, nil)
+		mockDB.EXPECT().UpdateIngestUploadSession(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := SaveUploadChunk(ctx, mockDB, root, session, 2, sha256Hex([]byte("89")), strings.NewReader("89"))
+		assert.Nil(t, err)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for name, testCase := range map[string]struct {
+			index    int64
+			checksum string
+			data     string
+			expected error
+		}{
+			"index out of range": {index: 3, checksum: sha256Hex([]byte("0123")), data: "0123", expected: ErrUploadChunkOutOfRange},
+			"negative index":     {index: -1, checksum: sha256Hex([]byte("0123")), data: "0123", expected: ErrUploadChunkOutOfRange},
+			"short chunk":        {index: 0, checksum: sha256Hex([]byte("012")), data: "012", expected: ErrUploadChunkLength},
+			"long chunk":         {index: 0, checksum: sha256Hex([]byte("01234")), data: "01234", expected: ErrUploadChunkLength},
+			"checksum mismatch":  {index: 0, checksum: sha256Hex([]byte("3210")), data: "0123", expected: ErrUploadChunkChecksum},
+		} {
+			t.Run(name, func(t *testing.T) {
+				mockDB := mocks.NewMockUploadData(gomock.NewController(t))
+
+				_, err := SaveUploadChunk(ctx, mockDB, root, session, testCase.index, testCase.checksum, strings.NewReader(testCase.data))
+				assert.ErrorIs(t, err, testCase.expected)
+
+				chunkFiles, err := filepath.Glob(filepath.Join(directory, "chunk-0-*"))
+				require.Nil(t, err)
+				assert.Empty(t, chunkFiles)
+			})
+		}
+	})
+
+	t.Run("conflicting chunk keeps the original", func(t *testing.T) {
+		mockDB := mocks.NewMockUploadData(gomock.NewController(t))
+		mockDB.EXPECT().CreateIngestUploadChunk(gomock.Any(), gomock.Any()).Return(model.IngestUploadChunk{}, ingest.ErrUploadChunkConflict)
+
+		_, err := SaveUploadChunk(ctx, mockDB, root, session, 1, sha256Hex([]byte("abcd")), strings.NewReader("abcd"))
+		assert.ErrorIs(t, err, ingest.ErrUploadChunkConflict)
+
+		content, err := os.ReadFile(uploadChunkPath(directory, 1, sha256Hex([]byte("4567"))))
+		require.Nil(t, err)
+		assert.Equal(t, "4567", string(content))
+
+		_, err = os.Stat(uploadChunkPath(directory, 1, sha256Hex([]byte("abcd"))))
+		assert.True(t, errors.Is(err, os.ErrNotExist))
+
+		parts, err := filepath.Glob(filepath.Join(directory, "*.part"))
+		require.Nil(t, err)
+		assert.Empty(t, parts)
+	})
+
+	t.Run("chunk that could not be recorded is removed", func(t *testing.T) {
+		mockDB := mocks.NewMockUploadData(gomock.NewController(t))
+		mockDB.EXPECT().CreateIngestUploadChunk(gomock.Any(), gomock.Any()).Return(model.IngestUploadChunk{}, errors.New("database unavailable"))
+
+		_, err := SaveUploadChunk(ctx, mockDB, root, session, 0, sha256Hex([]byte("0123")), strings.NewReader("0123"))
+		assert.NotNil(t, err)
+
+		chunkFiles, err := filepath.Glob(filepath.Join(directory, "chunk-0-*"))
+		require.Nil(t, err)
+		assert.Empty(t, chunkFiles)
+	})
+
+	t.Run("chunk received before keeps its file if it could not be recorded again", func(t *testing.T) {
+		mockDB := mocks.NewMockUploadData(gomock.NewController(t))
+		mockDB.EXPECT().CreateIngestUploadChunk(gomock.Any(), gomock.Any()).Return(model.IngestUploadChunk{}, errors.New("database unavailable"))
+
+		_, err := SaveUploadChunk(ctx, mockDB, root, session, 1, sha256Hex([]byte("4567")), strings.NewReader("4567"))
+		assert.NotNil(t, err)
+
+		content, err := os.ReadFile(uploadChunkPath(directory, 1, sha256Hex([]byte("4567"))))
+		require.Nil(t, err)
+		assert.Equal(t, "4567", string(content))
+	})
+}
+
+func TestFinalizeUploadSession(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		data      = gzipPayload(t)
+		validator = NewIngestValidator(IngestSchema{})
+	)
+
+	t.Run("chunks are assembled", func(t *testing.T) {
+		var (
+			root            = t.TempDir()
+			location        = t.TempDir()
+			mockDB          = mocks.NewMockUploadData(gomock.NewController(t))
+			session, chunks = newTestUploadSession(t, root, data, 16)
+		)
+
+		mockDB.EXPECT().GetIngestUploadChunks(gomock.Any(), session.ID).Return(chunks, nil)
+		mockDB.EXPECT().ClaimIngestUploadSession(gomock.Any(), session.ID, gomock.Any()).Return(nil)
+		mockDB.EXPECT().DeleteIngestUploadSession(gomock.Any(), session.ID).Return(nil)
+
+		params, err := FinalizeUploadSession(ctx, mockDB, root, location, session, validator)
+		require.Nil(t, err)
+		assert.Equal(t, model.FileTypeGzip, params.FileType)
+
+		content, err := os.ReadFile(params.Filename)
+		require.Nil(t, err)
+		assert.Equal(t, data, content)
+
+		_, err = os.Stat(UploadSessionDirectory(root, session.ID))
+		assert.True(t, errors.Is(err, os.ErrNotExist))
+	})
+
+	t.Run("incomplete session is kept", func(t *testing.T) {
+		var (
+			root            = t.TempDir()
+			mockDB          = mocks.NewMockUploadData(gomock.NewController(t))
+			session, chunks = newTestUploadSession(t, root, data, 16, 1)
+		)
+
+		mockDB.EXPECT().GetIngestUploadChunks(gomock.Any(), session.ID).Return(chunks, nil)
+
+		_, err := FinalizeUploadSession(ctx, mockDB, root, t.TempDir(), session, validator)
+		assert.ErrorIs(t, err, ErrUploadSessionIncomplete)
+
+		_, err = os.Stat(UploadSessionDirectory(root, session.ID))
+		assert.Nil(t, err)
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		var (
+			root            = t.TempDir()
+			location        = t.TempDir()
+			mockDB          = mocks.NewMockUploadData(gomock.NewController(t))
+			session, chunks = newTestUploadSession(t, root, data, 16)
+		)
+
+		session.SHA256 = sha256Hex([]byte("something else"))
+
+		mockDB.EXPECT().GetIngestUploadChunks(gomock.Any(), session.ID).Return(chunks, nil)
+		mockDB.EXPECT().ClaimIngestUploadSession(gomock.Any(), session.ID, gomock.Any()).Return(nil)
+		mockDB.EXPECT().DeleteIngestUploadSession(gomock.Any(), session.ID).Return(nil)
+
+		_, err := FinalizeUploadSession(ctx, mockDB, root, location, session, validator)
+		assert.ErrorIs(t, err, ErrUploadSessionMismatch)
+
+		entries, err := os.ReadDir(location)
+		require.Nil(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		var (
+			root            = t.TempDir()
+			mockDB          = mocks.NewMockUploadData(gomock.NewController(t))
+			session, chunks = newTestUploadSession(t, root, []byte("not a gzip file"), 4)
+		)
+
+		mockDB.EXPECT().GetIngestUploadChunks(gomock.Any(), session.ID).Return(chunks, nil)
+		mockDB.EXPECT().ClaimIngestUploadSession(gomock.Any(), session.ID, gomock.Any()).Return(nil)
+		mockDB.EXPECT().DeleteIngestUploadSession(gomock.Any(), session.ID).Return(nil)
+
+		_, err := FinalizeUploadSession(ctx, mockDB, root, t.TempDir(), session, validator)
+		assert.ErrorIs(t, err, ingest.ErrInvalidGzipFile)
+	})
+
+	t.Run("session is kept after a file system error", func(t *testing.T) {
+		var (
+			root            = t.TempDir()
+			location        = t.TempDir()
+			mockDB          = mocks.NewMockUploadData(gomock.NewController(t))
+			session, chunks = newTestUploadSession(t, root, data, 16)
+			lastChunk       = chunks[len(chunks)-1]
+		)
+
+		require.Nil(t, os.Remove(uploadChunkPath(UploadSessionDirectory(root, session.ID), lastChunk.ChunkIndex, lastChunk.SHA256)))
+
+		mockDB.EXPECT().GetIngestUploadChunks(gomock.Any(), session.ID).Return(chunks, nil)
+		mockDB.EXPECT().ClaimIngestUploadSession(gomock.Any(), session.ID, gomock.Any()).Return(nil)
+		mockDB.EXPECT().ReleaseIngestUploadSession(gomock.Any(), session.ID).Return(nil)
+
+		_, err := FinalizeUploadSession(ctx, mockDB, root, location, session, validator)
+		assert.ErrorIs(t, err, os.ErrNotExist)
+
+		_, err = os.Stat(UploadSessionDirectory(root, session.ID))
+		assert.Nil(t, err)
+
+		entries, err := os.ReadDir(location)
+		require.Nil(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("session being finalized is left alone", func(t *testing.T) {
+		var (
+			root            = t.TempDir()
+			location        = t.TempDir()
+			mockDB          = mocks.NewMockUploadData(gomock.NewController(t))
+			session, chunks = newTestUploadSession(t, root, data, 16)
+		)
+
+		mockDB.EXPECT().GetIngestUploadChunks(gomock.Any(), session.ID).Return(chunks, nil)
+		mockDB.EXPECT().ClaimIngestUploadSession(gomock.Any(), session.ID, gomock.Any()).Return(ingest.ErrUploadSessionFinalizing)
+
+		_, err := FinalizeUploadSession(ctx, mockDB, root, location, session, validator)
+		assert.ErrorIs(t, err, ingest.ErrUploadSessionFinalizing)
+
+		_, err = os.Stat(UploadSessionDirectory(root, session.ID))
+		assert.Nil(t, err)
+
+		entries, err := os.ReadDir(location)
+		require.Nil(t, err)
+		assert.Empty(t, entries)
+	})
+}
+
+func TestSweepUploadSessions(t *testing.T) {
+	var (
+		root   = t.TempDir()
+		mockDB = mocks.NewMockUploadData(gomock.NewController(t))
+	)
+
+	require.Nil(t, os.Mkdir(UploadSessionDirectory(root, 1), 0755))
+	require.Nil(t, os.Mkdir(UploadSessionDirectory(root, 2), 0755))
+
+	mockDB.EXPECT().SweepIngestUploadSessions(gomock.Any()).Return(nil)
+	mockDB.EXPECT().GetAllIngestUploadSessions(gomock.Any()).Return(model.IngestUploadSessions{{BigSerial: model.BigSerial{ID: 2}}}, nil)
+
+	SweepUploadSessions(context.Background(), mockDB, root)
+
+	_, err := os.Stat(UploadSessionDirectory(root, 1))
+	assert.True(t, errors.Is(err, os.ErrNotExist))
+
+	_, err = os.Stat(UploadSessionDirectory(root, 2))
+	assert.Nil(t, err)
+}
@@ -269,7 +269,9 @@ func handleScannerError(err error, dataFound, metaFound bool) (ingest.OriginalMe
 }
 
 type validationError struct {
-	Index   int
+	Index int
+	// Line is the 1-based line number of the error for NDJSON payloads and zero otherwise
+	Line    int
 	Message string
 }
 
@@ -306,10 +308,15 @@ func (s ValidationReport) Error() string {
 }
 
 func formatSchemaValidationError(arrayName string, index int, err error) string {
+	return formatSchemaViolations(fmt.Sprintf("%s[%d]", arrayName, index), err)
+}
+
+// formatSchemaViolations describes the schema violations of the item at the given location in the payload
+func formatSchemaViolations(location string, err error) string {
 	var sb strings.Builder
 	if ve, ok := err.(*jsonschema.ValidationError); ok {
 		numberOfViolations := len(ve.Causes)
-		sb.WriteString(fmt.Sprintf("%s[%d] schema validation failed with %d error(s): ", arrayName, index, numberOfViolations))
+		sb.WriteString(fmt.Sprintf("%s schema validation failed with %d error(s): ", location, numberOfViolations))
 
 		sb.WriteString("[")
 
@@ -421,6 +428,14 @@ func (v *validator) reportValidation(index int, msg string) {
 	v.validationErrors = append(v.validationErrors, validationError{Index: index, Message: msg})
 }
 
+func (v *validator) reportLineCritical(line int, msg string) {
+	v.criticalErrors = append(v.criticalErrors, validationError{Line: line, Message: msg})
+}
+
+func (v *validator) reportLineValidation(line int, msg string) {
+	v.validationErrors = append(v.validationErrors, validationError{Line: line, Message: msg})
+}
+
 func (v *validator) hasErrors() bool {
 	return len(v.criticalErrors) > 0 || len(v.validationErrors) > 0
 }
@@ -29,6 +29,7 @@ type IngestTaskParams struct {
 	FileType         model.FileType
 	RequestID        string
 	JobID            int64
+	DryRun           bool
 }
 
 func CreateIngestTask(ctx context.Context, db UploadData, params IngestTaskParams) (model.IngestTask, error) {
@@ -38,6 +39,7 @@ func CreateIngestTask(ctx context.Context, db UploadData, params IngestTaskParam
 		RequestGUID:      params.RequestID,
 		JobId:            null.Int64From(params.JobID),
 		FileType:         params.FileType,
+		DryRun:           params.DryRun,
 	}
 
 	return db.CreateIngestTask(ctx, newIngestTask)
@@ -34,20 +34,30 @@ import (
 var ErrInvalidJSON = errors.New("file is not valid json")
 
 func SaveIngestFile(location string, request *http.Request, validator IngestValidator) (IngestTaskParams, error) {
-	fileData := request.Body
+	return saveIngestFile(location, request.Header, request.Body, validator)
+}
 
+// saveIngestFile validates the file data with the validator matching the given content type header and writes it to a
+// temp file in location
+func saveIngestFile(location string, header http.Header, fileData io.Reader, validator IngestValidator) (IngestTaskParams, error) {
 	var (
 		fileType     model.FileType
 		validationFn FileValidator
 	)
 
 	switch {
-	case utils.HeaderMatches(request.Header, headers.ContentType.String(), mediatypes.ApplicationJson.String()):
+	case utils.HeaderMatches(header, headers.ContentType.String(), mediatypes.ApplicationJson.String()):
 		fileType = model.FileTypeJson
 		validationFn = validator.WriteAndValidateJSON
-	case utils.HeaderMatches(request.Header, headers.ContentType.String(), ingest.AllowedZipFileUploadTypes...):
+	case utils.HeaderMatches(header, headers.ContentType.String(), ingest.AllowedNDJSONFileUploadTypes...):
+		fileType = model.FileTypeNDJSON
+		validationFn = validator.WriteAndValidateNDJSON
+	case utils.HeaderMatches(header, headers.ContentType.String(), ingest.AllowedZipFileUploadTypes...):
 		fileType = model.FileTypeZip
 		validationFn = WriteAndValidateZip
+	case utils.HeaderMatches(header, headers.ContentType.String(), ingest.AllowedGzipFileUploadTypes...):
+		fileType = model.FileTypeGzip
+		validationFn = WriteAndValidateGzip
 	default:
 		return IngestTaskParams{}, fmt.Errorf("invalid content type for ingest file")
 	}
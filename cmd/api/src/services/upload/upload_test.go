@@ -18,6 +18,7 @@ package upload
 
 import (
 	"bytes"
+	"compress/gzip"
 	"errors"
 	"fmt"
 	"io"
@@ -51,6 +52,57 @@ func TestWriteAndValidateZip(t *testing.T) {
 	})
 }
 
+func TestWriteAndValidateGzip(t *testing.T) {
+	t.Run("valid gzip file is ok", func(t *testing.T) {
+		var (
+			writer     = bytes.Buffer{}
+			compressed = bytes.Buffer{}
+			gzipWriter = gzip.NewWriter(&compressed)
+		)
+
+		_, err := gzipWriter.Write([]byte(`{"meta": {"type": "domains", "version": 6, "count": 0}, "data": []}`))
+		assert.Nil(t, err)
+		assert.Nil(t, gzipWriter.Close())
+
+		expected := bytes.Clone(compressed.Bytes())
+
+		_, err = WriteAndValidateGzip(&compressed, &writer)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, writer.Bytes())
+	})
+
+	t.Run("invalid bytes causes error", func(t *testing.T) {
+		var (
+			writer  = bytes.Buffer{}
+			badGzip = strings.NewReader("123123")
+		)
+
+		_, err := WriteAndValidateGzip(badGzip, &writer)
+		assert.Equal(t, err, ingest.ErrInvalidGzipFile)
+	})
+
+	t.Run("truncated header causes error", func(t *testing.T) {
+		var (
+			writer  = bytes.Buffer{}
+			badGzip = bytes.NewReader([]byte{0x1f, 0x8b})
+		)
+
+		_, err := WriteAndValidateGzip(badGzip, &writer)
+		assert.Equal(t, err, ingest.ErrInvalidGzipFile)
+	})
+
+	t.Run("zip file is rejected", func(t *testing.T) {
+		writer := bytes.Buffer{}
+
+		file, err := os.Open("../../test/fixtures/fixtures/goodzip.zip")
+		assert.Nil(t, err)
+		defer file.Close()
+
+		_, err = WriteAndValidateGzip(file, &writer)
+		assert.Equal(t, err, ingest.ErrInvalidGzipFile)
+	})
+}
+
 func TestWriteAndValidateJSON(t *testing.T) {
 	tests := []struct {
 		name           string
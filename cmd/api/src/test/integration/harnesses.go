@@ -3743,6 +3743,18 @@ func (s *ESC6aHarnessTemplate2) Setup(c *GraphTestContext) {
 	setupHarnessFromArrowsJson(c, "esc6a-template2")
 }
 
+type ESC7HarnessPrincipalEdges struct{}
+
+func (s *ESC7HarnessPrincipalEdges) Setup(c *GraphTestContext) {
+	setupHarnessFromArrowsJson(c, "esc7-principal-edges")
+}
+
+type ESC15HarnessPrincipalEdges struct{}
+
+func (s *ESC15HarnessPrincipalEdges) Setup(c *GraphTestContext) {
+	setupHarnessFromArrowsJson(c, "esc15-principal-edges")
+}
+
 type ESC10aPrincipalHarness struct {
 	Domain       *graph.Node
 	NTAuthStore  *graph.Node
@@ -6876,6 +6888,103 @@ func (s *AZAddSecretHarness) Setup(graphTestContext *GraphTestContext) {
 	graphTestContext.NewRelationship(s.AZTenant, s.CloudAppAdminRole, azure.Contains)
 }
 
+type AZAdministrativeUnitHarness struct {
+	AZTenant           *graph.Node
+	AdministrativeUnit *graph.Node
+	ScopedAdmin        *graph.Node
+	MemberUser         *graph.Node
+	MemberGroup        *graph.Node
+	OutsideUser        *graph.Node
+	OutsideGroup       *graph.Node
+	PasswordAdminRole  *graph.Node
+	GroupsAdminRole    *graph.Node
+}
+
+func (s *AZAdministrativeUnitHarness) Setup(graphTestContext *GraphTestContext) {
+	tenantID := RandomObjectID(graphTestContext.testCtx)
+	administrativeUnitObjectID := RandomObjectID(graphTestContext.testCtx)
+	s.AZTenant = graphTestContext.NewAzureTenant(tenantID)
+
+	s.AdministrativeUnit = graphTestContext.NewNode(graph.AsProperties(graph.PropertyMap{
+		common.Name:     "AdministrativeUnit",
+		common.ObjectID: administrativeUnitObjectID,
+		azure.TenantID:  tenantID,
+	}), azure.Entity, azure.AdministrativeUnit)
+
+	s.ScopedAdmin = graphTestContext.NewAzureUser("ScopedAdmin", "ScopedAdmin", "", RandomObjectID(graphTestContext.testCtx), "", tenantID, false)
+	s.MemberUser = graphTestContext.NewAzureUser("MemberUser", "MemberUser", "", RandomObjectID(graphTestContext.testCtx), "", tenantID, false)
+	s.OutsideUser = graphTestContext.NewAzureUser("OutsideUser", "OutsideUser", "", RandomObjectID(graphTestContext.testCtx), "", tenantID, false)
+	s.MemberGroup = graphTestContext.NewAzureGroup("MemberGroup", RandomObjectID(graphTestContext.testCtx), tenantID)
+	s.OutsideGroup = graphTestContext.NewAzureGroup("OutsideGroup", RandomObjectID(graphTestContext.testCtx), tenantID)
+
+	s.MemberGroup.Properties.Set(azure.IsAssignableToRole.String(), false)
+	s.OutsideGroup.Properties.Set(azure.IsAssignableToRole.String(), false)
+	graphTestContext.UpdateNode(s.MemberGroup)
+	graphTestContext.UpdateNode(s.OutsideGroup)
+
+	s.PasswordAdminRole = graphTestContext.NewAzureRole("PasswordAdminRole", RandomObjectID(graphTestContext.testCtx), azure.PasswordAdministratorRole, tenantID)
+	s.GroupsAdminRole = graphTestContext.NewAzureRole("GroupsAdminRole", RandomObjectID(graphTestContext.testCtx), azure.GroupsAdministratorRole, tenantID)
+
+	graphTestContext.NewRelationship(s.AZTenant, s.AdministrativeUnit, azure.Contains)
+	graphTestContext.NewRelationship(s.AZTenant, s.ScopedAdmin, azure.Contains)
+	graphTestContext.NewRelationship(s.AZTenant, s.MemberUser, azure.Contains)
+	graphTestContext.NewRelationship(s.AZTenant, s.OutsideUser, azure.Contains)
+	graphTestContext.NewRelationship(s.AZTenant, s.MemberGroup, azure.Contains)
+	graphTestContext.NewRelationship(s.AZTenant, s.OutsideGroup, azure.Contains)
+	graphTestContext.NewRelationship(s.AZTenant, s.PasswordAdminRole, azure.Contains)
+	graphTestContext.NewRelationship(s.AZTenant, s.GroupsAdminRole, azure.Contains)
+
+	graphTestContext.NewRelationship(s.AdministrativeUnit, s.MemberUser, azure.Contains)
+	graphTestContext.NewRelationship(s.AdministrativeUnit, s.MemberGroup, azure.Contains)
+
+	scope := graph.AsProperties(graph.PropertyMap{
+		azure.Scope: "ADMINISTRATIVEUNITS/" + strings.ToUpper(administrativeUnitObjectID),
+	})
+
+	graphTestContext.NewRelationship(s.ScopedAdmin, s.PasswordAdminRole, azure.HasScopedRole, scope)
+	graphTestContext.NewRelationship(s.ScopedAdmin, s.GroupsAdminRole, azure.HasScopedRole, scope)
+}
+
+type AZStorageAccountHarness struct {
+	AZTenant                 *graph.Node
+	AZResourceGroup          *graph.Node
+	StorageAccount           *graph.Node
+	SharedKeyDisabledAccount *graph.Node
+	Contributor              *graph.Node
+	Owner                    *graph.Node
+}
+
+func (s *AZStorageAccountHarness) Setup(graphTestContext *GraphTestContext) {
+	tenantID := RandomObjectID(graphTestContext.testCtx)
+	s.AZTenant = graphTestContext.NewAzureTenant(tenantID)
+	s.AZResourceGroup = graphTestContext.NewAzureResourceGroup("ResourceGroup", RandomObjectID(graphTestContext.testCtx), tenantID)
+
+	s.StorageAccount = graphTestContext.NewNode(graph.AsProperties(graph.PropertyMap{
+		common.Name:                "StorageAccount",
+		common.ObjectID:            RandomObjectID(graphTestContext.testCtx),
+		azure.TenantID:             tenantID,
+		azure.AllowSharedKeyAccess: true,
+	}), azure.Entity, azure.StorageAccount)
+
+	s.SharedKeyDisabledAccount = graphTestContext.NewNode(graph.AsProperties(graph.PropertyMap{
+		common.Name:                "SharedKeyDisabledAccount",
+		common.ObjectID:            RandomObjectID(graphTestContext.testCtx),
+		azure.TenantID:             tenantID,
+		azure.AllowSharedKeyAccess: false,
+	}), azure.Entity, azure.StorageAccount)
+
+	s.Contributor = graphTestContext.NewAzureUser("Contributor", "Contributor", "", RandomObjectID(graphTestContext.testCtx), "", tenantID, false)
+	s.Owner = graphTestContext.NewAzureUser("Owner", "Owner", "", RandomObjectID(graphTestContext.testCtx), "", tenantID, false)
+
+	graphTestContext.NewRelationship(s.AZTenant, s.AZResourceGroup, azure.Contains)
+	graphTestContext.NewRelationship(s.AZResourceGroup, s.StorageAccount, azure.Contains)
+	graphTestContext.NewRelationship(s.AZResourceGroup, s.SharedKeyDisabledAccount, azure.Contains)
+
+	graphTestContext.NewRelationship(s.Contributor, s.AZResourceGroup, azure.Contributor)
+	graphTestContext.NewRelationship(s.Owner, s.StorageAccount, azure.Owner)
+	graphTestContext.NewRelationship(s.Owner, s.SharedKeyDisabledAccount, azure.Owner)
+}
+
 type ExtendedByPolicyHarness struct {
 	IssuancePolicy0 *graph.Node
 	IssuancePolicy1 *graph.Node
@@ -7068,6 +7177,72 @@ func (s *HybridAttackPaths) Setup(graphTestContext *GraphTestContext, adUserObje
 	}
 }
 
+// HybridSyncedGroupsAndDevices contains synced Entra groups and devices across two tenants. AZGroup1 and AZGroup2 share
+// the on-prem SID of ADGroup, AZUnmatchedGroup points at an SID with no AD node and AZUnsyncedDevice has on-prem sync
+// disabled.
+type HybridSyncedGroupsAndDevices struct {
+	AZTenant1        *graph.Node
+	AZTenant2        *graph.Node
+	AZGroup1         *graph.Node
+	AZGroup2         *graph.Node
+	AZUnmatchedGroup *graph.Node
+	AZDevice         *graph.Node
+	AZUnsyncedDevice *graph.Node
+	ADGroup          *graph.Node
+	ADComputer       *graph.Node
+	ADComputer2      *graph.Node
+}
+
+func (s *HybridSyncedGroupsAndDevices) Setup(graphTestContext *GraphTestContext) {
+	var (
+		tenant1ID = RandomObjectID(graphTestContext.testCtx)
+		tenant2ID = RandomObjectID(graphTestContext.testCtx)
+		domainSid = RandomDomainSID()
+
+		adGroupSID     = domainSid + "-1105"
+		adComputerSID  = domainSid + "-1106"
+		adComputer2SID = domainSid + "-1107"
+		unmatchedSID   = domainSid + "-1108"
+	)
+
+	newSyncedAzureNode := func(name, tenantID, onPremID string, onPremSyncEnabled bool, kind graph.Kind) *graph.Node {
+		return graphTestContext.NewNode(graph.AsProperties(graph.PropertyMap{
+			common.Name:             name,
+			common.ObjectID:         RandomObjectID(graphTestContext.testCtx),
+			azure.TenantID:          tenantID,
+			azure.OnPremSyncEnabled: onPremSyncEnabled,
+			azure.OnPremID:          onPremID,
+		}), azure.Entity, kind)
+	}
+
+	newADNode := func(name, objectID string, kind graph.Kind) *graph.Node {
+		return graphTestContext.NewNode(graph.AsProperties(graph.PropertyMap{
+			common.Name:     name,
+			common.ObjectID: objectID,
+			ad.DomainSID:    domainSid,
+		}), ad.Entity, kind)
+	}
+
+	s.AZTenant1 = graphTestContext.NewAzureTenant(tenant1ID)
+	s.AZTenant2 = graphTestContext.NewAzureTenant(tenant2ID)
+
+	s.AZGroup1 = newSyncedAzureNode("AZGroup1", tenant1ID, adGroupSID, true, azure.Group)
+	s.AZGroup2 = newSyncedAzureNode("AZGroup2", tenant2ID, adGroupSID, true, azure.Group)
+	s.AZUnmatchedGroup = newSyncedAzureNode("AZUnmatchedGroup", tenant1ID, unmatchedSID, true, azure.Group)
+	s.AZDevice = newSyncedAzureNode("AZDevice", tenant1ID, adComputerSID, true, azure.Device)
+	s.AZUnsyncedDevice = newSyncedAzureNode("AZUnsyncedDevice", tenant2ID, adComputer2SID, false, azure.Device)
+
+	s.ADGroup = newADNode("ADGroup", adGroupSID, ad.Group)
+	s.ADComputer = newADNode("ADComputer", adComputerSID, ad.Computer)
+	s.ADComputer2 = newADNode("ADComputer2", adComputer2SID, ad.Computer)
+
+	graphTestContext.NewRelationship(s.AZTenant1, s.AZGroup1, azure.Contains)
+	graphTestContext.NewRelationship(s.AZTenant2, s.AZGroup2, azure.Contains)
+	graphTestContext.NewRelationship(s.AZTenant1, s.AZUnmatchedGroup, azure.Contains)
+	graphTestContext.NewRelationship(s.AZTenant1, s.AZDevice, azure.Contains)
+	graphTestContext.NewRelationship(s.AZTenant2, s.AZUnsyncedDevice, azure.Contains)
+}
+
 type DCSyncHarness struct {
 	Domain1 *graph.Node
 
@@ -8893,6 +9068,77 @@ func (s *CoerceAndRelayNTLMtoADCS) Setup(graphTestContext *GraphTestContext) {
 	graphTestContext.UpdateNode(s.AuthenticatedUsersGroup)
 }
 
+type CoerceAndRelayNTLMtoADCSRPC struct {
+	AuthenticatedUsersGroup *graph.Node
+	CertTemplate1           *graph.Node
+	Computer                *graph.Node
+	CAHost1                 *graph.Node
+	CAHost2                 *graph.Node
+	Domain                  *graph.Node
+	EnterpriseCA1           *graph.Node
+	EnterpriseCA2           *graph.Node
+	NTAuthStore             *graph.Node
+	RootCA                  *graph.Node
+}
+
+func (s *CoerceAndRelayNTLMtoADCSRPC) Setup(graphTestContext *GraphTestContext) {
+	domainSid := RandomDomainSID()
+	s.AuthenticatedUsersGroup = graphTestContext.NewActiveDirectoryGroup("Authenticated Users Group", domainSid)
+	s.CertTemplate1 = graphTestContext.NewActiveDirectoryCertTemplate("CertTemplate1", domainSid, CertTemplateData{
+		ApplicationPolicies:           []string{},
+		AuthenticationEnabled:         true,
+		AuthorizedSignatures:          0,
+		EffectiveEKUs:                 []string{},
+		EnrolleeSuppliesSubject:       false,
+		NoSecurityExtension:           false,
+		RequiresManagerApproval:       false,
+		SchannelAuthenticationEnabled: false,
+		SchemaVersion:                 1,
+		SubjectAltRequireEmail:        false,
+		SubjectAltRequireSPN:          false,
+		SubjectAltRequireUPN:          false,
+	})
+	s.CAHost1 = graphTestContext.NewActiveDirectoryComputer("CAHost1", domainSid)
+	s.CAHost2 = graphTestContext.NewActiveDirectoryComputer("CAHost2", domainSid)
+	s.Computer = graphTestContext.NewActiveDirectoryComputer("Computer", domainSid)
+	s.Domain = graphTestContext.NewActiveDirectoryDomain("Domain", domainSid, false, true)
+	s.EnterpriseCA1 = graphTestContext.NewActiveDirectoryEnterpriseCA("EnterpriseCA1", domainSid)
+	s.EnterpriseCA2 = graphTestContext.NewActiveDirectoryEnterpriseCA("EnterpriseCA2", domainSid)
+	s.NTAuthStore = graphTestContext.NewActiveDirectoryNTAuthStore("NTAuthStore", domainSid)
+	s.RootCA = graphTestContext.NewActiveDirectoryRootCA("RootCA", domainSid)
+	graphTestContext.NewRelationship(s.Computer, s.CertTemplate1, ad.Enroll)
+	graphTestContext.NewRelationship(s.Computer, s.EnterpriseCA1, ad.Enroll)
+	graphTestContext.NewRelationship(s.Computer, s.EnterpriseCA2, ad.Enroll)
+	graphTestContext.NewRelationship(s.AuthenticatedUsersGroup, s.EnterpriseCA1, ad.Enroll)
+	graphTestContext.NewRelationship(s.AuthenticatedUsersGroup, s.EnterpriseCA2, ad.Enroll)
+	graphTestContext.NewRelationship(s.CAHost1, s.EnterpriseCA1, ad.HostsCAService)
+	graphTestContext.NewRelationship(s.CAHost2, s.EnterpriseCA2, ad.HostsCAService)
+	graphTestContext.NewRelationship(s.CertTemplate1, s.EnterpriseCA1, ad.PublishedTo)
+	graphTestContext.NewRelationship(s.CertTemplate1, s.EnterpriseCA2, ad.PublishedTo)
+	graphTestContext.NewRelationship(s.EnterpriseCA1, s.RootCA, ad.IssuedSignedBy)
+	graphTestContext.NewRelationship(s.EnterpriseCA2, s.RootCA, ad.IssuedSignedBy)
+	graphTestContext.NewRelationship(s.EnterpriseCA1, s.NTAuthStore, ad.TrustedForNTAuth)
+	graphTestContext.NewRelationship(s.EnterpriseCA2, s.NTAuthStore, ad.TrustedForNTAuth)
+	graphTestContext.NewRelationship(s.NTAuthStore, s.Domain, ad.NTAuthStoreFor)
+	graphTestContext.NewRelationship(s.RootCA, s.Domain, ad.RootCAFor)
+
+	// Only EnterpriseCA1 accepts unencrypted ICPR requests; neither CA exposes a vulnerable web enrollment endpoint
+	s.EnterpriseCA1.Properties.Set(ad.EnforceEncryptICertRequest.String(), false)
+	s.EnterpriseCA1.Properties.Set(ad.HasVulnerableEndpoint.String(), false)
+	graphTestContext.UpdateNode(s.EnterpriseCA1)
+	s.EnterpriseCA2.Properties.Set(ad.EnforceEncryptICertRequest.String(), true)
+	s.EnterpriseCA2.Properties.Set(ad.HasVulnerableEndpoint.String(), false)
+	graphTestContext.UpdateNode(s.EnterpriseCA2)
+	s.Computer.Properties.Set(ad.RestrictOutboundNTLM.String(), false)
+	graphTestContext.UpdateNode(s.Computer)
+	s.CAHost1.Properties.Set(common.Enabled.String(), true)
+	graphTestContext.UpdateNode(s.CAHost1)
+	s.CAHost2.Properties.Set(common.Enabled.String(), true)
+	graphTestContext.UpdateNode(s.CAHost2)
+	s.AuthenticatedUsersGroup.Properties.Set(common.ObjectID.String(), fmt.Sprintf("authenticated-users%s", wellknown.AuthenticatedUsersSIDSuffix.String()))
+	graphTestContext.UpdateNode(s.AuthenticatedUsersGroup)
+}
+
 type CoerceAndRelayNTLMToSMB struct {
 	Computer1  *graph.Node
 	Computer10 *graph.Node
@@ -10166,6 +10412,8 @@ type HarnessDetails struct {
 	AZInboundControlHarness                         AZInboundControlHarness
 	ExtendedByPolicyHarness                         ExtendedByPolicyHarness
 	AZAddSecretHarness                              AZAddSecretHarness
+	AZAdministrativeUnitHarness                     AZAdministrativeUnitHarness
+	AZStorageAccountHarness                         AZStorageAccountHarness
 	ESC3Harness1                                    ESC3Harness1
 	ESC3Harness2                                    ESC3Harness2
 	ESC3Harness3                                    ESC3Harness3
@@ -10208,6 +10456,8 @@ type HarnessDetails struct {
 	ESC6bTemplate2Harness                           ESC6bTemplate2Harness
 	ESC6bHarnessDC1                                 ESC6bHarnessDC1
 	ESC6bHarnessDC2                                 ESC6bHarnessDC2
+	ESC7HarnessPrincipalEdges                       ESC7HarnessPrincipalEdges
+	ESC15HarnessPrincipalEdges                      ESC15HarnessPrincipalEdges
 	ESC4Template1                                   ESC4Template1
 	ESC4Template2                                   ESC4Template2
 	ESC4Template3                                   ESC4Template3
@@ -10220,11 +10470,13 @@ type HarnessDetails struct {
 	DCSyncHarness                                   DCSyncHarness
 	SyncLAPSPasswordHarness                         SyncLAPSPasswordHarness
 	HybridAttackPaths                               HybridAttackPaths
+	HybridSyncedGroupsAndDevices                    HybridSyncedGroupsAndDevices
 	OwnsWriteOwner                                  OwnsWriteOwner
 	NTLMCoerceAndRelayNTLMToSMB                     CoerceAndRelayNTLMToSMB
 	NTLMCoerceAndRelayNTLMToLDAP                    CoerceAndRelayNTLMToLDAP
 	NTLMCoerceAndRelayNTLMToLDAPS                   CoerceAndRelayNTLMToLDAPS
 	NTLMCoerceAndRelayNTLMToADCS                    CoerceAndRelayNTLMtoADCS
+	NTLMCoerceAndRelayNTLMToADCSRPC                 CoerceAndRelayNTLMtoADCSRPC
 	NTLMCoerceAndRelayToLDAPSelfRelay               CoerceAndRelayNTLMToLDAPSelfRelay
 	NTLMCoerceAndRelayToLDAPSSelfRelay              CoerceAndRelayNTLMToLDAPSSelfRelay
 	NTLMCoerceAndRelayNTLMToSMBSelfRelay            CoerceAndRelayNTLMToSMBSelfRelay
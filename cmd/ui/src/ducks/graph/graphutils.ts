@@ -222,6 +222,9 @@ const ICONS: { [id in GraphNodeTypes]: string } = {
     [GraphNodeTypes.AZWebApp]: 'fa-object-group',
     [GraphNodeTypes.AZLogicApp]: 'fa-sitemap',
     [GraphNodeTypes.AZAutomationAccount]: 'fa-cog',
+    [GraphNodeTypes.AZAdministrativeUnit]: 'fa-layer-group',
+    [GraphNodeTypes.AZStorageAccount]: 'fa-hard-drive',
+    [GraphNodeTypes.AZSQLServer]: 'fa-database',
     [GraphNodeTypes.Base]: 'fa-question',
     [GraphNodeTypes.Computer]: 'fa-desktop',
     [GraphNodeTypes.Domain]: 'fa-globe',
@@ -35,6 +35,9 @@ export enum GraphNodeTypes {
     AZWebApp = 'AZWebApp',
     AZLogicApp = 'AZLogicApp',
     AZAutomationAccount = 'AZAutomationAccount',
+    AZAdministrativeUnit = 'AZAdministrativeUnit',
+    AZStorageAccount = 'AZStorageAccount',
+    AZSQLServer = 'AZSQLServer',
     Base = 'Base',
     User = 'User',
     Group = 'Group',
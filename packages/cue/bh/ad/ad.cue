@@ -120,6 +120,20 @@ RoleSeparationEnabledCollected: types.#StringEnum & {
 	representation: "roleseparationenabledcollected"
 }
 
+IsESC15Patched: types.#StringEnum & {
+	symbol:         "IsESC15Patched"
+	schema:         "ad"
+	name:           "Is ESC15 Patched"
+	representation: "isesc15patched"
+}
+
+EnforceEncryptICertRequest: types.#StringEnum & {
+	symbol:         "EnforceEncryptICertRequest"
+	schema:         "ad"
+	name:           "Enforce Encrypt ICertRequest"
+	representation: "enforceencrypticertrequest"
+}
+
 HasBasicConstraints: types.#StringEnum & {
 	symbol:         "HasBasicConstraints"
 	schema:         "ad"
@@ -1050,6 +1064,8 @@ Properties: [
 	IsUserSpecifiesSanEnabledCollected,
 	RoleSeparationEnabled,
 	RoleSeparationEnabledCollected,
+	IsESC15Patched,
+	EnforceEncryptICertRequest,
 	HasBasicConstraints,
 	BasicConstraintPathLength,
 	UnresolvedPublishedTemplates,
@@ -1613,6 +1629,11 @@ ADCSESC6b: types.#Kind & {
 	schema: "active_directory"
 }
 
+ADCSESC7: types.#Kind & {
+	symbol: "ADCSESC7"
+	schema: "active_directory"
+}
+
 ADCSESC9a: types.#Kind & {
 	symbol: "ADCSESC9a"
 	schema: "active_directory"
@@ -1638,12 +1659,27 @@ ADCSESC13: types.#Kind & {
 	schema: "active_directory"
 }
 
+ADCSESC15: types.#Kind & {
+	symbol: "ADCSESC15"
+	schema: "active_directory"
+}
+
 SyncedToADUser: types.#Kind & {
 	symbol:			"SyncedToADUser"
 	schema:			"active_directory"
 	representation:	"SyncedToADUser"
 }
 
+SyncedToADGroup: types.#Kind & {
+	symbol: "SyncedToADGroup"
+	schema: "active_directory"
+}
+
+SyncedToADComputer: types.#Kind & {
+	symbol: "SyncedToADComputer"
+	schema: "active_directory"
+}
+
 CoerceAndRelayNTLMToSMB: types.#Kind & {
 	symbol: "CoerceAndRelayNTLMToSMB"
 	schema: "active_directory"
@@ -1654,6 +1690,11 @@ CoerceAndRelayNTLMToADCS: types.#Kind & {
 	schema: "active_directory"
 }
 
+CoerceAndRelayNTLMToADCSRPC: types.#Kind & {
+	symbol: "CoerceAndRelayNTLMToADCSRPC"
+	schema: "active_directory"
+}
+
 WriteOwnerLimitedRights: types.#Kind & {
 	symbol: "WriteOwnerLimitedRights"
 	schema: "active_directory"
@@ -1786,14 +1827,19 @@ RelationshipKinds: [
 	ADCSESC4,
 	ADCSESC6a,
 	ADCSESC6b,
+	ADCSESC7,
 	ADCSESC9a,
 	ADCSESC9b,
 	ADCSESC10a,
 	ADCSESC10b,
 	ADCSESC13,
+	ADCSESC15,
 	SyncedToADUser,
+	SyncedToADGroup,
+	SyncedToADComputer,
 	CoerceAndRelayNTLMToSMB,
 	CoerceAndRelayNTLMToADCS,
+	CoerceAndRelayNTLMToADCSRPC,
 	WriteOwnerLimitedRights,
 	WriteOwnerRaw,
 	OwnsLimitedRights,
@@ -1882,14 +1928,19 @@ SharedRelationshipKinds: [
 	ADCSESC4,
 	ADCSESC6a,
 	ADCSESC6b,
+	ADCSESC7,
 	ADCSESC9a,
 	ADCSESC9b,
 	ADCSESC10a,
 	ADCSESC10b,
 	ADCSESC13,
+	ADCSESC15,
 	SyncedToADUser,
+	SyncedToADGroup,
+	SyncedToADComputer,
 	CoerceAndRelayNTLMToSMB,
 	CoerceAndRelayNTLMToADCS,
+	CoerceAndRelayNTLMToADCSRPC,
 	WriteOwnerLimitedRights,
 	OwnsLimitedRights,
 	ClaimSpecialIdentity,
@@ -1924,13 +1975,16 @@ EdgeCompositionRelationships: [
 	ADCSESC4,
 	ADCSESC6a,
 	ADCSESC6b,
+	ADCSESC7,
 	ADCSESC9a,
 	ADCSESC9b,
 	ADCSESC10a,
 	ADCSESC10b,
 	ADCSESC13,
+	ADCSESC15,
 	CoerceAndRelayNTLMToSMB,
 	CoerceAndRelayNTLMToADCS,
+	CoerceAndRelayNTLMToADCSRPC,
 	CoerceAndRelayNTLMToLDAP,
 	CoerceAndRelayNTLMToLDAPS,
 	GPOAppliesTo,
@@ -1954,17 +2008,22 @@ PostProcessedRelationships: [
 	ADCSESC4,
 	ADCSESC6a,
 	ADCSESC6b,
+	ADCSESC7,
 	ADCSESC10a,
 	ADCSESC10b,
 	ADCSESC9a,
 	ADCSESC9b,
 	ADCSESC13,
+	ADCSESC15,
 	EnrollOnBehalfOf,
 	SyncedToADUser,
+	SyncedToADGroup,
+	SyncedToADComputer,
 	Owns,
 	WriteOwner,
 	ExtendedByPolicy,
 	CoerceAndRelayNTLMToADCS,
+	CoerceAndRelayNTLMToADCSRPC,
 	CoerceAndRelayNTLMToSMB,
 	CoerceAndRelayNTLMToLDAP,
 	CoerceAndRelayNTLMToLDAPS,
@@ -311,6 +311,13 @@ LastSuccessfulSignInDateTime: types.#StringEnum & {
 	representation: "lastsuccessfulsignindatetime"
 }
 
+AllowSharedKeyAccess: types.#StringEnum & {
+	symbol:         "AllowSharedKeyAccess"
+	schema:         "azure"
+	name:           "Allow Shared Key Access"
+	representation: "allowsharedkeyaccess"
+}
+
 Properties: [
 	AppOwnerOrganizationID,
 	AppDescription,
@@ -351,7 +358,8 @@ Properties: [
 	EndUserAssignmentRequiresMFA,
 	EndUserAssignmentRequiresJustification,
 	EndUserAssignmentRequiresTicketInformation,
-	LastSuccessfulSignInDateTime
+	LastSuccessfulSignInDateTime,
+	AllowSharedKeyAccess
 ]
 
 // Kinds
@@ -475,6 +483,24 @@ AutomationAccount: types.#Kind & {
 	representation: "AZAutomationAccount"
 }
 
+AdministrativeUnit: types.#Kind & {
+	symbol:         "AdministrativeUnit"
+	schema:         "azure"
+	representation: "AZAdministrativeUnit"
+}
+
+StorageAccount: types.#Kind & {
+	symbol:         "StorageAccount"
+	schema:         "azure"
+	representation: "AZStorageAccount"
+}
+
+SQLServer: types.#Kind & {
+	symbol:         "SQLServer"
+	schema:         "azure"
+	representation: "AZSQLServer"
+}
+
 NodeKinds: [
 	Entity,
 	VMScaleSet,
@@ -496,6 +522,9 @@ NodeKinds: [
 	WebApp,
 	LogicApp,
 	AutomationAccount,
+	AdministrativeUnit,
+	StorageAccount,
+	SQLServer,
 ]
 
 AvereContributor: types.#Kind & {
@@ -779,6 +808,16 @@ SyncedToEntraUser: types.#Kind & {
 	schema: "azure"
 }
 
+SyncedToEntraGroup: types.#Kind & {
+	symbol: "SyncedToEntraGroup"
+	schema: "azure"
+}
+
+SyncedToEntraDevice: types.#Kind & {
+	symbol: "SyncedToEntraDevice"
+	schema: "azure"
+}
+
 AZRoleEligible: types.#Kind & {
 	symbol: "AZRoleEligible"
 	schema: "azure"
@@ -791,6 +830,18 @@ AZRoleApprover: types.#Kind & {
 	representation:	"AZRoleApprover"
 }
 
+GetStorageKeys: types.#Kind & {
+	symbol:         "GetStorageKeys"
+	schema:         "azure"
+	representation: "AZGetStorageKeys"
+}
+
+HasScopedRole: types.#Kind & {
+	symbol:         "HasScopedRole"
+	schema:         "azure"
+	representation: "AZHasScopedRole"
+}
+
 RelationshipKinds: [
 	AvereContributor,
 	Contains,
@@ -839,8 +890,12 @@ RelationshipKinds: [
 	AZMGGrantAppRoles,
 	AZMGGrantRole,
 	SyncedToEntraUser,
+	SyncedToEntraGroup,
+	SyncedToEntraDevice,
 	AZRoleEligible,
 	AZRoleApprover,
+	GetStorageKeys,
+	HasScopedRole,
 ]
 
 AppRoleTransitRelationshipKinds: [
@@ -941,8 +996,11 @@ InboundOutboundRelationshipKinds: [
 	AZMGGrantAppRoles,
 	AZMGGrantRole,
 	SyncedToEntraUser,
+	SyncedToEntraGroup,
+	SyncedToEntraDevice,
 	AZRoleEligible,
 	AZRoleApprover,
+	GetStorageKeys,
 	Contains
 ]
 
@@ -962,5 +1020,8 @@ PostProcessedRelationships: [
 	AZMGGrantAppRoles,
 	AZMGGrantRole,
 	SyncedToEntraUser,
+	SyncedToEntraGroup,
+	SyncedToEntraDevice,
 	AZRoleApprover,
+	GetStorageKeys,
 ]
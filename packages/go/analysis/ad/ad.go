@@ -692,6 +692,8 @@ func GetEdgeCompositionPath(ctx context.Context, db graph.Database, edge *graph.
 			pathSet, err = GetADCSESC4EdgeComposition(ctx, db, edge)
 		case ad.ADCSESC6a, ad.ADCSESC6b:
 			pathSet, err = GetADCSESC6EdgeComposition(ctx, db, edge)
+		case ad.ADCSESC7:
+			pathSet, err = GetADCSESC7EdgeComposition(ctx, db, edge)
 		case ad.ADCSESC9a:
 			pathSet, err = GetADCSESC9aEdgeComposition(ctx, db, edge)
 		case ad.ADCSESC9b:
@@ -700,8 +702,12 @@ func GetEdgeCompositionPath(ctx context.Context, db graph.Database, edge *graph.
 			pathSet, err = GetADCSESC10EdgeComposition(ctx, db, edge)
 		case ad.ADCSESC13:
 			pathSet, err = GetADCSESC13EdgeComposition(ctx, db, edge)
+		case ad.ADCSESC15:
+			pathSet, err = GetADCSESC15EdgeComposition(ctx, db, edge)
 		case ad.CoerceAndRelayNTLMToADCS:
 			pathSet, err = GetCoerceAndRelayNTLMtoADCSEdgeComposition(ctx, db, edge)
+		case ad.CoerceAndRelayNTLMToADCSRPC:
+			pathSet, err = GetCoerceAndRelayNTLMtoADCSRPCEdgeComposition(ctx, db, edge)
 		case ad.CoerceAndRelayNTLMToSMB:
 			pathSet, err = GetCoerceAndRelayNTLMtoSMBEdgeComposition(ctx, db, edge)
 		}
@@ -726,6 +732,8 @@ func GetRelayTargets(ctx context.Context, db graph.Database, edge *graph.Relatio
 			nodeSet, err = GetVulnerableDomainControllersForRelayNTLMtoLDAPS(ctx, db, edge)
 		case ad.CoerceAndRelayNTLMToADCS:
 			nodeSet, err = GetVulnerableEnterpriseCAsForRelayNTLMtoADCS(ctx, db, edge)
+		case ad.CoerceAndRelayNTLMToADCSRPC:
+			nodeSet, err = GetVulnerableEnterpriseCAsForRelayNTLMtoADCSRPC(ctx, db, edge)
 		case ad.CoerceAndRelayNTLMToSMB:
 			nodeSet, err = GetCoercionTargetsForCoerceAndRelayNTLMtoSMB(ctx, db, edge)
 		}
@@ -35,7 +35,7 @@ var (
 	EkuCertRequestAgent = "1.3.6.1.4.1.311.20.2.1"
 )
 
-func PostADCS(ctx context.Context, db graph.Database, localGroupData *LocalGroupData, adcsEnabled bool) (*analysis.AtomicPostProcessingStats, ADCSCache, error) {
+func PostADCS(ctx context.Context, db graph.Database, localGroupData *LocalGroupData, adcsEnabled bool, scope *DomainScope) (*analysis.AtomicPostProcessingStats, ADCSCache, error) {
 	defer measure.ContextLogAndMeasure(
 		ctx,
 		slog.LevelInfo,
@@ -54,14 +54,14 @@ func PostADCS(ctx context.Context, db graph.Database, localGroupData *LocalGroup
 		return &analysis.AtomicPostProcessingStats{}, cache, fmt.Errorf("failed fetching AIACA nodes: %w", err)
 	} else if certTemplates, err := FetchNodesByKind(ctx, db, ad.CertTemplate); err != nil {
 		return &analysis.AtomicPostProcessingStats{}, cache, fmt.Errorf("failed fetching cert template nodes: %w", err)
-	} else if step1Stats, err := postADCSPreProcessStep1(ctx, db, enterpriseCertAuthorities, rootCertAuthorities, aiaCertAuthorities, certTemplates); err != nil {
+	} else if step1Stats, err := postADCSPreProcessStep1(ctx, db, enterpriseCertAuthorities, rootCertAuthorities, aiaCertAuthorities, certTemplates, scope); err != nil {
 		return &analysis.AtomicPostProcessingStats{}, cache, fmt.Errorf("failed adcs pre-processing step 1: %w", err)
 	} else if err := cache.BuildCache(ctx, db, enterpriseCertAuthorities, certTemplates); err != nil {
 		return &analysis.AtomicPostProcessingStats{}, cache, fmt.Errorf("failed building ADCS cache: %w", err)
-	} else if step2Stats, err := postADCSPreProcessStep2(ctx, db, cache); err != nil {
+	} else if step2Stats, err := postADCSPreProcessStep2(ctx, db, cache, scope); err != nil {
 		return &analysis.AtomicPostProcessingStats{}, cache, fmt.Errorf("failed adcs pre-processing step 2: %w", err)
 	} else {
-		operation := analysis.NewPostRelationshipOperation(ctx, db, "ADCS Post Processing")
+		operation := scope.NewPostRelationshipOperation(ctx, db, "ADCS Post Processing")
 
 		operation.Stats.Merge(step1Stats)
 		operation.Stats.Merge(step2Stats)
@@ -73,10 +73,17 @@ func PostADCS(ctx context.Context, db graph.Database, localGroupData *LocalGroup
 			for _, domain := range cache.GetDomains() {
 				innerDomain := domain
 
-				if cache.DoesCAChainProperlyToDomain(innerEnterpriseCA, innerDomain) && cache.DoesCAHaveHostingComputer(innerEnterpriseCA) {
+				// ESC relationships end at the target domain so domains outside of the scope need not be considered
+				if !scope.ContainsNode(innerDomain.ID) {
+					continue
+				} else if cache.DoesCAChainProperlyToDomain(innerEnterpriseCA, innerDomain) && cache.DoesCAHaveHostingComputer(innerEnterpriseCA) {
 					targetDomains.Add(innerDomain)
 				}
 			}
+
+			if !scope.IsFull() && targetDomains.Len() == 0 {
+				continue
+			}
 			processEnterpriseCAWithValidCertChainToDomain(innerEnterpriseCA, targetDomains, localGroupData, cache, operation)
 		}
 		return &operation.Stats, cache, operation.Done()
@@ -84,8 +91,8 @@ func PostADCS(ctx context.Context, db graph.Database, localGroupData *LocalGroup
 }
 
 // postADCSPreProcessStep1 processes the edges that are not dependent on any other post-processed edges
-func postADCSPreProcessStep1(ctx context.Context, db graph.Database, enterpriseCertAuthorities, rootCertAuthorities, aiaCertAuthorities, certTemplates []*graph.Node) (*analysis.AtomicPostProcessingStats, error) {
-	operation := analysis.NewPostRelationshipOperation(ctx, db, "ADCS Post Processing Step 1")
+func postADCSPreProcessStep1(ctx context.Context, db graph.Database, enterpriseCertAuthorities, rootCertAuthorities, aiaCertAuthorities, certTemplates []*graph.Node, scope *DomainScope) (*analysis.AtomicPostProcessingStats, error) {
+	operation := scope.NewPostRelationshipOperation(ctx, db, "ADCS Post Processing Step 1")
 	// TODO clean up the operation.Done() calls below
 
 	if err := PostTrustedForNTAuth(ctx, db, operation); err != nil {
@@ -106,8 +113,8 @@ func postADCSPreProcessStep1(ctx context.Context, db graph.Database, enterpriseC
 }
 
 // postADCSPreProcessStep2 Processes the edges that are dependent on those processed in postADCSPreProcessStep1
-func postADCSPreProcessStep2(ctx context.Context, db graph.Database, cache ADCSCache) (*analysis.AtomicPostProcessingStats, error) {
-	operation := analysis.NewPostRelationshipOperation(ctx, db, "ADCS Post Processing Step 2")
+func postADCSPreProcessStep2(ctx context.Context, db graph.Database, cache ADCSCache, scope *DomainScope) (*analysis.AtomicPostProcessingStats, error) {
+	operation := scope.NewPostRelationshipOperation(ctx, db, "ADCS Post Processing Step 2")
 
 	if err := PostEnrollOnBehalfOf(cache, operation); err != nil {
 		operation.Done()
@@ -172,6 +179,15 @@ func processEnterpriseCAWithValidCertChainToDomain(enterpriseCA *graph.Node, tar
 		return nil
 	})
 
+	operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
+		if err := PostADCSESC7(ctx, tx, outC, localGroupData, enterpriseCA, targetDomains, cache); errors.Is(err, graph.ErrPropertyNotFound) {
+			slog.WarnContext(ctx, fmt.Sprintf("Post processing for %s: %v", ad.ADCSESC7.String(), err))
+		} else if err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("Failed post processing for %s: %v", ad.ADCSESC7.String(), err))
+		}
+		return nil
+	})
+
 	operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
 		if err := PostADCSESC9a(ctx, tx, outC, localGroupData, enterpriseCA, targetDomains, cache); errors.Is(err, graph.ErrPropertyNotFound) {
 			slog.WarnContext(ctx, fmt.Sprintf("Post processing for %s: %v", ad.ADCSESC9a.String(), err))
@@ -216,4 +232,13 @@ func processEnterpriseCAWithValidCertChainToDomain(enterpriseCA *graph.Node, tar
 		}
 		return nil
 	})
+
+	operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
+		if err := PostADCSESC15(ctx, tx, outC, localGroupData, enterpriseCA, targetDomains, cache); errors.Is(err, graph.ErrPropertyNotFound) {
+			slog.WarnContext(ctx, fmt.Sprintf("Post processing for %s: %v", ad.ADCSESC15.String(), err))
+		} else if err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("Failed post processing for %s: %v", ad.ADCSESC15.String(), err))
+		}
+		return nil
+	})
 }
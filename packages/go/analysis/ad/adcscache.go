@@ -50,6 +50,8 @@ type ADCSCache struct {
 	certTemplateEnrollers           map[graph.ID][]*graph.Node // principals that have enrollment on a cert template via `enroll`, `generic all`, `all extended rights` edges
 	certTemplateControllers         map[graph.ID][]*graph.Node // principals that have privileges on a cert template via `owner`, `generic all`, `write dacl`, `write owner` edges
 	enterpriseCAEnrollers           map[graph.ID][]*graph.Node // principals that have enrollment rights on an enterprise ca via `enroll` edge
+	enterpriseCAAdministrators      map[graph.ID][]*graph.Node // principals that can manage an enterprise ca via `manage ca` edge
+	enterpriseCAOfficers            map[graph.ID][]*graph.Node // principals that can approve pending requests on an enterprise ca via `manage certificates` edge
 	publishedTemplateCache          map[graph.ID][]*graph.Node // cert templates that are published to an enterprise ca
 	hasUPNCertMappingInForest       cardinality.Duplex[uint64] // domains where at least one DC in the forest has Schannel UPN cert mapping enabled
 	hasWeakCertBindingInForest      cardinality.Duplex[uint64] // domains where at least one DC in the forest has Kerberos weak cert binding enabled
@@ -67,6 +69,8 @@ func NewADCSCache() ADCSCache {
 		certTemplateEnrollers:           make(map[graph.ID][]*graph.Node),
 		certTemplateControllers:         make(map[graph.ID][]*graph.Node),
 		enterpriseCAEnrollers:           make(map[graph.ID][]*graph.Node),
+		enterpriseCAAdministrators:      make(map[graph.ID][]*graph.Node),
+		enterpriseCAOfficers:            make(map[graph.ID][]*graph.Node),
 		publishedTemplateCache:          make(map[graph.ID][]*graph.Node),
 		hasUPNCertMappingInForest:       cardinality.NewBitmap64(),
 		hasWeakCertBindingInForest:      cardinality.NewBitmap64(),
@@ -131,6 +135,18 @@ func (s *ADCSCache) BuildCache(ctx context.Context, db graph.Database, enterpris
 				}
 			}
 
+			if firstDegreeAdministrators, err := fetchFirstDegreeNodes(tx, eca, ad.ManageCA); err != nil {
+				slog.ErrorContext(ctx, fmt.Sprintf("Error fetching administrators for enterprise ca %d: %v", eca.ID, err))
+			} else {
+				s.enterpriseCAAdministrators[eca.ID] = firstDegreeAdministrators.Slice()
+			}
+
+			if firstDegreeOfficers, err := fetchFirstDegreeNodes(tx, eca, ad.ManageCertificates); err != nil {
+				slog.ErrorContext(ctx, fmt.Sprintf("Error fetching officers for enterprise ca %d: %v", eca.ID, err))
+			} else {
+				s.enterpriseCAOfficers[eca.ID] = firstDegreeOfficers.Slice()
+			}
+
 			if publishedTemplates, err := FetchCertTemplatesPublishedToCA(tx, eca); err != nil {
 				slog.ErrorContext(ctx, fmt.Sprintf("Error fetching published cert templates for enterprise ca %d: %v", eca.ID, err))
 			} else {
@@ -287,6 +303,20 @@ func (s *ADCSCache) GetEnterpriseCAEnrollers(id graph.ID) []*graph.Node {
 	return s.enterpriseCAEnrollers[id]
 }
 
+func (s *ADCSCache) GetEnterpriseCAAdministrators(id graph.ID) []*graph.Node {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.enterpriseCAAdministrators[id]
+}
+
+func (s *ADCSCache) GetEnterpriseCAOfficers(id graph.ID) []*graph.Node {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.enterpriseCAOfficers[id]
+}
+
 func (s *ADCSCache) GetPublishedTemplateCache(id graph.ID) []*graph.Node {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
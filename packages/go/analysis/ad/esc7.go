@@ -0,0 +1,390 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ad
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/specterops/bloodhound/packages/go/analysis"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/dawgs/cardinality"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+	"github.com/specterops/dawgs/traversal"
+	"github.com/specterops/dawgs/util/channels"
+)
+
+// PostADCSESC7 creates ADCSESC7 edges for principals that can enroll in a published template and hold a management
+// right on the enterprise CA that defeats the template's issuance requirements. The two rights are abused differently:
+//
+//   - ManageCertificates makes the principal a certificate officer that can approve its own pending requests, so
+//     manager approval does not protect templates where the enrollee supplies the subject.
+//   - ManageCA makes the principal a CA administrator that can enable EDITF_ATTRIBUTESUBJECTALTNAME2 to supply a SAN
+//     in any request, or make itself a certificate officer. Templates without the security extension are therefore
+//     vulnerable even when the enrollee does not supply the subject.
+func PostADCSESC7(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob, localGroupData *LocalGroupData, enterpriseCA *graph.Node, targetDomains *graph.NodeSet, cache ADCSCache) error {
+	if publishedCertTemplates := cache.GetPublishedTemplateCache(enterpriseCA.ID); len(publishedCertTemplates) == 0 {
+		return nil
+	} else {
+		var (
+			enterpriseCAEnrollers      = cache.GetEnterpriseCAEnrollers(enterpriseCA.ID)
+			enterpriseCAOfficers       = cache.GetEnterpriseCAOfficers(enterpriseCA.ID)
+			enterpriseCAAdministrators = cache.GetEnterpriseCAAdministrators(enterpriseCA.ID)
+		)
+
+		for _, publishedCertTemplate := range publishedCertTemplates {
+			if len(enterpriseCAOfficers) > 0 {
+				if valid, err := isCertTemplateValidForESC7ManageCertificates(publishedCertTemplate); err != nil {
+					slog.WarnContext(ctx, fmt.Sprintf("Error validating cert template %d for %s: %v", publishedCertTemplate.ID, ad.ManageCertificates, err))
+				} else if valid {
+					submitADCSESC7Edges(ctx, tx, outC, localGroupData, publishedCertTemplate, targetDomains, cache.GetCertTemplateEnrollers(publishedCertTemplate.ID), enterpriseCAEnrollers, enterpriseCAOfficers)
+				}
+			}
+
+			if len(enterpriseCAAdministrators) > 0 {
+				if valid, err := isCertTemplateValidForESC7ManageCA(publishedCertTemplate); err != nil {
+					slog.WarnContext(ctx, fmt.Sprintf("Error validating cert template %d for %s: %v", publishedCertTemplate.ID, ad.ManageCA, err))
+				} else if valid {
+					submitADCSESC7Edges(ctx, tx, outC, localGroupData, publishedCertTemplate, targetDomains, cache.GetCertTemplateEnrollers(publishedCertTemplate.ID), enterpriseCAEnrollers, enterpriseCAAdministrators)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// submitADCSESC7Edges submits ADCSESC7 edges to the target domains for principals that are in each of the given sets
+// and may use the cert template
+func submitADCSESC7Edges(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob, localGroupData *LocalGroupData, certTemplate *graph.Node, targetDomains *graph.NodeSet, principalSets ...[]*graph.Node) {
+	enrollers := CalculateCrossProductNodeSets(localGroupData, principalSets...)
+
+	if filteredEnrollers, err := filterUserDNSResults(tx, enrollers, certTemplate); err != nil {
+		slog.WarnContext(ctx, fmt.Sprintf("Error filtering users in ESC7: %v", err))
+	} else {
+		filteredEnrollers.Each(func(value uint64) bool {
+			for _, domain := range targetDomains.Slice() {
+				channels.Submit(ctx, outC, analysis.CreatePostRelationshipJob{
+					FromID: graph.ID(value),
+					ToID:   domain.ID,
+					Kind:   ad.ADCSESC7,
+				})
+			}
+			return true
+		})
+	}
+}
+
+// isCertTemplateValidForESC7ManageCertificates returns true if a certificate officer can obtain an authentication
+// certificate for another principal from the template by approving their own request
+func isCertTemplateValidForESC7ManageCertificates(ct *graph.Node) (bool, error) {
+	if valid, err := isCertTemplateIssuableForESC7(ct); err != nil || !valid {
+		return false, err
+	} else if enrolleeSuppliesSubject, err := ct.Properties.Get(ad.EnrolleeSuppliesSubject.String()).Bool(); err != nil {
+		return false, err
+	} else {
+		return enrolleeSuppliesSubject, nil
+	}
+}
+
+// isCertTemplateValidForESC7ManageCA returns true if a CA administrator can obtain an authentication certificate for
+// another principal from the template, either by supplying the subject as an officer or by supplying a SAN through
+// EDITF_ATTRIBUTESUBJECTALTNAME2 on a template without the security extension
+func isCertTemplateValidForESC7ManageCA(ct *graph.Node) (bool, error) {
+	if valid, err := isCertTemplateIssuableForESC7(ct); err != nil || !valid {
+		return false, err
+	} else if enrolleeSuppliesSubject, err := ct.Properties.Get(ad.EnrolleeSuppliesSubject.String()).Bool(); err != nil {
+		return false, err
+	} else if enrolleeSuppliesSubject {
+		return true, nil
+	} else if noSecurityExtension, err := ct.Properties.Get(ad.NoSecurityExtension.String()).Bool(); err != nil {
+		return false, err
+	} else {
+		return noSecurityExtension, nil
+	}
+}
+
+// isCertTemplateIssuableForESC7 returns true if the template issues authentication certificates without requiring
+// authorized signatures. Manager approval is not checked since both management rights can approve requests.
+func isCertTemplateIssuableForESC7(ct *graph.Node) (bool, error) {
+	if authenticationEnabled, err := ct.Properties.Get(ad.AuthenticationEnabled.String()).Bool(); err != nil {
+		return false, err
+	} else if !authenticationEnabled {
+		return false, nil
+	} else if schemaVersion, err := ct.Properties.Get(ad.SchemaVersion.String()).Float64(); err != nil {
+		return false, err
+	} else if schemaVersion == 1 {
+		return true, nil
+	} else if authorizedSignatures, err := ct.Properties.Get(ad.AuthorizedSignatures.String()).Float64(); err != nil {
+		return false, err
+	} else {
+		return authorizedSignatures == 0, nil
+	}
+}
+
+func GetADCSESC7EdgeComposition(ctx context.Context, db graph.Database, edge *graph.Relationship) (graph.PathSet, error) {
+	/*
+		Each management right is composed separately since it is paired with different templates:
+
+		MATCH p1 = (n {objectid:'S-1-5-21-2697957641-2271029196-387917394-2227'})-[:MemberOf*0..]->()-[:ManageCertificates]->(ca)-[:TrustedForNTAuth]->(nt)-[:NTAuthStoreFor]->(d {objectid:'S-1-5-21-2697957641-2271029196-387917394'})
+
+		MATCH p2 = (n)-[:MemberOf*0..]->()-[:Enroll]->(ca)
+
+		MATCH p3 = (n)-[:MemberOf*0..]->()-[:GenericAll|Enroll|AllExtendedRights]->(ct)-[:PublishedTo]->(ca)-[:IssuedSignedBy|EnterpriseCAFor|RootCAFor*1..]->(d)
+		WHERE ct.authenticationenabled = true
+			AND ct.enrolleesuppliessubject = true
+			AND (ct.schemaversion = 1 OR ct.authorizedsignatures = 0)
+			AND (
+				n:Group
+				OR n:Computer
+				OR (
+					n:User
+					AND ct.subjectaltrequiredns = false
+					AND ct.subjectaltrequiredomaindns = false
+				)
+			)
+
+		RETURN p1,p2,p3
+
+		For ManageCA, p1 matches [:ManageCA] instead and p3 also matches templates where
+		ct.enrolleesuppliessubject = false AND ct.nosecurityextension = true
+	*/
+
+	var (
+		startNode  *graph.Node
+		startNodes = graph.NodeSet{}
+
+		traversalInst      = traversal.New(db, analysis.MaximumDatabaseParallelWorkers)
+		lock               = &sync.Mutex{}
+		paths              = graph.PathSet{}
+		managementRights   = []graph.Kind{ad.ManageCertificates, ad.ManageCA}
+		path1Segments      = map[graph.Kind]map[graph.ID][]*graph.PathSegment{}
+		path2Segments      = map[graph.ID][]*graph.PathSegment{}
+		path1EnterpriseCAs = map[graph.Kind]cardinality.Duplex[uint64]{}
+		path2EnterpriseCAs = cardinality.NewBitmap64()
+		finalEnterpriseCAs = map[graph.Kind]cardinality.Duplex[uint64]{}
+		managedCAs         = cardinality.NewBitmap64()
+	)
+
+	if err := db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		var err error
+		if startNode, err = ops.FetchNode(tx, edge.StartID); err != nil {
+			return err
+		} else if nodeSet, err := FetchAuthUsersAndEveryoneGroups(tx); err != nil {
+			return err
+		} else {
+			// Add startnode, Auth. Users, and Everyone to start nodes
+			startNodes.AddSet(nodeSet)
+			startNodes.Add(startNode)
+			return nil
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	// P1
+	for _, managementRight := range managementRights {
+		var (
+			segments      = map[graph.ID][]*graph.PathSegment{}
+			enterpriseCAs = cardinality.NewBitmap64()
+		)
+
+		for _, n := range startNodes.Slice() {
+			if err := traversalInst.BreadthFirst(ctx, traversal.Plan{
+				Root: n,
+				Driver: ADCSESC7Path1Pattern(edge.EndID, managementRight).Do(
+					func(terminal *graph.PathSegment) error {
+						enterpriseCA := terminal.Search(func(nextSegment *graph.PathSegment) bool {
+							return nextSegment.Node.Kinds.ContainsOneOf(ad.EnterpriseCA)
+						})
+
+						lock.Lock()
+						enterpriseCAs.Add(enterpriseCA.ID.Uint64())
+						segments[enterpriseCA.ID] = append(segments[enterpriseCA.ID], terminal)
+						lock.Unlock()
+
+						return nil
+					}),
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		path1Segments[managementRight] = segments
+		path1EnterpriseCAs[managementRight] = enterpriseCAs
+		finalEnterpriseCAs[managementRight] = cardinality.NewBitmap64()
+		managedCAs.Or(enterpriseCAs)
+	}
+
+	// P2
+	for _, n := range startNodes.Slice() {
+		if err := traversalInst.BreadthFirst(ctx, traversal.Plan{
+			Root: n,
+			Driver: ADCSESC7Path2Pattern(managedCAs).Do(
+				func(terminal *graph.PathSegment) error {
+					lock.Lock()
+					path2EnterpriseCAs.Add(terminal.Node.ID.Uint64())
+					path2Segments[terminal.Node.ID] = append(path2Segments[terminal.Node.ID], terminal)
+					lock.Unlock()
+
+					return nil
+				}),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	// P3
+	for _, managementRight := range managementRights {
+		// Only enterprise CAs that the principal can both manage and enroll on are candidates for P3
+		enterpriseCAs := path1EnterpriseCAs[managementRight]
+		enterpriseCAs.And(path2EnterpriseCAs)
+
+		if enterpriseCAs.Cardinality() == 0 {
+			continue
+		}
+
+		for _, n := range startNodes.Slice() {
+			if err := traversalInst.BreadthFirst(ctx, traversal.Plan{
+				Root: n,
+				Driver: ADCSESC7Path3Pattern(edge.EndID, enterpriseCAs, managementRight).Do(
+					func(terminal *graph.PathSegment) error {
+						certTemplate := terminal.Search(func(nextSegment *graph.PathSegment) bool {
+							return nextSegment.Node.Kinds.ContainsOneOf(ad.CertTemplate)
+						})
+
+						if !startNode.Kinds.ContainsOneOf(ad.User) || certTemplateValidForUserVictim(certTemplate) {
+							lock.Lock()
+							paths.AddPath(terminal.Path())
+
+							// add the ECA where the template is published (first ECA in the path in case of multi-tier hierarchy) to final list of ECAs
+							terminal.Path().Walk(func(start, end *graph.Node, relationship *graph.Relationship) bool {
+								if end.Kinds.ContainsOneOf(ad.EnterpriseCA) {
+									finalEnterpriseCAs[managementRight].Add(end.ID.Uint64())
+									return false
+								}
+								return true
+							})
+							lock.Unlock()
+						}
+
+						return nil
+					}),
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if paths.Len() > 0 {
+		for _, managementRight := range managementRights {
+			finalEnterpriseCAs[managementRight].Each(func(value uint64) bool {
+				for _, segment := range path1Segments[managementRight][graph.ID(value)] {
+					paths.AddPath(segment.Path())
+				}
+
+				for _, segment := range path2Segments[graph.ID(value)] {
+					paths.AddPath(segment.Path())
+				}
+				return true
+			})
+		}
+	}
+
+	return paths, nil
+}
+
+func ADCSESC7Path1Pattern(domainID graph.ID, managementRight graph.Kind) traversal.PatternContinuation {
+	return traversal.NewPattern().
+		OutboundWithDepth(0, 0, query.And(
+			query.Kind(query.Relationship(), ad.MemberOf),
+			query.Kind(query.End(), ad.Group),
+		)).
+		Outbound(query.And(
+			query.Kind(query.Relationship(), managementRight),
+			query.Kind(query.End(), ad.EnterpriseCA),
+		)).
+		Outbound(query.And(
+			query.KindIn(query.Relationship(), ad.TrustedForNTAuth),
+			query.Kind(query.End(), ad.NTAuthStore),
+		)).
+		Outbound(query.And(
+			query.KindIn(query.Relationship(), ad.NTAuthStoreFor),
+			query.Equals(query.EndID(), domainID),
+		))
+}
+
+func ADCSESC7Path2Pattern(enterpriseCAs cardinality.Duplex[uint64]) traversal.PatternContinuation {
+	return traversal.NewPattern().
+		OutboundWithDepth(0, 0, query.And(
+			query.Kind(query.Relationship(), ad.MemberOf),
+			query.Kind(query.End(), ad.Group),
+		)).
+		Outbound(query.And(
+			query.KindIn(query.Relationship(), ad.Enroll),
+			query.InIDs(query.End(), graph.DuplexToGraphIDs(enterpriseCAs)...),
+			query.Kind(query.End(), ad.EnterpriseCA),
+		))
+}
+
+func ADCSESC7Path3Pattern(domainID graph.ID, enterpriseCAs cardinality.Duplex[uint64], managementRight graph.Kind) traversal.PatternContinuation {
+	// A CA administrator may also supply a SAN through EDITF_ATTRIBUTESUBJECTALTNAME2 on templates without the security extension
+	subjectCriteria := query.Equals(query.EndProperty(ad.EnrolleeSuppliesSubject.String()), true)
+	if managementRight.Is(ad.ManageCA) {
+		subjectCriteria = query.Or(
+			subjectCriteria,
+			query.Equals(query.EndProperty(ad.NoSecurityExtension.String()), true),
+		)
+	}
+
+	return traversal.NewPattern().
+		OutboundWithDepth(0, 0, query.And(
+			query.Kind(query.Relationship(), ad.MemberOf),
+			query.Kind(query.End(), ad.Group),
+		)).
+		Outbound(query.And(
+			query.KindIn(query.Relationship(), ad.GenericAll, ad.Enroll, ad.AllExtendedRights),
+			query.Kind(query.End(), ad.CertTemplate),
+			query.Equals(query.EndProperty(ad.AuthenticationEnabled.String()), true),
+			subjectCriteria,
+			query.Or(
+				query.Equals(query.EndProperty(ad.SchemaVersion.String()), 1),
+				query.Equals(query.EndProperty(ad.AuthorizedSignatures.String()), 0),
+			),
+		)).
+		Outbound(query.And(
+			query.KindIn(query.Relationship(), ad.PublishedTo),
+			query.InIDs(query.End(), graph.DuplexToGraphIDs(enterpriseCAs)...),
+			query.Kind(query.End(), ad.EnterpriseCA),
+		)).
+		OutboundWithDepth(0, 0, query.And(
+			query.KindIn(query.Relationship(), ad.IssuedSignedBy, ad.EnterpriseCAFor),
+			query.KindIn(query.End(), ad.EnterpriseCA, ad.AIACA),
+		)).
+		Outbound(query.And(
+			query.KindIn(query.Relationship(), ad.IssuedSignedBy, ad.EnterpriseCAFor),
+			query.Kind(query.End(), ad.RootCA),
+		)).
+		Outbound(query.And(
+			query.KindIn(query.Relationship(), ad.RootCAFor),
+			query.Equals(query.EndID(), domainID),
+		))
+}
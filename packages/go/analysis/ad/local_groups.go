@@ -31,7 +31,7 @@ import (
 	"github.com/specterops/dawgs/util/channels"
 )
 
-func PostCanRDP(parentCtx context.Context, graphDB graph.Database, localGroupData *LocalGroupData, enforceURA bool, citrixEnabled bool) (*analysis.AtomicPostProcessingStats, error) {
+func PostCanRDP(parentCtx context.Context, graphDB graph.Database, localGroupData *LocalGroupData, enforceURA bool, citrixEnabled bool, scope *DomainScope) (*analysis.AtomicPostProcessingStats, error) {
 	var (
 		ctx, done             = context.WithCancel(parentCtx)
 		stats                 = analysis.NewAtomicPostProcessingStats()
@@ -175,6 +175,10 @@ func PostCanRDP(parentCtx context.Context, graphDB graph.Database, localGroupDat
 	}
 
 	localGroupData.Computers.Each(func(nextComputer uint64) bool {
+		if !scope.ContainsNode(graph.ID(nextComputer)) {
+			return true
+		}
+
 		return channels.Submit(ctx, workC, nextComputer)
 	})
 
@@ -190,7 +194,7 @@ func PostCanRDP(parentCtx context.Context, graphDB graph.Database, localGroupDat
 	return &stats, nil
 }
 
-func PostLocalGroups(parentCtx context.Context, graphDB graph.Database, localGroupData *LocalGroupData) (*analysis.AtomicPostProcessingStats, error) {
+func PostLocalGroups(parentCtx context.Context, graphDB graph.Database, localGroupData *LocalGroupData, scope *DomainScope) (*analysis.AtomicPostProcessingStats, error) {
 	const (
 		adminGroupSuffix    = "-544"
 		psRemoteGroupSuffix = "-580"
@@ -368,6 +372,10 @@ func PostLocalGroups(parentCtx context.Context, graphDB graph.Database, localGro
 	}
 
 	localGroupData.Computers.Each(func(value uint64) bool {
+		if !scope.ContainsNode(graph.ID(value)) {
+			return true
+		}
+
 		return channels.Submit(ctx, computerC, value)
 	})
 
@@ -125,7 +125,7 @@ func NewNTLMCache(ctx context.Context, db graph.Database, localGroupData *LocalG
 }
 
 // PostNTLM is the initial function used to execute our NTLM analysis
-func PostNTLM(ctx context.Context, db graph.Database, localGroupData *LocalGroupData, adcsCache ADCSCache, ntlmEnabled bool, compositionCounter *analysis.CompositionCounter) (*analysis.AtomicPostProcessingStats, error) {
+func PostNTLM(ctx context.Context, db graph.Database, localGroupData *LocalGroupData, adcsCache ADCSCache, ntlmEnabled bool, compositionCounter *analysis.CompositionCounter, scope *DomainScope) (*analysis.AtomicPostProcessingStats, error) {
 	defer measure.ContextLogAndMeasure(
 		ctx,
 		slog.LevelInfo,
@@ -136,7 +136,7 @@ func PostNTLM(ctx context.Context, db graph.Database, localGroupData *LocalGroup
 	)()
 
 	var (
-		operation = analysis.NewPostRelationshipOperation(ctx, db, "PostNTLM")
+		operation = scope.NewPostRelationshipOperation(ctx, db, "PostNTLM")
 		// compositionChannel      = make(chan analysis.CompositionInfo)
 	)
 
@@ -180,7 +180,10 @@ func PostNTLM(ctx context.Context, db graph.Database, localGroupData *LocalGroup
 			for computer := range cursor.Chan() {
 				innerComputer := computer
 
-				if domainSid, err := innerComputer.Properties.Get(ad.DomainSID.String()).String(); err != nil {
+				// NTLM relay relationships end at the coerced computer
+				if !scope.ContainsNode(innerComputer.ID) {
+					continue
+				} else if domainSid, err := innerComputer.Properties.Get(ad.DomainSID.String()).String(); err != nil {
 					continue
 				} else if authenticatedUserGroupID, ok := ntlmCache.GetAuthenticatedUserGroupForDomain(domainSid); !ok {
 					continue
@@ -216,6 +219,9 @@ func PostNTLM(ctx context.Context, db graph.Database, localGroupData *LocalGroup
 		if err := PostCoerceAndRelayNTLMToADCS(adcsCache, operation, ntlmCache); err != nil {
 			operation.Done()
 			return nil, err
+		} else if err := PostCoerceAndRelayNTLMToADCSRPC(adcsCache, operation, ntlmCache); err != nil {
+			operation.Done()
+			return nil, err
 		}
 
 		return &operation.Stats, operation.Done()
@@ -223,6 +229,17 @@ func PostNTLM(ctx context.Context, db graph.Database, localGroupData *LocalGroup
 }
 
 func GetCoerceAndRelayNTLMtoADCSEdgeComposition(ctx context.Context, db graph.Database, edge *graph.Relationship) (graph.PathSet, error) {
+	return getCoerceAndRelayNTLMtoEnterpriseCAEdgeComposition(ctx, db, edge, query.Equals(query.EndProperty(ad.HasVulnerableEndpoint.String()), true))
+}
+
+func GetCoerceAndRelayNTLMtoADCSRPCEdgeComposition(ctx context.Context, db graph.Database, edge *graph.Relationship) (graph.PathSet, error) {
+	return getCoerceAndRelayNTLMtoEnterpriseCAEdgeComposition(ctx, db, edge, query.Equals(query.EndProperty(ad.EnforceEncryptICertRequest.String()), false))
+}
+
+// getCoerceAndRelayNTLMtoEnterpriseCAEdgeComposition builds the composition of an NTLM relay edge targeting an
+// enterprise CA. The relay endpoint requirement differs per edge and is applied to the enterprise CA the cert template
+// is published to.
+func getCoerceAndRelayNTLMtoEnterpriseCAEdgeComposition(ctx context.Context, db graph.Database, edge *graph.Relationship, enterpriseCACriteria graph.Criteria) (graph.PathSet, error) {
 	var (
 		endNode    *graph.Node
 		domainNode *graph.Node
@@ -263,7 +280,7 @@ func GetCoerceAndRelayNTLMtoADCSEdgeComposition(ctx context.Context, db graph.Da
 	for _, n := range startNodes.Slice() {
 		if err := traversalInst.BreadthFirst(ctx, traversal.Plan{
 			Root: n,
-			Driver: coerceAndRelayNTLMtoADCSPath1Pattern(domainNode.ID).Do(func(terminal *graph.PathSegment) error {
+			Driver: coerceAndRelayNTLMtoADCSPath1Pattern(domainNode.ID, enterpriseCACriteria).Do(func(terminal *graph.PathSegment) error {
 				var enterpriseCANode *graph.Node
 				terminal.WalkReverse(func(nextSegment *graph.PathSegment) bool {
 					if nextSegment.Node.Kinds.ContainsOneOf(ad.EnterpriseCA) {
@@ -318,7 +335,7 @@ func GetCoerceAndRelayNTLMtoADCSEdgeComposition(ctx context.Context, db graph.Da
 	return paths, nil
 }
 
-func coerceAndRelayNTLMtoADCSPath1Pattern(domainID graph.ID) traversal.PatternContinuation {
+func coerceAndRelayNTLMtoADCSPath1Pattern(domainID graph.ID, enterpriseCACriteria graph.Criteria) traversal.PatternContinuation {
 	return traversal.NewPattern().OutboundWithDepth(0, 0, query.And(
 		query.Kind(query.Relationship(), ad.MemberOf),
 		query.Kind(query.End(), ad.Group),
@@ -343,7 +360,7 @@ func coerceAndRelayNTLMtoADCSPath1Pattern(domainID graph.ID) traversal.PatternCo
 		Outbound(query.And(
 			query.KindIn(query.Relationship(), ad.PublishedTo),
 			query.Kind(query.End(), ad.EnterpriseCA),
-			query.Equals(query.EndProperty(ad.HasVulnerableEndpoint.String()), true),
+			enterpriseCACriteria,
 		)).
 		OutboundWithDepth(0, 0, query.And(
 			query.KindIn(query.Relationship(), ad.IssuedSignedBy, ad.EnterpriseCAFor),
@@ -379,6 +396,16 @@ func coerceAndRelayNTLMtoADCSPath2Pattern(domainID graph.ID, enterpriseCAs cardi
 }
 
 func PostCoerceAndRelayNTLMToADCS(adcsCache ADCSCache, operation analysis.StatTrackedOperation[analysis.CreatePostRelationshipJob], ntlmCache NTLMCache) error {
+	return postCoerceAndRelayNTLMToEnterpriseCA(adcsCache, operation, ntlmCache, ad.CoerceAndRelayNTLMToADCS, isEnterpriseCAValidForADCS)
+}
+
+// PostCoerceAndRelayNTLMToADCSRPC creates edges for relaying coerced NTLM authentication to the ICertPassage (ICPR) RPC
+// interface of enterprise CAs that do not enforce encrypted certificate requests (ESC11)
+func PostCoerceAndRelayNTLMToADCSRPC(adcsCache ADCSCache, operation analysis.StatTrackedOperation[analysis.CreatePostRelationshipJob], ntlmCache NTLMCache) error {
+	return postCoerceAndRelayNTLMToEnterpriseCA(adcsCache, operation, ntlmCache, ad.CoerceAndRelayNTLMToADCSRPC, isEnterpriseCAValidForADCSRPC)
+}
+
+func postCoerceAndRelayNTLMToEnterpriseCA(adcsCache ADCSCache, operation analysis.StatTrackedOperation[analysis.CreatePostRelationshipJob], ntlmCache NTLMCache, relationshipKind graph.Kind, isEnterpriseCAValid func(eca *graph.Node) (bool, error)) error {
 	for _, outerDomain := range adcsCache.GetDomains() {
 		for _, outerEnterpriseCA := range adcsCache.GetEnterpriseCertAuthorities() {
 			domain := outerDomain
@@ -390,11 +417,12 @@ func PostCoerceAndRelayNTLMToADCS(adcsCache ADCSCache, operation analysis.StatTr
 				} else if !adcsCache.DoesCAChainProperlyToDomain(enterpriseCA, domain) || !adcsCache.DoesCAHaveHostingComputer(enterpriseCA) {
 					// If the CA doesn't chain up to the domain properly then its invalid. It also requires a hosting computer
 					return nil
-				} else if ecaValid, err := isEnterpriseCAValidForADCS(enterpriseCA); err != nil {
+				} else if ecaValid, err := isEnterpriseCAValid(enterpriseCA); err != nil {
 					if errors.Is(err, graph.ErrPropertyNotFound) {
 						slog.WarnContext(
 							ctx,
 							"Did not validate EnterpriseCA for ADCS relay",
+							slog.String("kind", relationshipKind.String()),
 							slog.Int("node_id", int(enterpriseCA.ID)),
 							attr.Error(err),
 						)
@@ -402,6 +430,7 @@ func PostCoerceAndRelayNTLMToADCS(adcsCache ADCSCache, operation analysis.StatTr
 						slog.ErrorContext(
 							ctx,
 							"Error validating EnterpriseCA for ADCS relay",
+							slog.String("kind", relationshipKind.String()),
 							slog.Int("node_id", int(enterpriseCA.ID)),
 							attr.Error(err),
 						)
@@ -482,7 +511,7 @@ func PostCoerceAndRelayNTLMToADCS(adcsCache ADCSCache, operation analysis.StatTr
 						outC <- analysis.CreatePostRelationshipJob{
 							FromID: authUsersGroup,
 							ToID:   graph.ID(value),
-							Kind:   ad.CoerceAndRelayNTLMToADCS,
+							Kind:   relationshipKind,
 						}
 						return true
 					})
@@ -504,6 +533,14 @@ func isEnterpriseCAValidForADCS(eca *graph.Node) (bool, error) {
 	}
 }
 
+func isEnterpriseCAValidForADCSRPC(eca *graph.Node) (bool, error) {
+	if enforceEncryption, err := eca.Properties.Get(ad.EnforceEncryptICertRequest.String()).Bool(); err != nil {
+		return false, err
+	} else {
+		return !enforceEncryption, nil
+	}
+}
+
 func isCertTemplateValidForADCSRelay(ct *graph.Node) (bool, error) {
 	if reqManagerApproval, err := ct.Properties.Get(ad.RequiresManagerApproval.String()).Bool(); err != nil {
 		return false, err
@@ -644,6 +681,28 @@ func GetVulnerableEnterpriseCAsForRelayNTLMtoADCS(ctx context.Context, db graph.
 
 }
 
+func GetVulnerableEnterpriseCAsForRelayNTLMtoADCSRPC(ctx context.Context, db graph.Database, edge *graph.Relationship) (graph.NodeSet, error) {
+	var (
+		nodes = graph.NodeSet{}
+	)
+
+	if composition, err := GetCoerceAndRelayNTLMtoADCSRPCEdgeComposition(ctx, db, edge); err != nil {
+		return graph.NodeSet{}, err
+	} else {
+		for _, node := range composition.AllNodes().ContainingNodeKinds(ad.EnterpriseCA) {
+			if enforceEncryption, err := node.Properties.Get(ad.EnforceEncryptICertRequest.String()).Bool(); errors.Is(err, graph.ErrPropertyNotFound) {
+				continue
+			} else if err != nil {
+				slog.ErrorContext(ctx, fmt.Sprintf("error getting enforceencrypticertrequest from node %d", node.ID))
+			} else if !enforceEncryption {
+				nodes.Add(node)
+			}
+		}
+
+		return nodes, nil
+	}
+}
+
 func GetVulnerableDomainControllersForRelayNTLMtoLDAP(ctx context.Context, db graph.Database, edge *graph.Relationship) (graph.NodeSet, error) {
 	var (
 		startNode *graph.Node
@@ -36,7 +36,7 @@ import (
 	"github.com/specterops/dawgs/query"
 )
 
-func PostOwnsAndWriteOwner(ctx context.Context, db graph.Database, localGroupData *LocalGroupData) (*analysis.AtomicPostProcessingStats, error) {
+func PostOwnsAndWriteOwner(ctx context.Context, db graph.Database, localGroupData *LocalGroupData, scope *DomainScope) (*analysis.AtomicPostProcessingStats, error) {
 	defer measure.ContextLogAndMeasure(
 		ctx,
 		slog.LevelInfo,
@@ -46,7 +46,7 @@ func PostOwnsAndWriteOwner(ctx context.Context, db graph.Database, localGroupDat
 		attr.Scope("process"),
 	)()
 
-	operation := analysis.NewPostRelationshipOperation(ctx, db, "PostOwnsAndWriteOwner")
+	operation := scope.NewPostRelationshipOperation(ctx, db, "PostOwnsAndWriteOwner")
 
 	// Get the dSHeuristics values for all domains
 	if dsHeuristicsCache, anyEnforced, err := GetDsHeuristicsCache(ctx, db); err != nil {
@@ -36,7 +36,7 @@ import (
 	"github.com/specterops/dawgs/util/channels"
 )
 
-func PostSyncLAPSPassword(ctx context.Context, db graph.Database, localGroupData *LocalGroupData) (*analysis.AtomicPostProcessingStats, error) {
+func PostSyncLAPSPassword(ctx context.Context, db graph.Database, localGroupData *LocalGroupData, scope *DomainScope) (*analysis.AtomicPostProcessingStats, error) {
 	defer measure.ContextLogAndMeasure(
 		ctx,
 		slog.LevelInfo,
@@ -49,9 +49,13 @@ func PostSyncLAPSPassword(ctx context.Context, db graph.Database, localGroupData
 	if domainNodes, err := fetchCollectedDomainNodes(ctx, db); err != nil {
 		return &analysis.AtomicPostProcessingStats{}, err
 	} else {
-		operation := analysis.NewPostRelationshipOperation(ctx, db, "SyncLAPSPassword Post Processing")
+		operation := scope.NewPostRelationshipOperation(ctx, db, "SyncLAPSPassword Post Processing")
 		for _, domain := range domainNodes {
 			innerDomain := domain
+			if !scope.ContainsNode(innerDomain.ID) {
+				continue
+			}
+
 			operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
 				if lapsSyncers, err := getLAPSSyncers(tx, innerDomain, localGroupData); err != nil {
 					return err
@@ -80,7 +84,7 @@ func PostSyncLAPSPassword(ctx context.Context, db graph.Database, localGroupData
 	}
 }
 
-func PostDCSync(ctx context.Context, db graph.Database, localGroupData *LocalGroupData) (*analysis.AtomicPostProcessingStats, error) {
+func PostDCSync(ctx context.Context, db graph.Database, localGroupData *LocalGroupData, scope *DomainScope) (*analysis.AtomicPostProcessingStats, error) {
 	defer measure.ContextLogAndMeasure(
 		ctx,
 		slog.LevelInfo,
@@ -93,10 +97,14 @@ func PostDCSync(ctx context.Context, db graph.Database, localGroupData *LocalGro
 	if domainNodes, err := fetchCollectedDomainNodes(ctx, db); err != nil {
 		return &analysis.AtomicPostProcessingStats{}, err
 	} else {
-		operation := analysis.NewPostRelationshipOperation(ctx, db, "DCSync Post Processing")
+		operation := scope.NewPostRelationshipOperation(ctx, db, "DCSync Post Processing")
 
 		for _, domain := range domainNodes {
 			innerDomain := domain
+			if !scope.ContainsNode(innerDomain.ID) {
+				continue
+			}
+
 			operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
 				if dcSyncers, err := getDCSyncers(tx, innerDomain, localGroupData); err != nil {
 					return err
@@ -121,7 +129,7 @@ func PostDCSync(ctx context.Context, db graph.Database, localGroupData *LocalGro
 	}
 }
 
-func PostProtectAdminGroups(ctx context.Context, db graph.Database) (*analysis.AtomicPostProcessingStats, error) {
+func PostProtectAdminGroups(ctx context.Context, db graph.Database, scope *DomainScope) (*analysis.AtomicPostProcessingStats, error) {
 	defer measure.ContextLogAndMeasure(
 		ctx,
 		slog.LevelInfo,
@@ -136,9 +144,12 @@ func PostProtectAdminGroups(ctx context.Context, db graph.Database) (*analysis.A
 		return &analysis.AtomicPostProcessingStats{}, err
 	}
 
-	operation := analysis.NewPostRelationshipOperation(ctx, db, "ProtectAdminGroups Post Processing")
+	operation := scope.NewPostRelationshipOperation(ctx, db, "ProtectAdminGroups Post Processing")
 
 	for _, domain := range domainNodes {
+		if !scope.ContainsNode(domain.ID) {
+			continue
+		}
 
 		operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
 			if adminSDHolderIDs, err := getAdminSDHolder(tx, domain); graph.IsErrNotFound(err) {
@@ -168,7 +179,7 @@ func PostProtectAdminGroups(ctx context.Context, db graph.Database) (*analysis.A
 	return &operation.Stats, operation.Done()
 }
 
-func PostHasTrustKeys(ctx context.Context, db graph.Database) (*analysis.AtomicPostProcessingStats, error) {
+func PostHasTrustKeys(ctx context.Context, db graph.Database, scope *DomainScope) (*analysis.AtomicPostProcessingStats, error) {
 	defer measure.ContextLogAndMeasure(
 		ctx,
 		slog.LevelInfo,
@@ -181,7 +192,9 @@ func PostHasTrustKeys(ctx context.Context, db graph.Database) (*analysis.AtomicP
 	if domainNodes, err := fetchCollectedDomainNodes(ctx, db); err != nil {
 		return &analysis.AtomicPostProcessingStats{}, err
 	} else {
-		operation := analysis.NewPostRelationshipOperation(ctx, db, "HasTrustKeys Post Processing")
+		// HasTrustKeys relationships end at a trust account in the trusting domain so every domain is visited and the
+		// operation discards relationships outside of the scope
+		operation := scope.NewPostRelationshipOperation(ctx, db, "HasTrustKeys Post Processing")
 		if err := operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
 			for _, domain := range domainNodes {
 				if netbios, err := domain.Properties.Get(ad.NetBIOS.String()).String(); err != nil {
@@ -0,0 +1,203 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ad
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/specterops/bloodhound/packages/go/analysis"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/cardinality"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/query"
+)
+
+// DomainSIDFromObjectID returns the SID of the domain an AD object identifier belongs to. Domain SIDs are returned
+// as-is and principal SIDs have their relative identifier removed. Object identifiers that are not domain SIDs or
+// domain principal SIDs, such as GUIDs or well-known SIDs, are not attributable and return false.
+func DomainSIDFromObjectID(objectID string) (string, bool) {
+	const (
+		domainSIDParts    = 7
+		principalSIDParts = 8
+	)
+
+	if !strings.HasPrefix(strings.ToUpper(objectID), ad.DomainSIDPrefix+"-") {
+		return "", false
+	}
+
+	switch parts := strings.Split(objectID, "-"); len(parts) {
+	case domainSIDParts:
+		return strings.ToUpper(objectID), true
+	case principalSIDParts:
+		return strings.ToUpper(strings.Join(parts[:domainSIDParts], "-")), true
+	default:
+		return "", false
+	}
+}
+
+// DomainScope restricts post-processing to derived relationships that end at a node belonging to one of a set of
+// domains. A nil scope covers the entire graph.
+type DomainScope struct {
+	domainSIDs []string
+	nodeIDs    cardinality.Duplex[uint64]
+}
+
+// FetchDomainScope builds a DomainScope for the given domains and every domain directly trust-connected to them. An
+// empty set of domain SIDs results in a nil scope which covers the entire graph.
+func FetchDomainScope(ctx context.Context, db graph.Database, domainSIDs []string) (*DomainScope, error) {
+	if len(domainSIDs) == 0 {
+		return nil, nil
+	}
+
+	defer measure.ContextMeasure(
+		ctx,
+		slog.LevelInfo,
+		"Fetch domain scope",
+		attr.Namespace("analysis"),
+		attr.Function("FetchDomainScope"),
+		attr.Scope("process"),
+	)()
+
+	scope := &DomainScope{
+		nodeIDs: cardinality.NewBitmap64(),
+	}
+
+	return scope, db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		var (
+			scopedDomainSIDs = slices.Clone(domainSIDs)
+			trustedDomainIDs []graph.ID
+		)
+
+		if err := tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Kind(query.Start(), ad.Domain),
+				query.KindIn(query.Relationship(), ad.SameForestTrust, ad.CrossForestTrust),
+				query.Kind(query.End(), ad.Domain),
+				query.Or(
+					query.In(query.StartProperty(common.ObjectID.String()), domainSIDs),
+					query.In(query.EndProperty(common.ObjectID.String()), domainSIDs),
+				),
+			)
+		}).FetchTriples(func(cursor graph.Cursor[graph.RelationshipTripleResult]) error {
+			for triple := range cursor.Chan() {
+				trustedDomainIDs = append(trustedDomainIDs, triple.StartID, triple.EndID)
+			}
+
+			return cursor.Error()
+		}); err != nil {
+			return err
+		}
+
+		if len(trustedDomainIDs) > 0 {
+			if err := tx.Nodes().Filterf(func() graph.Criteria {
+				return query.InIDs(query.NodeID(), trustedDomainIDs...)
+			}).Fetch(func(cursor graph.Cursor[*graph.Node]) error {
+				for domain := range cursor.Chan() {
+					if domainSID, err := domain.Properties.Get(common.ObjectID.String()).String(); err == nil {
+						scopedDomainSIDs = append(scopedDomainSIDs, domainSID)
+					}
+				}
+
+				return cursor.Error()
+			}); err != nil {
+				return err
+			}
+		}
+
+		slices.Sort(scopedDomainSIDs)
+		scope.domainSIDs = slices.Compact(scopedDomainSIDs)
+
+		slog.InfoContext(ctx, "Post-processing scoped to changed domains", slog.Any("domain_sids", scope.domainSIDs))
+
+		return tx.Nodes().Filterf(func() graph.Criteria {
+			return query.Or(
+				query.In(query.NodeProperty(ad.DomainSID.String()), scope.domainSIDs),
+				query.And(
+					query.Kind(query.Node(), ad.Domain),
+					query.In(query.NodeProperty(common.ObjectID.String()), scope.domainSIDs),
+				),
+			)
+		}).FetchIDs(func(cursor graph.Cursor[graph.ID]) error {
+			for id := range cursor.Chan() {
+				scope.nodeIDs.Add(id.Uint64())
+			}
+
+			return cursor.Error()
+		})
+	})
+}
+
+// IsFull returns true if the scope covers the entire graph
+func (s *DomainScope) IsFull() bool {
+	return s == nil
+}
+
+// DomainSIDs returns the SIDs of all domains covered by the scope, including trust-connected neighbours
+func (s *DomainScope) DomainSIDs() []string {
+	if s == nil {
+		return nil
+	}
+
+	return s.domainSIDs
+}
+
+// ContainsNode returns true if the node belongs to a domain covered by the scope
+func (s *DomainScope) ContainsNode(id graph.ID) bool {
+	return s == nil || s.nodeIDs.Contains(id.Uint64())
+}
+
+// Accepts reports whether the scope owns the given post-processed relationship. A relationship belongs to the domain
+// of the node it ends at.
+func (s *DomainScope) Accepts(job analysis.CreatePostRelationshipJob) bool {
+	return s.ContainsNode(job.ToID)
+}
+
+// RelationshipCriteria matches post-processed relationships owned by the scope. A full scope returns nil.
+func (s *DomainScope) RelationshipCriteria() graph.Criteria {
+	if s == nil {
+		return nil
+	}
+
+	return query.Or(
+		query.In(query.EndProperty(ad.DomainSID.String()), s.domainSIDs),
+		query.And(
+			query.Kind(query.End(), ad.Domain),
+			query.In(query.EndProperty(common.ObjectID.String()), s.domainSIDs),
+		),
+	)
+}
+
+// NewPostRelationshipOperation creates a post-processing operation that discards relationships not owned by the scope
+func (s *DomainScope) NewPostRelationshipOperation(ctx context.Context, db graph.Database, operationName string) analysis.StatTrackedOperation[analysis.CreatePostRelationshipJob] {
+	if s == nil {
+		return analysis.NewPostRelationshipOperation(ctx, db, operationName)
+	}
+
+	return analysis.NewFilteredPostRelationshipOperation(ctx, db, operationName, s.Accepts)
+}
+
+// DeleteScopedTransitEdges deletes the AD post-processed relationships owned by the given scope
+func DeleteScopedTransitEdges(ctx context.Context, db graph.Database, scope *DomainScope) (*analysis.AtomicPostProcessingStats, error) {
+	return analysis.DeleteTransitEdgesMatching(ctx, db, graph.Kinds{ad.Entity, azure.Entity}, ad.PostProcessedRelationships(), scope.RelationshipCriteria())
+}
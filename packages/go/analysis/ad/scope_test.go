@@ -0,0 +1,54 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ad_test
+
+import (
+	"testing"
+
+	"github.com/specterops/bloodhound/packages/go/analysis"
+	ad2 "github.com/specterops/bloodhound/packages/go/analysis/ad"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomainSIDFromObjectID(t *testing.T) {
+	for _, testCase := range []struct {
+		objectID  string
+		domainSID string
+		ok        bool
+	}{
+		{objectID: "S-1-5-21-3130019616-2776909439-2417379446-1105", domainSID: "S-1-5-21-3130019616-2776909439-2417379446", ok: true},
+		{objectID: "S-1-5-21-3130019616-2776909439-2417379446", domainSID: "S-1-5-21-3130019616-2776909439-2417379446", ok: true},
+		{objectID: "s-1-5-21-3130019616-2776909439-2417379446-500", domainSID: "S-1-5-21-3130019616-2776909439-2417379446", ok: true},
+		{objectID: "TESTLAB.LOCAL-S-1-5-32-544"},
+		{objectID: "S-1-5-32-544"},
+		{objectID: "0E1B3B4A-7C4B-4B2E-9E3A-2B5B1C1D1E1F"},
+	} {
+		domainSID, ok := ad2.DomainSIDFromObjectID(testCase.objectID)
+
+		assert.Equal(t, testCase.ok, ok, testCase.objectID)
+		assert.Equal(t, testCase.domainSID, domainSID, testCase.objectID)
+	}
+}
+
+func TestNilDomainScopeCoversEverything(t *testing.T) {
+	var scope *ad2.DomainScope
+
+	assert.True(t, scope.IsFull())
+	assert.Nil(t, scope.DomainSIDs())
+	assert.Nil(t, scope.RelationshipCriteria())
+	assert.True(t, scope.Accepts(analysis.CreatePostRelationshipJob{FromID: 1, ToID: 2}))
+}
@@ -45,6 +45,8 @@ func GetDescendentKinds(kind graph.Kind) []graph.Kind {
 			azure.LogicApp,
 			azure.AutomationAccount,
 			azure.KeyVault,
+			azure.StorageAccount,
+			azure.SQLServer,
 			azure.App,
 			azure.ServicePrincipal,
 			azure.Device,
@@ -64,6 +66,8 @@ func GetDescendentKinds(kind graph.Kind) []graph.Kind {
 			azure.LogicApp,
 			azure.AutomationAccount,
 			azure.KeyVault,
+			azure.StorageAccount,
+			azure.SQLServer,
 			azure.FunctionApp,
 		}
 
@@ -77,6 +81,8 @@ func GetDescendentKinds(kind graph.Kind) []graph.Kind {
 			azure.LogicApp,
 			azure.AutomationAccount,
 			azure.KeyVault,
+			azure.StorageAccount,
+			azure.SQLServer,
 			azure.FunctionApp,
 		}
 
@@ -91,6 +97,8 @@ func GetDescendentKinds(kind graph.Kind) []graph.Kind {
 			azure.LogicApp,
 			azure.AutomationAccount,
 			azure.KeyVault,
+			azure.StorageAccount,
+			azure.SQLServer,
 			azure.FunctionApp,
 		}
 	}
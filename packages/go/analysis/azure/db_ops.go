@@ -57,6 +57,10 @@ func ListEntityDescendentPaths(ctx context.Context, db graph.Database, relatedEn
 				targetKind = azure.AutomationAccount
 			case RelatedEntityTypeDescendentKeyVaults:
 				targetKind = azure.KeyVault
+			case RelatedEntityTypeDescendentStorageAccounts:
+				targetKind = azure.StorageAccount
+			case RelatedEntityTypeDescendentSQLServers:
+				targetKind = azure.SQLServer
 			case RelatedEntityTypeDescendentApplications:
 				targetKind = azure.App
 			case RelatedEntityTypeDescendentVMScaleSets:
@@ -111,6 +115,10 @@ func ListEntityDescendents(ctx context.Context, db graph.Database, relatedEntity
 				targetKind = azure.AutomationAccount
 			case RelatedEntityTypeDescendentKeyVaults:
 				targetKind = azure.KeyVault
+			case RelatedEntityTypeDescendentStorageAccounts:
+				targetKind = azure.StorageAccount
+			case RelatedEntityTypeDescendentSQLServers:
+				targetKind = azure.SQLServer
 			case RelatedEntityTypeDescendentApplications:
 				targetKind = azure.App
 			case RelatedEntityTypeDescendentVMScaleSets:
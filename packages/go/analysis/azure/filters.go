@@ -54,6 +54,10 @@ func FilterSecretReaders() graph.Criteria {
 	return query.KindIn(query.Relationship(), azure.MemberOf, azure.Contributor, azure.Owner, azure.GetSecrets)
 }
 
+func FilterStorageKeyReaders() graph.Criteria {
+	return query.KindIn(query.Relationship(), azure.MemberOf, azure.GetStorageKeys)
+}
+
 func FilterControlsRelationships() graph.Criteria {
 	return query.KindIn(query.Relationship(), append(azure.ControlRelationships(), azure.MemberOf, azure.Contains)...)
 }
@@ -36,6 +36,7 @@ const (
 	RelatedEntityTypeVaultCertReaders                   RelatedEntityType = "certificate-readers"
 	RelatedEntityTypeVaultSecretReaders                 RelatedEntityType = "secret-readers"
 	RelatedEntityTypeVaultKeyReaders                    RelatedEntityType = "key-readers"
+	RelatedEntityTypeStorageKeyReaders                  RelatedEntityType = "storage-key-readers"
 	RelatedEntityTypeGroupMembers                       RelatedEntityType = "group-members"
 	RelatedEntityTypeRoles                              RelatedEntityType = "roles"
 	RelatedEntityTypeFunctionApps                       RelatedEntityType = "function-apps"
@@ -56,6 +57,8 @@ const (
 	RelatedEntityTypeDescendentLogicApps                RelatedEntityType = "descendent-logic-apps"
 	RelatedEntityTypeDescendentAutomationAccounts       RelatedEntityType = "descendent-automation-accounts"
 	RelatedEntityTypeDescendentKeyVaults                RelatedEntityType = "descendent-key-vaults"
+	RelatedEntityTypeDescendentStorageAccounts          RelatedEntityType = "descendent-storage-accounts"
+	RelatedEntityTypeDescendentSQLServers               RelatedEntityType = "descendent-sql-servers"
 	RelatedEntityTypeDescendentApplications             RelatedEntityType = "descendent-applications"
 	RelatedEntityTypeDescendentServicePrincipals        RelatedEntityType = "descendent-service-principals"
 	RelatedEntityTypeDescendentDevices                  RelatedEntityType = "descendent-devices"
@@ -191,6 +194,19 @@ type FunctionAppDetails struct {
 	InboundObjectControl int `json:"inbound_object_control"`
 }
 
+type StorageAccountDetails struct {
+	Node
+
+	InboundObjectControl int `json:"inbound_object_control"`
+	StorageKeyReaders    int `json:"storage_key_readers"`
+}
+
+type SQLServerDetails struct {
+	Node
+
+	InboundObjectControl int `json:"inbound_object_control"`
+}
+
 type KeyVaultReaderCounts struct {
 	KeyReaders         int `json:"KeyReaders"`
 	CertificateReaders int `json:"CertificateReaders"`
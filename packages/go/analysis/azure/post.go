@@ -741,6 +741,71 @@ func ExecuteCommand(ctx context.Context, db graph.Database) (*analysis.AtomicPos
 	}
 }
 
+// GetStorageKeys creates AZGetStorageKeys edges from the principals that may list the access keys of a storage account.
+// Storage accounts with shared key access disabled are skipped since their keys can not be used to authenticate.
+func GetStorageKeys(ctx context.Context, db graph.Database) (*analysis.AtomicPostProcessingStats, error) {
+	defer measure.ContextLogAndMeasure(
+		ctx,
+		slog.LevelInfo,
+		"Post-processing GetStorageKeys",
+		attr.Namespace("analysis"),
+		attr.Function("GetStorageKeys"),
+		attr.Scope("process"),
+	)()
+
+	var storageAccounts graph.NodeSet
+
+	if err := db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		var err error
+
+		storageAccounts, err = ops.FetchNodeSet(tx.Nodes().Filterf(func() graph.Criteria {
+			return query.Kind(query.Node(), azure.StorageAccount)
+		}))
+
+		return err
+	}); err != nil {
+		return &analysis.AtomicPostProcessingStats{}, err
+	}
+
+	operation := analysis.NewPostRelationshipOperation(ctx, db, "AZGetStorageKeys Post Processing")
+
+	for _, storageAccount := range storageAccounts {
+		innerStorageAccount := storageAccount
+
+		if err := operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
+			if allowSharedKeyAccess, err := innerStorageAccount.Properties.GetOrDefault(azure.AllowSharedKeyAccess.String(), true).Bool(); err != nil {
+				return err
+			} else if !allowSharedKeyAccess {
+				return nil
+			} else if keyHolders, err := FetchStorageAccountKeyHolders(tx, innerStorageAccount); err != nil {
+				return err
+			} else {
+				for _, keyHolder := range keyHolders {
+					nextJob := analysis.CreatePostRelationshipJob{
+						FromID: keyHolder.ID,
+						ToID:   innerStorageAccount.ID,
+						Kind:   azure.GetStorageKeys,
+					}
+
+					if !channels.Submit(ctx, outC, nextJob) {
+						return nil
+					}
+				}
+
+				return nil
+			}
+		}); err != nil {
+			if err := operation.Done(); err != nil {
+				slog.ErrorContext(ctx, "Error caught during azure GetStorageKeys teardown", attr.Error(err))
+			}
+
+			return &operation.Stats, err
+		}
+	}
+
+	return &operation.Stats, operation.Done()
+}
+
 func resetPassword(operation analysis.StatTrackedOperation[analysis.CreatePostRelationshipJob], tenant *graph.Node, roleAssignments RoleAssignments) error {
 	defer measure.LogAndMeasure(
 		slog.LevelInfo,
@@ -779,38 +844,162 @@ func resetPasswordEndNodeBitmapForRole(role *graph.Node, roleAssignments RoleAss
 		return nil, fmt.Errorf("role node %d is missing property %s", role.ID, azure.RoleTemplateID)
 	} else if roleTemplateID, err := roleTemplateIDProp.String(); err != nil {
 		return nil, fmt.Errorf("role node %d property %s is not a string", role.ID, azure.RoleTemplateID)
+	} else if result, err := resetPasswordEndNodeBitmapForRoleTemplateID(roleTemplateID, roleAssignments); err != nil {
+		return nil, fmt.Errorf("role node %d has %w", role.ID, err)
 	} else {
-		result := cardinality.NewBitmap64()
-		switch roleTemplateID {
-		case azure.CompanyAdministratorRole, azure.PrivilegedAuthenticationAdministratorRole, azure.PartnerTier2SupportRole:
-			result.Or(roleAssignments.Users())
-		case azure.UserAccountAdministratorRole:
-			result.Or(roleAssignments.UsersWithoutRoles())
-			result.Or(roleAssignments.UsersWithRolesExclusive(UserAdministratorPasswordResetTargetRoles()...))
-			result.AndNot(roleAssignments.UsersWithRoleAssignableGroupMembership())
-		case azure.HelpdeskAdministratorRole:
-			result.Or(roleAssignments.UsersWithoutRoles())
-			result.Or(roleAssignments.UsersWithRolesExclusive(HelpdeskAdministratorPasswordResetTargetRoles()...))
-			result.AndNot(roleAssignments.UsersWithRoleAssignableGroupMembership())
-		case azure.AuthenticationAdministratorRole:
-			result.Or(roleAssignments.UsersWithoutRoles())
-			result.Or(roleAssignments.UsersWithRolesExclusive(AuthenticationAdministratorPasswordResetTargetRoles()...))
-			result.AndNot(roleAssignments.UsersWithRoleAssignableGroupMembership())
-		case azure.PasswordAdministratorRole:
-			result.Or(roleAssignments.UsersWithoutRoles())
-			result.Or(roleAssignments.UsersWithRolesExclusive(PasswordAdministratorPasswordResetTargetRoles()...))
-			result.AndNot(roleAssignments.UsersWithRoleAssignableGroupMembership())
-		case azure.PartnerTier1SupportRole:
-			result.Or(roleAssignments.UsersWithoutRoles())
-			result.AndNot(roleAssignments.UsersWithRoleAssignableGroupMembership())
-		default:
-			return nil, fmt.Errorf("role node %d has unsupported role template id '%s'", role.ID, roleTemplateID)
+		return result, nil
+	}
+}
+
+func resetPasswordEndNodeBitmapForRoleTemplateID(roleTemplateID string, roleAssignments RoleAssignments) (cardinality.Duplex[uint64], error) {
+	result := cardinality.NewBitmap64()
+	switch roleTemplateID {
+	case azure.CompanyAdministratorRole, azure.PrivilegedAuthenticationAdministratorRole, azure.PartnerTier2SupportRole:
+		result.Or(roleAssignments.Users())
+	case azure.UserAccountAdministratorRole:
+		result.Or(roleAssignments.UsersWithoutRoles())
+		result.Or(roleAssignments.UsersWithRolesExclusive(UserAdministratorPasswordResetTargetRoles()...))
+		result.AndNot(roleAssignments.UsersWithRoleAssignableGroupMembership())
+	case azure.HelpdeskAdministratorRole:
+		result.Or(roleAssignments.UsersWithoutRoles())
+		result.Or(roleAssignments.UsersWithRolesExclusive(HelpdeskAdministratorPasswordResetTargetRoles()...))
+		result.AndNot(roleAssignments.UsersWithRoleAssignableGroupMembership())
+	case azure.AuthenticationAdministratorRole:
+		result.Or(roleAssignments.UsersWithoutRoles())
+		result.Or(roleAssignments.UsersWithRolesExclusive(AuthenticationAdministratorPasswordResetTargetRoles()...))
+		result.AndNot(roleAssignments.UsersWithRoleAssignableGroupMembership())
+	case azure.PasswordAdministratorRole:
+		result.Or(roleAssignments.UsersWithoutRoles())
+		result.Or(roleAssignments.UsersWithRolesExclusive(PasswordAdministratorPasswordResetTargetRoles()...))
+		result.AndNot(roleAssignments.UsersWithRoleAssignableGroupMembership())
+	case azure.PartnerTier1SupportRole:
+		result.Or(roleAssignments.UsersWithoutRoles())
+		result.AndNot(roleAssignments.UsersWithRoleAssignableGroupMembership())
+	default:
+		return nil, fmt.Errorf("unsupported role template id '%s'", roleTemplateID)
+	}
+
+	return result, nil
+}
+
+// administrativeUnitResetPassword creates AZResetPassword edges for password reset roles assigned at administrative unit
+// scope. The role node is shared with tenant-wide assignments, so these edges start at the assigned principal instead
+// and only target users that are members of the administrative unit.
+func administrativeUnitResetPassword(operation analysis.StatTrackedOperation[analysis.CreatePostRelationshipJob], roleAssignments RoleAssignments) error {
+	defer measure.LogAndMeasure(
+		slog.LevelInfo,
+		"Administrative Unit AZResetPassword Post Processing",
+		attr.Namespace("analysis"),
+		attr.Function("administrativeUnitResetPassword"),
+		attr.Scope("routine"),
+	)()
+
+	return operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
+		for _, roleTemplateID := range ResetPasswordRoleIDs() {
+			for administrativeUnitID, principals := range roleAssignments.AdministrativeUnitRoleMap[roleTemplateID] {
+				if targets, err := resetPasswordEndNodeBitmapForRoleTemplateID(roleTemplateID, roleAssignments); err != nil {
+					return fmt.Errorf("unable to continue processing azresetpassword for administrative unit node %d: %w", administrativeUnitID, err)
+				} else {
+					targets.And(roleAssignments.AdministrativeUnitMembersOfKind(administrativeUnitID, azure.User))
+
+					if !submitAdministrativeUnitEdges(ctx, outC, principals, targets, azure.ResetPassword) {
+						return nil
+					}
+				}
+			}
 		}
 
-		return result, nil
+		return nil
+	})
+}
+
+// administrativeUnitAddMembers creates AZAddMembers edges for group management roles assigned at administrative unit
+// scope. Only groups that are members of the administrative unit are targeted.
+func administrativeUnitAddMembers(roleAssignments RoleAssignments, operation analysis.StatTrackedOperation[analysis.CreatePostRelationshipJob]) {
+	defer measure.LogAndMeasure(
+		slog.LevelInfo,
+		"Administrative Unit AZ Add Members Post Processing",
+		attr.Namespace("analysis"),
+		attr.Function("administrativeUnitAddMembers"),
+		attr.Scope("routine"),
+	)()
+
+	for administrativeUnitID := range roleAssignments.AdministrativeUnitMembers {
+		var (
+			innerAdministrativeUnitID = administrativeUnitID
+		)
+
+		if err := operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
+			var (
+				allGroupsPrincipals         = roleAssignments.AdministrativeUnitPrincipalsWithRole(innerAdministrativeUnitID, AddMemberAllGroupsTargetRoles()...)
+				notRoleAssignablePrincipals = roleAssignments.AdministrativeUnitPrincipalsWithRole(innerAdministrativeUnitID, AddMemberGroupNotRoleAssignableTargetRoles()...)
+				allGroups                   = roleAssignments.AdministrativeUnitMembersOfKind(innerAdministrativeUnitID, azure.Group)
+				notRoleAssignableGroups     = cardinality.NewBitmap64()
+				err                         error
+			)
+
+			if allGroupsPrincipals.Cardinality() == 0 && notRoleAssignablePrincipals.Cardinality() == 0 {
+				return nil
+			}
+
+			allGroups.Each(func(nextID uint64) bool {
+				group := roleAssignments.Principals.GetNode(graph.ID(nextID))
+
+				if isRoleAssignable, propErr := group.Properties.Get(azure.IsAssignableToRole.String()).Bool(); propErr != nil {
+					if graph.IsErrPropertyNotFound(propErr) {
+						slog.WarnContext(
+							ctx,
+							"Node is missing property",
+							slog.Uint64("node_id", group.ID.Uint64()),
+							slog.String("property", azure.IsAssignableToRole.String()),
+						)
+					} else {
+						err = propErr
+						return false
+					}
+				} else if !isRoleAssignable {
+					notRoleAssignableGroups.Add(nextID)
+				}
+
+				return true
+			})
+
+			if err != nil {
+				return err
+			} else if !submitAdministrativeUnitEdges(ctx, outC, allGroupsPrincipals, allGroups, azure.AddMembers) {
+				return nil
+			} else {
+				submitAdministrativeUnitEdges(ctx, outC, notRoleAssignablePrincipals, notRoleAssignableGroups, azure.AddMembers)
+				return nil
+			}
+		}); err != nil {
+			slog.Error("Failed to submit post processing job for principals with administrative unit scoped role allowing AZAddMembers edge", attr.Error(err))
+		}
 	}
 }
 
+// submitAdministrativeUnitEdges submits an edge of the given kind from every principal to every target. It returns
+// false if the context was cancelled while submitting.
+func submitAdministrativeUnitEdges(ctx context.Context, outC chan<- analysis.CreatePostRelationshipJob, principals, targets cardinality.Duplex[uint64], kind graph.Kind) bool {
+	submitted := true
+
+	principals.Each(func(principalID uint64) bool {
+		targets.Each(func(targetID uint64) bool {
+			submitted = channels.Submit(ctx, outC, analysis.CreatePostRelationshipJob{
+				FromID: graph.ID(principalID),
+				ToID:   graph.ID(targetID),
+				Kind:   kind,
+			})
+
+			return submitted
+		})
+
+		return submitted
+	})
+
+	return submitted
+}
+
 func globalAdmins(roleAssignments RoleAssignments, tenant *graph.Node, operation analysis.StatTrackedOperation[analysis.CreatePostRelationshipJob]) {
 	defer measure.LogAndMeasure(
 		slog.LevelInfo,
@@ -1031,6 +1220,16 @@ func UserRoleAssignments(ctx context.Context, db graph.Database) (*analysis.Atom
 					privilegedAuthAdmins(roleAssignments, tenant, operation)
 					addMembers(roleAssignments, operation)
 				}
+
+				if err := administrativeUnitResetPassword(operation, roleAssignments); err != nil {
+					if err := operation.Done(); err != nil {
+						slog.ErrorContext(ctx, "Error caught during azure UserRoleAssignments.administrativeUnitResetPassword teardown", attr.Error(err))
+					}
+
+					return &analysis.AtomicPostProcessingStats{}, err
+				} else {
+					administrativeUnitAddMembers(roleAssignments, operation)
+				}
 			}
 		}
 
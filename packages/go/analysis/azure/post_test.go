@@ -75,6 +75,48 @@ func TestRoleAssignments_UsersWithoutRoles(t *testing.T) {
 	assert.True(t, assignments.UsersWithoutRoles().Contains(uint64(user2.ID)))
 }
 
+func TestRoleAssignments_AdministrativeUnitScope(t *testing.T) {
+	var (
+		assignments          = setupRoleAssignments()
+		administrativeUnitID = graph.ID(10)
+		scopedPrincipals     = cardinality.NewBitmap64()
+		members              = cardinality.NewBitmap64()
+	)
+
+	scopedPrincipals.Add(uint64(user2.ID))
+	members.Add(uint64(user.ID), uint64(group.ID))
+
+	assignments.AdministrativeUnitRoleMap = map[string]map[graph.ID]cardinality.Duplex[uint64]{
+		constants.PasswordAdministratorRoleID: {
+			administrativeUnitID: scopedPrincipals,
+		},
+	}
+	assignments.AdministrativeUnitMembers = map[graph.ID]cardinality.Duplex[uint64]{
+		administrativeUnitID: members,
+	}
+
+	assert.True(t, assignments.AdministrativeUnitPrincipalsWithRole(administrativeUnitID, azschema.PasswordAdministratorRole).Contains(uint64(user2.ID)))
+	assert.Equal(t, uint64(0), assignments.AdministrativeUnitPrincipalsWithRole(administrativeUnitID, azschema.HelpdeskAdministratorRole).Cardinality())
+	assert.Equal(t, uint64(0), assignments.AdministrativeUnitPrincipalsWithRole(graph.ID(11), azschema.PasswordAdministratorRole).Cardinality())
+
+	// Scoped role holders are no longer considered users without roles
+	assert.False(t, assignments.UsersWithoutRoles().Contains(uint64(user2.ID)))
+
+	// Scoped role holders are not tenant-wide role holders
+	assert.False(t, assignments.PrincipalsWithRole(azschema.PasswordAdministratorRole).Contains(uint64(user2.ID)))
+
+	auUsers := assignments.AdministrativeUnitMembersOfKind(administrativeUnitID, azschema.User)
+	assert.Equal(t, uint64(1), auUsers.Cardinality())
+	assert.True(t, auUsers.Contains(uint64(user.ID)))
+
+	auGroups := assignments.AdministrativeUnitMembersOfKind(administrativeUnitID, azschema.Group)
+	assert.Equal(t, uint64(1), auGroups.Cardinality())
+	assert.True(t, auGroups.Contains(uint64(group.ID)))
+
+	// Filtering by kind must not modify the stored membership
+	assert.Equal(t, uint64(2), members.Cardinality())
+}
+
 func TestRoleAssignments_NodesWithRole(t *testing.T) {
 	assignments := setupRoleAssignments()
 	assert.True(t, assignments.PrincipalsWithRole(constants.ReportsReaderRoleID, constants.GlobalAdministratorRoleID).Contains(uint64(user.ID)))
@@ -21,10 +21,13 @@ import (
 	"fmt"
 	"log/slog"
 	"slices"
+	"strings"
 
 	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+	"github.com/specterops/bloodhound/packages/go/ein"
 	"github.com/specterops/bloodhound/packages/go/graphschema"
 	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
 	"github.com/specterops/dawgs/cardinality"
 	"github.com/specterops/dawgs/graph"
 	"github.com/specterops/dawgs/ops"
@@ -98,6 +101,13 @@ type RoleAssignments struct {
 	Principals                    graph.NodeKindSet
 	RoleMap                       map[string]cardinality.Duplex[uint64]
 	RoleAssignableGroupMembership cardinality.Duplex[uint64]
+
+	// AdministrativeUnitRoleMap holds the principals of role assignments scoped to an administrative unit indexed by
+	// role template ID and then by administrative unit node ID. These principals are not part of RoleMap.
+	AdministrativeUnitRoleMap map[string]map[graph.ID]cardinality.Duplex[uint64]
+
+	// AdministrativeUnitMembers holds the node IDs of the users, groups and devices of each administrative unit
+	AdministrativeUnitMembers map[graph.ID]cardinality.Duplex[uint64]
 }
 
 func (s RoleAssignments) GetNodeKindSet(bm cardinality.Duplex[uint64]) graph.NodeKindSet {
@@ -132,6 +142,11 @@ func (s RoleAssignments) UsersWithAnyRole() cardinality.Duplex[uint64] {
 	for _, bitmap := range s.RoleMap {
 		principalsWithRoles.Or(bitmap)
 	}
+	for _, scopedAssignments := range s.AdministrativeUnitRoleMap {
+		for _, bitmap := range scopedAssignments {
+			principalsWithRoles.Or(bitmap)
+		}
+	}
 	principalsWithRoles.And(users)
 	return principalsWithRoles
 }
@@ -189,10 +204,40 @@ func (s RoleAssignments) PrincipalsWithRolesExclusive(roleTemplateIDs ...string)
 			excludedPrincipals.Or(bitmap)
 		}
 	}
+	for roleID, scopedAssignments := range s.AdministrativeUnitRoleMap {
+		for _, bitmap := range scopedAssignments {
+			if slices.Contains(roleTemplateIDs, roleID) {
+				result.Or(bitmap)
+			} else {
+				excludedPrincipals.Or(bitmap)
+			}
+		}
+	}
 	result.AndNot(excludedPrincipals)
 	return result
 }
 
+// AdministrativeUnitPrincipalsWithRole returns a roaring bitmap of principals that have been assigned one or more of the matching roles scoped to the given administrative unit
+func (s RoleAssignments) AdministrativeUnitPrincipalsWithRole(administrativeUnitID graph.ID, roleTemplateIDs ...string) cardinality.Duplex[uint64] {
+	result := cardinality.NewBitmap64()
+	for _, roleTemplateID := range roleTemplateIDs {
+		if bitmap, ok := s.AdministrativeUnitRoleMap[roleTemplateID][administrativeUnitID]; ok {
+			result.Or(bitmap)
+		}
+	}
+	return result
+}
+
+// AdministrativeUnitMembersOfKind returns a roaring bitmap of the tenant principals of the given kind that are members of the given administrative unit
+func (s RoleAssignments) AdministrativeUnitMembersOfKind(administrativeUnitID graph.ID, kind graph.Kind) cardinality.Duplex[uint64] {
+	result := cardinality.NewBitmap64()
+	if members, ok := s.AdministrativeUnitMembers[administrativeUnitID]; ok {
+		result.Or(members)
+		result.And(s.Principals.Get(kind).IDBitmap())
+	}
+	return result
+}
+
 // NodesWithRolesExclusive will return nodes that *only* have a role/roles listed and exclude nodes that have other roles
 func (s RoleAssignments) NodesWithRolesExclusive(roleTemplateIDs ...string) graph.NodeKindSet {
 	bm := s.PrincipalsWithRolesExclusive(roleTemplateIDs...)
@@ -220,6 +265,8 @@ func initTenantRoleAssignments(tx graph.Transaction, tenant *graph.Node) (RoleAs
 			Principals:                    roleMembers.KindSet(),
 			RoleMap:                       make(map[string]cardinality.Duplex[uint64]),
 			RoleAssignableGroupMembership: cardinality.NewBitmap64(),
+			AdministrativeUnitRoleMap:     make(map[string]map[graph.ID]cardinality.Duplex[uint64]),
+			AdministrativeUnitMembers:     make(map[graph.ID]cardinality.Duplex[uint64]),
 		}, nil
 	}
 }
@@ -241,6 +288,9 @@ func TenantRoleAssignments(ctx context.Context, db graph.Database, tenant *graph
 					fetchedRoleAssignments.RoleAssignableGroupMembership.Or(members.IDBitmap())
 				}
 			}
+			if err := populateAdministrativeUnitRoleAssignments(tx, tenant, roles, fetchedRoleAssignments); err != nil {
+				return err
+			}
 			return roles.KindSet().EachNode(func(node *graph.Node) error {
 				if roleTemplateID, err := node.Properties.Get(azure.RoleTemplateID.String()).String(); err != nil {
 					if !graph.IsErrPropertyNotFound(err) {
@@ -260,6 +310,81 @@ func TenantRoleAssignments(ctx context.Context, db graph.Database, tenant *graph
 	})
 }
 
+// populateAdministrativeUnitRoleAssignments indexes the members of each administrative unit in the tenant and the
+// principals of role assignments scoped to those administrative units. Members of a role assignable group inherit the
+// scoped role assignments of the group.
+func populateAdministrativeUnitRoleAssignments(tx graph.Transaction, tenant *graph.Node, roles graph.NodeSet, roleAssignments RoleAssignments) error {
+	administrativeUnitIDs := make(map[string]graph.ID)
+
+	if administrativeUnits, err := TenantAdministrativeUnits(tx, tenant); err != nil {
+		return err
+	} else if len(administrativeUnits) == 0 {
+		return nil
+	} else {
+		for _, administrativeUnit := range administrativeUnits {
+			if objectID, err := administrativeUnit.Properties.Get(common.ObjectID.String()).String(); err != nil {
+				slog.Warn(fmt.Sprintf("Administrative unit node %d is missing property %s", administrativeUnit.ID, common.ObjectID))
+			} else if members, err := EndNodes(tx, administrativeUnit, azure.Contains, azure.User, azure.Group, azure.Device); err != nil {
+				return err
+			} else {
+				administrativeUnitIDs[strings.ToUpper(objectID)] = administrativeUnit.ID
+				roleAssignments.AdministrativeUnitMembers[administrativeUnit.ID] = members.IDBitmap()
+			}
+		}
+	}
+
+	scopedAssignments, err := ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Kind(query.Relationship(), azure.HasScopedRole),
+			query.InIDs(query.EndID(), roles.IDs()...),
+		)
+	}))
+	if err != nil {
+		return err
+	}
+
+	for _, scopedAssignment := range scopedAssignments {
+		scope, _ := scopedAssignment.Properties.Get(azure.Scope.String()).String()
+
+		if administrativeUnitID, ok := administrativeUnitIDs[strings.TrimPrefix(scope, ein.AdministrativeUnitScopePrefix)]; !ok {
+			// The administrative unit was not collected so there are no members to scope the assignment to
+			continue
+		} else if role := roles.Get(scopedAssignment.EndID); role == nil {
+			continue
+		} else if roleTemplateID, err := role.Properties.Get(azure.RoleTemplateID.String()).String(); err != nil {
+			if !graph.IsErrPropertyNotFound(err) {
+				return err
+			}
+		} else if principal, err := ops.FetchNode(tx, scopedAssignment.StartID); err != nil {
+			return err
+		} else {
+			principals := cardinality.NewBitmap64()
+
+			if principal.Kinds.ContainsOneOf(azure.Group) {
+				if members, err := FetchRoleAssignableGroupMembersUsers(tx, principal, 0, 0); err != nil {
+					return err
+				} else {
+					principals.Or(members.IDBitmap())
+				}
+			} else {
+				principals.Add(principal.ID.Uint64())
+			}
+
+			if _, ok := roleAssignments.AdministrativeUnitRoleMap[roleTemplateID]; !ok {
+				roleAssignments.AdministrativeUnitRoleMap[roleTemplateID] = make(map[graph.ID]cardinality.Duplex[uint64])
+			}
+
+			if existing, ok := roleAssignments.AdministrativeUnitRoleMap[roleTemplateID][administrativeUnitID]; ok {
+				existing.Or(principals)
+			} else {
+				roleAssignments.AdministrativeUnitRoleMap[roleTemplateID][administrativeUnitID] = principals
+			}
+		}
+	}
+
+	return nil
+}
+
 // RoleMembers returns the NodeSet of members for a given set of roles
 func RoleMembers(tx graph.Transaction, tenant *graph.Node, roleTemplateIDs ...string) (graph.NodeSet, error) {
 	if tenantRoles, err := TenantRoles(tx, tenant, roleTemplateIDs...); err != nil {
@@ -0,0 +1,50 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package azure
+
+import (
+	"context"
+
+	"github.com/specterops/bloodhound/packages/go/graphschema"
+	"github.com/specterops/dawgs/graph"
+)
+
+func SQLServerEntityDetails(ctx context.Context, db graph.Database, validPrimaryKinds graphschema.ValidPrimaryKinds, objectID string, hydrateCounts bool) (SQLServerDetails, error) {
+	var details SQLServerDetails
+
+	return details, db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		if node, err := FetchEntityByObjectID(tx, objectID); err != nil {
+			return err
+		} else {
+			details.Node = FromGraphNode(validPrimaryKinds, node)
+			if hydrateCounts {
+				details, err = PopulateSQLServerEntityDetailsCounts(tx, node, details)
+			}
+			return err
+		}
+	})
+}
+
+func PopulateSQLServerEntityDetailsCounts(tx graph.Transaction, node *graph.Node, details SQLServerDetails) (SQLServerDetails, error) {
+	if inboundObjectControl, err := FetchInboundEntityObjectControllers(tx, node, 0, 0); err != nil {
+		return details, err
+	} else {
+		details.InboundObjectControl = inboundObjectControl.Len()
+	}
+
+	return details, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package azure
+
+import (
+	"context"
+
+	"github.com/specterops/bloodhound/packages/go/graphschema"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+)
+
+func StorageAccountEntityDetails(ctx context.Context, db graph.Database, validPrimaryKinds graphschema.ValidPrimaryKinds, objectID string, hydrateCounts bool) (StorageAccountDetails, error) {
+	var details StorageAccountDetails
+
+	return details, db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		if node, err := FetchEntityByObjectID(tx, objectID); err != nil {
+			return err
+		} else {
+			details.Node = FromGraphNode(validPrimaryKinds, node)
+			if hydrateCounts {
+				details, err = PopulateStorageAccountEntityDetailsCounts(tx, node, details)
+			}
+			return err
+		}
+	})
+}
+
+func PopulateStorageAccountEntityDetailsCounts(tx graph.Transaction, node *graph.Node, details StorageAccountDetails) (StorageAccountDetails, error) {
+	if inboundObjectControl, err := FetchInboundEntityObjectControllers(tx, node, 0, 0); err != nil {
+		return details, err
+	} else {
+		details.InboundObjectControl = inboundObjectControl.Len()
+	}
+
+	if storageKeyReaders, err := FetchStorageKeyReaders(tx, node, 0, 0); err != nil {
+		return details, err
+	} else {
+		details.StorageKeyReaders = storageKeyReaders.Len()
+	}
+
+	return details, nil
+}
+
+// FetchStorageAccountKeyHolders returns the principals holding a role that allows listing the keys of the given storage
+// account. Owner and Contributor both grant the listKeys action whether they are assigned on the storage account itself
+// or inherited from a containing resource group, subscription or management group.
+func FetchStorageAccountKeyHolders(tx graph.Transaction, storageAccount *graph.Node) (graph.NodeSet, error) {
+	scopeIDs := []graph.ID{storageAccount.ID}
+
+	if ancestors, err := ops.AcyclicTraverseNodes(tx, ops.TraversalPlan{
+		Root:        storageAccount,
+		Direction:   graph.DirectionInbound,
+		BranchQuery: FilterContains,
+	}, func(node *graph.Node) bool {
+		return node.ID != storageAccount.ID
+	}); err != nil {
+		return nil, err
+	} else {
+		scopeIDs = append(scopeIDs, ancestors.IDs()...)
+	}
+
+	return ops.FetchStartNodes(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.KindIn(query.Relationship(), azure.Owner, azure.Contributor),
+			query.InIDs(query.EndID(), scopeIDs...),
+		)
+	}))
+}
+
+func FetchStorageKeyReaderPaths(tx graph.Transaction, storageAccount *graph.Node) (graph.PathSet, error) {
+	return ops.TraversePaths(tx, ops.TraversalPlan{
+		Root:        storageAccount,
+		Direction:   graph.DirectionInbound,
+		BranchQuery: FilterStorageKeyReaders,
+	})
+}
+
+func FetchStorageKeyReaders(tx graph.Transaction, storageAccount *graph.Node, skip, limit int) (graph.NodeSet, error) {
+	return ops.AcyclicTraverseTerminals(tx, ops.TraversalPlan{
+		Root:        storageAccount,
+		Direction:   graph.DirectionInbound,
+		Skip:        skip,
+		Limit:       limit,
+		BranchQuery: FilterStorageKeyReaders,
+	})
+}
+
+func ListStorageKeyReaderPaths(ctx context.Context, db graph.Database, objectID string) (graph.PathSet, error) {
+	var paths graph.PathSet
+
+	return paths, db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		if node, err := FetchEntityByObjectID(tx, objectID); err != nil {
+			return err
+		} else {
+			paths, err = FetchStorageKeyReaderPaths(tx, node)
+			return err
+		}
+	})
+}
+
+func ListStorageKeyReaders(ctx context.Context, db graph.Database, objectID string, skip, limit int) (graph.NodeSet, error) {
+	var nodes graph.NodeSet
+
+	return nodes, db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		if node, err := FetchEntityByObjectID(tx, objectID); err != nil {
+			return err
+		} else {
+			nodes, err = FetchStorageKeyReaders(tx, node, skip, limit)
+			return err
+		}
+	})
+}
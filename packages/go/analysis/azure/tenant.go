@@ -63,6 +63,21 @@ func PopulateTenantEntityDetailsCounts(tx graph.Transaction, node *graph.Node, d
 	return details, nil
 }
 
+// TenantAdministrativeUnits returns the complete set of Administrative Unit nodes contained by the given Tenant node
+func TenantAdministrativeUnits(tx graph.Transaction, tenant *graph.Node) (graph.NodeSet, error) {
+	if !IsTenantNode(tenant) {
+		return nil, fmt.Errorf("node %d must contain kind %s", tenant.ID, azure.Tenant)
+	} else {
+		return ops.FetchEndNodes(tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Equals(query.StartID(), tenant.ID),
+				query.Kind(query.Relationship(), azure.Contains),
+				query.Kind(query.End(), azure.AdministrativeUnit),
+			)
+		}))
+	}
+}
+
 // TenantPrincipals returns the complete set of User, Group, and Service Principal nodes contained by the given Tenant node
 func TenantPrincipals(tx graph.Transaction, tenant *graph.Node) (graph.NodeSet, error) {
 	if !IsTenantNode(tenant) {
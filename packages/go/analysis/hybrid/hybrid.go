@@ -35,6 +35,43 @@ import (
 	"github.com/specterops/dawgs/util/channels"
 )
 
+// syncedIdentityKind describes a pair of Entra and AD node kinds that are synchronized by Entra Connect along with the
+// relationship kinds created between a matched pair
+type syncedIdentityKind struct {
+	entraKind graph.Kind
+	adKind    graph.Kind
+	toAD      graph.Kind
+	toEntra   graph.Kind
+
+	// createMissing controls whether a standalone AD node is created when no AD node matches the on-prem ID
+	createMissing bool
+}
+
+// syncedIdentityKinds lists every synchronized identity kind handled by PostHybrid. Entra devices are matched using the
+// same onpremid and onpremsyncenabled properties as users and groups, which ingest maps from the collector's
+// onPremisesSecurityIdentifier and onPremisesSyncEnabled device fields. Only hybrid joined devices have them set.
+var syncedIdentityKinds = []syncedIdentityKind{
+	{
+		entraKind:     azureSchema.User,
+		adKind:        adSchema.User,
+		toAD:          adSchema.SyncedToADUser,
+		toEntra:       azureSchema.SyncedToEntraUser,
+		createMissing: true,
+	},
+	{
+		entraKind: azureSchema.Group,
+		adKind:    adSchema.Group,
+		toAD:      adSchema.SyncedToADGroup,
+		toEntra:   azureSchema.SyncedToEntraGroup,
+	},
+	{
+		entraKind: azureSchema.Device,
+		adKind:    adSchema.Computer,
+		toAD:      adSchema.SyncedToADComputer,
+		toEntra:   azureSchema.SyncedToEntraDevice,
+	},
+}
+
 func PostHybrid(ctx context.Context, db graph.Database) (*analysis.AtomicPostProcessingStats, error) {
 	defer measure.ContextLogAndMeasure(
 		ctx,
@@ -55,116 +92,130 @@ func PostHybrid(ctx context.Context, db graph.Database) (*analysis.AtomicPostPro
 	operation := analysis.NewPostRelationshipOperation(ctx, db, "Hybrid Attack Paths Post Processing")
 
 	err = db.ReadTransaction(ctx, func(tx graph.Transaction) error {
-		var (
-			// entraObjIDMap is used to index AD user objectids by Entra node ids
-			entraObjIDMap = make(map[graph.ID]string, 1024)
-			// adObjIDMap is used as a reverse mapping of a list of Entra node ids indexed by the AD user objectids
-			adObjIDMap = make(map[string][]graph.ID, 1024)
-			// entraToADMap is the final mapping between an Entra user node id to an AD user node id
-			entraToADMap = make(map[graph.ID]graph.ID, 1024)
-		)
-
-		// Work on Entra users by their tenant association. Loop therefore through each Entra tenant
-		for _, tenant := range tenants {
-			// Fetch all users in this Entra tenant
-			if tenantUsers, err := fetchEntraUsers(tx, tenant); err != nil {
+		for _, syncedKind := range syncedIdentityKinds {
+			if err := postSyncedIdentities(ctx, db, tx, operation.Operation, tenants, syncedKind); err != nil {
 				return err
-			} else if len(tenantUsers) == 0 {
-				// If there are no users present, exit this loop
-				continue
-			} else {
-				// Loop through each Entra user in this tenant
-				for _, tenantUser := range tenantUsers {
-					// Check to see if the Entra user has an on prem sync property set
-					if onPremID, hasOnPrem, err := hasOnPremUser(tenantUser); !hasOnPrem {
-						continue
-					} else if err != nil {
-						return err
-					} else {
-						// We know this user has an onPrem counterpart, so add the node id and onPremID to our three maps
-						adObjIDMap[onPremID] = append(adObjIDMap[onPremID], tenantUser.ID)
-						entraObjIDMap[tenantUser.ID] = onPremID
-
-						// Initialize the current user id as an index in the entraToADMap, but use 0 as the nodeid for AD since we
-						// currently don't know it and 0 is never going to be a valid user node id
-						entraToADMap[tenantUser.ID] = 0
-					}
-				}
 			}
 		}
 
-		// Because there's a chance for AD users to exist in the graph without having a valid domain node linked to them,
-		// we need to grab all of them directly, unlike Entra
-		if adUsers, err := fetchADUsers(tx); err != nil {
+		return tx.Commit()
+	})
+
+	// Because we need to close the operation either way at this stage, we attempt to close it and then report either or
+	// both errors in one line
+	if opErr := operation.Done(); opErr != nil || err != nil {
+		return &operation.Stats, fmt.Errorf("marking operation as done: %w; transaction error (if any): %v", opErr, err)
+	}
+
+	return &operation.Stats, nil
+}
+
+// postSyncedIdentities matches the Entra nodes of a synchronized identity kind to their AD counterparts by on-prem ID
+// and submits the relationships in both directions for every matched pair
+func postSyncedIdentities(ctx context.Context, db graph.Database, tx graph.Transaction, operation *ops.Operation[analysis.CreatePostRelationshipJob], tenants graph.NodeSet, syncedKind syncedIdentityKind) error {
+	var (
+		// entraObjIDMap is used to index AD objectids by Entra node ids
+		entraObjIDMap = make(map[graph.ID]string, 1024)
+		// adObjIDMap is used as a reverse mapping of a list of Entra node ids indexed by the AD objectids
+		adObjIDMap = make(map[string][]graph.ID, 1024)
+		// entraToADMap is the final mapping between an Entra node id to an AD node id
+		entraToADMap = make(map[graph.ID]graph.ID, 1024)
+	)
+
+	// Work on Entra nodes by their tenant association. Loop therefore through each Entra tenant
+	for _, tenant := range tenants {
+		// Fetch all nodes of this kind in this Entra tenant
+		if tenantNodes, err := fetchEntraNodes(tx, tenant, syncedKind.entraKind); err != nil {
 			return err
+		} else if len(tenantNodes) == 0 {
+			// If there are no nodes present, exit this loop
+			continue
 		} else {
-			// Loop through each Active Directory user
-			for _, adUser := range adUsers {
-				// Get the user's Object ID
-				if objectID, err := adUser.Properties.Get(common.ObjectID.String()).String(); err != nil {
-					return err
-				} else if azUsers, ok := adObjIDMap[objectID]; !ok {
-					// Skip adding this relationship if we've already seen it before as that implies it will be created
+			// Loop through each Entra node in this tenant
+			for _, tenantNode := range tenantNodes {
+				// Check to see if the Entra node has an on prem sync property set
+				if onPremID, hasOnPrem, err := hasOnPremUser(tenantNode); !hasOnPrem {
 					continue
+				} else if err != nil {
+					return err
 				} else {
-					// Because there could theoretically be more than one Entra user mapped to this objectid, we want to loop through all when adding our current id to the final map
-					for _, azUser := range azUsers {
-						entraToADMap[azUser] = adUser.ID
-					}
+					// We know this node has an onPrem counterpart, so add the node id and onPremID to our three maps
+					adObjIDMap[onPremID] = append(adObjIDMap[onPremID], tenantNode.ID)
+					entraObjIDMap[tenantNode.ID] = onPremID
+
+					// Initialize the current node id as an index in the entraToADMap, but use 0 as the nodeid for AD since we
+					// currently don't know it and 0 is never going to be a valid node id
+					entraToADMap[tenantNode.ID] = 0
 				}
 			}
 		}
+	}
 
-		if err := operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
-			for azUser, potentialADUser := range entraToADMap {
-				var adUser = potentialADUser
-
-				// The 0 value should never be a valid id for an AD user node, just by the nature of the graph, so we're cheating
-				// by checking if we set it to 0 as a flag that this node was never actually found, meaning it needs to be created first
-				if potentialADUser == 0 {
-					if adUserNode, err := createMissingADUser(ctx, db, entraObjIDMap[azUser]); err != nil {
-						return err
-					} else {
-						adUser = adUserNode.ID
-					}
-				}
+	if len(entraToADMap) == 0 {
+		return nil
+	}
 
-				SyncedToEntraUserRelationship := analysis.CreatePostRelationshipJob{
-					FromID: adUser,
-					ToID:   azUser,
-					Kind:   azureSchema.SyncedToEntraUser,
+	// Because there's a chance for AD nodes to exist in the graph without having a valid domain node linked to them,
+	// we need to grab all of them directly, unlike Entra
+	if adNodes, err := fetchADNodes(tx, syncedKind.adKind); err != nil {
+		return err
+	} else {
+		// Loop through each Active Directory node
+		for _, adNode := range adNodes {
+			// Get the node's Object ID
+			if objectID, err := adNode.Properties.Get(common.ObjectID.String()).String(); err != nil {
+				return err
+			} else if entraNodes, ok := adObjIDMap[objectID]; !ok {
+				// Skip AD nodes that no Entra node claims as its on-prem counterpart
+				continue
+			} else {
+				// Because there could theoretically be more than one Entra node mapped to this objectid, we want to loop through all when adding our current id to the final map
+				for _, entraNode := range entraNodes {
+					entraToADMap[entraNode] = adNode.ID
 				}
+			}
+		}
+	}
 
-				if !channels.Submit(ctx, outC, SyncedToEntraUserRelationship) {
-					return nil
-				}
+	return operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
+		for entraNode, potentialADNode := range entraToADMap {
+			var adNode = potentialADNode
 
-				SyncedToADUserRelationship := analysis.CreatePostRelationshipJob{
-					FromID: azUser,
-					ToID:   adUser,
-					Kind:   adSchema.SyncedToADUser,
+			// The 0 value should never be a valid id for an AD node, just by the nature of the graph, so we're cheating
+			// by checking if we set it to 0 as a flag that this node was never actually found
+			if potentialADNode == 0 {
+				if !syncedKind.createMissing {
+					continue
+				} else if adUserNode, err := createMissingADUser(ctx, db, entraObjIDMap[entraNode]); err != nil {
+					return err
+				} else {
+					adNode = adUserNode.ID
 				}
+			}
 
-				if !channels.Submit(ctx, outC, SyncedToADUserRelationship) {
-					return nil
-				}
+			syncedToEntraRelationship := analysis.CreatePostRelationshipJob{
+				FromID: adNode,
+				ToID:   entraNode,
+				Kind:   syncedKind.toEntra,
 			}
 
-			return nil
-		}); err != nil {
-			return err
-		}
+			if !channels.Submit(ctx, outC, syncedToEntraRelationship) {
+				return nil
+			}
 
-		return tx.Commit()
-	})
+			syncedToADRelationship := analysis.CreatePostRelationshipJob{
+				FromID: entraNode,
+				ToID:   adNode,
+				Kind:   syncedKind.toAD,
+			}
 
-	// Because we need to close the operation either way at this stage, we attempt to close it and then report either or
-	// both errors in one line
-	if opErr := operation.Done(); opErr != nil || err != nil {
-		return &operation.Stats, fmt.Errorf("marking operation as done: %w; transaction error (if any): %v", opErr, err)
-	}
+			if !channels.Submit(ctx, outC, syncedToADRelationship) {
+				return nil
+			}
+		}
 
-	return &operation.Stats, nil
+		return nil
+	})
 }
 
 // hasOnPremUser takes a node and returns the OnPremID as a string, whether the node has an onPrem user defined as a bool
@@ -212,22 +263,22 @@ func createMissingADUser(ctx context.Context, db graph.Database, objectID string
 	return newNode, err
 }
 
-// fetchEntraUsers fetches all the Entra users for a given root node (generally the tenant node)
-func fetchEntraUsers(tx graph.Transaction, root *graph.Node) (graph.NodeSet, error) {
+// fetchEntraNodes fetches all the Entra nodes of the given kind for a given root node (generally the tenant node)
+func fetchEntraNodes(tx graph.Transaction, root *graph.Node, kind graph.Kind) (graph.NodeSet, error) {
 	return ops.FetchEndNodes(tx.Relationships().Filterf(func() graph.Criteria {
 		return query.And(
 			query.InIDs(query.StartID(), root.ID),
 			query.Kind(query.Relationship(), azureSchema.Contains),
-			query.KindIn(query.End(), azureSchema.User),
+			query.KindIn(query.End(), kind),
 		)
 	}))
 }
 
-// fetchADUsers gets all AD Users in the graph
-func fetchADUsers(tx graph.Transaction) ([]*graph.Node, error) {
+// fetchADNodes gets all AD nodes of the given kind in the graph
+func fetchADNodes(tx graph.Transaction, kind graph.Kind) ([]*graph.Node, error) {
 	return ops.FetchNodes(tx.Nodes().Filterf(func() graph.Criteria {
 		return query.And(
-			query.Kind(query.Node(), adSchema.User),
+			query.Kind(query.Node(), kind),
 		)
 	}))
 }
@@ -162,6 +162,12 @@ type DeleteRelationshipJob struct {
 }
 
 func DeleteTransitEdges(ctx context.Context, db graph.Database, baseKinds graph.Kinds, targetRelationships graph.Kinds) (*AtomicPostProcessingStats, error) {
+	return DeleteTransitEdgesMatching(ctx, db, baseKinds, targetRelationships, nil)
+}
+
+// DeleteTransitEdgesMatching deletes the target post-processed relationships that also satisfy the given criteria.
+// Nil criteria matches every relationship of the target kinds.
+func DeleteTransitEdgesMatching(ctx context.Context, db graph.Database, baseKinds graph.Kinds, targetRelationships graph.Kinds, criteria graph.Criteria) (*AtomicPostProcessingStats, error) {
 	var (
 		relationshipIDs []graph.ID
 		stats           = NewAtomicPostProcessingStats()
@@ -182,11 +188,17 @@ func DeleteTransitEdges(ctx context.Context, db graph.Database, baseKinds graph.
 
 		if err := db.ReadTransaction(ctx, func(tx graph.Transaction) error {
 			fetchedRelationshipIDs, err := ops.FetchRelationshipIDs(tx.Relationships().Filterf(func() graph.Criteria {
-				return query.And(
+				filters := []graph.Criteria{
 					query.KindIn(query.Start(), baseKinds...),
 					query.Kind(query.Relationship(), closureKindCopy),
 					query.KindIn(query.End(), baseKinds...),
-				)
+				}
+
+				if criteria != nil {
+					filters = append(filters, criteria)
+				}
+
+				return query.And(filters...)
 			}))
 
 			stats.AddRelationshipsDeleted(closureKindCopy, int32(len(fetchedRelationshipIDs)))
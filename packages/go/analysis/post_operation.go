@@ -38,6 +38,12 @@ type StatTrackedOperation[T any] struct {
 }
 
 func NewPostRelationshipOperation(ctx context.Context, db graph.Database, operationName string) StatTrackedOperation[CreatePostRelationshipJob] {
+	return NewFilteredPostRelationshipOperation(ctx, db, operationName, nil)
+}
+
+// NewFilteredPostRelationshipOperation behaves like NewPostRelationshipOperation but only writes jobs accepted by the
+// given filter. A nil filter accepts every job.
+func NewFilteredPostRelationshipOperation(ctx context.Context, db graph.Database, operationName string, filter func(job CreatePostRelationshipJob) bool) StatTrackedOperation[CreatePostRelationshipJob] {
 	operation := StatTrackedOperation[CreatePostRelationshipJob]{}
 	operation.NewOperation(ctx, db)
 	operation.Operation.SubmitWriter(func(ctx context.Context, batch graph.Batch, inC <-chan CreatePostRelationshipJob) error {
@@ -55,6 +61,10 @@ func NewPostRelationshipOperation(ctx context.Context, db graph.Database, operat
 		)
 
 		for nextJob := range inC {
+			if filter != nil && !filter(nextJob) {
+				continue
+			}
+
 			if len(nextJob.RelProperties) > 0 {
 				tempRelProp := relProp.Clone()
 				for key, val := range nextJob.RelProperties {
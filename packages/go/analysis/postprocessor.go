@@ -0,0 +1,226 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/specterops/dawgs/graph"
+)
+
+// PostProcessor is a named post-processing step. T is the state shared by the post-processors of a platform, such as
+// the scope of the run or prefetched local group membership.
+type PostProcessor[T any] struct {
+	Name string
+	// DependsOn names the post-processors whose results this one consumes. A post-processor is disabled along with any
+	// of its dependencies.
+	DependsOn []string
+	// Relationships lists the post-processed relationship kinds created by the post-processor. They are removed from
+	// the graph while the post-processor is disabled.
+	Relationships graph.Kinds
+	// Required post-processors prepare data the rest of the pipeline relies on and can not be disabled
+	Required bool
+	Run      func(ctx context.Context, db graph.Database, state T) (*AtomicPostProcessingStats, error)
+}
+
+// PlannedPostProcessor describes whether a post-processor runs under a given configuration
+type PlannedPostProcessor struct {
+	Name      string   `json:"name"`
+	DependsOn []string `json:"depends_on"`
+	Required  bool     `json:"required"`
+	Enabled   bool     `json:"enabled"`
+	// DisabledBy names the disabled dependency responsible for disabling a post-processor that was not disabled itself
+	DisabledBy string `json:"disabled_by,omitempty"`
+}
+
+// PostProcessingPlan lists post-processors in the order they run
+type PostProcessingPlan []PlannedPostProcessor
+
+func (s PostProcessingPlan) IsEnabled(name string) bool {
+	for _, planned := range s {
+		if planned.Name == name {
+			return planned.Enabled
+		}
+	}
+
+	return false
+}
+
+// PostProcessorRecorder records every post-processor a registry runs or skips, e.g. to time them and store their
+// outcome. RunPostProcessing must run the given step and return its error.
+type PostProcessorRecorder interface {
+	RunPostProcessing(name string, step func() (*AtomicPostProcessingStats, error)) error
+	Skip(name string)
+}
+
+// PostProcessorRegistry holds the post-processors of a platform in the order they run
+type PostProcessorRegistry[T any] struct {
+	processors []PostProcessor[T]
+}
+
+// NewPostProcessorRegistry registers post-processors in the order given. Names must be unique and dependencies must be
+// registered ahead of the post-processors that depend on them. Required post-processors may only depend on other
+// required post-processors.
+func NewPostProcessorRegistry[T any](processors ...PostProcessor[T]) (PostProcessorRegistry[T], error) {
+	registered := make(map[string]PostProcessor[T], len(processors))
+
+	for _, processor := range processors {
+		if processor.Name == "" {
+			return PostProcessorRegistry[T]{}, errors.New("post-processor is missing a name")
+		} else if _, found := registered[processor.Name]; found {
+			return PostProcessorRegistry[T]{}, fmt.Errorf("post-processor %s is registered more than once", processor.Name)
+		} else if processor.Run == nil {
+			return PostProcessorRegistry[T]{}, fmt.Errorf("post-processor %s has nothing to run", processor.Name)
+		}
+
+		for _, dependency := range processor.DependsOn {
+			if dependencyProcessor, found := registered[dependency]; !found {
+				return PostProcessorRegistry[T]{}, fmt.Errorf("post-processor %s depends on %s which is not registered ahead of it", processor.Name, dependency)
+			} else if processor.Required && !dependencyProcessor.Required {
+				return PostProcessorRegistry[T]{}, fmt.Errorf("required post-processor %s depends on optional post-processor %s", processor.Name, dependency)
+			}
+		}
+
+		registered[processor.Name] = processor
+	}
+
+	return PostProcessorRegistry[T]{
+		processors: processors,
+	}, nil
+}
+
+// MustNewPostProcessorRegistry is NewPostProcessorRegistry for registries declared at package scope. It panics if the
+// post-processors are not valid.
+func MustNewPostProcessorRegistry[T any](processors ...PostProcessor[T]) PostProcessorRegistry[T] {
+	if registry, err := NewPostProcessorRegistry(processors...); err != nil {
+		panic(err)
+	} else {
+		return registry
+	}
+}
+
+// Lookup returns the registered post-processor with the given name
+func (s PostProcessorRegistry[T]) Lookup(name string) (PostProcessor[T], bool) {
+	for _, processor := range s.processors {
+		if processor.Name == name {
+			return processor, true
+		}
+	}
+
+	return PostProcessor[T]{}, false
+}
+
+// Plan resolves which post-processors run when the named post-processors are disabled. Names that are not registered
+// and required post-processors are ignored.
+func (s PostProcessorRegistry[T]) Plan(disabled []string) PostProcessingPlan {
+	var (
+		plan           = make(PostProcessingPlan, 0, len(s.processors))
+		disabledByName = map[string]string{}
+	)
+
+	for _, processor := range s.processors {
+		planned := PlannedPostProcessor{
+			Name:      processor.Name,
+			DependsOn: slices.Clone(processor.DependsOn),
+			Required:  processor.Required,
+			Enabled:   true,
+		}
+
+		if planned.DependsOn == nil {
+			planned.DependsOn = []string{}
+		}
+
+		if !processor.Required && slices.Contains(disabled, processor.Name) {
+			planned.Enabled = false
+			disabledByName[processor.Name] = processor.Name
+		} else {
+			for _, dependency := range processor.DependsOn {
+				if disabledBy, isDisabled := disabledByName[dependency]; isDisabled {
+					planned.Enabled = false
+					planned.DisabledBy = disabledBy
+					disabledByName[processor.Name] = disabledBy
+					break
+				}
+			}
+		}
+
+		plan = append(plan, planned)
+	}
+
+	return plan
+}
+
+// DisabledRelationships returns the relationship kinds created by the post-processors the plan disables
+func (s PostProcessorRegistry[T]) DisabledRelationships(plan PostProcessingPlan) graph.Kinds {
+	var kinds graph.Kinds
+
+	for _, processor := range s.processors {
+		if !plan.IsEnabled(processor.Name) {
+			kinds = append(kinds, processor.Relationships...)
+		}
+	}
+
+	return kinds
+}
+
+// Run executes the post-processors enabled by the plan in order. Execution stops at the first post-processor to
+// fail; the stats of every post-processor that ran, including the failed one, are returned. Every post-processor run
+// or skipped is reported to the recorder unless it is nil.
+func (s PostProcessorRegistry[T]) Run(ctx context.Context, db graph.Database, state T, plan PostProcessingPlan, recorder PostProcessorRecorder) (*AtomicPostProcessingStats, error) {
+	aggregateStats := NewAtomicPostProcessingStats()
+
+	for _, processor := range s.processors {
+		if !plan.IsEnabled(processor.Name) {
+			slog.InfoContext(ctx, fmt.Sprintf("Skipping disabled post-processor %s", processor.Name))
+
+			if recorder != nil {
+				recorder.Skip(processor.Name)
+			}
+
+			continue
+		}
+
+		var (
+			stats *AtomicPostProcessingStats
+			err   error
+			step  = func() (*AtomicPostProcessingStats, error) {
+				stats, err = processor.Run(ctx, db, state)
+				return stats, err
+			}
+		)
+
+		if recorder != nil {
+			err = recorder.RunPostProcessing(processor.Name, step)
+		} else {
+			step()
+		}
+
+		if stats != nil {
+			aggregateStats.Merge(stats)
+		}
+
+		if err != nil {
+			return &aggregateStats, fmt.Errorf("post-processor %s: %w", processor.Name, err)
+		}
+	}
+
+	return &aggregateStats, nil
+}
@@ -0,0 +1,209 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analysis_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/specterops/bloodhound/packages/go/analysis"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func recordingPostProcessor(name string, kind graph.Kind, dependsOn ...string) analysis.PostProcessor[*[]string] {
+	return analysis.PostProcessor[*[]string]{
+		Name:          name,
+		DependsOn:     dependsOn,
+		Relationships: graph.Kinds{kind},
+		Run: func(ctx context.Context, db graph.Database, ran *[]string) (*analysis.AtomicPostProcessingStats, error) {
+			*ran = append(*ran, name)
+
+			stats := analysis.NewAtomicPostProcessingStats()
+			stats.AddRelationshipsCreated(kind, 1)
+
+			return &stats, nil
+		},
+	}
+}
+
+func testRegistry(t *testing.T) analysis.PostProcessorRegistry[*[]string] {
+	t.Helper()
+
+	domainAssociations := recordingPostProcessor("domain_associations", ad.MemberOf)
+	domainAssociations.Required = true
+
+	registry, err := analysis.NewPostProcessorRegistry(
+		domainAssociations,
+		recordingPostProcessor("dcsync", ad.DCSync, "domain_associations"),
+		recordingPostProcessor("adcs", ad.ADCSESC1, "domain_associations"),
+		recordingPostProcessor("ntlm", ad.CoerceAndRelayNTLMToADCS, "adcs"),
+	)
+	require.NoError(t, err)
+
+	return registry
+}
+
+func TestNewPostProcessorRegistry(t *testing.T) {
+	t.Run("rejects duplicate names", func(t *testing.T) {
+		_, err := analysis.NewPostProcessorRegistry(
+			recordingPostProcessor("dcsync", ad.DCSync),
+			recordingPostProcessor("dcsync", ad.DCSync),
+		)
+		require.ErrorContains(t, err, "registered more than once")
+	})
+
+	t.Run("rejects dependencies registered later", func(t *testing.T) {
+		_, err := analysis.NewPostProcessorRegistry(
+			recordingPostProcessor("ntlm", ad.CoerceAndRelayNTLMToADCS, "adcs"),
+			recordingPostProcessor("adcs", ad.ADCSESC1),
+		)
+		require.ErrorContains(t, err, "not registered ahead of it")
+	})
+
+	t.Run("rejects required post-processors depending on optional ones", func(t *testing.T) {
+		required := recordingPostProcessor("ntlm", ad.CoerceAndRelayNTLMToADCS, "adcs")
+		required.Required = true
+
+		_, err := analysis.NewPostProcessorRegistry(
+			recordingPostProcessor("adcs", ad.ADCSESC1),
+			required,
+		)
+		require.ErrorContains(t, err, "depends on optional post-processor")
+	})
+
+	t.Run("rejects post-processors without a run function", func(t *testing.T) {
+		_, err := analysis.NewPostProcessorRegistry(analysis.PostProcessor[*[]string]{Name: "adcs"})
+		require.ErrorContains(t, err, "has nothing to run")
+	})
+}
+
+func TestPostProcessorRegistry_Plan(t *testing.T) {
+	registry := testRegistry(t)
+
+	t.Run("enables everything by default", func(t *testing.T) {
+		for _, planned := range registry.Plan(nil) {
+			assert.True(t, planned.Enabled, planned.Name)
+		}
+	})
+
+	t.Run("disables dependents of a disabled post-processor", func(t *testing.T) {
+		plan := registry.Plan([]string{"adcs"})
+
+		require.Len(t, plan, 4)
+		assert.True(t, plan.IsEnabled("domain_associations"))
+		assert.True(t, plan.IsEnabled("dcsync"))
+		assert.False(t, plan.IsEnabled("adcs"))
+		assert.Empty(t, plan[2].DisabledBy)
+		assert.False(t, plan.IsEnabled("ntlm"))
+		assert.Equal(t, "adcs", plan[3].DisabledBy)
+	})
+
+	t.Run("ignores required and unknown post-processors", func(t *testing.T) {
+		plan := registry.Plan([]string{"domain_associations", "unknown"})
+
+		for _, planned := range plan {
+			assert.True(t, planned.Enabled, planned.Name)
+		}
+	})
+}
+
+func TestPostProcessorRegistry_DisabledRelationships(t *testing.T) {
+	registry := testRegistry(t)
+
+	assert.Empty(t, registry.DisabledRelationships(registry.Plan(nil)))
+	assert.ElementsMatch(t, graph.Kinds{ad.ADCSESC1, ad.CoerceAndRelayNTLMToADCS}, registry.DisabledRelationships(registry.Plan([]string{"adcs"})))
+}
+
+func TestPostProcessorRegistry_Run(t *testing.T) {
+	t.Run("runs enabled post-processors in order", func(t *testing.T) {
+		var (
+			registry = testRegistry(t)
+			ran      []string
+		)
+
+		stats, err := registry.Run(context.Background(), nil, &ran, registry.Plan([]string{"dcsync"}), nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"domain_associations", "adcs", "ntlm"}, ran)
+		assert.Len(t, stats.RelationshipsCreated, 3)
+		assert.NotContains(t, stats.RelationshipsCreated, ad.DCSync)
+	})
+
+	t.Run("stops at the first failure", func(t *testing.T) {
+		var (
+			failure = errors.New("failure")
+			failing = recordingPostProcessor("adcs", ad.ADCSESC1)
+			ran     []string
+		)
+
+		failing.Run = func(ctx context.Context, db graph.Database, ran *[]string) (*analysis.AtomicPostProcessingStats, error) {
+			*ran = append(*ran, "adcs")
+
+			stats := analysis.NewAtomicPostProcessingStats()
+			stats.AddRelationshipsCreated(ad.ADCSESC1, 2)
+
+			return &stats, failure
+		}
+
+		registry, err := analysis.NewPostProcessorRegistry(
+			recordingPostProcessor("dcsync", ad.DCSync),
+			failing,
+			recordingPostProcessor("ntlm", ad.CoerceAndRelayNTLMToADCS, "adcs"),
+		)
+		require.NoError(t, err)
+
+		stats, err := registry.Run(context.Background(), nil, &ran, registry.Plan(nil), nil)
+		require.ErrorIs(t, err, failure)
+		assert.Equal(t, []string{"dcsync", "adcs"}, ran)
+		assert.Equal(t, int32(2), *stats.RelationshipsCreated[ad.ADCSESC1])
+	})
+	t.Run("reports every post-processor to the recorder", func(t *testing.T) {
+		var (
+			registry = testRegistry(t)
+			recorder = &testRecorder{}
+			ran      []string
+		)
+
+		stats, err := registry.Run(context.Background(), nil, &ran, registry.Plan([]string{"adcs"}), recorder)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"domain_associations", "dcsync"}, ran)
+		assert.Equal(t, []string{"domain_associations", "dcsync"}, recorder.ran)
+		assert.Equal(t, []string{"adcs", "ntlm"}, recorder.skipped)
+
+		// Stats still reach the caller when a recorder is set
+		assert.Len(t, stats.RelationshipsCreated, 2)
+	})
+}
+
+type testRecorder struct {
+	ran     []string
+	skipped []string
+}
+
+func (s *testRecorder) RunPostProcessing(name string, step func() (*analysis.AtomicPostProcessingStats, error)) error {
+	s.ran = append(s.ran, name)
+
+	_, err := step()
+	return err
+}
+
+func (s *testRecorder) Skip(name string) {
+	s.skipped = append(s.skipped, name)
+}
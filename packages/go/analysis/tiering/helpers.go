@@ -24,24 +24,26 @@ import (
 )
 
 type SearchTierNodesCtx struct {
-	PrimaryTierID             int // Tier ID for findings processing
-	IsTierZero                bool
-	PrimaryTierKind           graph.Kind
-	SearchTierNodes           graph.Criteria
-	SearchTierNodesRel        graph.Criteria
-	SearchPrimaryTierNodes    graph.Criteria
-	SearchPrimaryTierNodesRel graph.Criteria
+	PrimaryTierID                int // Tier ID for findings processing
+	IsTierZero                   bool
+	PrimaryTierKind              graph.Kind
+	SearchTierNodes              graph.Criteria
+	SearchTierNodesRel           graph.Criteria
+	SearchPrimaryTierNodes       graph.Criteria
+	SearchPrimaryTierNodesRel    graph.Criteria
+	SearchPrimaryTierNodesRelEnd graph.Criteria
 }
 
 func NewSearchTierNodesCtx(tieringEnabled bool, isTierZero bool, tierID int, primaryKind graph.Kind, tierKinds ...graph.Kind) SearchTierNodesCtx {
 	return SearchTierNodesCtx{
-		PrimaryTierID:             tierID,
-		IsTierZero:                isTierZero,
-		PrimaryTierKind:           primaryKind,
-		SearchTierNodesRel:        searchTierNodesRel(tieringEnabled, tierKinds...),
-		SearchTierNodes:           SearchTierNodes(tieringEnabled, tierKinds...),
-		SearchPrimaryTierNodes:    SearchTierNodes(tieringEnabled, primaryKind),
-		SearchPrimaryTierNodesRel: searchTierNodesRel(tieringEnabled, primaryKind),
+		PrimaryTierID:                tierID,
+		IsTierZero:                   isTierZero,
+		PrimaryTierKind:              primaryKind,
+		SearchTierNodesRel:           searchTierNodesRel(tieringEnabled, tierKinds...),
+		SearchTierNodes:              SearchTierNodes(tieringEnabled, tierKinds...),
+		SearchPrimaryTierNodes:       SearchTierNodes(tieringEnabled, primaryKind),
+		SearchPrimaryTierNodesRel:    searchTierNodesRel(tieringEnabled, primaryKind),
+		SearchPrimaryTierNodesRelEnd: SearchTierNodesRelEnd(tieringEnabled, primaryKind),
 	}
 }
 
@@ -68,3 +70,16 @@ func searchTierNodesRel(tieringEnabled bool, tierKinds ...graph.Kind) graph.Crit
 		return query.StringContains(query.StartProperty(common.SystemTags.String()), ad.AdminTierZero)
 	}
 }
+
+// SearchTierNodesRelEnd matches relationships that end at a node in one of the given tiers
+func SearchTierNodesRelEnd(tieringEnabled bool, tierKinds ...graph.Kind) graph.Criteria {
+	if tieringEnabled {
+		// Default to tier zero in the event no tierKinds are supplied
+		if len(tierKinds) == 0 {
+			tierKinds = append(tierKinds, KindTagTierZero)
+		}
+		return query.KindIn(query.End(), tierKinds...)
+	} else {
+		return query.StringContains(query.EndProperty(common.SystemTags.String()), ad.AdminTierZero)
+	}
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/santhosh-tekuri/jsonschema/v6"
 	"github.com/specterops/bloodhound/cmd/api/src/model/ingest"
+	"github.com/specterops/bloodhound/cmd/api/src/services/graphify/propertyschema"
 )
 
 // Error Definitions ------------------------------------------------------------------------------
@@ -43,7 +44,8 @@ type Validator struct {
 	decoder *json.Decoder
 	depth   int
 
-	schema IngestSchema
+	schema          IngestSchema
+	propertySchemas *propertyschema.Registry
 
 	originalData  originalData
 	opengraphData opengraphData
@@ -86,6 +88,12 @@ func NewValidator(reader io.Reader, schema IngestSchema) Validator {
 	}
 }
 
+// SetPropertySchemas enables checking OpenGraph node and edge properties against the property schemas of the
+// given extensions, using the same rules applied during ingest
+func (v *Validator) SetPropertySchemas(propertySchemas *propertyschema.Registry) {
+	v.propertySchemas = propertySchemas
+}
+
 // Return Definitions -----------------------------------------------------------------------------
 
 type ValidationReport struct {
@@ -504,6 +512,12 @@ func (v *Validator) handleOpenGraphArray(arrayName string, schema *jsonschema.Sc
 					Errors:    errorDetails,
 				})
 			}
+		} else if errorDetails := v.validateProperties(arrayName, item.Object); len(errorDetails) > 0 {
+			v.reportValidationError(ValidationError{
+				Location:  fmt.Sprintf("/graph/%s[%d]", arrayName, index),
+				RawObject: item.RawObject,
+				Errors:    errorDetails,
+			})
 		}
 
 		index++
@@ -516,6 +530,50 @@ func (v *Validator) handleOpenGraphArray(arrayName string, schema *jsonschema.Sc
 	return index, nil
 }
 
+// validateProperties() checks the properties of a node or edge that passed schema validation against the property
+// schema of the extension owning its kinds. Values that would be coerced during ingest are not reported.
+func (v *Validator) validateProperties(arrayName string, object any) []ValidationErrorDetail {
+	var (
+		errorDetails = make([]ValidationErrorDetail, 0)
+		entity       = propertyschema.EntityNode
+		kinds        []string
+	)
+
+	item, ok := object.(map[string]any)
+	if !ok || v.propertySchemas == nil {
+		return errorDetails
+	}
+
+	properties, _ := item["properties"].(map[string]any)
+
+	if arrayName == "edges" {
+		entity = propertyschema.EntityRelationship
+
+		if kind, ok := item["kind"].(string); ok {
+			kinds = append(kinds, kind)
+		}
+	} else if rawKinds, ok := item["kinds"].([]any); ok {
+		for _, rawKind := range rawKinds {
+			if kind, ok := rawKind.(string); ok {
+				kinds = append(kinds, kind)
+			}
+		}
+	}
+
+	_, result := v.propertySchemas.Validate(entity, kinds, properties)
+
+	for _, violation := range result.Violations {
+		if violation.Action != propertyschema.ActionCoerced {
+			errorDetails = append(errorDetails, ValidationErrorDetail{
+				Location: "/properties/" + violation.Property,
+				Error:    fmt.Sprintf("%s (%s by extension %s)", violation.Reason, violation.Action, result.Extension),
+			})
+		}
+	}
+
+	return errorDetails
+}
+
 // extractJsonSchemaErrors() is a helper function that takes the errors returned by santhosh-tekuri/jsonschema and
 // make turn them into a format agreeable with ValidationReport
 func extractJsonSchemaErrors(ve *jsonschema.ValidationError) ([]ValidationErrorDetail, error) {
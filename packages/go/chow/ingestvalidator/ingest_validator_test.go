@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/specterops/bloodhound/cmd/api/src/model/ingest"
+	"github.com/specterops/bloodhound/cmd/api/src/services/graphify/propertyschema"
 	validator "github.com/specterops/bloodhound/packages/go/chow/ingestvalidator"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -357,3 +358,41 @@ func Test_ParseAndValidate(t *testing.T) {
 		})
 	}
 }
+
+func Test_ParseAndValidate_PropertySchemas(t *testing.T) {
+	schema, err := validator.LoadIngestSchema()
+	require.NoError(t, err)
+
+	propertySchemas, err := propertyschema.LoadExtensionDefinition(strings.NewReader(`{
+		"schema": {"name": "TestExtension"},
+		"node_kinds": [{"name": "TE_User"}],
+		"relationship_kinds": [{"name": "TE_MemberOf"}],
+		"properties": [
+			{"name": "count", "data_type": "integer"},
+			{"name": "state", "data_type": "string", "enum_values": ["on", "off"]}
+		]
+	}`))
+	require.NoError(t, err)
+
+	payload := `{"graph":{"nodes":[{"id":"A","kinds":["TE_User"],"properties":{"count":"3","state":"on"}},{"id":"B","kinds":["TE_User"],"properties":{"count":1.5}}],` +
+		`"edges":[{"kind":"TE_MemberOf","start":{"value":"A"},"end":{"value":"B"},"properties":{"state":"unknown"}}]}}`
+
+	v := validator.NewValidator(strings.NewReader(payload), schema)
+	v.SetPropertySchemas(propertySchemas)
+
+	_, report, err := v.ParseAndValidate()
+	assert.ErrorIs(t, err, validator.ErrValidationErrors)
+
+	assert.ElementsMatch(t, report.ValidationErrors, []validator.ValidationError{
+		{
+			Location:  "/graph/nodes[1]",
+			RawObject: `{"id":"B","kinds":["TE_User"],"properties":{"count":1.5}}`,
+			Errors:    []validator.ValidationErrorDetail{{Location: "/properties/count", Error: "expected integer but got float64 (dropped by extension TestExtension)"}},
+		},
+		{
+			Location:  "/graph/edges[0]",
+			RawObject: `{"kind":"TE_MemberOf","start":{"value":"A"},"end":{"value":"B"},"properties":{"state":"unknown"}}`,
+			Errors:    []validator.ValidationErrorDetail{{Location: "/properties/state", Error: "value unknown is not one of on, off (dropped by extension TestExtension)"}},
+		},
+	})
+}
@@ -25,16 +25,19 @@ import (
 	"os"
 	"strings"
 
+	"github.com/specterops/bloodhound/cmd/api/src/services/graphify/propertyschema"
 	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
 	validator "github.com/specterops/bloodhound/packages/go/chow/ingestvalidator"
 )
 
 var (
-	output string
+	output    string
+	extension string
 )
 
 func main() {
 	flag.StringVar(&output, "output", "", "output file")
+	flag.StringVar(&extension, "extension", "", "OpenGraph extension definition file to check node and edge properties against")
 	flag.Parse()
 
 	files := flag.Args()
@@ -64,6 +67,18 @@ func main() {
 
 	v := validator.NewValidator(reader, jsonSchema)
 
+	if extension != "" {
+		if propertySchemas, err := loadExtensionDefinition(extension); err != nil {
+			slog.Error("Failed to load extension definition",
+				slog.String("file_name", extension),
+				attr.Error(err),
+			)
+			os.Exit(1)
+		} else {
+			v.SetPropertySchemas(propertySchemas)
+		}
+	}
+
 	_, report, err := v.ParseAndValidate()
 	validationFailed := err != nil
 	if validationFailed {
@@ -92,6 +107,16 @@ func main() {
 	}
 }
 
+func loadExtensionDefinition(fileName string) (*propertyschema.Registry, error) {
+	reader, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return propertyschema.LoadExtensionDefinition(reader)
+}
+
 func outputReport(w io.WriteCloser, report validator.ValidationReport) error {
 	for _, e := range report.CriticalErrors {
 		_, err := w.Write([]byte(formatCriticalError(e)))
@@ -1269,6 +1269,11 @@ func ParseCARegistryProperties(enterpriseCA EnterpriseCA) IngestibleNode {
 		propMap[ad.RoleSeparationEnabled.String()] = enterpriseCA.CARegistryData.RoleSeparationEnabled.Value
 	}
 
+	// EnforceEncryptICertRequest
+	if enterpriseCA.CARegistryData.EnforceEncryptICertRequest.Collected {
+		propMap[ad.EnforceEncryptICertRequest.String()] = enterpriseCA.CARegistryData.EnforceEncryptICertRequest.Value
+	}
+
 	return IngestibleNode{
 		ObjectID:    enterpriseCA.ObjectIdentifier,
 		PropertyMap: propMap,
@@ -288,6 +288,39 @@ func TestConvertComputerToNode(t *testing.T) {
 	assert.Equal(t, true, result.PropertyMap[ad.SMBSigning.String()])
 }
 
+func TestParseCARegistryProperties(t *testing.T) {
+	enterpriseCA := ein.EnterpriseCA{
+		IngestBase: ein.IngestBase{
+			ObjectIdentifier: "ECA",
+		},
+		CARegistryData: ein.CARegistryData{
+			RoleSeparationEnabled: ein.RoleSeparationEnabled{
+				APIResult: ein.APIResult{
+					Collected: true,
+				},
+				Value: true,
+			},
+			EnforceEncryptICertRequest: ein.EnforceEncryptICertRequest{
+				APIResult: ein.APIResult{
+					Collected: true,
+				},
+				Value: false,
+			},
+		},
+	}
+
+	result := ein.ParseCARegistryProperties(enterpriseCA)
+	assert.Equal(t, "ECA", result.ObjectID)
+	assert.Equal(t, true, result.PropertyMap[ad.RoleSeparationEnabled.String()])
+	assert.Equal(t, false, result.PropertyMap[ad.EnforceEncryptICertRequest.String()])
+	assert.NotContains(t, result.PropertyMap, ad.IsUserSpecifiesSanEnabled.String())
+
+	enterpriseCA.CARegistryData.EnforceEncryptICertRequest.Collected = false
+
+	result = ein.ParseCARegistryProperties(enterpriseCA)
+	assert.NotContains(t, result.PropertyMap, ad.EnforceEncryptICertRequest.String())
+}
+
 func TestParseGroupMiscData(t *testing.T) {
 	t.Parallel()
 	type args struct {
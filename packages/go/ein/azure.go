@@ -39,6 +39,10 @@ import (
 const (
 	ISO8601               string = "2006-01-02T15:04:05Z"
 	KeyVaultPermissionGet string = "Get"
+
+	// AdministrativeUnitScopePrefix is the prefix of the scope of a role assignment scoped to an administrative unit.
+	// The remainder of the scope is the object ID of the administrative unit.
+	AdministrativeUnitScopePrefix string = "ADMINISTRATIVEUNITS/"
 )
 
 var (
@@ -84,7 +88,7 @@ func ConvertAZAppRelationships(app models.App) []IngestibleRelationship {
 	}
 }
 
-func ConvertAZDeviceToNode(device models.Device, ingestTime time.Time) IngestibleNode {
+func ConvertAZDeviceToNode(device models.Device, onPremises AzureDeviceOnPremises, ingestTime time.Time) IngestibleNode {
 	return IngestibleNode{
 		PropertyMap: map[string]any{
 			common.Name.String():                  strings.ToUpper(fmt.Sprintf("%s@%s", device.DisplayName, device.TenantName)),
@@ -93,6 +97,8 @@ func ConvertAZDeviceToNode(device models.Device, ingestTime time.Time) Ingestibl
 			azure.DeviceID.String():               device.DeviceId,
 			azure.OperatingSystemVersion.String(): device.OperatingSystemVersion,
 			azure.TrustType.String():              device.TrustType,
+			azure.OnPremID.String():               onPremises.OnPremisesSecurityIdentifier,
+			azure.OnPremSyncEnabled.String():      onPremises.OnPremisesSyncEnabled,
 			azure.TenantID.String():               strings.ToUpper(device.TenantId),
 			common.LastCollected.String():         ingestTime,
 		},
@@ -484,6 +490,70 @@ func ConvertAzureGroupMembersToRels(data models.GroupMembers) []IngestibleRelati
 	return relationships
 }
 
+func ConvertAzureAdministrativeUnit(data AzureAdministrativeUnit, ingestTime time.Time) (IngestibleNode, IngestibleRelationship) {
+	return IngestibleNode{
+		ObjectID: strings.ToUpper(data.Id),
+		PropertyMap: map[string]any{
+			common.Name.String():          strings.ToUpper(fmt.Sprintf("%s@%s", data.DisplayName, data.TenantName)),
+			common.DisplayName.String():   data.DisplayName,
+			common.Description.String():   data.Description,
+			azure.TenantID.String():       strings.ToUpper(data.TenantId),
+			common.LastCollected.String(): ingestTime,
+		},
+		Labels: []graph.Kind{azure.AdministrativeUnit},
+	}, NewIngestibleRelationship(
+		IngestibleEndpoint{
+			Value: strings.ToUpper(data.TenantId),
+			Kind:  azure.Tenant,
+		},
+		IngestibleEndpoint{
+			Kind:  azure.AdministrativeUnit,
+			Value: strings.ToUpper(data.Id),
+		},
+		IngestibleRel{
+			RelProps: map[string]any{},
+			RelType:  azure.Contains,
+		},
+	)
+}
+
+func ConvertAzureAdministrativeUnitMembersToRels(data AzureAdministrativeUnitMembers) []IngestibleRelationship {
+	relationships := make([]IngestibleRelationship, 0)
+
+	for _, raw := range data.Members {
+		var (
+			member azure2.DirectoryObject
+		)
+		if err := json.Unmarshal(raw.Member, &member); err != nil {
+			slog.Error(fmt.Sprintf(SerialError, "azure administrative unit member", err))
+		} else if memberType, err := ExtractTypeFromDirectoryObject(member); errors.Is(err, ErrInvalidType) {
+			slog.Warn(fmt.Sprintf(ExtractError, err))
+		} else if err != nil {
+			slog.Error(fmt.Sprintf(ExtractError, err))
+		} else if memberType == azure.ServicePrincipal {
+			// Service principals cannot be members of an administrative unit
+			continue
+		} else {
+			relationships = append(relationships, NewIngestibleRelationship(
+				IngestibleEndpoint{
+					Value: strings.ToUpper(data.AdministrativeUnitId),
+					Kind:  azure.AdministrativeUnit,
+				},
+				IngestibleEndpoint{
+					Kind:  memberType,
+					Value: strings.ToUpper(member.Id),
+				},
+				IngestibleRel{
+					RelProps: map[string]any{},
+					RelType:  azure.Contains,
+				},
+			))
+		}
+	}
+
+	return relationships
+}
+
 func ConvertAzureGroupOwnerToRels(data models.GroupOwners) []IngestibleRelationship {
 	relationships := make([]IngestibleRelationship, 0)
 
@@ -863,6 +933,7 @@ func ConvertAzureRole(data models.Role, ingestTime time.Time) (IngestibleNode, I
 func ConvertAzureRoleAssignmentToRels(roleAssignment azure2.UnifiedRoleAssignment, data models.RoleAssignments, roleObjectId string) []IngestibleRelationship {
 	var (
 		scope         string
+		relType       = azure.HasRole
 		relationships = make([]IngestibleRelationship, 0)
 	)
 
@@ -872,6 +943,12 @@ func ConvertAzureRoleAssignmentToRels(roleAssignment azure2.UnifiedRoleAssignmen
 		scope = strings.ToUpper(roleAssignment.DirectoryScopeId[1:])
 	}
 
+	// The role node is shared with tenant wide assignments, so an assignment scoped to an administrative unit must not
+	// be traversable to the role. Post-processing creates the edges to the members of the administrative unit instead.
+	if strings.HasPrefix(scope, AdministrativeUnitScopePrefix) {
+		relType = azure.HasScopedRole
+	}
+
 	if CanAddSecret(roleAssignment.RoleDefinitionId) && roleAssignment.DirectoryScopeId != "/" {
 		if relType, err := GetAddSecretRoleKind(roleAssignment.RoleDefinitionId); err != nil {
 			slog.Error(fmt.Sprintf("Error processing role assignment for role %s: %v", roleObjectId, err))
@@ -905,7 +982,7 @@ func ConvertAzureRoleAssignmentToRels(roleAssignment azure2.UnifiedRoleAssignmen
 				RelProps: map[string]any{
 					azure.Scope.String(): scope,
 				},
-				RelType: azure.HasRole,
+				RelType: relType,
 			},
 		))
 	}
@@ -1838,6 +1915,158 @@ func ConvertAzureAutomationAccount(account models.AutomationAccount, ingestTime
 	return node, relationships
 }
 
+func ConvertAzureStorageAccount(account AzureStorageAccount, ingestTime time.Time) (IngestibleNode, []IngestibleRelationship) {
+	allowSharedKeyAccess := true
+	if account.AllowSharedKeyAccess != nil {
+		allowSharedKeyAccess = *account.AllowSharedKeyAccess
+	}
+
+	node := IngestibleNode{
+		ObjectID: strings.ToUpper(account.Id),
+		PropertyMap: map[string]any{
+			common.Name.String():                strings.ToUpper(account.Name),
+			azure.TenantID.String():             strings.ToUpper(account.TenantId),
+			azure.AllowSharedKeyAccess.String(): allowSharedKeyAccess,
+			common.LastCollected.String():       ingestTime,
+		},
+		Labels: []graph.Kind{azure.StorageAccount},
+	}
+
+	relationships := []IngestibleRelationship{
+		NewIngestibleRelationship(
+			IngestibleEndpoint{
+				Value: strings.ToUpper(account.ResourceGroupId),
+				Kind:  azure.ResourceGroup,
+			},
+			IngestibleEndpoint{
+				Kind:  azure.StorageAccount,
+				Value: strings.ToUpper(account.Id),
+			},
+			IngestibleRel{
+				RelProps: map[string]any{},
+				RelType:  azure.Contains,
+			},
+		),
+	}
+
+	return node, append(relationships, convertAzureManagedIdentityRels(account.Id, azure.StorageAccount, account.Identity)...)
+}
+
+func ConvertAzureStorageAccountRoleAssignment(roleAssignments models.AzureRoleAssignments) []IngestibleRelationship {
+	return convertAzureResourceRoleAssignments(roleAssignments, azure.StorageAccount)
+}
+
+func ConvertAzureSQLServer(server AzureSQLServer, ingestTime time.Time) (IngestibleNode, []IngestibleRelationship) {
+	node := IngestibleNode{
+		ObjectID: strings.ToUpper(server.Id),
+		PropertyMap: map[string]any{
+			common.Name.String():          strings.ToUpper(server.Name),
+			azure.TenantID.String():       strings.ToUpper(server.TenantId),
+			common.LastCollected.String(): ingestTime,
+		},
+		Labels: []graph.Kind{azure.SQLServer},
+	}
+
+	relationships := []IngestibleRelationship{
+		NewIngestibleRelationship(
+			IngestibleEndpoint{
+				Value: strings.ToUpper(server.ResourceGroupId),
+				Kind:  azure.ResourceGroup,
+			},
+			IngestibleEndpoint{
+				Kind:  azure.SQLServer,
+				Value: strings.ToUpper(server.Id),
+			},
+			IngestibleRel{
+				RelProps: map[string]any{},
+				RelType:  azure.Contains,
+			},
+		),
+	}
+
+	return node, append(relationships, convertAzureManagedIdentityRels(server.Id, azure.SQLServer, server.Identity)...)
+}
+
+func ConvertAzureSQLServerRoleAssignment(roleAssignments models.AzureRoleAssignments) []IngestibleRelationship {
+	return convertAzureResourceRoleAssignments(roleAssignments, azure.SQLServer)
+}
+
+// convertAzureResourceRoleAssignments creates the Owner, User Access Administrator and Contributor relationships for
+// role assignments made directly on a resource of the given kind
+func convertAzureResourceRoleAssignments(roleAssignments models.AzureRoleAssignments, resourceKind graph.Kind) []IngestibleRelationship {
+	relationships := make([]IngestibleRelationship, 0)
+	for _, raw := range roleAssignments.RoleAssignments {
+		if strings.EqualFold(raw.Assignee.Properties.Scope, raw.ObjectId) {
+			if slices.Contains([]string{
+				constants.OwnerRoleID,
+				constants.UserAccessAdminRoleID,
+				constants.ContributorRoleID,
+			}, strings.ToLower(raw.RoleDefinitionId)) {
+				relationships = append(relationships, NewIngestibleRelationship(
+					IngestibleEndpoint{
+						Value: strings.ToUpper(raw.Assignee.GetPrincipalId()),
+						Kind:  azure.Entity,
+					},
+					IngestibleEndpoint{
+						Kind:  resourceKind,
+						Value: strings.ToUpper(roleAssignments.ObjectId),
+					},
+					IngestibleRel{
+						RelProps: map[string]any{},
+						RelType:  KindFromRoleId(raw.RoleDefinitionId),
+					},
+				))
+			}
+		}
+	}
+
+	return relationships
+}
+
+// convertAzureManagedIdentityRels creates the ManagedIdentity relationships from a resource to the service principals
+// of its system and user assigned identities
+func convertAzureManagedIdentityRels(resourceID string, resourceKind graph.Kind, identity AzureManagedIdentity) []IngestibleRelationship {
+	relationships := make([]IngestibleRelationship, 0)
+
+	if identity.PrincipalId != "" {
+		relationships = append(relationships, NewIngestibleRelationship(
+			IngestibleEndpoint{
+				Value: strings.ToUpper(resourceID),
+				Kind:  resourceKind,
+			},
+			IngestibleEndpoint{
+				Kind:  azure.ServicePrincipal,
+				Value: strings.ToUpper(identity.PrincipalId),
+			},
+			IngestibleRel{
+				RelProps: map[string]any{},
+				RelType:  azure.ManagedIdentity,
+			},
+		))
+	}
+
+	for _, userAssignedIdentity := range identity.UserAssignedIdentities {
+		if userAssignedIdentity.PrincipalId != "" {
+			relationships = append(relationships, NewIngestibleRelationship(
+				IngestibleEndpoint{
+					Value: strings.ToUpper(resourceID),
+					Kind:  resourceKind,
+				},
+				IngestibleEndpoint{
+					Kind:  azure.ServicePrincipal,
+					Value: strings.ToUpper(userAssignedIdentity.PrincipalId),
+				},
+				IngestibleRel{
+					RelProps: map[string]any{},
+					RelType:  azure.ManagedIdentity,
+				},
+			))
+		}
+	}
+
+	return relationships
+}
+
 func ConvertAzureRoleEligibilityScheduleInstanceToRel(instance models.RoleEligibilityScheduleInstance) []IngestibleRelationship {
 	id := strings.ToUpper(fmt.Sprintf("%s@%s", instance.RoleDefinitionId, instance.TenantId))
 
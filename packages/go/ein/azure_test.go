@@ -17,13 +17,19 @@
 package ein_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/bloodhoundad/azurehound/v2/enums"
 	"github.com/bloodhoundad/azurehound/v2/models"
+	azure2 "github.com/bloodhoundad/azurehound/v2/models/azure"
 	"github.com/specterops/bloodhound/packages/go/ein"
 	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -58,6 +64,171 @@ func TestConvertAzureRoleEligibilityScheduleInstanceToRel(t *testing.T) {
 	require.Len(t, expectedRels, 0)
 }
 
+func TestConvertAZDeviceToNode(t *testing.T) {
+	var (
+		raw = []byte(`{
+			"id": "8d0f6a4c-2b1e-4f3a-9c7d-5e6f7a8b9c0d",
+			"displayName": "WS01",
+			"deviceId": "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+			"onPremisesSyncEnabled": true,
+			"onPremisesSecurityIdentifier": "S-1-5-21-2697957641-2271029196-387917394-1104",
+			"tenantId": "6c12b0b0-b2cc-4a73-8252-0b94bfca2145",
+			"tenantName": "contoso.com"
+		}`)
+		device     models.Device
+		onPremises ein.AzureDeviceOnPremises
+	)
+
+	require.Nil(t, json.Unmarshal(raw, &device))
+	require.Nil(t, json.Unmarshal(raw, &onPremises))
+
+	node := ein.ConvertAZDeviceToNode(device, onPremises, time.Now())
+	require.Equal(t, "8D0F6A4C-2B1E-4F3A-9C7D-5E6F7A8B9C0D", node.ObjectID)
+	require.Equal(t, []graph.Kind{azure.Device}, node.Labels)
+	require.Equal(t, "S-1-5-21-2697957641-2271029196-387917394-1104", node.PropertyMap[azure.OnPremID.String()])
+	require.Equal(t, true, node.PropertyMap[azure.OnPremSyncEnabled.String()])
+
+	// Cloud only devices are not synced
+	node = ein.ConvertAZDeviceToNode(device, ein.AzureDeviceOnPremises{}, time.Now())
+	require.Equal(t, "", node.PropertyMap[azure.OnPremID.String()])
+	require.Equal(t, false, node.PropertyMap[azure.OnPremSyncEnabled.String()])
+}
+
+func TestConvertAzureAdministrativeUnit(t *testing.T) {
+	testData := ein.AzureAdministrativeUnit{
+		Id:          "5b1d2f3e-6c4a-4f5e-9d8c-7b6a5f4e3d2c",
+		DisplayName: "Helpdesk Scope",
+		TenantId:    "6c12b0b0-b2cc-4a73-8252-0b94bfca2145",
+		TenantName:  "contoso.com",
+	}
+
+	node, rel := ein.ConvertAzureAdministrativeUnit(testData, time.Now())
+	require.Equal(t, strings.ToUpper(testData.Id), node.ObjectID)
+	require.Equal(t, []graph.Kind{azure.AdministrativeUnit}, node.Labels)
+	require.Equal(t, "HELPDESK SCOPE@CONTOSO.COM", node.PropertyMap[common.Name.String()])
+
+	require.Equal(t, azure.Contains, rel.RelType)
+	require.Equal(t, strings.ToUpper(testData.TenantId), rel.Source.Value)
+	require.Equal(t, strings.ToUpper(testData.Id), rel.Target.Value)
+}
+
+func TestConvertAzureAdministrativeUnitMembersToRels(t *testing.T) {
+	newMember := func(id string, objectType any) ein.AzureAdministrativeUnitMember {
+		raw, err := json.Marshal(map[string]any{"id": id, "@odata.type": objectType})
+		require.Nil(t, err)
+
+		return ein.AzureAdministrativeUnitMember{Member: raw}
+	}
+
+	testData := ein.AzureAdministrativeUnitMembers{
+		AdministrativeUnitId: "5b1d2f3e-6c4a-4f5e-9d8c-7b6a5f4e3d2c",
+		Members: []ein.AzureAdministrativeUnitMember{
+			newMember("user-1", enums.EntityUser),
+			newMember("group-1", enums.EntityGroup),
+			newMember("device-1", enums.EntityDevice),
+			newMember("sp-1", enums.EntityServicePrincipal),
+		},
+	}
+
+	rels := ein.ConvertAzureAdministrativeUnitMembersToRels(testData)
+	require.Len(t, rels, 3)
+
+	expectedKinds := []graph.Kind{azure.User, azure.Group, azure.Device}
+	for idx, rel := range rels {
+		assert.Equal(t, azure.Contains, rel.RelType)
+		assert.Equal(t, azure.AdministrativeUnit, rel.Source.Kind)
+		assert.Equal(t, strings.ToUpper(testData.AdministrativeUnitId), rel.Source.Value)
+		assert.Equal(t, expectedKinds[idx], rel.Target.Kind)
+	}
+}
+
+func TestConvertAzureRoleAssignmentToRels(t *testing.T) {
+	var (
+		roleObjectID = "966707D0-3269-4727-9BE2-8C3A10F19B9D@6C12B0B0-B2CC-4A73-8252-0B94BFCA2145"
+		data         = models.RoleAssignments{TenantId: "6c12b0b0-b2cc-4a73-8252-0b94bfca2145"}
+	)
+
+	t.Run("tenant scope", func(t *testing.T) {
+		rels := ein.ConvertAzureRoleAssignmentToRels(azure2.UnifiedRoleAssignment{
+			PrincipalId:      "d1e2f3a4-b5c6-4d7e-8f9a-0b1c2d3e4f5a",
+			RoleDefinitionId: azure.PasswordAdministratorRole,
+			DirectoryScopeId: "/",
+		}, data, roleObjectID)
+
+		require.Len(t, rels, 1)
+		assert.Equal(t, azure.HasRole, rels[0].RelType)
+		assert.Equal(t, strings.ToUpper(data.TenantId), rels[0].RelProps[azure.Scope.String()])
+	})
+
+	t.Run("administrative unit scope", func(t *testing.T) {
+		rels := ein.ConvertAzureRoleAssignmentToRels(azure2.UnifiedRoleAssignment{
+			PrincipalId:      "d1e2f3a4-b5c6-4d7e-8f9a-0b1c2d3e4f5a",
+			RoleDefinitionId: azure.PasswordAdministratorRole,
+			DirectoryScopeId: "/administrativeUnits/5b1d2f3e-6c4a-4f5e-9d8c-7b6a5f4e3d2c",
+		}, data, roleObjectID)
+
+		// The scoped assignment must not be traversable to the tenant wide role
+		require.Len(t, rels, 1)
+		assert.Equal(t, azure.HasScopedRole, rels[0].RelType)
+		assert.Equal(t, roleObjectID, rels[0].Target.Value)
+		assert.Equal(t, "ADMINISTRATIVEUNITS/5B1D2F3E-6C4A-4F5E-9D8C-7B6A5F4E3D2C", rels[0].RelProps[azure.Scope.String()])
+	})
+}
+
+func TestConvertAzureStorageAccount(t *testing.T) {
+	testData := ein.AzureStorageAccount{
+		Id:              "/subscriptions/a1b2/resourcegroups/rg1/providers/microsoft.storage/storageaccounts/sa1",
+		Name:            "sa1",
+		ResourceGroupId: "/subscriptions/a1b2/resourcegroups/rg1",
+		TenantId:        "6c12b0b0-b2cc-4a73-8252-0b94bfca2145",
+		Identity: ein.AzureManagedIdentity{
+			PrincipalId: "8f4c2a1e-3b5d-4e6f-9a7b-1c2d3e4f5a6b",
+			UserAssignedIdentities: map[string]ein.AzureUserAssignedIdentity{
+				"uai1": {ClientId: "0d9e8f7a-6b5c-4d3e-2f1a-0b9c8d7e6f5a", PrincipalId: "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e"},
+			},
+		},
+	}
+
+	node, rels := ein.ConvertAzureStorageAccount(testData, time.Now())
+	require.Equal(t, strings.ToUpper(testData.Id), node.ObjectID)
+	require.Equal(t, []graph.Kind{azure.StorageAccount}, node.Labels)
+	require.Equal(t, true, node.PropertyMap[azure.AllowSharedKeyAccess.String()])
+
+	require.Len(t, rels, 3)
+	require.Equal(t, azure.Contains, rels[0].RelType)
+	require.Equal(t, strings.ToUpper(testData.ResourceGroupId), rels[0].Source.Value)
+
+	for _, rel := range rels[1:] {
+		require.Equal(t, azure.ManagedIdentity, rel.RelType)
+		require.Equal(t, strings.ToUpper(testData.Id), rel.Source.Value)
+		require.Equal(t, azure.ServicePrincipal, rel.Target.Kind)
+	}
+
+	allowSharedKeyAccess := false
+	testData.AllowSharedKeyAccess = &allowSharedKeyAccess
+
+	node, _ = ein.ConvertAzureStorageAccount(testData, time.Now())
+	require.Equal(t, false, node.PropertyMap[azure.AllowSharedKeyAccess.String()])
+}
+
+func TestConvertAzureSQLServer(t *testing.T) {
+	testData := ein.AzureSQLServer{
+		Id:              "/subscriptions/a1b2/resourcegroups/rg1/providers/microsoft.sql/servers/sql1",
+		Name:            "sql1",
+		ResourceGroupId: "/subscriptions/a1b2/resourcegroups/rg1",
+		TenantId:        "6c12b0b0-b2cc-4a73-8252-0b94bfca2145",
+	}
+
+	node, rels := ein.ConvertAzureSQLServer(testData, time.Now())
+	require.Equal(t, strings.ToUpper(testData.Id), node.ObjectID)
+	require.Equal(t, []graph.Kind{azure.SQLServer}, node.Labels)
+
+	// No managed identity is assigned so only the resource group containment is created
+	require.Len(t, rels, 1)
+	require.Equal(t, azure.Contains, rels[0].RelType)
+	require.Equal(t, strings.ToUpper(testData.Id), rels[0].Target.Value)
+}
+
 func Test_ConvertAzureRoleManagementPolicyAssignment(t *testing.T) {
 	model := models.RoleManagementPolicyAssignment{
 		Id:                                "id-1234",
@@ -17,6 +17,8 @@
 package ein
 
 import (
+	"encoding/json"
+
 	"github.com/specterops/bloodhound/packages/go/analysis"
 	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
 	"github.com/specterops/dawgs/graph"
@@ -137,11 +139,17 @@ type RoleSeparationEnabled struct {
 	Value bool
 }
 
+type EnforceEncryptICertRequest struct {
+	APIResult
+	Value bool
+}
+
 type CARegistryData struct {
 	CASecurity                  CASecurity
 	EnrollmentAgentRestrictions EnrollmentAgentRestrictions
 	IsUserSpecifiesSanEnabled   IsUserSpecifiesSanEnabled
 	RoleSeparationEnabled       RoleSeparationEnabled
+	EnforceEncryptICertRequest  EnforceEncryptICertRequest
 }
 
 type DCRegistryData struct {
@@ -393,3 +401,73 @@ type EdgeEndpoint struct {
 	MatchBy          string            `json:"match_by"`
 	PropertyMatchers []PropertyMatcher `json:"property_matchers"`
 }
+
+// AzureAdministrativeUnit is an Entra administrative unit. Role assignments scoped to an administrative unit only apply
+// to its members.
+type AzureAdministrativeUnit struct {
+	Id          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Description string `json:"description"`
+	Visibility  string `json:"visibility"`
+	TenantId    string `json:"tenantId"`
+	TenantName  string `json:"tenantName"`
+}
+
+// AzureDeviceOnPremises holds the on-premises sync state reported for an Entra device. Hybrid joined devices carry the
+// security identifier of their AD computer, which the AzureHound device model does not decode, so it is read from the
+// same payload alongside the device.
+type AzureDeviceOnPremises struct {
+	OnPremisesSecurityIdentifier string `json:"onPremisesSecurityIdentifier"`
+	OnPremisesSyncEnabled        bool   `json:"onPremisesSyncEnabled"`
+}
+
+type AzureAdministrativeUnitMember struct {
+	Member               json.RawMessage `json:"member"`
+	AdministrativeUnitId string          `json:"administrativeUnitId"`
+}
+
+type AzureAdministrativeUnitMembers struct {
+	Members              []AzureAdministrativeUnitMember `json:"members"`
+	AdministrativeUnitId string                          `json:"administrativeUnitId"`
+}
+
+// AzureManagedIdentity holds the system and user assigned managed identities of an Azure resource
+type AzureManagedIdentity struct {
+	PrincipalId            string                               `json:"principalId"`
+	TenantId               string                               `json:"tenantId"`
+	Type                   string                               `json:"type"`
+	UserAssignedIdentities map[string]AzureUserAssignedIdentity `json:"userAssignedIdentities"`
+}
+
+type AzureUserAssignedIdentity struct {
+	ClientId    string `json:"clientId"`
+	PrincipalId string `json:"principalId"`
+}
+
+type AzureStorageAccount struct {
+	Id                string               `json:"id"`
+	Name              string               `json:"name"`
+	Kind              string               `json:"kind"`
+	Location          string               `json:"location"`
+	ResourceGroupId   string               `json:"resourceGroupId"`
+	ResourceGroupName string               `json:"resourceGroupName"`
+	SubscriptionId    string               `json:"subscriptionId"`
+	TenantId          string               `json:"tenantId"`
+	Identity          AzureManagedIdentity `json:"identity"`
+
+	// AllowSharedKeyAccess is left unset by Azure unless it has been explicitly configured, in which case shared key
+	// access is allowed
+	AllowSharedKeyAccess *bool `json:"allowSharedKeyAccess"`
+}
+
+type AzureSQLServer struct {
+	Id                       string               `json:"id"`
+	Name                     string               `json:"name"`
+	Location                 string               `json:"location"`
+	FullyQualifiedDomainName string               `json:"fullyQualifiedDomainName"`
+	ResourceGroupId          string               `json:"resourceGroupId"`
+	ResourceGroupName        string               `json:"resourceGroupName"`
+	SubscriptionId           string               `json:"subscriptionId"`
+	TenantId                 string               `json:"tenantId"`
+	Identity                 AzureManagedIdentity `json:"identity"`
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/specterops/bloodhound/cmd/api/src/database"
 	"github.com/specterops/bloodhound/cmd/api/src/migrations"
 	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/services/dogtags"
 	"github.com/specterops/bloodhound/cmd/api/src/services/graphify"
 	"github.com/specterops/bloodhound/cmd/api/src/services/graphify/endpoint"
 	"github.com/specterops/bloodhound/cmd/api/src/services/upload"
@@ -133,6 +134,7 @@ type GraphService interface {
 type CommunityGraphService struct {
 	db       database.Database
 	readOpts graphify.ReadOptions
+	dogTags  dogtags.Service
 }
 
 func NewCommunityGraphService() (*CommunityGraphService, error) {
@@ -143,7 +145,7 @@ func NewCommunityGraphService() (*CommunityGraphService, error) {
 
 	readOpts := graphify.ReadOptions{IngestSchema: schema, FileType: model.FileTypeJson}
 
-	return &CommunityGraphService{readOpts: readOpts}, nil
+	return &CommunityGraphService{readOpts: readOpts, dogTags: dogtags.NewDefaultService()}, nil
 }
 
 func (s *CommunityGraphService) TeardownService(ctx context.Context) {
@@ -192,7 +194,7 @@ func (s *CommunityGraphService) Ingest(ctx context.Context, batch *graphify.Inge
 }
 
 func (s *CommunityGraphService) RunAnalysis(ctx context.Context, graphDB graph.Database) error {
-	return datapipe.RunAnalysisOperations(ctx, s.db, graphDB, config.Configuration{})
+	return datapipe.RunAnalysisOperations(ctx, s.db, graphDB, config.Configuration{}, s.dogTags)
 }
 
 // Run generate command
@@ -29,6 +29,7 @@ var (
 	StartNode         = newParam("start_node", nil)
 	EndNode           = newParam("end_node", nil)
 	RelationshipKinds = newParam("relationship_kinds", containsPredicate)
+	CostProfile       = newParam("cost_profile", nil)
 )
 
 // param is an immutable path or query parameter
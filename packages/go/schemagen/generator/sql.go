@@ -161,6 +161,18 @@ var nodeIcons = map[string]nodeIcon{
 		Icon:  "fa-sitemap",
 		Color: "#BD93D8",
 	},
+	"AZAdministrativeUnit": {
+		Icon:  "fa-layer-group",
+		Color: "#7EB2DD",
+	},
+	"AZStorageAccount": {
+		Icon:  "fa-hard-drive",
+		Color: "#5A9BD5",
+	},
+	"AZSQLServer": {
+		Icon:  "fa-database",
+		Color: "#D96C8A",
+	},
 }
 
 func GenerateExtensionSQLActiveDirectory(dir string, adSchema model.ActiveDirectory) error {
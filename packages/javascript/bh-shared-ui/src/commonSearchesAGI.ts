@@ -530,13 +530,18 @@ RETURN p\nLIMIT 1000`,
             {
                 name: 'All coerce and NTLM relay edges',
                 description: '',
-                query: `MATCH p = (n:Base)-[:CoerceAndRelayNTLMToLDAP|CoerceAndRelayNTLMToLDAPS|CoerceAndRelayNTLMToADCS|CoerceAndRelayNTLMToSMB]->(:Base)\nRETURN p LIMIT 500`,
+                query: `MATCH p = (n:Base)-[:CoerceAndRelayNTLMToLDAP|CoerceAndRelayNTLMToLDAPS|CoerceAndRelayNTLMToADCS|CoerceAndRelayNTLMToADCSRPC|CoerceAndRelayNTLMToSMB]->(:Base)\nRETURN p LIMIT 500`,
             },
             {
                 name: 'ESC8-vulnerable Enterprise CAs',
                 description: '',
                 query: `MATCH (n:EnterpriseCA)\nWHERE n.hasvulnerableendpoint=true\nRETURN n`,
             },
+            {
+                name: 'ESC11-vulnerable Enterprise CAs',
+                description: '',
+                query: `MATCH (n:EnterpriseCA)\nWHERE n.enforceencrypticertrequest=false\nRETURN n`,
+            },
             {
                 name: 'Computers with the outgoing NTLM setting set to Deny all',
                 description: '',
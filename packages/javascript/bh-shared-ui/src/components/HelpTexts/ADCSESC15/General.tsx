@@ -0,0 +1,46 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Typography } from '@mui/material';
+import { FC } from 'react';
+import { EdgeInfoProps } from '../index';
+import { groupSpecialFormat } from '../utils';
+
+const General: FC<EdgeInfoProps> = ({ sourceName, sourceType }) => {
+    return (
+        <>
+            <Typography variant='body2'>
+                {groupSpecialFormat(sourceType, sourceName)} the privileges to perform the ADCS ESC15 attack against the
+                target domain.
+            </Typography>
+            <Typography variant='body2'>
+                The principal has permission to enroll on one or more schema version 1 certificate templates where the
+                enrollee supplies the subject. They also have enrollment permission for an enterprise CA with the
+                necessary templates published. This enterprise CA is trusted for NT authentication in the forest, and
+                chains up to a root CA for the forest.
+            </Typography>
+            <Typography variant='body2'>
+                The enterprise CA has not been patched against CVE-2024-49019. Such a CA copies any application
+                policies included in the certificate signing request into the issued certificate, even if the template
+                does not allow for authentication. An attacker principal can request a certificate with the Client
+                Authentication application policy and a Subject Alternate Name (SAN) identifying another principal,
+                and use it to authenticate as any AD forest user or computer through Schannel.
+            </Typography>
+        </>
+    );
+};
+
+export default General;
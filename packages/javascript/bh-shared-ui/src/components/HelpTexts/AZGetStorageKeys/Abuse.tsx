@@ -0,0 +1,40 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Typography } from '@mui/material';
+import { FC } from 'react';
+
+const Abuse: FC = () => {
+    return (
+        <>
+            <Typography variant='body2'>
+                Use the Azure CLI or the Az PowerShell module to list the keys of the storage account:
+            </Typography>
+            <Typography component={'pre'}>
+                {'az storage account keys list --account-name <storage account> --resource-group <resource group>'}
+            </Typography>
+            <Typography component={'pre'}>
+                {'Get-AzStorageAccountKey -ResourceGroupName <resource group> -Name <storage account>'}
+            </Typography>
+            <Typography variant='body2'>
+                Either key can then be used to read and write blobs, files, queues and tables in the storage account,
+                for example with Azure Storage Explorer or the --account-key parameter of the Azure CLI.
+            </Typography>
+        </>
+    );
+};
+
+export default Abuse;
@@ -0,0 +1,30 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Typography } from '@mui/material';
+import { FC } from 'react';
+
+const Opsec: FC = () => {
+    return (
+        <Typography variant='body2'>
+            Listing storage account keys is recorded in the Azure activity log as a
+            Microsoft.Storage/storageAccounts/listKeys/action operation. Requests authorized with the account keys
+            are not tied to an Entra ID identity in the storage account logs.
+        </Typography>
+    );
+};
+
+export default Opsec;
@@ -0,0 +1,45 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Typography } from '@mui/material';
+import { FC } from 'react';
+import { EdgeInfoProps } from '../index';
+
+const General: FC<EdgeInfoProps> = () => {
+    return (
+        <>
+            <Typography variant='body2'>
+                This edge indicates that an attacker with "Authenticated Users" access can trigger SMB-based coercion
+                from the target computer to their attacker-controlled host via NTLM. The authentication attempt from the
+                target computer can then be relayed to the ICertPassage (ICPR) RPC interface of an Active Directory
+                Certificate Services (ADCS) enterprise CA that does not require encrypted certificate requests (ESC11).
+                This allows the attacker to obtain a certificate enabling domain authentication as the target computer.
+            </Typography>
+
+            <Typography variant='body2'>
+                An enterprise CA is vulnerable when the IF_ENFORCEENCRYPTICERTREQUEST flag is not set in its
+                InterfaceFlags registry value, which BloodHound stores in the enforceencrypticertrequest property.
+            </Typography>
+
+            <Typography variant='body2'>
+                Click on Relay Targets to view vulnerable enterprise CA servers that enable certificate enrollment for
+                the target computer.
+            </Typography>
+        </>
+    );
+};
+
+export default General;
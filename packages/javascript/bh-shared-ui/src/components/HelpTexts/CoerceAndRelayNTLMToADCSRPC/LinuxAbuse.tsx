@@ -0,0 +1,71 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Typography } from '@mui/material';
+import { FC } from 'react';
+import CodeController from '../CodeController/CodeController';
+
+const LinuxAbuse: FC = () => {
+    return (
+        <>
+            <Typography variant={'body2'}>
+                1. Start the Relay Server The NTLM relay to the ICPR RPC interface can be executed with{' '}
+                <a
+                    target='_blank'
+                    rel='noopener noreferrer'
+                    href={'https://github.com/fortra/impacket/blob/master/examples/ntlmrelayx.py'}>
+                    ntlmrelayx.py
+                </a>
+                . Specify the enterprise CA host as an RPC target and provide the CA name and template:
+                <CodeController>
+                    {'ntlmrelayx.py -t rpc://<CA_HOST> -rpc-mode ICPR -icpr-ca-name <CA_NAME> --template <TEMPLATE_NAME> -smb2support'}
+                </CodeController>
+            </Typography>
+
+            <Typography variant={'body2'}>
+                2. Coerce the Target Computer Several coercion methods are documented here:{' '}
+                <a
+                    target='_blank'
+                    rel='noopener noreferrer'
+                    href={'https://github.com/p0dalirius/windows-coerced-authentication-methods'}>
+                    Windows Coerced Authentication Methods
+                </a>
+                . Examples of tools include:
+                <a
+                    target='_blank'
+                    rel='noopener noreferrer'
+                    href={'https://github.com/dirkjanm/krbrelayx/blob/master/printerbug.py'}>
+                    printerbug.py
+                </a>
+                <a target='_blank' rel='noopener noreferrer' href={'https://github.com/topotam/PetitPotam'}>
+                    PetitPotam
+                </a>
+            </Typography>
+
+            <Typography variant={'body2'}>
+                3. Authenticate with the Certificate Use the obtained certificate to request a TGT as the target
+                computer, for example with{' '}
+                <a target='_blank' rel='noopener noreferrer' href={'https://github.com/ly4k/Certipy'}>
+                    Certipy
+                </a>
+                :
+                <CodeController>{'certipy auth -pfx <COMPUTER>.pfx'}</CodeController>
+            </Typography>
+        </>
+    );
+};
+
+export default LinuxAbuse;
@@ -0,0 +1,49 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Typography } from '@mui/material';
+import { FC } from 'react';
+
+const Opsec: FC = () => {
+    return (
+        <>
+            <Typography variant='body2'>
+                <b>Detection of NTLM Relay</b>
+                <br />
+                NTLM relayed authentications can be detected by login events on the CA host where the IP address does
+                not match the computer’s actual IP address. This detection technique is described in the blog post:{' '}
+                <a
+                    target='_blank'
+                    rel='noopener noreferrer'
+                    href={'https://posts.bluraven.io/detecting-ntlm-relay-attacks-d92e99e68fb9'}>
+                    Detecting NTLM Relay Attacks
+                </a>
+                .
+            </Typography>
+
+            <Typography variant={'body2'}>
+                <b>Detection of Certificate Requests</b>
+                <br />
+                When certificate services auditing is enabled, the CA logs Windows Event ID 4886 ("Certificate Services
+                received a certificate request") and 4887 ("Certificate Services approved a certificate request and
+                issued a certificate") for the requesting computer. A certificate issued to a computer account from an
+                unexpected source address is an indicator of relay.
+            </Typography>
+        </>
+    );
+};
+
+export default Opsec;
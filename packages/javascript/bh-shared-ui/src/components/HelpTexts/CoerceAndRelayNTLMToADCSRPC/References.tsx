@@ -0,0 +1,76 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Box, Link } from '@mui/material';
+import { FC } from 'react';
+
+const References: FC = () => {
+    return (
+        <Box className='overflow-x-auto'>
+            <Link
+                target='_blank'
+                rel='noopener noreferrer'
+                href='https://blog.compass-security.com/2022/11/relaying-to-ad-certificate-services-over-rpc/'>
+                Compass Security: Relaying to AD Certificate Services over RPC
+            </Link>
+            <br />
+            <Link
+                target='_blank'
+                rel='noopener noreferrer'
+                href='https://learn.microsoft.com/en-us/openspecs/windows_protocols/ms-icpr/9b8ed605-6b00-41d1-9a2a-9897e40678fc'>
+                Microsoft: [MS-ICPR] ICertPassage Remote Protocol
+            </Link>
+            <br />
+            <Link
+                target='_blank'
+                rel='noopener noreferrer'
+                href='https://specterops.io/wp-content/uploads/sites/3/2022/06/Certified_Pre-Owned.pdf'>
+                Certified Pre-Owned
+            </Link>
+            <br />
+            <Link target='_blank' rel='noopener noreferrer' href='https://en.hackndo.com/ntlm-relay/'>
+                Hackndo: NTLM relay
+            </Link>
+            <br />
+            <Link
+                target='_blank'
+                rel='noopener noreferrer'
+                href='https://github.com/p0dalirius/windows-coerced-authentication-methods'>
+                Windows Coerced Authentication Methods
+            </Link>
+            <br />
+            <Link target='_blank' rel='noopener noreferrer' href='https://github.com/topotam/PetitPotam'>
+                PetitPotam
+            </Link>
+            <br />
+            <Link
+                target='_blank'
+                rel='noopener noreferrer'
+                href='https://github.com/fortra/impacket/blob/master/examples/ntlmrelayx.py'>
+                ntlmrelayx.py
+            </Link>
+            <br />
+            <Link
+                target='_blank'
+                rel='noopener noreferrer'
+                href='https://posts.bluraven.io/detecting-ntlm-relay-attacks-d92e99e68fb9'>
+                Detecting NTLM Relay Attacks
+            </Link>
+        </Box>
+    );
+};
+
+export default References;
@@ -0,0 +1,34 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Typography } from '@mui/material';
+import { FC } from 'react';
+
+const General: FC = () => {
+    return (
+        <>
+            <Typography variant='body2'>
+                The Entra device is the hybrid joined counterpart of the on-prem AD computer.
+            </Typography>
+            <Typography variant='body2'>
+                Both objects represent the same physical machine. Code execution on the device obtained through Entra or
+                Intune management gives control of the operating system, and with it the on-prem computer account.
+            </Typography>
+        </>
+    );
+};
+
+export default General;
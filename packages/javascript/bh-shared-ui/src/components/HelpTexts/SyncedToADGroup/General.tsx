@@ -0,0 +1,33 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Typography } from '@mui/material';
+import { FC } from 'react';
+
+const General: FC = () => {
+    return (
+        <>
+            <Typography variant='body2'>The Entra group is synchronized from the on-prem AD group.</Typography>
+            <Typography variant='body2'>
+                Membership of a synchronized group is managed in on-prem AD and cannot be changed in Entra. If group
+                writeback is configured, membership changes made to the Entra group are written back to the on-prem AD
+                group, so control of the Entra group may grant control of the on-prem group membership.
+            </Typography>
+        </>
+    );
+};
+
+export default General;
@@ -19,10 +19,12 @@ import ADCSESC1 from './ADCSESC1/ADCSESC1';
 import ADCSESC10a from './ADCSESC10a/ADCSESC10a';
 import ADCSESC10b from './ADCSESC10b/ADCSESC10b';
 import ADCSESC13 from './ADCSESC13/ADCSESC13';
+import ADCSESC15 from './ADCSESC15/ADCSESC15';
 import ADCSESC3 from './ADCSESC3/ADCSESC3';
 import ADCSESC4 from './ADCSESC4/ADCSESC4';
 import ADCSESC6a from './ADCSESC6a/ADCSESC6a';
 import ADCSESC6b from './ADCSESC6b/ADCSESC6b';
+import ADCSESC7 from './ADCSESC7/ADCSESC7';
 import ADCSESC9a from './ADCSESC9a/ADCSESC9a';
 import ADCSESC9b from './ADCSESC9b/ADCSESC9b';
 import AZAKSContributor from './AZAKSContributor/AZAKSContributor';
@@ -39,6 +41,7 @@ import AZExecuteCommand from './AZExecuteCommand/AZExecuteCommand';
 import AZGetCertificates from './AZGetCertificates/AZGetCertificates';
 import AZGetKeys from './AZGetKeys/AZGetKeys';
 import AZGetSecrets from './AZGetSecrets/AZGetSecrets';
+import AZGetStorageKeys from './AZGetStorageKeys/AZGetStorageKeys';
 import AZGlobalAdmin from './AZGlobalAdmin/AZGlobalAdmin';
 import AZHasRole from './AZHasRole/AZHasRole';
 import AZKeyVaultKVContributor from './AZKeyVaultKVContributor/AZKeyVaultKVContributor';
@@ -83,6 +86,7 @@ import CanPSRemote from './CanPSRemote/CanPSRemote';
 import CanRDP from './CanRDP/CanRDP';
 import ClaimSpecialIdentity from './ClaimSpecialIdentity/ClaimSpecialIdentity';
 import CoerceAndRelayNTLMToADCS from './CoerceAndRelayNTLMToADCS/CoerceAndRelayNTLMToADCS';
+import CoerceAndRelayNTLMToADCSRPC from './CoerceAndRelayNTLMToADCSRPC/CoerceAndRelayNTLMToADCSRPC';
 import CoerceAndRelayNTLMToLDAP from './CoerceAndRelayNTLMToLDAP/CoerceAndRelayNTLMToLDAP';
 import CoerceAndRelayNTLMToLDAPS from './CoerceAndRelayNTLMToLDAPS/CoerceAndRelayNTLMToLDAPS';
 import CoerceAndRelayNTLMToSMB from './CoerceAndRelayNTLMToSMB/CoerceAndRelayNTLMToSMB';
@@ -127,7 +131,11 @@ import SQLAdmin from './SQLAdmin/SQLAdmin';
 import SameForestTrust from './SameForestTrust/SameForestTrust';
 import SpoofSIDHistory from './SpoofSIDHistory/SpoofSIDHistory';
 import SyncLAPSPassword from './SyncLAPSPassword/SyncLAPSPassword';
+import SyncedToADComputer from './SyncedToADComputer/SyncedToADComputer';
+import SyncedToADGroup from './SyncedToADGroup/SyncedToADGroup';
 import SyncedToADUser from './SyncedToADUser/SyncedToADUser';
+import SyncedToEntraDevice from './SyncedToEntraDevice/SyncedToEntraDevice';
+import SyncedToEntraGroup from './SyncedToEntraGroup/SyncedToEntraGroup';
 import SyncedToEntraUser from './SyncedToEntraUser/SyncedToEntraUser';
 import TrustedForNTAuth from './TrustedForNTAuth/TrustedForNTAuth';
 import WriteAccountRestrictions from './WriteAccountRestrictions/WriteAccountRestrictions';
@@ -195,6 +203,7 @@ const EdgeInfoComponents = {
     AZGetCertificates: AZGetCertificates,
     AZGetKeys: AZGetKeys,
     AZGetSecrets: AZGetSecrets,
+    AZGetStorageKeys: AZGetStorageKeys,
     AZHasRole: AZHasRole,
     AZManagedIdentity: AZManagedIdentity,
     AZMemberOf: AZMemberOf,
@@ -255,11 +264,13 @@ const EdgeInfoComponents = {
     ADCSESC3: ADCSESC3,
     ADCSESC6a: ADCSESC6a,
     ADCSESC6b: ADCSESC6b,
+    ADCSESC7: ADCSESC7,
     ADCSESC9a: ADCSESC9a,
     ADCSESC9b: ADCSESC9b,
     ADCSESC10a: ADCSESC10a,
     ADCSESC10b: ADCSESC10b,
     ADCSESC13: ADCSESC13,
+    ADCSESC15: ADCSESC15,
     ManageCA: ManageCA,
     ManageCertificates: ManageCertificates,
     WritePKIEnrollmentFlag: WritePKIEnrollmentFlag,
@@ -269,10 +280,15 @@ const EdgeInfoComponents = {
     ExtendedByPolicy: ExtendedByPolicy,
     SyncedToADUser: SyncedToADUser,
     SyncedToEntraUser: SyncedToEntraUser,
+    SyncedToADGroup: SyncedToADGroup,
+    SyncedToADComputer: SyncedToADComputer,
+    SyncedToEntraGroup: SyncedToEntraGroup,
+    SyncedToEntraDevice: SyncedToEntraDevice,
     CoerceAndRelayNTLMToSMB: CoerceAndRelayNTLMToSMB,
     CoerceAndRelayNTLMToLDAP: CoerceAndRelayNTLMToLDAP,
     CoerceAndRelayNTLMToLDAPS: CoerceAndRelayNTLMToLDAPS,
     CoerceAndRelayNTLMToADCS: CoerceAndRelayNTLMToADCS,
+    CoerceAndRelayNTLMToADCSRPC: CoerceAndRelayNTLMToADCSRPC,
     ProtectAdminGroups: ProtectAdminGroups,
     ClaimSpecialIdentity: ClaimSpecialIdentity,
     HasTrustKeys: HasTrustKeys,
@@ -36,6 +36,7 @@ export enum FileUploadJobStatus {
     INGESTING = 6,
     ANALYZING = 7,
     PARTIALLY_COMPLETE = 8,
+    PREVIEWED = 9,
 }
 
 export const FileUploadJobStatusToString: Record<FileUploadJobStatus, string> = {
@@ -49,4 +50,5 @@ export const FileUploadJobStatusToString: Record<FileUploadJobStatus, string> =
     6: 'Ingesting',
     7: 'Analyzing',
     8: 'Partially Complete',
+    9: 'Previewed',
 };
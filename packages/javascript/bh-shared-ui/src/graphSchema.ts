@@ -135,14 +135,19 @@ export enum ActiveDirectoryRelationshipKind {
     ADCSESC4 = 'ADCSESC4',
     ADCSESC6a = 'ADCSESC6a',
     ADCSESC6b = 'ADCSESC6b',
+    ADCSESC7 = 'ADCSESC7',
     ADCSESC9a = 'ADCSESC9a',
     ADCSESC9b = 'ADCSESC9b',
     ADCSESC10a = 'ADCSESC10a',
     ADCSESC10b = 'ADCSESC10b',
     ADCSESC13 = 'ADCSESC13',
+    ADCSESC15 = 'ADCSESC15',
     SyncedToADUser = 'SyncedToADUser',
+    SyncedToADGroup = 'SyncedToADGroup',
+    SyncedToADComputer = 'SyncedToADComputer',
     CoerceAndRelayNTLMToSMB = 'CoerceAndRelayNTLMToSMB',
     CoerceAndRelayNTLMToADCS = 'CoerceAndRelayNTLMToADCS',
+    CoerceAndRelayNTLMToADCSRPC = 'CoerceAndRelayNTLMToADCSRPC',
     WriteOwnerLimitedRights = 'WriteOwnerLimitedRights',
     WriteOwnerRaw = 'WriteOwnerRaw',
     OwnsLimitedRights = 'OwnsLimitedRights',
@@ -289,6 +294,8 @@ export function ActiveDirectoryRelationshipKindToDisplay(value: ActiveDirectoryR
             return 'ADCSESC6a';
         case ActiveDirectoryRelationshipKind.ADCSESC6b:
             return 'ADCSESC6b';
+        case ActiveDirectoryRelationshipKind.ADCSESC7:
+            return 'ADCSESC7';
         case ActiveDirectoryRelationshipKind.ADCSESC9a:
             return 'ADCSESC9a';
         case ActiveDirectoryRelationshipKind.ADCSESC9b:
@@ -299,12 +306,20 @@ export function ActiveDirectoryRelationshipKindToDisplay(value: ActiveDirectoryR
             return 'ADCSESC10b';
         case ActiveDirectoryRelationshipKind.ADCSESC13:
             return 'ADCSESC13';
+        case ActiveDirectoryRelationshipKind.ADCSESC15:
+            return 'ADCSESC15';
         case ActiveDirectoryRelationshipKind.SyncedToADUser:
             return 'SyncedToADUser';
+        case ActiveDirectoryRelationshipKind.SyncedToADGroup:
+            return 'SyncedToADGroup';
+        case ActiveDirectoryRelationshipKind.SyncedToADComputer:
+            return 'SyncedToADComputer';
         case ActiveDirectoryRelationshipKind.CoerceAndRelayNTLMToSMB:
             return 'CoerceAndRelayNTLMToSMB';
         case ActiveDirectoryRelationshipKind.CoerceAndRelayNTLMToADCS:
             return 'CoerceAndRelayNTLMToADCS';
+        case ActiveDirectoryRelationshipKind.CoerceAndRelayNTLMToADCSRPC:
+            return 'CoerceAndRelayNTLMToADCSRPC';
         case ActiveDirectoryRelationshipKind.WriteOwnerLimitedRights:
             return 'WriteOwnerLimitedRights';
         case ActiveDirectoryRelationshipKind.WriteOwnerRaw:
@@ -343,13 +358,16 @@ export const EdgeCompositionRelationships = [
     'ADCSESC4',
     'ADCSESC6a',
     'ADCSESC6b',
+    'ADCSESC7',
     'ADCSESC9a',
     'ADCSESC9b',
     'ADCSESC10a',
     'ADCSESC10b',
     'ADCSESC13',
+    'ADCSESC15',
     'CoerceAndRelayNTLMToSMB',
     'CoerceAndRelayNTLMToADCS',
+    'CoerceAndRelayNTLMToADCSRPC',
     'CoerceAndRelayNTLMToLDAP',
     'CoerceAndRelayNTLMToLDAPS',
     'GPOAppliesTo',
@@ -369,6 +387,8 @@ export enum ActiveDirectoryKindProperties {
     IsUserSpecifiesSanEnabledCollected = 'isuserspecifiessanenabledcollected',
     RoleSeparationEnabled = 'roleseparationenabled',
     RoleSeparationEnabledCollected = 'roleseparationenabledcollected',
+    IsESC15Patched = 'isesc15patched',
+    EnforceEncryptICertRequest = 'enforceencrypticertrequest',
     HasBasicConstraints = 'hasbasicconstraints',
     BasicConstraintPathLength = 'basicconstraintpathlength',
     UnresolvedPublishedTemplates = 'unresolvedpublishedtemplates',
@@ -525,6 +545,10 @@ export function ActiveDirectoryKindPropertiesToDisplay(value: ActiveDirectoryKin
             return 'Role Separation Enabled';
         case ActiveDirectoryKindProperties.RoleSeparationEnabledCollected:
             return 'Role Separation Enabled Collected';
+        case ActiveDirectoryKindProperties.IsESC15Patched:
+            return 'Is ESC15 Patched';
+        case ActiveDirectoryKindProperties.EnforceEncryptICertRequest:
+            return 'Enforce Encrypt ICertRequest';
         case ActiveDirectoryKindProperties.HasBasicConstraints:
             return 'Has Basic Constraints';
         case ActiveDirectoryKindProperties.BasicConstraintPathLength:
@@ -822,14 +846,19 @@ export function ActiveDirectoryPathfindingEdges(): ActiveDirectoryRelationshipKi
         ActiveDirectoryRelationshipKind.ADCSESC4,
         ActiveDirectoryRelationshipKind.ADCSESC6a,
         ActiveDirectoryRelationshipKind.ADCSESC6b,
+        ActiveDirectoryRelationshipKind.ADCSESC7,
         ActiveDirectoryRelationshipKind.ADCSESC9a,
         ActiveDirectoryRelationshipKind.ADCSESC9b,
         ActiveDirectoryRelationshipKind.ADCSESC10a,
         ActiveDirectoryRelationshipKind.ADCSESC10b,
         ActiveDirectoryRelationshipKind.ADCSESC13,
+        ActiveDirectoryRelationshipKind.ADCSESC15,
         ActiveDirectoryRelationshipKind.SyncedToADUser,
+        ActiveDirectoryRelationshipKind.SyncedToADGroup,
+        ActiveDirectoryRelationshipKind.SyncedToADComputer,
         ActiveDirectoryRelationshipKind.CoerceAndRelayNTLMToSMB,
         ActiveDirectoryRelationshipKind.CoerceAndRelayNTLMToADCS,
+        ActiveDirectoryRelationshipKind.CoerceAndRelayNTLMToADCSRPC,
         ActiveDirectoryRelationshipKind.WriteOwnerLimitedRights,
         ActiveDirectoryRelationshipKind.OwnsLimitedRights,
         ActiveDirectoryRelationshipKind.ClaimSpecialIdentity,
@@ -888,14 +917,19 @@ export function ActiveDirectoryPathfindingEdgesMatchFrontend(): ActiveDirectoryR
         ActiveDirectoryRelationshipKind.ADCSESC4,
         ActiveDirectoryRelationshipKind.ADCSESC6a,
         ActiveDirectoryRelationshipKind.ADCSESC6b,
+        ActiveDirectoryRelationshipKind.ADCSESC7,
         ActiveDirectoryRelationshipKind.ADCSESC9a,
         ActiveDirectoryRelationshipKind.ADCSESC9b,
         ActiveDirectoryRelationshipKind.ADCSESC10a,
         ActiveDirectoryRelationshipKind.ADCSESC10b,
         ActiveDirectoryRelationshipKind.ADCSESC13,
+        ActiveDirectoryRelationshipKind.ADCSESC15,
         ActiveDirectoryRelationshipKind.SyncedToADUser,
+        ActiveDirectoryRelationshipKind.SyncedToADGroup,
+        ActiveDirectoryRelationshipKind.SyncedToADComputer,
         ActiveDirectoryRelationshipKind.CoerceAndRelayNTLMToSMB,
         ActiveDirectoryRelationshipKind.CoerceAndRelayNTLMToADCS,
+        ActiveDirectoryRelationshipKind.CoerceAndRelayNTLMToADCSRPC,
         ActiveDirectoryRelationshipKind.WriteOwnerLimitedRights,
         ActiveDirectoryRelationshipKind.OwnsLimitedRights,
         ActiveDirectoryRelationshipKind.ClaimSpecialIdentity,
@@ -933,6 +967,9 @@ export enum AzureNodeKind {
     WebApp = 'AZWebApp',
     LogicApp = 'AZLogicApp',
     AutomationAccount = 'AZAutomationAccount',
+    AdministrativeUnit = 'AZAdministrativeUnit',
+    StorageAccount = 'AZStorageAccount',
+    SQLServer = 'AZSQLServer',
 }
 export function AzureNodeKindToDisplay(value: AzureNodeKind): string | undefined {
     switch (value) {
@@ -976,6 +1013,12 @@ export function AzureNodeKindToDisplay(value: AzureNodeKind): string | undefined
             return 'LogicApp';
         case AzureNodeKind.AutomationAccount:
             return 'AutomationAccount';
+        case AzureNodeKind.AdministrativeUnit:
+            return 'AdministrativeUnit';
+        case AzureNodeKind.StorageAccount:
+            return 'StorageAccount';
+        case AzureNodeKind.SQLServer:
+            return 'SQLServer';
         default:
             return undefined;
     }
@@ -1028,8 +1071,12 @@ export enum AzureRelationshipKind {
     AZMGGrantAppRoles = 'AZMGGrantAppRoles',
     AZMGGrantRole = 'AZMGGrantRole',
     SyncedToEntraUser = 'SyncedToEntraUser',
+    SyncedToEntraGroup = 'SyncedToEntraGroup',
+    SyncedToEntraDevice = 'SyncedToEntraDevice',
     AZRoleEligible = 'AZRoleEligible',
     AZRoleApprover = 'AZRoleApprover',
+    GetStorageKeys = 'AZGetStorageKeys',
+    HasScopedRole = 'AZHasScopedRole',
 }
 export function AzureRelationshipKindToDisplay(value: AzureRelationshipKind): string | undefined {
     switch (value) {
@@ -1127,10 +1174,18 @@ export function AzureRelationshipKindToDisplay(value: AzureRelationshipKind): st
             return 'AZMGGrantRole';
         case AzureRelationshipKind.SyncedToEntraUser:
             return 'SyncedToEntraUser';
+        case AzureRelationshipKind.SyncedToEntraGroup:
+            return 'SyncedToEntraGroup';
+        case AzureRelationshipKind.SyncedToEntraDevice:
+            return 'SyncedToEntraDevice';
         case AzureRelationshipKind.AZRoleEligible:
             return 'AZRoleEligible';
         case AzureRelationshipKind.AZRoleApprover:
             return 'AZRoleApprover';
+        case AzureRelationshipKind.GetStorageKeys:
+            return 'GetStorageKeys';
+        case AzureRelationshipKind.HasScopedRole:
+            return 'HasScopedRole';
         default:
             return undefined;
     }
@@ -1177,6 +1232,7 @@ export enum AzureKindProperties {
     EndUserAssignmentRequiresJustification = 'enduserassignmentrequiresjustification',
     EndUserAssignmentRequiresTicketInformation = 'enduserassignmentrequiresticketinformation',
     LastSuccessfulSignInDateTime = 'lastsuccessfulsignindatetime',
+    AllowSharedKeyAccess = 'allowsharedkeyaccess',
 }
 export function AzureKindPropertiesToDisplay(value: AzureKindProperties): string | undefined {
     switch (value) {
@@ -1260,6 +1316,8 @@ export function AzureKindPropertiesToDisplay(value: AzureKindProperties): string
             return 'End User Assignment Requires Ticket Information';
         case AzureKindProperties.LastSuccessfulSignInDateTime:
             return 'Last Successful Sign In Date Time';
+        case AzureKindProperties.AllowSharedKeyAccess:
+            return 'Allow Shared Key Access';
         default:
             return undefined;
     }
@@ -1304,8 +1362,11 @@ export function AzurePathfindingEdges(): AzureRelationshipKind[] {
         AzureRelationshipKind.AZMGGrantAppRoles,
         AzureRelationshipKind.AZMGGrantRole,
         AzureRelationshipKind.SyncedToEntraUser,
+        AzureRelationshipKind.SyncedToEntraGroup,
+        AzureRelationshipKind.SyncedToEntraDevice,
         AzureRelationshipKind.AZRoleEligible,
         AzureRelationshipKind.AZRoleApprover,
+        AzureRelationshipKind.GetStorageKeys,
         AzureRelationshipKind.Contains,
     ];
 }
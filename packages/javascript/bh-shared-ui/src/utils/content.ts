@@ -135,6 +135,13 @@ export const entityInformationEndpoints: Record<EntityKinds, (id: string, option
             undefined,
             options
         ),
+    [AzureNodeKind.StorageAccount]: (id: string, options?: RequestOptions) =>
+        apiClient.getAZEntityInfoV2('storage-accounts', id, undefined, false, undefined, undefined, undefined, options),
+    [AzureNodeKind.SQLServer]: (id: string, options?: RequestOptions) =>
+        apiClient.getAZEntityInfoV2('sql-servers', id, undefined, false, undefined, undefined, undefined, options),
+    // Administrative units do not have a dedicated endpoint so their properties are served by the generic az-base endpoint
+    [AzureNodeKind.AdministrativeUnit]: (id: string, options?: RequestOptions) =>
+        apiClient.getAZEntityInfoV2('az-base', id, undefined, false, undefined, undefined, undefined, options),
     [ActiveDirectoryNodeKind.Entity]: (id: string, options?: RequestOptions) => apiClient.getBaseV2(id, false, options),
     // LocalGroups and LocalUsers are entities that we handle directly and add the `Base` kind to so using getBaseV2 is an assumption but should work
     [ActiveDirectoryNodeKind.LocalGroup]: (id: string, options?: RequestOptions) =>
@@ -326,6 +333,16 @@ export const allSections: Partial<Record<EntityKinds, (id: string) => EntityInfo
                     label: 'Descendant Key Vaults',
                     queryType: 'azmanagementgroup-descendant_key_vaults',
                 },
+                {
+                    id,
+                    label: 'Descendant Storage Accounts',
+                    queryType: 'azmanagementgroup-descendant_storage_accounts',
+                },
+                {
+                    id,
+                    label: 'Descendant SQL Servers',
+                    queryType: 'azmanagementgroup-descendant_sql_servers',
+                },
                 {
                     id,
                     label: 'Descendant Function Apps',
@@ -379,6 +396,16 @@ export const allSections: Partial<Record<EntityKinds, (id: string) => EntityInfo
                     label: 'Descendant Key Vaults',
                     queryType: 'azresourcegroup-descendant_key_vaults',
                 },
+                {
+                    id,
+                    label: 'Descendant Storage Accounts',
+                    queryType: 'azresourcegroup-descendant_storage_accounts',
+                },
+                {
+                    id,
+                    label: 'Descendant SQL Servers',
+                    queryType: 'azresourcegroup-descendant_sql_servers',
+                },
                 {
                     id,
                     label: 'Descendant Web Apps',
@@ -489,6 +516,16 @@ export const allSections: Partial<Record<EntityKinds, (id: string) => EntityInfo
                     label: 'Descendant Key Vaults',
                     queryType: 'azsubscription-descendant_objects-descendant_key_vaults',
                 },
+                {
+                    id,
+                    label: 'Descendant Storage Accounts',
+                    queryType: 'azsubscription-descendant_objects-descendant_storage_accounts',
+                },
+                {
+                    id,
+                    label: 'Descendant SQL Servers',
+                    queryType: 'azsubscription-descendant_objects-descendant_sql_servers',
+                },
                 {
                     id,
                     label: 'Descendant Web Apps',
@@ -577,6 +614,16 @@ export const allSections: Partial<Record<EntityKinds, (id: string) => EntityInfo
                     label: 'Descendant Key Vaults',
                     queryType: 'aztenant-descendant_key_vaults',
                 },
+                {
+                    id,
+                    label: 'Descendant Storage Accounts',
+                    queryType: 'aztenant-descendant_storage_accounts',
+                },
+                {
+                    id,
+                    label: 'Descendant SQL Servers',
+                    queryType: 'aztenant-descendant_sql_servers',
+                },
                 {
                     id,
                     label: 'Descendant Function Apps',
@@ -684,6 +731,25 @@ export const allSections: Partial<Record<EntityKinds, (id: string) => EntityInfo
             queryType: 'azautomationaccount-inbound_object_control',
         },
     ],
+    [AzureNodeKind.StorageAccount]: (id: string) => [
+        {
+            id,
+            label: 'Storage Key Readers',
+            queryType: 'azstorageaccount-storage_key_readers',
+        },
+        {
+            id,
+            label: 'Inbound Object Control',
+            queryType: 'azstorageaccount-inbound_object_control',
+        },
+    ],
+    [AzureNodeKind.SQLServer]: (id: string) => [
+        {
+            id,
+            label: 'Inbound Object Control',
+            queryType: 'azsqlserver-inbound_object_control',
+        },
+    ],
     [ActiveDirectoryNodeKind.Entity]: (id: string) => [
         {
             id,
@@ -1253,6 +1319,18 @@ export const entityRelationshipEndpoints = {
                 signal: controller.signal,
             })
             .then((res) => res.data),
+    'azmanagementgroup-descendant_storage_accounts': ({ id, counts, skip, limit, type }) =>
+        apiClient
+            .getAZEntityInfoV2('management-groups', id, 'descendent-storage-accounts', counts, skip, limit, type, {
+                signal: controller.signal,
+            })
+            .then((res) => res.data),
+    'azmanagementgroup-descendant_sql_servers': ({ id, counts, skip, limit, type }) =>
+        apiClient
+            .getAZEntityInfoV2('management-groups', id, 'descendent-sql-servers', counts, skip, limit, type, {
+                signal: controller.signal,
+            })
+            .then((res) => res.data),
     'azmanagementgroup-descendant_function_apps': ({ id, counts, skip, limit, type }) =>
         apiClient
             .getAZEntityInfoV2('management-groups', id, 'descendent-function-apps', counts, skip, limit, type, {
@@ -1307,6 +1385,18 @@ export const entityRelationshipEndpoints = {
                 signal: controller.signal,
             })
             .then((res) => res.data),
+    'azresourcegroup-descendant_storage_accounts': ({ id, counts, skip, limit, type }) =>
+        apiClient
+            .getAZEntityInfoV2('resource-groups', id, 'descendent-storage-accounts', counts, skip, limit, type, {
+                signal: controller.signal,
+            })
+            .then((res) => res.data),
+    'azresourcegroup-descendant_sql_servers': ({ id, counts, skip, limit, type }) =>
+        apiClient
+            .getAZEntityInfoV2('resource-groups', id, 'descendent-sql-servers', counts, skip, limit, type, {
+                signal: controller.signal,
+            })
+            .then((res) => res.data),
     'azresourcegroup-descendant_web_apps': ({ id, counts, skip, limit, type }) =>
         apiClient
             .getAZEntityInfoV2('resource-groups', id, 'descendent-web-apps', counts, skip, limit, type, {
@@ -1433,6 +1523,18 @@ export const entityRelationshipEndpoints = {
                 signal: controller.signal,
             })
             .then((res) => res.data),
+    'azsubscription-descendant_objects-descendant_storage_accounts': ({ id, counts, skip, limit, type }) =>
+        apiClient
+            .getAZEntityInfoV2('subscriptions', id, 'descendent-storage-accounts', counts, skip, limit, type, {
+                signal: controller.signal,
+            })
+            .then((res) => res.data),
+    'azsubscription-descendant_objects-descendant_sql_servers': ({ id, counts, skip, limit, type }) =>
+        apiClient
+            .getAZEntityInfoV2('subscriptions', id, 'descendent-sql-servers', counts, skip, limit, type, {
+                signal: controller.signal,
+            })
+            .then((res) => res.data),
     'azsubscription-descendant_objects-descendant_web_apps': ({ id, counts, skip, limit, type }) =>
         apiClient
             .getAZEntityInfoV2('subscriptions', id, 'descendent-web-apps', counts, skip, limit, type, {
@@ -1529,6 +1631,18 @@ export const entityRelationshipEndpoints = {
                 signal: controller.signal,
             })
             .then((res) => res.data),
+    'aztenant-descendant_storage_accounts': ({ id, counts, skip, limit, type }) =>
+        apiClient
+            .getAZEntityInfoV2('tenants', id, 'descendent-storage-accounts', counts, skip, limit, type, {
+                signal: controller.signal,
+            })
+            .then((res) => res.data),
+    'aztenant-descendant_sql_servers': ({ id, counts, skip, limit, type }) =>
+        apiClient
+            .getAZEntityInfoV2('tenants', id, 'descendent-sql-servers', counts, skip, limit, type, {
+                signal: controller.signal,
+            })
+            .then((res) => res.data),
     'aztenant-descendant_function_apps': ({ id, counts, skip, limit, type }) =>
         apiClient
             .getAZEntityInfoV2('tenants', id, 'descendent-function-apps', counts, skip, limit, type, {
@@ -1631,6 +1745,27 @@ export const entityRelationshipEndpoints = {
                 signal: controller.signal,
             })
             .then((res) => res.data),
+    'azstorageaccount-storage_key_readers': ({ id, counts, skip, limit, type }) =>
+        apiClient
+            .getAZEntityInfoV2('storage-accounts', id, 'storage-key-readers', counts, skip, limit, type, {
+                signal: controller.signal,
+            })
+            .then((res) => {
+                if (type !== 'graph') res.data.countLabel = 'Storage Key Readers';
+                return res.data;
+            }),
+    'azstorageaccount-inbound_object_control': ({ id, counts, skip, limit, type }) =>
+        apiClient
+            .getAZEntityInfoV2('storage-accounts', id, 'inbound-control', counts, skip, limit, type, {
+                signal: controller.signal,
+            })
+            .then((res) => res.data),
+    'azsqlserver-inbound_object_control': ({ id, counts, skip, limit, type }) =>
+        apiClient
+            .getAZEntityInfoV2('sql-servers', id, 'inbound-control', counts, skip, limit, type, {
+                signal: controller.signal,
+            })
+            .then((res) => res.data),
     'base-outbound_object_control': ({ id, skip, limit, type }) =>
         apiClient.getBaseControllablesV2(id, skip, limit, type, { signal: controller.signal }).then((res) => res.data),
     'base-inbound_object_control': ({ id, skip, limit, type }) =>
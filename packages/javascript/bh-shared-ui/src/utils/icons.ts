@@ -27,11 +27,14 @@ import {
     faCog,
     faCube,
     faCubes,
+    faDatabase,
     faDesktop,
     faGlobe,
+    faHardDrive,
     faIdCard,
     faKey,
     faLandmark,
+    faLayerGroup,
     faList,
     faLock,
     faObjectGroup,
@@ -178,6 +181,21 @@ export const NODE_ICONS: IconDictionary = {
         color: '#F4BA44',
     },
 
+    [AzureNodeKind.AdministrativeUnit]: {
+        icon: faLayerGroup,
+        color: '#7EB2DD',
+    },
+
+    [AzureNodeKind.StorageAccount]: {
+        icon: faHardDrive,
+        color: '#5A9BD5',
+    },
+
+    [AzureNodeKind.SQLServer]: {
+        icon: faDatabase,
+        color: '#D96C8A',
+    },
+
     [AzureNodeKind.FunctionApp]: {
         icon: faBolt,
         color: '#F4BA44',
@@ -70,6 +70,7 @@ export const JOB_STATUS_MAP: Record<number, string> = {
     6: 'Ingesting',
     7: 'Analyzing',
     8: 'Partially Completed',
+    9: 'Previewed',
 } as const satisfies Record<number, string>;
 
 export type JobStatusCode = keyof typeof JOB_STATUS_MAP;
@@ -85,6 +86,7 @@ export const JOB_STATUS_INDICATORS: Record<JobStatusCode, { status: IndicatorTyp
     6: { status: 'pending', pulse: true },
     7: { status: 'pending' },
     8: { status: 'pending' },
+    9: { status: 'good' },
 } as const satisfies Record<JobStatusCode, { status: IndicatorType; pulse?: boolean }>;
 
 export const COLLECTION_MAP: Record<JobCollectionKey, string> = {
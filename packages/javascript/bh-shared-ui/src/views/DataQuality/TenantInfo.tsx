@@ -69,6 +69,12 @@ export const TenantMap = {
     tenants: { displayText: 'Tenants', kind: AzureNodeKind.Tenant },
 };
 
+export const SyncedIdentityMap = {
+    synced_users: { displayText: 'Synced Users', kind: AzureNodeKind.User },
+    synced_groups: { displayText: 'Synced Groups', kind: AzureNodeKind.Group },
+    synced_devices: { displayText: 'Synced Devices', kind: AzureNodeKind.Device },
+};
+
 export const TenantInfo: React.FC<{ contextId: string; headers?: boolean; onDataError?: () => void }> = ({
     contextId,
     headers = false,
@@ -160,6 +166,20 @@ const Layout: React.FC<{
                             value={stats?.relationships}
                             loading={loading}
                         />
+                        {Object.keys(SyncedIdentityMap).map((key) => {
+                            const mapValue = SyncedIdentityMap[key as keyof typeof SyncedIdentityMap];
+                            const value = stats?.[key as keyof AzureDataQualityStat] as number;
+
+                            return (
+                                <LoadContainer
+                                    key={key}
+                                    icon={<NodeIcon nodeType={mapValue.kind} />}
+                                    display={mapValue.displayText}
+                                    value={value}
+                                    loading={loading}
+                                />
+                            );
+                        })}
                     </TableBody>
                 </Table>
             </TableContainer>
@@ -116,11 +116,13 @@ export const BUILTIN_EDGE_CATEGORIES: Category[] = [
                     ActiveDirectoryRelationshipKind.ADCSESC4,
                     ActiveDirectoryRelationshipKind.ADCSESC6a,
                     ActiveDirectoryRelationshipKind.ADCSESC6b,
+                    ActiveDirectoryRelationshipKind.ADCSESC7,
                     ActiveDirectoryRelationshipKind.ADCSESC9a,
                     ActiveDirectoryRelationshipKind.ADCSESC9b,
                     ActiveDirectoryRelationshipKind.ADCSESC10a,
                     ActiveDirectoryRelationshipKind.ADCSESC10b,
                     ActiveDirectoryRelationshipKind.ADCSESC13,
+                    ActiveDirectoryRelationshipKind.ADCSESC15,
                 ],
             },
             {
@@ -132,13 +134,18 @@ export const BUILTIN_EDGE_CATEGORIES: Category[] = [
             },
             {
                 name: 'Cross Platform',
-                edgeTypes: [ActiveDirectoryRelationshipKind.SyncedToADUser],
+                edgeTypes: [
+                    ActiveDirectoryRelationshipKind.SyncedToADUser,
+                    ActiveDirectoryRelationshipKind.SyncedToADGroup,
+                    ActiveDirectoryRelationshipKind.SyncedToADComputer,
+                ],
             },
             {
                 name: 'NTLM Relay',
                 edgeTypes: [
                     ActiveDirectoryRelationshipKind.CoerceAndRelayNTLMToSMB,
                     ActiveDirectoryRelationshipKind.CoerceAndRelayNTLMToADCS,
+                    ActiveDirectoryRelationshipKind.CoerceAndRelayNTLMToADCSRPC,
                     ActiveDirectoryRelationshipKind.CoerceAndRelayNTLMToLDAP,
                     ActiveDirectoryRelationshipKind.CoerceAndRelayNTLMToLDAPS,
                 ],
@@ -195,6 +202,7 @@ export const BUILTIN_EDGE_CATEGORIES: Category[] = [
                     AzureRelationshipKind.GetCertificates,
                     AzureRelationshipKind.GetKeys,
                     AzureRelationshipKind.GetSecrets,
+                    AzureRelationshipKind.GetStorageKeys,
                 ],
             },
             {
@@ -220,7 +228,11 @@ export const BUILTIN_EDGE_CATEGORIES: Category[] = [
             },
             {
                 name: 'Cross Platform',
-                edgeTypes: [AzureRelationshipKind.SyncedToEntraUser],
+                edgeTypes: [
+                    AzureRelationshipKind.SyncedToEntraUser,
+                    AzureRelationshipKind.SyncedToEntraGroup,
+                    AzureRelationshipKind.SyncedToEntraDevice,
+                ],
             },
         ],
     },
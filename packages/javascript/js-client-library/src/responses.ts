@@ -120,6 +120,9 @@ export type AzureDataQualityStat = TimestampFields & {
     managed_clusters: number;
     vm_scale_sets: number;
     web_apps: number;
+    synced_users: number;
+    synced_groups: number;
+    synced_devices: number;
     tenants?: number;
     tenantid?: string;
 };